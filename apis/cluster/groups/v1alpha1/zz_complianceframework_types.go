@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComplianceFrameworkParameters define the desired state of a Gitlab
+// compliance framework. Compliance frameworks belong to a top-level group and
+// can be assigned to all of its projects.
+// https://docs.gitlab.com/user/compliance/compliance_center/compliance_frameworks_report/
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector] required.
+type ComplianceFrameworkParameters struct {
+	// GroupID is the ID or full path of the top-level group.
+	// +optional
+	// +immutable
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// Name of the compliance framework, unique within the group.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Description of the compliance framework.
+	// +kubebuilder:validation:MinLength:=1
+	Description string `json:"description"`
+
+	// Color of the compliance framework label as hexadecimal value, for
+	// example #1aaa55. The case and the short form #1a5 are ignored when
+	// comparing it with GitLab.
+	// +kubebuilder:validation:Pattern:=`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Color string `json:"color"`
+
+	// PipelineConfigurationFullPath is the compliance pipeline configuration
+	// run in the pipelines of the projects, for example
+	// .compliance-gitlab-ci.yml@compliance/pipelines. Requires GitLab
+	// Ultimate.
+	// +optional
+	PipelineConfigurationFullPath *string `json:"pipelineConfigurationFullPath,omitempty"`
+}
+
+// ComplianceFrameworkObservation represents the observed state of a Gitlab
+// compliance framework.
+type ComplianceFrameworkObservation struct {
+	// ID of the compliance framework, used to assign it to projects.
+	ID int64 `json:"id,omitempty"`
+
+	// GroupPath is the full path of the group of the compliance framework.
+	GroupPath string `json:"groupPath,omitempty"`
+}
+
+// A ComplianceFrameworkSpec defines the desired state of a Gitlab compliance
+// framework.
+type ComplianceFrameworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ComplianceFrameworkParameters `json:"forProvider"`
+}
+
+// A ComplianceFrameworkStatus represents the observed state of a Gitlab
+// compliance framework.
+type ComplianceFrameworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ComplianceFrameworkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ComplianceFramework is a managed resource that represents a Gitlab
+// compliance framework. Its external name is the ID of the framework.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ComplianceFramework struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComplianceFrameworkSpec   `json:"spec"`
+	Status ComplianceFrameworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComplianceFrameworkList contains a list of ComplianceFramework items
+type ComplianceFrameworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceFramework `json:"items"`
+}
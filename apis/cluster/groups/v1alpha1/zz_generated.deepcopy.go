@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -348,6 +350,149 @@ func (in *BadgeStatus) DeepCopy() *BadgeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFramework) DeepCopyInto(out *ComplianceFramework) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFramework.
+func (in *ComplianceFramework) DeepCopy() *ComplianceFramework {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFramework)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComplianceFramework) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkList) DeepCopyInto(out *ComplianceFrameworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComplianceFramework, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkList.
+func (in *ComplianceFrameworkList) DeepCopy() *ComplianceFrameworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComplianceFrameworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkObservation) DeepCopyInto(out *ComplianceFrameworkObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkObservation.
+func (in *ComplianceFrameworkObservation) DeepCopy() *ComplianceFrameworkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkParameters) DeepCopyInto(out *ComplianceFrameworkParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PipelineConfigurationFullPath != nil {
+		in, out := &in.PipelineConfigurationFullPath, &out.PipelineConfigurationFullPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkParameters.
+func (in *ComplianceFrameworkParameters) DeepCopy() *ComplianceFrameworkParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkSpec) DeepCopyInto(out *ComplianceFrameworkSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkSpec.
+func (in *ComplianceFrameworkSpec) DeepCopy() *ComplianceFrameworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkStatus) DeepCopyInto(out *ComplianceFrameworkStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkStatus.
+func (in *ComplianceFrameworkStatus) DeepCopy() *ComplianceFrameworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
 	*out = *in
@@ -542,6 +687,339 @@ func (in *Group) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAvatar) DeepCopyInto(out *GroupAvatar) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(commonv1alpha1.ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAvatar.
+func (in *GroupAvatar) DeepCopy() *GroupAvatar {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupAvatar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHook) DeepCopyInto(out *GroupHook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHook.
+func (in *GroupHook) DeepCopy() *GroupHook {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupHook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHookList) DeepCopyInto(out *GroupHookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHookList.
+func (in *GroupHookList) DeepCopy() *GroupHookList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupHookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHookObservation) DeepCopyInto(out *GroupHookObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomHeaderKeys != nil {
+		in, out := &in.CustomHeaderKeys, &out.CustomHeaderKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenRotatedAt != nil {
+		in, out := &in.TokenRotatedAt, &out.TokenRotatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHookObservation.
+func (in *GroupHookObservation) DeepCopy() *GroupHookObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHookObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHookParameters) DeepCopyInto(out *GroupHookParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.PushEvents != nil {
+		in, out := &in.PushEvents, &out.PushEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PushEventsBranchFilter != nil {
+		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.BranchFilterStrategy != nil {
+		in, out := &in.BranchFilterStrategy, &out.BranchFilterStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuesEvents != nil {
+		in, out := &in.IssuesEvents, &out.IssuesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfidentialIssuesEvents != nil {
+		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsEvents != nil {
+		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TagPushEvents != nil {
+		in, out := &in.TagPushEvents, &out.TagPushEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoteEvents != nil {
+		in, out := &in.NoteEvents, &out.NoteEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfidentialNoteEvents != nil {
+		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JobEvents != nil {
+		in, out := &in.JobEvents, &out.JobEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PipelineEvents != nil {
+		in, out := &in.PipelineEvents, &out.PipelineEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WikiPageEvents != nil {
+		in, out := &in.WikiPageEvents, &out.WikiPageEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeploymentEvents != nil {
+		in, out := &in.DeploymentEvents, &out.DeploymentEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FeatureFlagEvents != nil {
+		in, out := &in.FeatureFlagEvents, &out.FeatureFlagEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReleasesEvents != nil {
+		in, out := &in.ReleasesEvents, &out.ReleasesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MilestoneEvents != nil {
+		in, out := &in.MilestoneEvents, &out.MilestoneEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubGroupEvents != nil {
+		in, out := &in.SubGroupEvents, &out.SubGroupEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProjectEvents != nil {
+		in, out := &in.ProjectEvents, &out.ProjectEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MemberEvents != nil {
+		in, out := &in.MemberEvents, &out.MemberEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmojiEvents != nil {
+		in, out := &in.EmojiEvents, &out.EmojiEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VulnerabilityEvents != nil {
+		in, out := &in.VulnerabilityEvents, &out.VulnerabilityEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceAccessTokenEvents != nil {
+		in, out := &in.ResourceAccessTokenEvents, &out.ResourceAccessTokenEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSSLVerification != nil {
+		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.RotateEvery != nil {
+		in, out := &in.RotateEvery, &out.RotateEvery
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CustomWebhookTemplate != nil {
+		in, out := &in.CustomWebhookTemplate, &out.CustomWebhookTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomHeaders != nil {
+		in, out := &in.CustomHeaders, &out.CustomHeaders
+		*out = make([]HookCustomHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHookParameters.
+func (in *GroupHookParameters) DeepCopy() *GroupHookParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHookParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHookSpec) DeepCopyInto(out *GroupHookSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHookSpec.
+func (in *GroupHookSpec) DeepCopy() *GroupHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupHookStatus) DeepCopyInto(out *GroupHookStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupHookStatus.
+func (in *GroupHookStatus) DeepCopy() *GroupHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupList) DeepCopyInto(out *GroupList) {
 	*out = *in
@@ -727,66 +1205,229 @@ func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RequestAccessEnabled != nil {
-		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
-		*out = new(bool)
-		**out = **in
+	if in.RequestAccessEnabled != nil {
+		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParentIDRef != nil {
+		in, out := &in.ParentIDRef, &out.ParentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentIDSelector != nil {
+		in, out := &in.ParentIDSelector, &out.ParentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedRunnersMinutesLimit != nil {
+		in, out := &in.SharedRunnersMinutesLimit, &out.SharedRunnersMinutesLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ExtraSharedRunnersMinutesLimit != nil {
+		in, out := &in.ExtraSharedRunnersMinutesLimit, &out.ExtraSharedRunnersMinutesLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DuoFeaturesEnabled != nil {
+		in, out := &in.DuoFeaturesEnabled, &out.DuoFeaturesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockDuoFeaturesEnabled != nil {
+		in, out := &in.LockDuoFeaturesEnabled, &out.LockDuoFeaturesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExperimentFeaturesEnabled != nil {
+		in, out := &in.ExperimentFeaturesEnabled, &out.ExperimentFeaturesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Avatar != nil {
+		in, out := &in.Avatar, &out.Avatar
+		*out = new(GroupAvatar)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroups, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PermanentlyRemove != nil {
+		in, out := &in.PermanentlyRemove, &out.PermanentlyRemove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveFinalizerOnPendingDeletion != nil {
+		in, out := &in.RemoveFinalizerOnPendingDeletion, &out.RemoveFinalizerOnPendingDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullPathToRemove != nil {
+		in, out := &in.FullPathToRemove, &out.FullPathToRemove
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
+func (in *GroupParameters) DeepCopy() *GroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRules) DeepCopyInto(out *GroupPushRules) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRules.
+func (in *GroupPushRules) DeepCopy() *GroupPushRules {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPushRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupPushRules) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRulesList) DeepCopyInto(out *GroupPushRulesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupPushRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRulesList.
+func (in *GroupPushRulesList) DeepCopy() *GroupPushRulesList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPushRulesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupPushRulesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRulesObservation) DeepCopyInto(out *GroupPushRulesObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.ParentID != nil {
-		in, out := &in.ParentID, &out.ParentID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRulesObservation.
+func (in *GroupPushRulesObservation) DeepCopy() *GroupPushRulesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPushRulesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRulesParameters) DeepCopyInto(out *GroupPushRulesParameters) {
+	*out = *in
+	in.PushRules.DeepCopyInto(&out.PushRules)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
 		*out = new(int64)
 		**out = **in
 	}
-	if in.ParentIDRef != nil {
-		in, out := &in.ParentIDRef, &out.ParentIDRef
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ParentIDSelector != nil {
-		in, out := &in.ParentIDSelector, &out.ParentIDSelector
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SharedRunnersMinutesLimit != nil {
-		in, out := &in.SharedRunnersMinutesLimit, &out.SharedRunnersMinutesLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ExtraSharedRunnersMinutesLimit != nil {
-		in, out := &in.ExtraSharedRunnersMinutesLimit, &out.ExtraSharedRunnersMinutesLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SharedWithGroups != nil {
-		in, out := &in.SharedWithGroups, &out.SharedWithGroups
-		*out = make([]SharedWithGroups, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.PermanentlyRemove != nil {
-		in, out := &in.PermanentlyRemove, &out.PermanentlyRemove
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RemoveFinalizerOnPendingDeletion != nil {
-		in, out := &in.RemoveFinalizerOnPendingDeletion, &out.RemoveFinalizerOnPendingDeletion
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRulesParameters.
+func (in *GroupPushRulesParameters) DeepCopy() *GroupPushRulesParameters {
+	if in == nil {
+		return nil
 	}
-	if in.FullPathToRemove != nil {
-		in, out := &in.FullPathToRemove, &out.FullPathToRemove
-		*out = new(string)
-		**out = **in
+	out := new(GroupPushRulesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRulesSpec) DeepCopyInto(out *GroupPushRulesSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRulesSpec.
+func (in *GroupPushRulesSpec) DeepCopy() *GroupPushRulesSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(GroupPushRulesSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
-func (in *GroupParameters) DeepCopy() *GroupParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPushRulesStatus) DeepCopyInto(out *GroupPushRulesStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPushRulesStatus.
+func (in *GroupPushRulesStatus) DeepCopy() *GroupPushRulesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupParameters)
+	out := new(GroupPushRulesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -825,6 +1466,22 @@ func (in *GroupStatus) DeepCopy() *GroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookCustomHeader) DeepCopyInto(out *HookCustomHeader) {
+	*out = *in
+	in.ValueSecretRef.DeepCopyInto(&out.ValueSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookCustomHeader.
+func (in *HookCustomHeader) DeepCopy() *HookCustomHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HookCustomHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LDAPGroupLink) DeepCopyInto(out *LDAPGroupLink) {
 	*out = *in
@@ -1496,6 +2153,186 @@ func (in *ServiceAccount) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessToken) DeepCopyInto(out *ServiceAccountAccessToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessToken.
+func (in *ServiceAccountAccessToken) DeepCopy() *ServiceAccountAccessToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountAccessToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessTokenList) DeepCopyInto(out *ServiceAccountAccessTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccountAccessToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessTokenList.
+func (in *ServiceAccountAccessTokenList) DeepCopy() *ServiceAccountAccessTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountAccessTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessTokenObservation) DeepCopyInto(out *ServiceAccountAccessTokenObservation) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessTokenObservation.
+func (in *ServiceAccountAccessTokenObservation) DeepCopy() *ServiceAccountAccessTokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessTokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessTokenParameters) DeepCopyInto(out *ServiceAccountAccessTokenParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountID != nil {
+		in, out := &in.ServiceAccountID, &out.ServiceAccountID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ServiceAccountIDRef != nil {
+		in, out := &in.ServiceAccountIDRef, &out.ServiceAccountIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountIDSelector != nil {
+		in, out := &in.ServiceAccountIDSelector, &out.ServiceAccountIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessTokenParameters.
+func (in *ServiceAccountAccessTokenParameters) DeepCopy() *ServiceAccountAccessTokenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessTokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessTokenSpec) DeepCopyInto(out *ServiceAccountAccessTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessTokenSpec.
+func (in *ServiceAccountAccessTokenSpec) DeepCopy() *ServiceAccountAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAccessTokenStatus) DeepCopyInto(out *ServiceAccountAccessTokenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAccessTokenStatus.
+func (in *ServiceAccountAccessTokenStatus) DeepCopy() *ServiceAccountAccessTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAccessTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountList) DeepCopyInto(out *ServiceAccountList) {
 	*out = *in
@@ -1796,11 +2633,21 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupIDSearch != nil {
+		in, out := &in.GroupIDSearch, &out.GroupIDSearch
+		*out = new(commonv1alpha1.GitLabSearch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnvironmentScope != nil {
 		in, out := &in.EnvironmentScope, &out.EnvironmentScope
 		*out = new(string)
 		**out = **in
 	}
+	if in.PruneOtherScopes != nil {
+		in, out := &in.PruneOtherScopes, &out.PruneOtherScopes
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
@@ -21,6 +21,8 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 )
 
 // VisibilityValue represents a visibility level within GitLab.
@@ -157,6 +159,31 @@ type GroupParameters struct {
 	// +optional
 	ExtraSharedRunnersMinutesLimit *int64 `json:"extraSharedRunnersMinutesLimit,omitempty"`
 
+	// DuoFeaturesEnabled enables GitLab Duo features for the group.
+	// Requires GitLab 16.10 or later and a license including GitLab Duo.
+	// Left unchanged if not set.
+	// +optional
+	DuoFeaturesEnabled *bool `json:"duoFeaturesEnabled,omitempty"`
+
+	// LockDuoFeaturesEnabled enforces duoFeaturesEnabled on all subgroups
+	// and projects of the group.
+	// Requires GitLab 16.10 or later and a license including GitLab Duo.
+	// Left unchanged if not set.
+	// +optional
+	LockDuoFeaturesEnabled *bool `json:"lockDuoFeaturesEnabled,omitempty"`
+
+	// ExperimentFeaturesEnabled enables experiment and beta features, such
+	// as AI features that are not generally available, for the group and its
+	// subgroups and projects. GitLab Ultimate and top-level groups only.
+	// Left unchanged if not set.
+	// +optional
+	ExperimentFeaturesEnabled *bool `json:"experimentFeaturesEnabled,omitempty"`
+
+	// Avatar of the group. The avatar is not managed if omitted and removed
+	// if set without a source.
+	// +optional
+	Avatar *GroupAvatar `json:"avatar,omitempty"`
+
 	// SharedWithGroups create links for sharing a group with another group.
 	// +optional
 	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups,omitempty"`
@@ -177,6 +204,21 @@ type GroupParameters struct {
 	FullPathToRemove *string `json:"fullPathToRemove,omitempty"`
 }
 
+// GroupAvatar references an image to use as group avatar. At most one of
+// SecretRef or ConfigMapRef may be set. The image must be a PNG, JPEG, GIF,
+// BMP, ICO or WebP file of at most 200 KiB.
+// +kubebuilder:validation:XValidation:rule="!(has(self.secretRef) && has(self.configMapRef))",message="at most one of secretRef or configMapRef may be set"
+type GroupAvatar struct {
+	// SecretRef references a key of a Secret holding the image.
+	// +optional
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// ConfigMapRef references a key of a ConfigMap holding the image,
+	// usually in its binaryData.
+	// +optional
+	ConfigMapRef *commonv1alpha1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
 // AccessLevelValue represents a permission level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/permissions/permissions.html
@@ -264,6 +306,14 @@ type GroupObservation struct {
 	MarkedForDeletionOn *metav1.Time                  `json:"markedForDeletionOn,omitempty"`
 	CreatedAt           *metav1.Time                  `json:"createdAt,omitempty"`
 	SharedWithGroups    []SharedWithGroupsObservation `json:"sharedWithGroups,omitempty"`
+
+	// AvatarHash is the SHA-256 hash of the avatar image last uploaded to
+	// GitLab.
+	AvatarHash string `json:"avatarHash,omitempty"`
+
+	// AvatarURLChecked is the avatar URL last found to hold the image of
+	// avatarHash. The avatar is only downloaded again once its URL changes.
+	AvatarURLChecked string `json:"avatarUrlChecked,omitempty"`
 }
 
 // SharedWithGroupsObservation is the observed state of a SharedWithGroups.
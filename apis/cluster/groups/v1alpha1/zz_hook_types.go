@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GroupHookParameters define the desired state of a Gitlab group hook. Group
+// hooks are triggered by events of all projects and subgroups of the group.
+// https://docs.gitlab.com/api/group_webhooks/
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector] required.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.urlSecretRef)",message="exactly one of url or urlSecretRef is required"
+type GroupHookParameters struct {
+	// GroupID is the ID or URL-encoded path of the group.
+	// +optional
+	// +immutable
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// URL is the hook URL.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// URLSecretRef references a secret key holding the hook URL, e.g. if it
+	// carries a token or an internal hostname. The URL is read at every
+	// reconciliation and never written to the spec or status.
+	// +optional
+	URLSecretRef *xpv1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Name of the hook.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the hook.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// PushEvents triggers hook on push events.
+	// +optional
+	PushEvents *bool `json:"pushEvents,omitempty"`
+
+	// PushEventsBranchFilter triggers hook on push events for matching
+	// branches only.
+	// +optional
+	PushEventsBranchFilter *string `json:"pushEventsBranchFilter,omitempty"`
+
+	// BranchFilterStrategy selects how PushEventsBranchFilter is matched.
+	// +kubebuilder:validation:Enum:=wildcard;regex;all_branches
+	// +optional
+	BranchFilterStrategy *string `json:"branchFilterStrategy,omitempty"`
+
+	// IssuesEvents triggers hook on issues events.
+	// +optional
+	IssuesEvents *bool `json:"issuesEvents,omitempty"`
+
+	// ConfidentialIssuesEvents triggers hook on confidential issues events.
+	// +optional
+	ConfidentialIssuesEvents *bool `json:"confidentialIssuesEvents,omitempty"`
+
+	// MergeRequestsEvents triggers hook on merge requests events.
+	// +optional
+	MergeRequestsEvents *bool `json:"mergeRequestsEvents,omitempty"`
+
+	// TagPushEvents triggers hook on tag push events.
+	// +optional
+	TagPushEvents *bool `json:"tagPushEvents,omitempty"`
+
+	// NoteEvents triggers hook on note events.
+	// +optional
+	NoteEvents *bool `json:"noteEvents,omitempty"`
+
+	// ConfidentialNoteEvents triggers hook on confidential note events.
+	// +optional
+	ConfidentialNoteEvents *bool `json:"confidentialNoteEvents,omitempty"`
+
+	// JobEvents triggers hook on job events.
+	// +optional
+	JobEvents *bool `json:"jobEvents,omitempty"`
+
+	// PipelineEvents triggers hook on pipeline events.
+	// +optional
+	PipelineEvents *bool `json:"pipelineEvents,omitempty"`
+
+	// WikiPageEvents triggers hook on wiki events.
+	// +optional
+	WikiPageEvents *bool `json:"wikiPageEvents,omitempty"`
+
+	// DeploymentEvents triggers hook on deployment events.
+	// +optional
+	DeploymentEvents *bool `json:"deploymentEvents,omitempty"`
+
+	// FeatureFlagEvents triggers hook on feature flag events.
+	// +optional
+	FeatureFlagEvents *bool `json:"featureFlagEvents,omitempty"`
+
+	// ReleasesEvents triggers hook on release events.
+	// +optional
+	ReleasesEvents *bool `json:"releasesEvents,omitempty"`
+
+	// MilestoneEvents triggers hook on milestone events.
+	// +optional
+	MilestoneEvents *bool `json:"milestoneEvents,omitempty"`
+
+	// SubGroupEvents triggers hook on subgroup events.
+	// +optional
+	SubGroupEvents *bool `json:"subGroupEvents,omitempty"`
+
+	// ProjectEvents triggers hook on project events.
+	// +optional
+	ProjectEvents *bool `json:"projectEvents,omitempty"`
+
+	// MemberEvents triggers hook on member events.
+	// +optional
+	MemberEvents *bool `json:"memberEvents,omitempty"`
+
+	// EmojiEvents triggers hook on emoji events.
+	// +optional
+	EmojiEvents *bool `json:"emojiEvents,omitempty"`
+
+	// VulnerabilityEvents triggers hook on vulnerability events.
+	// +optional
+	VulnerabilityEvents *bool `json:"vulnerabilityEvents,omitempty"`
+
+	// ResourceAccessTokenEvents triggers hook on project and group access
+	// token expiry events.
+	// +optional
+	ResourceAccessTokenEvents *bool `json:"resourceAccessTokenEvents,omitempty"`
+
+	// EnableSSLVerification enables SSL verification when triggering the hook.
+	// +optional
+	EnableSSLVerification *bool `json:"enableSslVerification,omitempty"`
+
+	// TokenSecretRef references the secret token to validate received
+	// payloads. GitLab does not return the token, so it is only pushed when
+	// the hook is created or updated for other reasons.
+	// +optional
+	TokenSecretRef *xpv1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+
+	// RotateEvery makes the provider generate the secret token of the hook
+	// and replace it once the duration, e.g. 720h, elapsed since the last
+	// rotation. The token is written to the connection secret under token,
+	// and the token it replaced under previousToken, so that receivers can
+	// accept both until the next rotation. It cannot be combined with tokenSecretRef.
+	// +optional
+	RotateEvery *metav1.Duration `json:"rotateEvery,omitempty"`
+
+	// CustomWebhookTemplate is the custom payload template of the hook.
+	// +optional
+	CustomWebhookTemplate *string `json:"customWebhookTemplate,omitempty"`
+
+	// CustomHeaders are sent with every request of the hook. GitLab does
+	// not return their values, so they are only pushed when the referenced
+	// values change.
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	CustomHeaders []HookCustomHeader `json:"customHeaders,omitempty"`
+}
+
+// HookCustomHeader is a custom header sent with the requests of a hook.
+type HookCustomHeader struct {
+	// Key is the name of the header.
+	// +kubebuilder:validation:MinLength:=1
+	Key string `json:"key"`
+
+	// ValueSecretRef references the value of the header.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// GroupHookObservation represents the observed state of a Gitlab group hook.
+type GroupHookObservation struct {
+	// ID of the group hook at gitlab
+	ID int64 `json:"id,omitempty"`
+
+	// GroupID is the ID of the group of the hook.
+	GroupID int64 `json:"groupId,omitempty"`
+
+	// CreatedAt specifies the time the group hook was created
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// AlertStatus is executable while the hook is triggered, or
+	// temporarily_disabled or disabled after repeated failures.
+	AlertStatus string `json:"alertStatus,omitempty"`
+
+	// CustomHeaderKeys are the keys of the custom headers of the hook.
+	CustomHeaderKeys []string `json:"customHeaderKeys,omitempty"`
+
+	// CustomHeadersHash is the SHA-256 hash of the custom headers last
+	// pushed to GitLab. It is used to detect value changes without
+	// comparing secrets against GitLab.
+	CustomHeadersHash string `json:"customHeadersHash,omitempty"`
+
+	// TokenRotatedAt is the time the provider last generated the secret
+	// token of the hook, see rotateEvery.
+	TokenRotatedAt *metav1.Time `json:"tokenRotatedAt,omitempty"`
+}
+
+// A GroupHookSpec defines the desired state of a Gitlab group hook.
+type GroupHookSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupHookParameters `json:"forProvider"`
+}
+
+// A GroupHookStatus represents the observed state of a Gitlab group hook.
+type GroupHookStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupHookObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupHook is a managed resource that represents a Gitlab group hook. Its
+// external name is the ID of the hook.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type GroupHook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupHookSpec   `json:"spec"`
+	Status GroupHookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupHookList contains a list of GroupHook items
+type GroupHookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupHook `json:"items"`
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceAccountAccessTokenParameters define the desired state of a personal
+// access token of a Gitlab group service account
+// https://docs.gitlab.com/ee/api/group_service_accounts.html
+type ServiceAccountAccessTokenParameters struct {
+	// GroupID is the ID of the group owning the service account.
+	// +optional
+	// +immutable
+	GroupID *int64 `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// ServiceAccountID is the user ID of the service account to create the
+	// access token for.
+	// +optional
+	// +immutable
+	ServiceAccountID *int64 `json:"serviceAccountId,omitempty"`
+
+	// ServiceAccountIDRef is a reference to a group service account to
+	// retrieve its serviceAccountId
+	// +optional
+	// +immutable
+	ServiceAccountIDRef *xpv1.Reference `json:"serviceAccountIdRef,omitempty"`
+
+	// ServiceAccountIDSelector selects reference to a group service account
+	// to retrieve its serviceAccountId.
+	// +optional
+	ServiceAccountIDSelector *xpv1.Selector `json:"serviceAccountIdSelector,omitempty"`
+
+	// ExpiresAt is the expiration date of the access token in ISO 8601 format (2019-03-15T08:00:00Z).
+	// The date cannot be set later than the maximum allowable lifetime of an access token.
+	// A new token is created once the token expired.
+	// +optional
+	// +immutable
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Scopes indicates the access token scopes, e.g. api or read_repository.
+	// +immutable
+	Scopes []string `json:"scopes"`
+
+	// Name of the access token
+	// +required
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the access token
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+}
+
+// ServiceAccountAccessTokenObservation represents a personal access token of a
+// group service account.
+type ServiceAccountAccessTokenObservation struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	UserID      int64        `json:"userId"`
+	Scopes      []string     `json:"scopes"`
+	ExpiresAt   *metav1.Time `json:"expiresAt,omitempty"`
+	Active      bool         `json:"active"`
+	CreatedAt   *metav1.Time `json:"createdAt"`
+	Revoked     bool         `json:"revoked"`
+}
+
+// A ServiceAccountAccessTokenSpec defines the desired state of a personal
+// access token of a Gitlab group service account.
+type ServiceAccountAccessTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceAccountAccessTokenParameters `json:"forProvider"`
+}
+
+// A ServiceAccountAccessTokenStatus represents the observed state of a
+// personal access token of a Gitlab group service account.
+type ServiceAccountAccessTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAccountAccessTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceAccountAccessToken is a managed resource that represents a personal
+// access token of a Gitlab group service account. The token is published to
+// the connection secret under the key "token".
+// This is only available with at least a Premium license.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ServiceAccountAccessToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountAccessTokenSpec   `json:"spec"`
+	Status ServiceAccountAccessTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountAccessTokenList contains a list of ServiceAccountAccessToken items
+type ServiceAccountAccessTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountAccessToken `json:"items"`
+}
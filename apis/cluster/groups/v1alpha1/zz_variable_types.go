@@ -50,16 +50,31 @@ type VariableParameters struct {
 	// +optional
 	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
 
+	// GroupIDSearch finds the group by its name, path or parent namespace in
+	// GitLab to retrieve its groupId, if neither groupId nor a reference
+	// sets it.
+	// +optional
+	GroupIDSearch *v1alpha1.GitLabSearch `json:"groupIdSearch,omitempty"`
+
 	// EnvironmentScope indicates the environment scope of a variable.
 	// +optional
 	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// PruneOtherScopes also removes the variables with the same key in all
+	// other environment scopes when the variable is deleted, e.g. leftovers
+	// of manual edits. Variables with the same key managed by other
+	// resources are removed as well. Defaults to false.
+	// +optional
+	PruneOtherScopes *bool `json:"pruneOtherScopes,omitempty"`
 }
 
 // VariableObservation represents the observed state of a Gitlab CI Variable.
 type VariableObservation struct {
 	v1alpha1.CommonVariableObservation `json:",inline"`
-	EnvironmentScope                   string `json:"environmentScope"`
-	Hidden                             bool   `json:"hidden"`
+	// EnvironmentScope is the environment scope the variable resolved to.
+	EnvironmentScope string `json:"environmentScope"`
+	// Hidden indicates the variable value is hidden in the UI and API.
+	Hidden bool `json:"hidden"`
 }
 
 // A VariableSpec defines the desired state of a Gitlab Group CI
@@ -84,6 +99,12 @@ type VariableStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".status.atProvider.key"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.variableType"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".status.atProvider.environmentScope"
+// +kubebuilder:printcolumn:name="MASKED",type="boolean",JSONPath=".status.atProvider.masked"
+// +kubebuilder:printcolumn:name="PROTECTED",type="boolean",JSONPath=".status.atProvider.protected"
+// +kubebuilder:printcolumn:name="RAW",type="boolean",JSONPath=".status.atProvider.raw",priority=1
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type Variable struct {
@@ -38,6 +38,15 @@ func (l *ApplicationSettingsList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this FeatureList.
+func (l *FeatureList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this LicenseList.
 func (l *LicenseList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -65,6 +74,24 @@ func (l *ServiceAccountList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this TopicList.
+func (l *TopicList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this UserImpersonationTokenList.
+func (l *UserImpersonationTokenList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this VariableList.
 func (l *VariableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -87,6 +87,30 @@ var (
 	VariableGroupVersionKind = SchemeGroupVersion.WithKind(VariableKind)
 )
 
+// Feature type metadata
+var (
+	FeatureKind             = reflect.TypeOf(Feature{}).Name()
+	FeatureGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureKind}.String()
+	FeatureKindAPIVersion   = FeatureKind + "." + SchemeGroupVersion.String()
+	FeatureGroupVersionKind = SchemeGroupVersion.WithKind(FeatureKind)
+)
+
+// Topic type metadata
+var (
+	TopicKind             = reflect.TypeOf(Topic{}).Name()
+	TopicGroupKind        = schema.GroupKind{Group: Group, Kind: TopicKind}.String()
+	TopicKindAPIVersion   = TopicKind + "." + SchemeGroupVersion.String()
+	TopicGroupVersionKind = SchemeGroupVersion.WithKind(TopicKind)
+)
+
+// UserImpersonationToken type metadata
+var (
+	UserImpersonationTokenKind             = reflect.TypeOf(UserImpersonationToken{}).Name()
+	UserImpersonationTokenGroupKind        = schema.GroupKind{Group: Group, Kind: UserImpersonationTokenKind}.String()
+	UserImpersonationTokenKindAPIVersion   = UserImpersonationTokenKind + "." + SchemeGroupVersion.String()
+	UserImpersonationTokenGroupVersionKind = SchemeGroupVersion.WithKind(UserImpersonationTokenKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ApplicationSettings{}, &ApplicationSettingsList{})
 	SchemeBuilder.Register(&Runner{}, &RunnerList{})
@@ -94,4 +118,7 @@ func init() {
 	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{})
 	SchemeBuilder.Register(&License{}, &LicenseList{})
 	SchemeBuilder.Register(&Variable{}, &VariableList{})
+	SchemeBuilder.Register(&Feature{}, &FeatureList{})
+	SchemeBuilder.Register(&Topic{}, &TopicList{})
+	SchemeBuilder.Register(&UserImpersonationToken{}, &UserImpersonationTokenList{})
 }
@@ -26,6 +26,7 @@ import (
 // DeployKeyParameters define desired state of Gitlab Deploy Key.
 // https://docs.gitlab.com/ee/api/deploy_keys.html
 // At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// +kubebuilder:validation:XValidation:rule="has(self.keySecretRef) != has(self.deployKeyId)",message="exactly one of keySecretRef and deployKeyId must be set"
 type DeployKeyParameters struct {
 	// The ID or URL-encoded path of the project owned by the authenticated user.
 	// +optional
@@ -56,11 +57,24 @@ type DeployKeyParameters struct {
 	// Expiration date for the Deploy Key. Does not expire if no value is provided.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z).
 	// +optional
+	// +immutable
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 
-	// KeySecretRef field representing reference to the key.
-	// This property is required.
-	KeySecretRef xpv1.SecretKeySelector `json:"keySecretRef"`
+	// KeySecretRef field representing reference to the key. GitLab cannot
+	// update the key of a Deploy Key, changing it requires creating the
+	// Deploy Key again. A Deploy Key of the project with the same fingerprint
+	// is adopted instead of adding the key again.
+	// Mutually exclusive with DeployKeyID.
+	// +optional
+	// +immutable
+	KeySecretRef *xpv1.SecretKeySelector `json:"keySecretRef,omitempty"`
+
+	// DeployKeyID is the ID of an existing Deploy Key, e.g. one added to
+	// another project, to enable on the project instead of adding a key.
+	// Mutually exclusive with KeySecretRef.
+	// +optional
+	// +immutable
+	DeployKeyID *int64 `json:"deployKeyId,omitempty"`
 }
 
 // DeployKeyObservation represents observed stated of Deploy Key.
@@ -68,6 +82,9 @@ type DeployKeyParameters struct {
 type DeployKeyObservation struct {
 	ID        *int64       `json:"id,omitempty"`
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// FingerprintSHA256 is the SHA256 fingerprint of the key.
+	FingerprintSHA256 string `json:"fingerprintSha256,omitempty"`
 }
 
 // DeployKeySpec defines desired state of Gitlab Deploy Key.
@@ -53,8 +53,12 @@ type DeployTokenParameters struct {
 
 	// Scopes indicates the deploy token scopes.
 	// Must be at least one of read_repository, read_registry, write_registry,
-	// read_package_registry, or write_package_registry.
+	// read_package_registry, or write_package_registry. Changing the scopes
+	// creates a new token, see the recreate-on-immutable-change annotation.
 	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=read_repository;read_registry;write_registry;read_package_registry;write_package_registry
+	// +listType=set
 	Scopes []string `json:"scopes"`
 }
 
@@ -29,6 +29,15 @@ func (l *AccessTokenList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ApprovalConfigurationList.
+func (l *ApprovalConfigurationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ApprovalRuleList.
 func (l *ApprovalRuleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -47,6 +56,15 @@ func (l *BadgeList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this BranchList.
+func (l *BranchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DeployKeyList.
 func (l *DeployKeyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -65,6 +83,15 @@ func (l *DeployTokenList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this DeploymentList.
+func (l *DeploymentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this HookList.
 func (l *HookList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -83,6 +110,15 @@ func (l *IntegrationMattermostList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this IssueList.
+func (l *IssueList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MemberList.
 func (l *MemberList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -92,6 +128,24 @@ func (l *MemberList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this MergeRequestList.
+func (l *MergeRequestList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PagesDomainList.
+func (l *PagesDomainList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PipelineScheduleList.
 func (l *PipelineScheduleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -101,6 +155,33 @@ func (l *PipelineScheduleList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ProjectClusterList.
+func (l *ProjectClusterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ProjectExternalStatusCheckList.
+func (l *ProjectExternalStatusCheckList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ProjectForkList.
+func (l *ProjectForkList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ProjectList.
 func (l *ProjectList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -146,6 +227,24 @@ func (l *RunnerList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this TagList.
+func (l *TagList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this TerraformStateList.
+func (l *TerraformStateList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this VariableList.
 func (l *VariableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -154,3 +253,21 @@ func (l *VariableList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this VariableSetList.
+func (l *VariableSetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this WikiPageList.
+func (l *WikiPageList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
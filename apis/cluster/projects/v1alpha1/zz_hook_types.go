@@ -24,9 +24,17 @@ import (
 )
 
 // HookParameters defines the desired state of a Gitlab Project Hook.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.urlSecretRef)",message="exactly one of url or urlSecretRef is required"
 type HookParameters struct {
 	// URL is the hook URL.
-	URL *string `json:"url"`
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// URLSecretRef references a secret key holding the hook URL, e.g. if it
+	// carries a token or an internal hostname. The URL is read at every
+	// reconciliation and never written to the spec or status.
+	// +optional
+	URLSecretRef *xpv1.SecretKeySelector `json:"urlSecretRef,omitempty"`
 
 	// ConfidentialNoteEvents triggers hook on confidential issues events.
 	// +optional
@@ -91,13 +99,44 @@ type HookParameters struct {
 	EnableSSLVerification *bool `json:"enableSslVerification,omitempty"`
 
 	// Token is the secret token to validate received payloads.
+	// +optional
 	Token *Token `json:"token"`
+
+	// RotateEvery makes the provider generate the secret token of the hook
+	// and replace it once the duration, e.g. 720h, elapsed since the last
+	// rotation. The token is written to the connection secret under token,
+	// and the token it replaced under previousToken, so that receivers can
+	// accept both until the next rotation. It cannot be combined with token.
+	// +optional
+	RotateEvery *metav1.Duration `json:"rotateEvery,omitempty"`
+
+	// CustomWebhookTemplate is the custom payload template of the hook.
+	// +optional
+	CustomWebhookTemplate *string `json:"customWebhookTemplate,omitempty"`
+
+	// CustomHeaders are sent with every request of the hook. GitLab does
+	// not return their values, so they are only pushed when the referenced
+	// values change.
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	CustomHeaders []HookCustomHeader `json:"customHeaders,omitempty"`
 }
 
 type Token struct {
 	SecretRef *xpv1.SecretKeySelector `json:"secretRef"`
 }
 
+// HookCustomHeader is a custom header sent with the requests of a hook.
+type HookCustomHeader struct {
+	// Key is the name of the header.
+	// +kubebuilder:validation:MinLength:=1
+	Key string `json:"key"`
+
+	// ValueSecretRef references the value of the header.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
 // HookObservation represents a project hook.
 //
 // GitLab API docs:
@@ -108,6 +147,18 @@ type HookObservation struct {
 
 	// CreatedAt specifies the time the project hook was created
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// CustomHeaderKeys are the keys of the custom headers of the hook.
+	CustomHeaderKeys []string `json:"customHeaderKeys,omitempty"`
+
+	// CustomHeadersHash is the SHA-256 hash of the custom headers last
+	// pushed to GitLab. It is used to detect value changes without
+	// comparing secrets against GitLab.
+	CustomHeadersHash string `json:"customHeadersHash,omitempty"`
+
+	// TokenRotatedAt is the time the provider last generated the secret
+	// token of the hook, see rotateEvery.
+	TokenRotatedAt *metav1.Time `json:"tokenRotatedAt,omitempty"`
 }
 
 // A HookSpec defines the desired state of a Gitlab Project Hook.
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssueState is the state of a GitLab issue.
+type IssueState string
+
+// Issue states.
+const (
+	IssueStateOpened IssueState = "opened"
+	IssueStateClosed IssueState = "closed"
+)
+
+// IssueParameters define the desired state of a GitLab project issue.
+// https://docs.gitlab.com/api/issues/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type IssueParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Title of the issue.
+	// +kubebuilder:validation:MinLength:=1
+	Title string `json:"title"`
+
+	// Description of the issue, limited to 1,048,576 characters.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels assigned to the issue. Labels that do not exist yet are
+	// created by GitLab.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// AssigneeIDs are the IDs of the users to assign the issue to.
+	// +optional
+	AssigneeIDs []int64 `json:"assigneeIds,omitempty"`
+
+	// MilestoneID is the global ID of the milestone to assign the issue to.
+	// +optional
+	MilestoneID *int64 `json:"milestoneId,omitempty"`
+
+	// State of the issue. Changing it closes or reopens the issue.
+	// +kubebuilder:validation:Enum=opened;closed
+	// +optional
+	State *IssueState `json:"state,omitempty"`
+}
+
+// IssueObservation represents the observed state of a GitLab project issue.
+type IssueObservation struct {
+	// ID is the global ID of the issue.
+	ID int64 `json:"id,omitempty"`
+
+	// IID is the ID of the issue within its project.
+	IID int64 `json:"iid,omitempty"`
+
+	// ProjectID is the ID of the project the issue belongs to.
+	ProjectID int64 `json:"projectId,omitempty"`
+
+	// State of the issue.
+	State string `json:"state,omitempty"`
+
+	// AuthorID is the ID of the user that created the issue.
+	AuthorID int64 `json:"authorId,omitempty"`
+
+	// WebURL is the URL of the issue.
+	WebURL string `json:"webUrl,omitempty"`
+
+	// CreatedAt is the time the issue was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the issue was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// ClosedAt is the time the issue was closed.
+	ClosedAt *metav1.Time `json:"closedAt,omitempty"`
+}
+
+// An IssueSpec defines the desired state of a GitLab project issue.
+type IssueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IssueParameters `json:"forProvider"`
+}
+
+// An IssueStatus represents the observed state of a GitLab project issue.
+type IssueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IssueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Issue is a managed resource that represents a GitLab project issue. Its
+// external name is the IID of the issue within the project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IID",type="integer",JSONPath=".status.atProvider.iid"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Issue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssueSpec   `json:"spec"`
+	Status IssueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssueList contains a list of Issue items.
+type IssueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Issue `json:"items"`
+}
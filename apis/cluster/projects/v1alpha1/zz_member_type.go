@@ -69,6 +69,10 @@ type MemberObservation struct {
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
 	WebURL    string       `json:"webURL,omitempty"`
 	AvatarURL string       `json:"avatarURL,omitempty"`
+
+	// InheritedAccessLevel is the access level the user inherits from an
+	// ancestor group while the user is not a direct member of the project.
+	InheritedAccessLevel AccessLevelValue `json:"inheritedAccessLevel,omitempty"`
 }
 
 // A MemberSpec defines the desired state of a Gitlab Project Member.
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PagesDomainParameters define the desired state of a GitLab Pages domain.
+// https://docs.gitlab.com/ee/api/pages_domains.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type PagesDomainParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Domain is the custom domain served by GitLab Pages.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Domain string `json:"domain"`
+
+	// AutoSslEnabled enables automatic generation of SSL certificates
+	// issued by Let's Encrypt for the domain.
+	// +optional
+	AutoSslEnabled *bool `json:"autoSslEnabled,omitempty"`
+
+	// Certificate is the certificate in PEM format with intermediates
+	// following in most specific to least specific order.
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+
+	// KeySecretRef references the certificate key in PEM format. GitLab never
+	// returns the key, so it is only pushed when the referenced value changes.
+	// +optional
+	KeySecretRef *xpv1.SecretKeySelector `json:"keySecretRef,omitempty"`
+}
+
+// PagesDomainCertificate represents the observed certificate of a GitLab Pages domain.
+type PagesDomainCertificate struct {
+	// Subject of the certificate.
+	Subject string `json:"subject,omitempty"`
+
+	// Expired indicates if the certificate has expired.
+	Expired bool `json:"expired,omitempty"`
+
+	// Expiration is the time the certificate expires.
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+}
+
+// PagesDomainObservation represents the observed state of a GitLab Pages domain.
+type PagesDomainObservation struct {
+	// Domain is the custom domain.
+	Domain string `json:"domain,omitempty"`
+
+	// URL is the URL the domain is served on.
+	URL string `json:"url,omitempty"`
+
+	// AutoSslEnabled indicates if Let's Encrypt certificates are enabled.
+	AutoSslEnabled bool `json:"autoSslEnabled,omitempty"`
+
+	// Verified indicates if the domain ownership has been verified.
+	Verified bool `json:"verified,omitempty"`
+
+	// VerificationCode is the value of the TXT record required to verify
+	// the domain.
+	VerificationCode string `json:"verificationCode,omitempty"`
+
+	// EnabledUntil is the time until an unverified domain stays enabled.
+	EnabledUntil *metav1.Time `json:"enabledUntil,omitempty"`
+
+	// Certificate is the certificate currently configured for the domain.
+	Certificate *PagesDomainCertificate `json:"certificate,omitempty"`
+
+	// KeyHash is the SHA-256 hash of the key last pushed to GitLab. It is
+	// used to detect key changes since GitLab does not return the key.
+	KeyHash string `json:"keyHash,omitempty"`
+}
+
+// A PagesDomainSpec defines the desired state of a GitLab Pages domain.
+type PagesDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PagesDomainParameters `json:"forProvider"`
+}
+
+// A PagesDomainStatus represents the observed state of a GitLab Pages domain.
+type PagesDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PagesDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PagesDomain is a managed resource that represents a GitLab Pages domain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="VERIFIED",type="boolean",JSONPath=".status.atProvider.verified"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type PagesDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PagesDomainSpec   `json:"spec"`
+	Status PagesDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PagesDomainList contains a list of PagesDomain items.
+type PagesDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PagesDomain `json:"items"`
+}
@@ -31,9 +31,95 @@ type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
 
+	// ReadCredentials authenticate the requests that only read from Gitlab,
+	// i.e. GET and HEAD requests, while Credentials are only used for
+	// requests that create, update or delete. This allows to keep the
+	// privileged token out of the observation path. Defaults to Credentials.
+	// +optional
+	ReadCredentials *ProviderCredentials `json:"readCredentials,omitempty"`
+
 	// InsecureSkipVerify ignores self signed TLS certificates when connecting
 	// to Gitlab.
 	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// RateLimit paces the requests sent to Gitlab. All managed resources
+	// using the same base URL and credentials share a single limiter.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// Retry configures how requests that Gitlab rate limited or failed with
+	// a server error are retried before the reconcile fails.
+	// +optional
+	Retry *Retry `json:"retry,omitempty"`
+
+	// LogHTTPBodies logs the request and response bodies of Gitlab API calls
+	// at debug level, with tokens, passwords, variable values and private
+	// keys redacted. The provider has to run with --debug for the bodies to
+	// be written. Defaults to false.
+	// +optional
+	LogHTTPBodies *bool `json:"logHttpBodies,omitempty"`
+
+	// VariableCache caches the variables of each project for the managed
+	// resources using the same base URL and credentials. Disabled by default.
+	// +optional
+	VariableCache *VariableCache `json:"variableCache,omitempty"`
+
+	// AllowSudo lets managed resources send their requests as another
+	// GitLab user with the gitlab.crossplane.io/sudo annotation. Only enable
+	// it if everyone able to annotate the resources using this
+	// ProviderConfig may act as any GitLab user. Defaults to false.
+	// +optional
+	AllowSudo *bool `json:"allowSudo,omitempty"`
+
+	// ValueHashKeySecretRef selects the secret key that keys the hashes of
+	// masked variable values recorded in the status of project variables.
+	// Unlike the credentials it is not expected to be rotated, rotating it
+	// applies every masked value once. Without it the hashes are keyed by
+	// the UID of the variable only.
+	// +optional
+	ValueHashKeySecretRef *xpv1.SecretKeySelector `json:"valueHashKeySecretRef,omitempty"`
+}
+
+// VariableCache configures the cache of project variables.
+type VariableCache struct {
+	// TTL is how long a cached variable list is used without asking Gitlab.
+	// After that it is revalidated with its ETag, and only transferred again
+	// if it changed. Writes of the provider drop the list right away, changes
+	// made outside of it are seen once the TTL expired.
+	TTL metav1.Duration `json:"ttl"`
+}
+
+// RateLimit configures a token bucket limiter for Gitlab API requests.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests sent to Gitlab.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// Burst is the number of requests that may be sent at once before
+	// requests are paced. Defaults to RequestsPerSecond.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int `json:"burst,omitempty"`
+}
+
+// Retry configures the retries of failed Gitlab API requests.
+type Retry struct {
+	// MaxRetries is the number of times a request is retried. Zero disables
+	// retries. Defaults to 5.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// MinBackoff is the wait before the first retry, which is doubled for
+	// every further retry. Defaults to 100ms.
+	// +optional
+	MinBackoff *metav1.Duration `json:"minBackoff,omitempty"`
+
+	// MaxBackoff caps the doubled wait between retries. A rate limited
+	// request waits as long as the Retry-After or RateLimit-Reset header of
+	// Gitlab asks for, even if that is longer. Defaults to 10s.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
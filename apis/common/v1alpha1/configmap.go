@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// LocalConfigMapKeySelector is a reference to a key in a ConfigMap in the
+// namespace of the referencing resource.
+type LocalConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key within the ConfigMap. The key is looked up in binaryData first
+	// and in data otherwise.
+	Key string `json:"key"`
+}
+
+// ConfigMapKeySelector is a reference to a key in a ConfigMap in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap. The key is looked up in binaryData first
+	// and in data otherwise.
+	Key string `json:"key"`
+}
+
+// LocalConfigMapReference is a reference to a ConfigMap in the namespace of
+// the referencing resource.
+type LocalConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+}
+
+// ConfigMapReference is a reference to a ConfigMap in an arbitrary namespace.
+type ConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
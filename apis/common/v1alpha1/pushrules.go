@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// PushRules are pre-receive Git hooks that restrict what can be pushed to a
+// repository. They are set on projects or, with GitLab Premium, on groups
+// where they apply to new projects of the group.
+type PushRules struct {
+	// All commit author emails must match this regular expression.
+	AuthorEmailRegex *string `json:"authorEmailRegex,omitempty"`
+
+	// All branch names must match this regular expression.
+	BranchNameRegex *string `json:"branchNameRegex,omitempty"`
+
+	// Users can only push commits to this repository if the committer email is
+	// one of their own verified emails.
+	CommitCommitterCheck *bool `json:"commitCommitterCheck,omitempty"`
+
+	// Users can only push commits to this repository if the commit author name
+	// is consistent with their GitLab account name.
+	CommitCommitterNameCheck *bool `json:"commitCommitterNameCheck,omitempty"`
+
+	// No commit message is allowed to match this regular expression.
+	CommitMessageNegativeRegex *string `json:"commitMessageNegativeRegex,omitempty"`
+
+	// All commit messages must match this regular expression.
+	CommitMessageRegex *string `json:"commitMessageRegex,omitempty"`
+
+	// Deny deleting a tag.
+	DenyDeleteTag *bool `json:"denyDeleteTag,omitempty"`
+
+	// All committed filenames must not match this regular expression.
+	FileNameRegex *string `json:"fileNameRegex,omitempty"`
+
+	// Maximum file size (MB).
+	MaxFileSize *int64 `json:"maxFileSize,omitempty"`
+
+	// Restrict commits by author (email) to existing GitLab users.
+	MemberCheck *bool `json:"memberCheck,omitempty"`
+
+	// GitLab rejects any files that are likely to contain secrets.
+	PreventSecrets *bool `json:"preventSecrets,omitempty"`
+
+	// Reject commit when it’s not signed.
+	RejectUnsignedCommits *bool `json:"rejectUnsignedCommits,omitempty"`
+
+	// Reject commit when it’s not DCO certified.
+	RejectNonDCOCommits *bool `json:"rejectNonDcoCommits,omitempty"`
+}
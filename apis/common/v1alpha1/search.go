@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// A GitLabSearch finds a project or group in GitLab by its attributes rather
+// than its ID. Every attribute that is set must match exactly, and exactly
+// one project or group must match all of them.
+// +kubebuilder:validation:XValidation:rule="has(self.name) || has(self.path)",message="name or path is required"
+type GitLabSearch struct {
+	// Name of the project or group.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Path of the project or group, i.e. the last segment of its full path.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Namespace is the full path of the namespace holding the project, or of
+	// the parent group of the group, e.g. my-group/my-subgroup.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
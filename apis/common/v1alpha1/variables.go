@@ -34,7 +34,11 @@ type CommonVariableParameters struct {
 	// +immutable
 	Key string `json:"key"`
 
-	// Value of a variable. Mutually exclusive with ValueSecretRef.
+	// Value of a variable. Mutually exclusive with ValueSecretRef. Without a
+	// value, ValueSecretRef or ValueTemplate the value observed in GitLab is
+	// late-initialized and only observed: changes made in GitLab are adopted.
+	// Once the value is changed, it is applied and changes made in GitLab are
+	// reverted.
 	// +kubebuilder:validation:MaxLength:=10000
 	// +optional
 	Value *string `json:"value,omitempty"`
@@ -53,7 +57,9 @@ type CommonVariableParameters struct {
 	// +optional
 	Protected *bool `json:"protected,omitempty"`
 
-	// Raw disables variable expansion of the variable.
+	// Raw disables variable expansion of the variable. Defaults to true for
+	// values read from secrets and to false otherwise, which is set in the
+	// spec when the variable is created.
 	// +optional
 	Raw *bool `json:"raw,omitempty"`
 
@@ -61,6 +67,15 @@ type CommonVariableParameters struct {
 	// +kubebuilder:validation:Enum:=env_var;file
 	// +optional
 	VariableType *VariableType `json:"variableType,omitempty"`
+
+	// PublishValue writes the value of the variable as resolved from the
+	// spec, e.g. from ValueSecretRef, to the connection secret under the key
+	// "value", so that a Composition can pass it on to other resources. The
+	// value is never read back from GitLab for this. Anyone who can read the
+	// connection secret can read the value, even if the variable is masked.
+	// Defaults to false.
+	// +optional
+	PublishValue *bool `json:"publishValue,omitempty"`
 }
 
 // CommonVariableObservation represents the observed state of a GitLab Variable.
@@ -410,6 +410,11 @@ func (in *CommonVariableParameters) DeepCopyInto(out *CommonVariableParameters)
 		*out = new(VariableType)
 		**out = **in
 	}
+	if in.PublishValue != nil {
+		in, out := &in.PublishValue, &out.PublishValue
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonVariableParameters.
@@ -421,3 +426,173 @@ func (in *CommonVariableParameters) DeepCopy() *CommonVariableParameters {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabSearch) DeepCopyInto(out *GitLabSearch) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabSearch.
+func (in *GitLabSearch) DeepCopy() *GitLabSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalConfigMapKeySelector) DeepCopyInto(out *LocalConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfigMapKeySelector.
+func (in *LocalConfigMapKeySelector) DeepCopy() *LocalConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalConfigMapReference) DeepCopyInto(out *LocalConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfigMapReference.
+func (in *LocalConfigMapReference) DeepCopy() *LocalConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushRules) DeepCopyInto(out *PushRules) {
+	*out = *in
+	if in.AuthorEmailRegex != nil {
+		in, out := &in.AuthorEmailRegex, &out.AuthorEmailRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.BranchNameRegex != nil {
+		in, out := &in.BranchNameRegex, &out.BranchNameRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommitCommitterCheck != nil {
+		in, out := &in.CommitCommitterCheck, &out.CommitCommitterCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CommitCommitterNameCheck != nil {
+		in, out := &in.CommitCommitterNameCheck, &out.CommitCommitterNameCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CommitMessageNegativeRegex != nil {
+		in, out := &in.CommitMessageNegativeRegex, &out.CommitMessageNegativeRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommitMessageRegex != nil {
+		in, out := &in.CommitMessageRegex, &out.CommitMessageRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.DenyDeleteTag != nil {
+		in, out := &in.DenyDeleteTag, &out.DenyDeleteTag
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FileNameRegex != nil {
+		in, out := &in.FileNameRegex, &out.FileNameRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemberCheck != nil {
+		in, out := &in.MemberCheck, &out.MemberCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreventSecrets != nil {
+		in, out := &in.PreventSecrets, &out.PreventSecrets
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectUnsignedCommits != nil {
+		in, out := &in.RejectUnsignedCommits, &out.RejectUnsignedCommits
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectNonDCOCommits != nil {
+		in, out := &in.RejectNonDCOCommits, &out.RejectNonDCOCommits
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRules.
+func (in *PushRules) DeepCopy() *PushRules {
+	if in == nil {
+		return nil
+	}
+	out := new(PushRules)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+// GroupPushRulesParameters define the desired push rules of a Gitlab group.
+// https://docs.gitlab.com/api/group_push_rules/
+type GroupPushRulesParameters struct {
+	v1alpha1.PushRules `json:",inline"`
+
+	// GroupID is the ID of the group to configure the push rules of.
+	// +optional
+	// +immutable
+	GroupID *int64 `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.NamespacedReference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.NamespacedSelector `json:"groupIdSelector,omitempty"`
+}
+
+// GroupPushRulesObservation represents the observed push rules of a Gitlab
+// group.
+type GroupPushRulesObservation struct {
+	// ID of the push rules.
+	ID int64 `json:"id,omitempty"`
+
+	// CreatedAt is the time the push rules were added to the group.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A GroupPushRulesSpec defines the desired state of Gitlab group push rules.
+type GroupPushRulesSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              GroupPushRulesParameters `json:"forProvider"`
+}
+
+// A GroupPushRulesStatus represents the observed state of Gitlab group push
+// rules.
+type GroupPushRulesStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupPushRulesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupPushRules is a managed resource that represents the push rules of a
+// Gitlab group. A group has at most one set of push rules, they are used as
+// the default push rules of new projects in the group. Push rules require a
+// GitLab Premium or Ultimate license.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type GroupPushRules struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupPushRulesSpec   `json:"spec"`
+	Status GroupPushRulesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupPushRulesList contains a list of GroupPushRules items
+type GroupPushRulesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupPushRules `json:"items"`
+}
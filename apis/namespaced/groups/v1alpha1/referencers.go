@@ -20,7 +20,9 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +49,25 @@ func toPtrValue(v string) (*int64, error) {
 	return &r, nil
 }
 
+// GroupID extracts the ID of a Group for references to integer fields. It is
+// read from the status as the external name may be the path of the group, and
+// falls back to a numeric external name until the group was observed.
+func GroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		g, ok := mg.(*Group)
+		if !ok {
+			return ""
+		}
+		if g.Status.AtProvider.ID != nil {
+			return strconv.FormatInt(*g.Status.AtProvider.ID, 10)
+		}
+		if _, err := strconv.ParseInt(meta.GetExternalName(g), 10, 64); err == nil {
+			return meta.GetExternalName(g)
+		}
+		return ""
+	}
+}
+
 // ResolveReferences of this Variable
 func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPINamespacedResolver(c, mg)
@@ -57,7 +78,7 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -85,7 +106,7 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -113,7 +134,7 @@ func (mg *DeployToken) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -141,7 +162,7 @@ func (mg *AccessToken) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -174,7 +195,7 @@ func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
 
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: ptr.Deref(idstrp, ""),
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 		Reference:    mg.Spec.ForProvider.ParentIDRef,
 		Selector:     mg.Spec.ForProvider.ParentIDSelector,
 		To: reference.To{
@@ -197,7 +218,7 @@ func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.SharedWithGroups); i3++ {
 		rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 			CurrentValue: fromPtrValue(mg.Spec.ForProvider.SharedWithGroups[i3].GroupID),
-			Extract:      reference.ExternalName(),
+			Extract:      GroupID(),
 			Reference:    mg.Spec.ForProvider.SharedWithGroups[i3].GroupIDRef,
 			Selector:     mg.Spec.ForProvider.SharedWithGroups[i3].GroupIDSelector,
 			To: reference.To{
@@ -231,7 +252,7 @@ func (mg *SamlGroupLink) ResolveReferences(ctx context.Context, c client.Reader)
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -259,7 +280,7 @@ func (mg *LdapGroupLink) ResolveReferences(ctx context.Context, c client.Reader)
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -287,7 +308,7 @@ func (mg *Runner) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -315,7 +336,7 @@ func (mg *Badge) ResolveReferences(ctx context.Context, c client.Reader) error {
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -343,9 +364,86 @@ func (mg *ServiceAccount) ResolveReferences(ctx context.Context, c client.Reader
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	resolvedID, err := toPtrValue(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = resolvedID
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServiceAccountAccessToken
+func (mg *ServiceAccountAccessToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.GroupID),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	resolvedID, err := toPtrValue(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = resolvedID
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.serviceAccountIdRef
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ServiceAccountID),
+		Reference:    mg.Spec.ForProvider.ServiceAccountIDRef,
+		Selector:     mg.Spec.ForProvider.ServiceAccountIDSelector,
+		To:           reference.To{Managed: &ServiceAccount{}, List: &ServiceAccountList{}},
 		Extract:      reference.ExternalName(),
 	})
 
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccountId")
+	}
+
+	resolvedID, err = toPtrValue(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccountId")
+	}
+
+	mg.Spec.ForProvider.ServiceAccountID = resolvedID
+	mg.Spec.ForProvider.ServiceAccountIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this GroupPushRules
+func (mg *GroupPushRules) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.GroupID),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.groupId")
 	}
@@ -360,3 +458,49 @@ func (mg *ServiceAccount) ResolveReferences(ctx context.Context, c client.Reader
 
 	return nil
 }
+
+// ResolveReferences of this GroupHook
+func (mg *GroupHook) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: ptr.Deref(mg.Spec.ForProvider.GroupID, ""),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ComplianceFramework
+func (mg *ComplianceFramework) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: ptr.Deref(mg.Spec.ForProvider.GroupID, ""),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
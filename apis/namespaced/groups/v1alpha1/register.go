@@ -116,6 +116,38 @@ var (
 	ServiceAccountGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountKind)
 )
 
+// ServiceAccountAccessToken type metadata
+var (
+	ServiceAccountAccessTokenKind             = reflect.TypeOf(ServiceAccountAccessToken{}).Name()
+	ServiceAccountAccessTokenGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: ServiceAccountAccessTokenKind}.String()
+	ServiceAccountAccessTokenKindAPIVersion   = ServiceAccountAccessTokenKind + "." + SchemeGroupVersion.String()
+	ServiceAccountAccessTokenGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountAccessTokenKind)
+)
+
+// GroupPushRules type metadata
+var (
+	GroupPushRulesKind             = reflect.TypeOf(GroupPushRules{}).Name()
+	GroupPushRulesGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: GroupPushRulesKind}.String()
+	GroupPushRulesKindAPIVersion   = GroupPushRulesKind + "." + SchemeGroupVersion.String()
+	GroupPushRulesGroupVersionKind = SchemeGroupVersion.WithKind(GroupPushRulesKind)
+)
+
+// GroupHook type metadata
+var (
+	GroupHookKind             = reflect.TypeOf(GroupHook{}).Name()
+	GroupHookGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: GroupHookKind}.String()
+	GroupHookKindAPIVersion   = GroupHookKind + "." + SchemeGroupVersion.String()
+	GroupHookGroupVersionKind = SchemeGroupVersion.WithKind(GroupHookKind)
+)
+
+// ComplianceFramework type metadata
+var (
+	ComplianceFrameworkKind             = reflect.TypeOf(ComplianceFramework{}).Name()
+	ComplianceFrameworkGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: ComplianceFrameworkKind}.String()
+	ComplianceFrameworkKindAPIVersion   = ComplianceFrameworkKind + "." + SchemeGroupVersion.String()
+	ComplianceFrameworkGroupVersionKind = SchemeGroupVersion.WithKind(ComplianceFrameworkKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Group{}, &GroupList{})
 	SchemeBuilder.Register(&Member{}, &MemberList{})
@@ -127,4 +159,8 @@ func init() {
 	SchemeBuilder.Register(&Runner{}, &RunnerList{})
 	SchemeBuilder.Register(&Badge{}, &BadgeList{})
 	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{})
+	SchemeBuilder.Register(&ServiceAccountAccessToken{}, &ServiceAccountAccessTokenList{})
+	SchemeBuilder.Register(&GroupPushRules{}, &GroupPushRulesList{})
+	SchemeBuilder.Register(&GroupHook{}, &GroupHookList{})
+	SchemeBuilder.Register(&ComplianceFramework{}, &ComplianceFrameworkList{})
 }
@@ -38,6 +38,15 @@ func (l *BadgeList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ComplianceFrameworkList.
+func (l *ComplianceFrameworkList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DeployTokenList.
 func (l *DeployTokenList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -47,6 +56,15 @@ func (l *DeployTokenList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this GroupHookList.
+func (l *GroupHookList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GroupList.
 func (l *GroupList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -56,6 +74,15 @@ func (l *GroupList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this GroupPushRulesList.
+func (l *GroupPushRulesList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this LdapGroupLinkList.
 func (l *LdapGroupLinkList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -92,6 +119,15 @@ func (l *SamlGroupLinkList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ServiceAccountAccessTokenList.
+func (l *ServiceAccountAccessTokenList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ServiceAccountList.
 func (l *ServiceAccountList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
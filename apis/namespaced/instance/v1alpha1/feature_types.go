@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FeatureParameters define the desired state of a GitLab feature flag.
+// Gates that are not set are not managed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/api/features/
+//
+// +kubebuilder:validation:XValidation:rule="has(self.enabled) || has(self.percentageOfTime) || has(self.percentageOfActors) || (has(self.groups) && size(self.groups) > 0) || (has(self.projects) && size(self.projects) > 0)",message="at least one gate must be set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.percentageOfTime) && has(self.percentageOfActors))",message="at most one of percentageOfTime or percentageOfActors may be set"
+type FeatureParameters struct {
+	// Name of the feature flag. Changing it manages a different feature
+	// flag and leaves the previous one as it is.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Enabled sets the boolean gate, which enables or disables the feature
+	// for everyone. Disabling the feature this way also clears all other
+	// gates, which are set again afterwards.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// PercentageOfTime enables the feature for the given percentage of
+	// checks. Mutually exclusive with PercentageOfActors.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	PercentageOfTime *int64 `json:"percentageOfTime,omitempty"`
+
+	// PercentageOfActors enables the feature for the given percentage of
+	// actors, e.g. users or projects, consistently for each actor. Mutually
+	// exclusive with PercentageOfTime.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	PercentageOfActors *int64 `json:"percentageOfActors,omitempty"`
+
+	// Groups is the full paths of the groups the feature is enabled for.
+	// Once set, the feature is disabled for all other groups it was
+	// enabled for.
+	// +optional
+	// +listType=set
+	Groups []string `json:"groups,omitempty"`
+
+	// Projects is the full paths of the projects the feature is enabled
+	// for. Once set, the feature is disabled for all other projects it was
+	// enabled for.
+	// +optional
+	// +listType=set
+	Projects []string `json:"projects,omitempty"`
+}
+
+// FeatureObservation represents the observed state of a GitLab feature flag.
+type FeatureObservation struct {
+	// State of the feature flag, one of on, off or conditional.
+	State string `json:"state,omitempty"`
+
+	// Enabled is the value of the boolean gate.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// PercentageOfTime is the value of the percentage of time gate.
+	PercentageOfTime int64 `json:"percentageOfTime,omitempty"`
+
+	// PercentageOfActors is the value of the percentage of actors gate.
+	PercentageOfActors int64 `json:"percentageOfActors,omitempty"`
+
+	// Actors the feature is enabled for, e.g. Project:42 or Group:7.
+	Actors []string `json:"actors,omitempty"`
+}
+
+// A FeatureSpec defines the desired state of a GitLab feature flag.
+type FeatureSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              FeatureParameters `json:"forProvider"`
+}
+
+// A FeatureStatus represents the observed state of a GitLab feature flag.
+type FeatureStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Feature is a managed resource that represents a GitLab feature flag.
+// Managing feature flags requires an administrator token and is only
+// possible on self-managed GitLab instances.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type Feature struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureSpec   `json:"spec"`
+	Status FeatureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureList contains a list of Feature items.
+type FeatureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Feature `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+// TopicParameters define the desired state of a GitLab topic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/api/topics/
+type TopicParameters struct {
+	// Name of the topic, which identifies it in the topics of projects.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Title of the topic, shown instead of its name.
+	// +kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// Description of the topic.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Avatar of the topic. The avatar is not managed if omitted and removed
+	// if set without a source.
+	// +optional
+	Avatar *TopicAvatar `json:"avatar,omitempty"`
+}
+
+// TopicAvatar references an image to use as topic avatar. At most one of
+// SecretRef or ConfigMapRef may be set. The image must be a PNG, JPEG, GIF,
+// BMP, ICO or WebP file of at most 200 KiB.
+// +kubebuilder:validation:XValidation:rule="!(has(self.secretRef) && has(self.configMapRef))",message="at most one of secretRef or configMapRef may be set"
+type TopicAvatar struct {
+	// SecretRef references a key of a Secret holding the image.
+	// +optional
+	SecretRef *xpv1.LocalSecretKeySelector `json:"secretRef,omitempty"`
+
+	// ConfigMapRef references a key of a ConfigMap holding the image,
+	// usually in its binaryData.
+	// +optional
+	ConfigMapRef *commonv1alpha1.LocalConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// TopicObservation represents the observed state of a GitLab topic.
+type TopicObservation struct {
+	ID                 int64  `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Title              string `json:"title,omitempty"`
+	Description        string `json:"description,omitempty"`
+	TotalProjectsCount uint64 `json:"totalProjectsCount,omitempty"`
+	AvatarURL          string `json:"avatarUrl,omitempty"`
+
+	// AvatarHash is the SHA-256 hash of the avatar image last uploaded to
+	// the topic.
+	AvatarHash string `json:"avatarHash,omitempty"`
+}
+
+// A TopicSpec defines the desired state of a GitLab topic.
+type TopicSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              TopicParameters `json:"forProvider"`
+}
+
+// A TopicStatus represents the observed state of a GitLab topic.
+type TopicStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TopicObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Topic is a managed resource that represents a GitLab topic, which
+// projects can be assigned to with their topics. Managing topics requires
+// an administrator token.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type Topic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TopicSpec   `json:"spec"`
+	Status TopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TopicList contains a list of Topic items.
+type TopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Topic `json:"items"`
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UserImpersonationTokenParameters define the desired state of a GitLab
+// impersonation token of a user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/api/user_tokens/#create-an-impersonation-token
+// +kubebuilder:validation:XValidation:rule="(has(self.expiresAt) ? 1 : 0) + (has(self.renewalPeriodDays) ? 1 : 0) == 1",message="exactly one of expiresAt or renewalPeriodDays must be set"
+type UserImpersonationTokenParameters struct {
+	// UserID is the ID of the user to impersonate with the token.
+	// +immutable
+	UserID int64 `json:"userId"`
+
+	// Name of the impersonation token.
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Scopes indicates the impersonation token scopes, e.g. api or
+	// read_user.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+
+	// ExpiresAt is the expiration date of the impersonation token in ISO 8601
+	// format (2019-03-15T08:00:00Z). A new token is created if it differs from
+	// the expiry of the current token.
+	// Mutually exclusive with RenewalPeriodDays.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// RenewalPeriodDays is the number of days each token generation should live.
+	// When the token becomes inactive the provider creates a new one expiring
+	// RenewalPeriodDays days from then and revokes the old one.
+	// Mutually exclusive with ExpiresAt.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RenewalPeriodDays *int `json:"renewalPeriodDays,omitempty"`
+}
+
+// UserImpersonationTokenObservation represents an impersonation token of a
+// user.
+type UserImpersonationTokenObservation struct {
+	ID         int64        `json:"id"`
+	Name       string       `json:"name"`
+	Scopes     []string     `json:"scopes"`
+	ExpiresAt  *metav1.Time `json:"expiresAt,omitempty"`
+	Active     bool         `json:"active"`
+	Revoked    bool         `json:"revoked"`
+	CreatedAt  *metav1.Time `json:"createdAt,omitempty"`
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty"`
+}
+
+// A UserImpersonationTokenSpec defines the desired state of a GitLab
+// impersonation token.
+type UserImpersonationTokenSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              UserImpersonationTokenParameters `json:"forProvider"`
+}
+
+// A UserImpersonationTokenStatus represents the observed state of a GitLab
+// impersonation token.
+type UserImpersonationTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserImpersonationTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserImpersonationToken is a managed resource that represents a GitLab
+// impersonation token, which acts as the user it was created for. The token
+// is published to the connection secret under the key "token". Managing
+// impersonation tokens requires an administrator token.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type UserImpersonationToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserImpersonationTokenSpec   `json:"spec"`
+	Status UserImpersonationTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserImpersonationTokenList contains a list of UserImpersonationToken items.
+type UserImpersonationTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserImpersonationToken `json:"items"`
+}
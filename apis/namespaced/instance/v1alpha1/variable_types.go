@@ -63,6 +63,11 @@ type VariableStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".status.atProvider.key"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.variableType"
+// +kubebuilder:printcolumn:name="MASKED",type="boolean",JSONPath=".status.atProvider.masked"
+// +kubebuilder:printcolumn:name="PROTECTED",type="boolean",JSONPath=".status.atProvider.protected"
+// +kubebuilder:printcolumn:name="RAW",type="boolean",JSONPath=".status.atProvider.raw",priority=1
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
 type Variable struct {
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalConfigurationParameters define the desired merge request approval
+// settings of a GitLab project. Settings left unset are not changed.
+// https://docs.gitlab.com/api/merge_request_approvals/#change-configuration
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// +kubebuilder:validation:XValidation:rule="!(has(self.resetApprovalsOnPush) && self.resetApprovalsOnPush && has(self.selectiveCodeOwnerRemovals) && self.selectiveCodeOwnerRemovals)",message="selectiveCodeOwnerRemovals requires resetApprovalsOnPush to be false"
+type ApprovalConfigurationParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// ResetApprovalsOnPush removes all approvals of a merge request when new
+	// commits are pushed to its source branch.
+	// +optional
+	ResetApprovalsOnPush *bool `json:"resetApprovalsOnPush,omitempty"`
+
+	// SelectiveCodeOwnerRemovals removes only the approvals of code owners
+	// whose files changed when new commits are pushed. It requires
+	// resetApprovalsOnPush to be false.
+	// +optional
+	SelectiveCodeOwnerRemovals *bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+
+	// DisableOverridingApproversPerMergeRequest prevents editing the
+	// approval rules of single merge requests.
+	// +optional
+	DisableOverridingApproversPerMergeRequest *bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+
+	// MergeRequestsAuthorApproval allows the author of a merge request to
+	// approve it.
+	// +optional
+	MergeRequestsAuthorApproval *bool `json:"mergeRequestsAuthorApproval,omitempty"`
+
+	// MergeRequestsDisableCommittersApproval prevents users who committed to
+	// a merge request from approving it.
+	// +optional
+	MergeRequestsDisableCommittersApproval *bool `json:"mergeRequestsDisableCommittersApproval,omitempty"`
+
+	// RequirePasswordToApprove requires approvers to authenticate again
+	// before approving.
+	// +optional
+	RequirePasswordToApprove *bool `json:"requirePasswordToApprove,omitempty"`
+}
+
+// ApprovalConfigurationObservation represents the observed merge request
+// approval settings of a GitLab project.
+type ApprovalConfigurationObservation struct {
+	// ResetApprovalsOnPush indicates that approvals are removed on push.
+	ResetApprovalsOnPush bool `json:"resetApprovalsOnPush,omitempty"`
+
+	// SelectiveCodeOwnerRemovals indicates that code owner approvals are
+	// removed on push if their files changed.
+	SelectiveCodeOwnerRemovals bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+
+	// DisableOverridingApproversPerMergeRequest indicates that the approval
+	// rules of single merge requests cannot be edited.
+	DisableOverridingApproversPerMergeRequest bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+
+	// MergeRequestsAuthorApproval indicates that authors may approve their
+	// merge requests.
+	MergeRequestsAuthorApproval bool `json:"mergeRequestsAuthorApproval,omitempty"`
+
+	// MergeRequestsDisableCommittersApproval indicates that committers may
+	// not approve.
+	MergeRequestsDisableCommittersApproval bool `json:"mergeRequestsDisableCommittersApproval,omitempty"`
+
+	// RequirePasswordToApprove indicates that approvers authenticate again.
+	RequirePasswordToApprove bool `json:"requirePasswordToApprove,omitempty"`
+}
+
+// A ApprovalConfigurationSpec defines the desired state of the merge request
+// approval settings of a GitLab project.
+type ApprovalConfigurationSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ApprovalConfigurationParameters `json:"forProvider"`
+}
+
+// A ApprovalConfigurationStatus represents the observed state of the merge
+// request approval settings of a GitLab project.
+type ApprovalConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ApprovalConfiguration is a managed resource that represents the merge
+// request approval settings of a GitLab project, separately from its
+// approval rules. Every project has these settings, so creating the resource
+// adopts them and deleting it leaves them unchanged in GitLab.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RESET-ON-PUSH",type="boolean",JSONPath=".status.atProvider.resetApprovalsOnPush"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type ApprovalConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalConfigurationSpec   `json:"spec"`
+	Status ApprovalConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalConfigurationList contains a list of ApprovalConfiguration items.
+type ApprovalConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalConfiguration `json:"items"`
+}
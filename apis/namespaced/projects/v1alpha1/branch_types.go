@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BranchParameters define the desired state of a GitLab repository branch.
+// https://docs.gitlab.com/ee/api/branches.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type BranchParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Branch is the name of the branch. An existing branch with the same
+	// name is adopted instead of being created. It cannot be changed once
+	// set, since the branch under the old name would be left behind.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="branch is immutable"
+	// +immutable
+	Branch string `json:"branch"`
+
+	// Ref is the branch name or commit SHA to create the branch from.
+	// Branches cannot be re-pointed through the API, so the ref cannot be
+	// changed once set.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ref is immutable"
+	// +immutable
+	Ref string `json:"ref"`
+}
+
+// BranchObservation represents the observed state of a GitLab repository branch.
+type BranchObservation struct {
+	// Name is the name of the branch.
+	Name string `json:"name,omitempty"`
+
+	// Ref is the ref the branch was created or adopted from.
+	Ref string `json:"ref,omitempty"`
+
+	// CommitID is the SHA of the commit the branch currently points to.
+	CommitID string `json:"commitId,omitempty"`
+
+	// Protected indicates if the branch is protected.
+	Protected bool `json:"protected,omitempty"`
+
+	// Merged indicates if the branch has been merged into the default branch.
+	Merged bool `json:"merged,omitempty"`
+
+	// Default indicates if the branch is the project's default branch.
+	Default bool `json:"default,omitempty"`
+
+	// CanPush indicates if the authenticated user can push to the branch.
+	CanPush bool `json:"canPush,omitempty"`
+
+	// DevelopersCanPush indicates if developers can push to the branch.
+	DevelopersCanPush bool `json:"developersCanPush,omitempty"`
+
+	// DevelopersCanMerge indicates if developers can merge into the branch.
+	DevelopersCanMerge bool `json:"developersCanMerge,omitempty"`
+
+	// WebURL is the URL of the branch in the GitLab UI.
+	WebURL string `json:"webUrl,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a GitLab repository branch.
+type BranchSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BranchParameters `json:"forProvider"`
+}
+
+// A BranchStatus represents the observed state of a GitLab repository branch.
+type BranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents a GitLab repository branch.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.branch"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.projectId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branch items.
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentParameters select the GitLab project deployment to observe.
+// https://docs.gitlab.com/api/deployments/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// At least 1 of [Environment, DeploymentID] required.
+type DeploymentParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Environment is the name of the environment whose latest deployment is
+	// observed.
+	// +optional
+	Environment *string `json:"environment,omitempty"`
+
+	// Status only considers deployments with the given status when looking
+	// up the latest deployment of the environment, e.g. success to observe
+	// what is currently deployed.
+	// +kubebuilder:validation:Enum=created;running;success;failed;canceled;skipped;blocked
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// DeploymentID is the ID of a specific deployment to observe instead of
+	// the latest deployment of the environment.
+	// +optional
+	DeploymentID *int64 `json:"deploymentId,omitempty"`
+}
+
+// DeploymentObservation represents the observed state of a GitLab project
+// deployment.
+type DeploymentObservation struct {
+	// ID of the deployment.
+	ID int64 `json:"id,omitempty"`
+
+	// IID is the ID of the deployment within the project.
+	IID int64 `json:"iid,omitempty"`
+
+	// Environment is the name of the environment deployed to.
+	Environment string `json:"environment,omitempty"`
+
+	// Status of the deployment, e.g. running or success.
+	Status string `json:"status,omitempty"`
+
+	// Ref is the branch or tag that was deployed.
+	Ref string `json:"ref,omitempty"`
+
+	// SHA of the commit that was deployed.
+	SHA string `json:"sha,omitempty"`
+
+	// Username of the user who triggered the deployment.
+	Username string `json:"username,omitempty"`
+
+	// PipelineID is the ID of the pipeline running the deployment job.
+	PipelineID int64 `json:"pipelineId,omitempty"`
+
+	// CreatedAt is the time the deployment was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the deployment was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// FinishedAt is the time the deployment job finished.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+}
+
+// A DeploymentSpec defines the GitLab project deployment to observe.
+type DeploymentSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              DeploymentParameters `json:"forProvider"`
+}
+
+// A DeploymentStatus represents the observed state of a GitLab project
+// deployment.
+type DeploymentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DeploymentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Deployment is an observe-only managed resource that reports the latest
+// deployment of a GitLab project environment. It never creates, updates or
+// deletes deployments.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENVIRONMENT",type="string",JSONPath=".status.atProvider.environment"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="REF",type="string",JSONPath=".status.atProvider.ref"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type Deployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeploymentSpec   `json:"spec"`
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeploymentList contains a list of Deployment items.
+type DeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Deployment `json:"items"`
+}
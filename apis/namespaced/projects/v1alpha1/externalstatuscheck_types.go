@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectExternalStatusCheckParameters define the desired state of an
+// external status check of a GitLab project.
+// https://docs.gitlab.com/api/status_checks/
+// +kubebuilder:validation:XValidation:rule="has(self.externalUrl) != has(self.externalUrlSecretRef)",message="exactly one of externalUrl or externalUrlSecretRef is required"
+type ProjectExternalStatusCheckParameters struct {
+	// ProjectID is the ID of the project to add the status check to.
+	// +optional
+	// +immutable
+	ProjectID *int64 `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Name of the status check. It is unique within the project.
+	Name string `json:"name"`
+
+	// ExternalURL is the URL GitLab sends the merge request data to.
+	// +optional
+	ExternalURL *string `json:"externalUrl,omitempty"`
+
+	// ExternalURLSecretRef references a secret key holding the URL GitLab
+	// sends the merge request data to, e.g. if it carries a token.
+	// +optional
+	ExternalURLSecretRef *xpv1.LocalSecretKeySelector `json:"externalUrlSecretRef,omitempty"`
+
+	// ProtectedBranchIDs are the IDs of the protected branches the status
+	// check applies to. It applies to all branches if there are none.
+	// +optional
+	ProtectedBranchIDs []int64 `json:"protectedBranchIds,omitempty"`
+
+	// ProtectedBranchIDRefs are references to ProtectedBranches to retrieve
+	// their IDs.
+	// +optional
+	ProtectedBranchIDRefs []xpv1.NamespacedReference `json:"protectedBranchIdRefs,omitempty"`
+
+	// ProtectedBranchIDSelector selects references to ProtectedBranches to
+	// retrieve their IDs.
+	// +optional
+	ProtectedBranchIDSelector *xpv1.NamespacedSelector `json:"protectedBranchIdSelector,omitempty"`
+}
+
+// ExternalStatusCheckProtectedBranch is a protected branch an external
+// status check applies to.
+type ExternalStatusCheckProtectedBranch struct {
+	// ID of the protected branch.
+	ID int64 `json:"id"`
+
+	// Name of the protected branch.
+	Name string `json:"name"`
+}
+
+// ProjectExternalStatusCheckObservation represents the observed state of an
+// external status check of a GitLab project.
+type ProjectExternalStatusCheckObservation struct {
+	// ID of the status check.
+	ID int64 `json:"id,omitempty"`
+
+	// Name of the status check.
+	Name string `json:"name,omitempty"`
+
+	// HMAC is true if GitLab signs the requests to the external URL.
+	HMAC bool `json:"hmac,omitempty"`
+
+	// ProtectedBranches the status check applies to.
+	// +optional
+	ProtectedBranches []ExternalStatusCheckProtectedBranch `json:"protectedBranches,omitempty"`
+}
+
+// A ProjectExternalStatusCheckSpec defines the desired state of an external
+// status check of a GitLab project.
+type ProjectExternalStatusCheckSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ProjectExternalStatusCheckParameters `json:"forProvider"`
+}
+
+// A ProjectExternalStatusCheckStatus represents the observed state of an
+// external status check of a GitLab project.
+type ProjectExternalStatusCheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectExternalStatusCheckObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectExternalStatusCheck is a managed resource that represents an
+// external status check of a GitLab project. Merge requests wait for the
+// external service to report the check as passed. Requires a GitLab Ultimate
+// license.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type ProjectExternalStatusCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectExternalStatusCheckSpec   `json:"spec"`
+	Status ProjectExternalStatusCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectExternalStatusCheckList contains a list of ProjectExternalStatusCheck
+// items.
+type ProjectExternalStatusCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectExternalStatusCheck `json:"items"`
+}
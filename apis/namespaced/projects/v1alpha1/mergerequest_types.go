@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MergeRequestParameters define the desired state of a GitLab project merge
+// request.
+// https://docs.gitlab.com/api/merge_requests/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type MergeRequestParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// SourceBranch is the branch to merge.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	SourceBranch string `json:"sourceBranch"`
+
+	// TargetBranch is the branch to merge into.
+	// +kubebuilder:validation:MinLength:=1
+	TargetBranch string `json:"targetBranch"`
+
+	// Title of the merge request.
+	// +kubebuilder:validation:MinLength:=1
+	Title string `json:"title"`
+
+	// Description of the merge request, limited to 1,048,576 characters.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels assigned to the merge request. Labels that do not exist yet are
+	// created by GitLab.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// AssigneeIDs are the IDs of the users to assign the merge request to.
+	// +optional
+	AssigneeIDs []int64 `json:"assigneeIds,omitempty"`
+
+	// RemoveSourceBranch removes the source branch when the merge request is
+	// merged.
+	// +optional
+	RemoveSourceBranch *bool `json:"removeSourceBranch,omitempty"`
+
+	// AutoMerge merges the merge request automatically once its pipeline
+	// succeeds and all merge checks pass. Setting it to false cancels a
+	// pending auto-merge.
+	// +optional
+	AutoMerge *bool `json:"autoMerge,omitempty"`
+}
+
+// MergeRequestObservation represents the observed state of a GitLab project
+// merge request.
+type MergeRequestObservation struct {
+	// ID is the global ID of the merge request.
+	ID int64 `json:"id,omitempty"`
+
+	// IID is the ID of the merge request within its project.
+	IID int64 `json:"iid,omitempty"`
+
+	// State of the merge request, one of opened, closed, locked or merged.
+	State string `json:"state,omitempty"`
+
+	// DetailedMergeStatus describes whether the merge request can be merged.
+	DetailedMergeStatus string `json:"detailedMergeStatus,omitempty"`
+
+	// AutoMergeEnabled indicates that the merge request is merged once its
+	// pipeline succeeds.
+	AutoMergeEnabled bool `json:"autoMergeEnabled,omitempty"`
+
+	// SHA is the head commit of the source branch.
+	SHA string `json:"sha,omitempty"`
+
+	// MergeCommitSHA is the commit created by merging the merge request.
+	MergeCommitSHA string `json:"mergeCommitSha,omitempty"`
+
+	// WebURL is the URL of the merge request.
+	WebURL string `json:"webUrl,omitempty"`
+
+	// CreatedAt is the time the merge request was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the merge request was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// MergedAt is the time the merge request was merged.
+	MergedAt *metav1.Time `json:"mergedAt,omitempty"`
+
+	// ClosedAt is the time the merge request was closed.
+	ClosedAt *metav1.Time `json:"closedAt,omitempty"`
+}
+
+// A MergeRequestSpec defines the desired state of a GitLab project merge request.
+type MergeRequestSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              MergeRequestParameters `json:"forProvider"`
+}
+
+// A MergeRequestStatus represents the observed state of a GitLab project merge request.
+type MergeRequestStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MergeRequestObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MergeRequest is a managed resource that represents a GitLab project merge
+// request. Its external name is the IID of the merge request within the
+// project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IID",type="integer",JSONPath=".status.atProvider.iid"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type MergeRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MergeRequestSpec   `json:"spec"`
+	Status MergeRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MergeRequestList contains a list of MergeRequest items.
+type MergeRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MergeRequest `json:"items"`
+}
@@ -21,6 +21,8 @@ import (
 	// +cluster-scope:delete=1
 	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 )
 
 // AccessControlValue represents an access control value within GitLab,
@@ -149,12 +151,56 @@ type ContainerExpirationPolicyAttributes struct {
 	NameRegex *string `url:"name_regex,omitempty" json:"name_regex,omitempty"`
 }
 
+// PackagesCleanupPolicy represents the cleanup policy of the package registry
+// of a project.
+type PackagesCleanupPolicy struct {
+	// KeepNDuplicatedPackageFiles is how many duplicated files of a package
+	// are kept, the oldest ones are deleted. One of all, 1, 10, 20, 30, 40 or
+	// 50, all keeps every file.
+	// +kubebuilder:validation:Enum=all;"1";"10";"20";"30";"40";"50"
+	KeepNDuplicatedPackageFiles string `json:"keepNDuplicatedPackageFiles"`
+}
+
+// CICatalogVersionObservation is a version of a project published in the
+// CI/CD catalog.
+type CICatalogVersionObservation struct {
+	// Name of the version, which is the tag of its release.
+	Name string `json:"name"`
+
+	// ReleasedAt is when the release of the version was created.
+	ReleasedAt *metav1.Time `json:"releasedAt,omitempty"`
+}
+
+// PackagesCleanupPolicyObservation is the observed cleanup policy of the
+// package registry of a project.
+type PackagesCleanupPolicyObservation struct {
+	KeepNDuplicatedPackageFiles string       `json:"keepNDuplicatedPackageFiles,omitempty"`
+	NextRunAt                   *metav1.Time `json:"nextRunAt,omitempty"`
+}
+
 // ProjectParameters define the desired state of a Gitlab Project
 type ProjectParameters struct {
 	// Set whether or not merge requests can be merged with skipped jobs.
 	// +optional
 	AllowMergeOnSkippedPipeline *bool `json:"allowMergeOnSkippedPipeline,omitempty"`
 
+	// AllowTransfer specifies whether the project is transferred to the
+	// namespace given by namespaceId when it differs from the namespace the
+	// project is in. Transferring changes the path of the project and of
+	// everything in it. Defaults to false, in which case a different
+	// namespace is ignored.
+	// +optional
+	AllowTransfer *bool `json:"allowTransfer,omitempty"`
+
+	// Set whether or not a pipeline triggerer is allowed to approve deployments
+	// to protected environments. Only applied on update.
+	// +optional
+	AllowPipelineTriggerApproveDeployment *bool `json:"allowPipelineTriggerApproveDeployment,omitempty"`
+
+	// Set visibility of analytics. One of disabled, private, or enabled.
+	// +optional
+	AnalyticsAccessLevel *AccessControlValue `json:"analyticsAccessLevel,omitempty"`
+
 	// How many approvers should approve merge request by default.More actions
 	// To configure approval rules, see Merge request approvals API.
 	//
@@ -166,8 +212,10 @@ type ProjectParameters struct {
 	// +optional
 	AutoCancelPendingPipelines *string `json:"autoCancelPendingPipelines,omitempty"`
 
-	// Auto Deploy strategy (continuous, manual or timedIncremental).
+	// Auto Deploy strategy of Auto DevOps. The setting is not managed if
+	// unset.
 	// +optional
+	// +kubebuilder:validation:Enum=continuous;manual;timed_incremental
 	AutoDevopsDeployStrategy *string `json:"autoDevopsDeployStrategy,omitempty"`
 
 	// Enable Auto DevOps for this project.
@@ -195,7 +243,15 @@ type ProjectParameters struct {
 	// +optional
 	BuildsAccessLevel *AccessControlValue `json:"buildsAccessLevel,omitempty"`
 
-	// The path to CI configuration file.
+	// CICatalogResource publishes the project in the CI/CD catalog, which
+	// makes the components released from it discoverable. Requires GitLab
+	// 16.6 or later.
+	// +optional
+	CICatalogResource *bool `json:"ciCatalogResource,omitempty"`
+
+	// The path to CI configuration file. A file in another project is
+	// referenced as path/to/ci.yml@group/project, optionally followed by
+	// :ref. The path is kept as given and compared verbatim.
 	// +optional
 	CIConfigPath *string `json:"ciConfigPath,omitempty"`
 
@@ -203,10 +259,22 @@ type ProjectParameters struct {
 	// +optional
 	CIDefaultGitDepth *int64 `json:"ciDefaultGitDepth,omitempty"`
 
+	// Pipelines older than this number of seconds are deleted together with
+	// their jobs and artifacts. Omit to keep the server setting.
+	// +optional
+	CIDeletePipelinesInSeconds *int64 `json:"ciDeletePipelinesInSeconds,omitempty"`
+
 	// When a new deployment job starts, skip older deployment jobs that are still pending
 	// +optional
 	CIForwardDeploymentEnabled *bool `json:"ciForwardDeploymentEnabled,omitempty"`
 
+	// CIPipelineVariablesMinimumOverrideRole is the minimum role required to
+	// run pipelines with variables, e.g. when running a pipeline manually.
+	// Requires GitLab 17.1 or later. The setting is not managed if unset.
+	// +optional
+	// +kubebuilder:validation:Enum=no_one_allowed;developer;maintainer;owner
+	CIPipelineVariablesMinimumOverrideRole *string `json:"ciPipelineVariablesMinimumOverrideRole,omitempty"`
+
 	// Update the image cleanup policy for this project. Accepts: cadence (string), keepN (integer), olderThan (string),
 	// nameRegex (string), nameRegexDelete (string), nameRegexKeep (string), enabled (boolean).
 	// +optional
@@ -238,13 +306,27 @@ type ProjectParameters struct {
 	Name *string `json:"name,omitempty"`
 
 	// Disable email notifications.
+	//
+	// Deprecated: Use emailsEnabled instead.
 	// +optional
 	EmailsDisabled *bool `json:"emailsDisabled,omitempty"`
 
+	// Enable email notifications.
+	// +optional
+	EmailsEnabled *bool `json:"emailsEnabled,omitempty"`
+
+	// Set visibility of environments. One of disabled, private, or enabled.
+	// +optional
+	EnvironmentsAccessLevel *AccessControlValue `json:"environmentsAccessLevel,omitempty"`
+
 	// The classification label for the project.
 	// +optional
 	ExternalAuthorizationClassificationLabel *string `json:"externalAuthorizationClassificationLabel,omitempty"`
 
+	// Set visibility of feature flags. One of disabled, private, or enabled.
+	// +optional
+	FeatureFlagsAccessLevel *AccessControlValue `json:"featureFlagsAccessLevel,omitempty"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	ForkingAccessLevel *AccessControlValue `json:"forkingAccessLevel,omitempty"`
@@ -264,6 +346,10 @@ type ProjectParameters struct {
 	// +optional
 	ImportURLSecretRef *xpv1.LocalSecretKeySelector `json:"importUrlSecretRef,omitempty"`
 
+	// Set visibility of infrastructure management. One of disabled, private, or enabled.
+	// +optional
+	InfrastructureAccessLevel *AccessControlValue `json:"infrastructureAccessLevel,omitempty"`
+
 	// false by default.
 	// +optional
 	// +immutable
@@ -278,14 +364,31 @@ type ProjectParameters struct {
 	// +optional
 	IssuesTemplate *string `json:"issuesTemplate,omitempty"`
 
+	// Keep the artifacts of the most recent successful pipeline of each ref
+	// regardless of their expiry. Artifacts expire after the instance wide
+	// defaultArtifactsExpireIn unless a job sets its own expiry.
+	// +optional
+	KeepLatestArtifact *bool `json:"keepLatestArtifact,omitempty"`
+
 	// Enable LFS.
 	// +optional
 	LFSEnabled *bool `json:"lfsEnabled,omitempty"`
 
+	// MergeCommitTemplate is the template of the message of merge commits,
+	// which may contain placeholders like %{title}. The template is not
+	// managed if unset.
+	// +optional
+	MergeCommitTemplate *string `json:"mergeCommitTemplate,omitempty"`
+
 	// Set the merge method used.
 	// +optional
 	MergeMethod *MergeMethodValue `json:"mergeMethod,omitempty"`
 
+	// Set whether merge requests of a fork target the fork instead of its
+	// upstream project by default.
+	// +optional
+	MergeRequestDefaultTargetSelf *bool `json:"mergeRequestDefaultTargetSelf,omitempty"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	MergeRequestsAccessLevel *AccessControlValue `json:"mergeRequestsAccessLevel,omitempty"`
@@ -311,19 +414,37 @@ type ProjectParameters struct {
 	// +optional
 	MirrorUserID *int64 `json:"mirrorUserId,omitempty"`
 
+	// Set visibility of model experiments. One of disabled, private, or enabled.
+	// +optional
+	ModelExperimentsAccessLevel *AccessControlValue `json:"modelExperimentsAccessLevel,omitempty"`
+
+	// Set visibility of the model registry. One of disabled, private, or enabled.
+	// +optional
+	ModelRegistryAccessLevel *AccessControlValue `json:"modelRegistryAccessLevel,omitempty"`
+
+	// Set visibility of monitoring. One of disabled, private, or enabled.
+	// +optional
+	MonitorAccessLevel *AccessControlValue `json:"monitorAccessLevel,omitempty"`
+
 	// Namespace for the new project (defaults to the current user’s namespace).
 	// +optional
 	NamespaceID *int64 `json:"namespaceId,omitempty"`
 
-	// NamespaceIDRef is a reference to a project to retrieve its namespaceId
+	// NamespaceIDRef is a reference to a group to retrieve its namespaceId
 	// +optional
-	// +immutable
 	NamespaceIDRef *xpv1.NamespacedReference `json:"namespaceIdRef,omitempty"`
 
-	// NamespaceIDSelector selects reference to a project to retrieve its namespaceId.
+	// NamespaceIDSelector selects reference to a group to retrieve its namespaceId.
 	// +optional
 	NamespaceIDSelector *xpv1.NamespacedSelector `json:"namespaceIdSelector,omitempty"`
 
+	// NamespacePath is the full path, e.g. my-group/my-subgroup, of the
+	// namespace for the new project. It is resolved to its ID when the project
+	// is created and ignored if namespaceId is set.
+	// +optional
+	// +immutable
+	NamespacePath *string `json:"namespacePath,omitempty"`
+
 	// Set whether merge requests can only be merged when all the discussions are resolved.
 	// +optional
 	OnlyAllowMergeIfAllDiscussionsAreResolved *bool `json:"onlyAllowMergeIfAllDiscussionsAreResolved,omitempty"`
@@ -344,6 +465,11 @@ type ProjectParameters struct {
 	// +optional
 	PackagesEnabled *bool `json:"packagesEnabled,omitempty"`
 
+	// PackagesCleanupPolicy configures the cleanup of duplicated package
+	// files. The policy is not managed if unset.
+	// +optional
+	PackagesCleanupPolicy *PackagesCleanupPolicy `json:"packagesCleanupPolicy,omitempty"`
+
 	// One of disabled, private, enabled, or public.
 	// +optional
 	PagesAccessLevel *AccessControlValue `json:"pagesAccessLevel,omitempty"`
@@ -353,9 +479,15 @@ type ProjectParameters struct {
 	// +optional
 	Path *string `json:"path,omitempty"`
 
+	// Set whether merge requests can only be merged if their title or
+	// description references a Jira issue. It can only be enabled while the
+	// Jira integration of the project is active and requires a GitLab
+	// Ultimate license.
+	// +optional
+	PreventMergeWithoutJiraIssue *bool `json:"preventMergeWithoutJiraIssue,omitempty"`
+
 	// Show link to create/view merge request when pushing from the command line.
 	// +optional
-	// +immutable
 	PrintingMergeRequestLinkEnabled *bool `json:"printingMergeRequestLinkEnabled,omitempty"`
 
 	// Deprecated: Use PublicJobs instead. This field will be removed in a future version.
@@ -374,7 +506,11 @@ type ProjectParameters struct {
 	// Push rules give you more control over what can and can’t be pushed to
 	// your repository.
 	// +optional
-	PushRules *PushRules `json:"pushRules,omitempty"`
+	PushRules *commonv1alpha1.PushRules `json:"pushRules,omitempty"`
+
+	// Set visibility of releases. One of disabled, private, or enabled.
+	// +optional
+	ReleasesAccessLevel *AccessControlValue `json:"releasesAccessLevel,omitempty"`
 
 	// Enable Delete source branch option by default for all new merge requests.
 	// +optional
@@ -388,10 +524,25 @@ type ProjectParameters struct {
 	// +optional
 	RequestAccessEnabled *bool `json:"requestAccessEnabled,omitempty"`
 
+	// Set visibility of requirements management. One of disabled, private, or enabled.
+	// +optional
+	RequirementsAccessLevel *AccessControlValue `json:"requirementsAccessLevel,omitempty"`
+
 	// Automatically resolve merge request diffs discussions on lines changed with a push.
 	// +optional
 	ResolveOutdatedDiffDiscussions *bool `json:"resolveOutdatedDiffDiscussions,omitempty"`
 
+	// RestrictUserDefinedVariables allows only users with the role set by
+	// ciPipelineVariablesMinimumOverrideRole to run pipelines with variables.
+	// Deprecated by GitLab in favor of ciPipelineVariablesMinimumOverrideRole.
+	// The setting is not managed if unset.
+	// +optional
+	RestrictUserDefinedVariables *bool `json:"restrictUserDefinedVariables,omitempty"`
+
+	// Set visibility of security and compliance. One of disabled, private, or enabled.
+	// +optional
+	SecurityAndComplianceAccessLevel *AccessControlValue `json:"securityAndComplianceAccessLevel,omitempty"`
+
 	// Enable or disable Service Desk feature.
 	// +optional
 	ServiceDeskEnabled *bool `json:"serviceDeskEnabled,omitempty"`
@@ -404,6 +555,12 @@ type ProjectParameters struct {
 	// +optional
 	SnippetsAccessLevel *AccessControlValue `json:"snippetsAccessLevel,omitempty"`
 
+	// SquashCommitTemplate is the template of the message of squash commits,
+	// which may contain placeholders like %{title}. The template is not
+	// managed if unset.
+	// +optional
+	SquashCommitTemplate *string `json:"squashCommitTemplate,omitempty"`
+
 	// The commit message used to apply merge request suggestions.
 	// +optional
 	SuggestionCommitMessage *string `json:"suggestionCommitMessage,omitempty"`
@@ -432,6 +589,14 @@ type ProjectParameters struct {
 	// +immutable
 	TemplateProjectID *int64 `json:"templateProjectId,omitempty"`
 
+	// TemplateProjectPath is the full path, e.g. my-group/my-template, of a
+	// custom project template. It is resolved to templateProjectId when the
+	// project is created and ignored if templateProjectId is set. Requires
+	// useCustomTemplate to be true.
+	// +optional
+	// +immutable
+	TemplateProjectPath *string `json:"templateProjectPath,omitempty"`
+
 	// Use either custom instance or group (with groupWithProjectTemplatesId) project template.
 	// +optional
 	// +immutable
@@ -453,50 +618,64 @@ type ProjectParameters struct {
 	// RemoveFinalizerOnPendingDeletion specifies wether the finalizer of this
 	// object should be removed in case the Kubernetes object and
 	// the external Gitlab project are marked for pending deletion.
+	// Defaults to true, set it to false to keep the object until GitLab
+	// removed the project. Has no effect if PermanentlyRemove is set.
+	// +optional
 	RemoveFinalizerOnPendingDeletion *bool `json:"removeFinalizerOnPendingDeletion,omitempty"`
-}
-
-type PushRules struct {
-	// All commit author emails must match this regular expression.
-	AuthorEmailRegex *string `json:"authorEmailRegex,omitempty"`
-
-	// All branch names must match this regular expression.
-	BranchNameRegex *string `json:"branchNameRegex,omitempty"`
 
-	// Users can only push commits to this repository if the committer email is
-	// one of their own verified emails.
-	CommitCommitterCheck *bool `json:"commitCommitterCheck,omitempty"`
-
-	// Users can only push commits to this repository if the commit author name
-	// is consistent with their GitLab account name.
-	CommitCommitterNameCheck *bool `json:"commitCommitterNameCheck,omitempty"`
-
-	// No commit message is allowed to match this regular expression.
-	CommitMessageNegativeRegex *string `json:"commitMessageNegativeRegex,omitempty"`
-
-	// All commit messages must match this regular expression.
-	CommitMessageRegex *string `json:"commitMessageRegex,omitempty"`
+	// VerifyPathOnDelete specifies whether the project is only deleted if it
+	// is the project first observed at the path given by path, namespacePath
+	// and namespaceId of this spec, which guards against deleting another
+	// project after the external name was changed. Defaults to true.
+	// +optional
+	VerifyPathOnDelete *bool `json:"verifyPathOnDelete,omitempty"`
 
-	// Deny deleting a tag.
-	DenyDeleteTag *bool `json:"denyDeleteTag,omitempty"`
+	// RestoreOnPendingDeletion specifies whether a project that was marked
+	// for pending deletion outside of this managed resource is restored.
+	// Defaults to false.
+	// +optional
+	RestoreOnPendingDeletion *bool `json:"restoreOnPendingDeletion,omitempty"`
 
-	// All committed filenames must not match this regular expression.
-	FileNameRegex *string `json:"fileNameRegex,omitempty"`
+	// Avatar of the project. The avatar is not managed if omitted and
+	// removed if set without a source.
+	// +optional
+	Avatar *ProjectAvatar `json:"avatar,omitempty"`
 
-	// Maximum file size (MB).
-	MaxFileSize *int64 `json:"maxFileSize,omitempty"`
+	// ComplianceFrameworks assigned to the project, defined in the top-level
+	// group of the project. Their order is not significant. The frameworks
+	// are not managed if omitted and all frameworks are removed if empty.
+	// Compliance frameworks require a GitLab Premium or Ultimate license.
+	// +optional
+	ComplianceFrameworks *[]ComplianceFrameworkReference `json:"complianceFrameworks,omitempty"`
+}
 
-	// Restrict commits by author (email) to existing GitLab users.
-	MemberCheck *bool `json:"memberCheck,omitempty"`
+// ComplianceFrameworkReference references a compliance framework of a
+// top-level group by its ID or name.
+// +kubebuilder:validation:XValidation:rule="(has(self.id) ? 1 : 0) + (has(self.name) ? 1 : 0) == 1",message="exactly one of id or name must be set"
+type ComplianceFrameworkReference struct {
+	// ID of the compliance framework.
+	// +optional
+	ID *int64 `json:"id,omitempty"`
 
-	// GitLab rejects any files that are likely to contain secrets.
-	PreventSecrets *bool `json:"preventSecrets,omitempty"`
+	// Name of the compliance framework, resolved to its ID in the top-level
+	// group of the project.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
 
-	// Reject commit when it’s not signed.
-	RejectUnsignedCommits *bool `json:"rejectUnsignedCommits,omitempty"`
+// ProjectAvatar references an image to use as project avatar. At most one of
+// SecretRef or ConfigMapRef may be set. The image must be a PNG, JPEG, GIF,
+// BMP, ICO or WebP file of at most 200 KiB.
+// +kubebuilder:validation:XValidation:rule="!(has(self.secretRef) && has(self.configMapRef))",message="at most one of secretRef or configMapRef may be set"
+type ProjectAvatar struct {
+	// SecretRef references a key of a Secret holding the image.
+	// +optional
+	SecretRef *xpv1.LocalSecretKeySelector `json:"secretRef,omitempty"`
 
-	// Reject commit when it’s not DCO certified.
-	RejectNonDCOCommits *bool `json:"rejectNonDcoCommits,omitempty"`
+	// ConfigMapRef references a key of a ConfigMap holding the image,
+	// usually in its binaryData.
+	// +optional
+	ConfigMapRef *commonv1alpha1.LocalConfigMapKeySelector `json:"configMapRef,omitempty"`
 }
 
 // ProjectNamespace represents a project namespace.
@@ -557,8 +736,9 @@ type StorageStatistics struct {
 
 // ProjectStatistics represents a statistics record for a project.
 type ProjectStatistics struct {
-	StorageStatistics `json:",inline"`
-	CommitCount       int64 `json:"commitCount"`
+	StorageStatistics     `json:",inline"`
+	CommitCount           int64 `json:"commitCount"`
+	PipelineArtifactsSize int64 `json:"pipelineArtifactsSize"`
 }
 
 // Links represents a project web links for self, issues, mergeRequests,
@@ -634,6 +814,40 @@ type ProjectObservation struct {
 	WebURL                    string                     `json:"webUrl,omitempty"`
 	WikiEnabled               bool                       `json:"wikiEnabled,omitempty"`
 	WikiAccessLevel           AccessControlValue         `json:"wikiAccessLevel,omitempty"`
+
+	// AvatarHash is the SHA-256 hash of the avatar image last uploaded to
+	// GitLab.
+	AvatarHash string `json:"avatarHash,omitempty"`
+
+	// AvatarURLChecked is the avatar URL last found to hold the image of
+	// avatarHash. The avatar is only downloaded again once its URL changes.
+	AvatarURLChecked string `json:"avatarUrlChecked,omitempty"`
+
+	// PackagesCleanupPolicy is the cleanup policy of the package registry.
+	// It is only observed if packagesCleanupPolicy is set.
+	PackagesCleanupPolicy *PackagesCleanupPolicyObservation `json:"packagesCleanupPolicy,omitempty"`
+
+	// ImportURLSecretVersion is the resource version of the secret referenced
+	// by importUrlSecretRef when the import URL was last sent to GitLab.
+	ImportURLSecretVersion string `json:"importUrlSecretVersion,omitempty"`
+
+	// CIConfigPathChecked is the ciConfigPath whose referenced project was
+	// last found to be readable. The project is not checked again until
+	// ciConfigPath changes.
+	CIConfigPathChecked string `json:"ciConfigPathChecked,omitempty"`
+
+	// VerifiedID is the ID of the project first observed at the path the
+	// spec expects. Only this project is deleted if verifyPathOnDelete is
+	// set, even after it was transferred or its namespace was renamed.
+	VerifiedID int64 `json:"verifiedId,omitempty"`
+
+	// CICatalogResource is true if the project is published in the CI/CD
+	// catalog. It is only observed if ciCatalogResource is set.
+	CICatalogResource *bool `json:"ciCatalogResource,omitempty"`
+
+	// CICatalogVersion is the latest version of the project published in the
+	// CI/CD catalog. It is only observed while the project is published.
+	CICatalogVersion *CICatalogVersionObservation `json:"ciCatalogVersion,omitempty"`
 }
 
 // A ProjectSpec defines the desired state of a Gitlab Project.
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectClusterPlatformKubernetes defines the Kubernetes cluster a GitLab
+// project cluster connects to.
+type ProjectClusterPlatformKubernetes struct {
+	// APIURL is the URL to access the Kubernetes API.
+	// +kubebuilder:validation:MinLength:=1
+	APIURL string `json:"apiUrl"`
+
+	// TokenSecretRef references the token to authenticate against
+	// Kubernetes. The token is not compared against GitLab and only pushed
+	// when the referenced value changes.
+	TokenSecretRef xpv1.LocalSecretKeySelector `json:"tokenSecretRef"`
+
+	// CaCertSecretRef references the TLS certificate of the Kubernetes API.
+	// It is required if the API uses a self-signed certificate and, like the
+	// token, only pushed when the referenced value changes.
+	// +optional
+	CaCertSecretRef *xpv1.LocalSecretKeySelector `json:"caCertSecretRef,omitempty"`
+
+	// Namespace is the unique namespace related to the project.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// AuthorizationType is the cluster authorization type.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum:=rbac;abac;unknown_authorization
+	AuthorizationType *string `json:"authorizationType,omitempty"`
+}
+
+// ProjectClusterParameters define the desired state of a certificate-based
+// GitLab project cluster.
+// https://docs.gitlab.com/ee/api/project_clusters.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProjectClusterParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Name of the cluster.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Domain is the base domain of the cluster.
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Enabled determines if the cluster is active. It can only be set when
+	// the cluster is added.
+	// +optional
+	// +immutable
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Managed determines if GitLab manages namespaces and service accounts
+	// for the cluster. It can only be set when the cluster is added.
+	// +optional
+	// +immutable
+	Managed *bool `json:"managed,omitempty"`
+
+	// EnvironmentScope is the associated environment to the cluster.
+	// Defaults to "*".
+	// +optional
+	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// ManagementProjectID is the ID of the management project for the cluster.
+	// +optional
+	ManagementProjectID *string `json:"managementProjectId,omitempty"`
+
+	// PlatformKubernetes defines the Kubernetes cluster to connect.
+	PlatformKubernetes ProjectClusterPlatformKubernetes `json:"platformKubernetes"`
+}
+
+// ProjectClusterPlatformKubernetesObservation represents the observed
+// Kubernetes platform of a GitLab project cluster.
+type ProjectClusterPlatformKubernetesObservation struct {
+	APIURL            string `json:"apiUrl,omitempty"`
+	Namespace         string `json:"namespace,omitempty"`
+	AuthorizationType string `json:"authorizationType,omitempty"`
+}
+
+// ProjectClusterObservation represents the observed state of a GitLab
+// project cluster.
+type ProjectClusterObservation struct {
+	ID                  int64                                        `json:"id,omitempty"`
+	Name                string                                       `json:"name,omitempty"`
+	Domain              string                                       `json:"domain,omitempty"`
+	EnvironmentScope    string                                       `json:"environmentScope,omitempty"`
+	ProviderType        string                                       `json:"providerType,omitempty"`
+	PlatformType        string                                       `json:"platformType,omitempty"`
+	ClusterType         string                                       `json:"clusterType,omitempty"`
+	CreatedAt           *metav1.Time                                 `json:"createdAt,omitempty"`
+	ManagementProjectID *int64                                       `json:"managementProjectId,omitempty"`
+	PlatformKubernetes  *ProjectClusterPlatformKubernetesObservation `json:"platformKubernetes,omitempty"`
+
+	// CredentialsHash is the SHA-256 hash of the token and CA certificate
+	// last pushed to GitLab. It is used to detect credential changes without
+	// comparing secrets against GitLab.
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+}
+
+// A ProjectClusterSpec defines the desired state of a GitLab project cluster.
+type ProjectClusterSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ProjectClusterParameters `json:"forProvider"`
+}
+
+// A ProjectClusterStatus represents the observed state of a GitLab project cluster.
+type ProjectClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectCluster is a managed resource that represents a certificate-based
+// GitLab project cluster. Certificate-based clusters are deprecated since
+// GitLab 14.5 in favor of the GitLab agent for Kubernetes; this resource
+// remains available for self-managed instances that still rely on them.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".status.atProvider.environmentScope"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type ProjectCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectClusterSpec   `json:"spec"`
+	Status ProjectClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectClusterList contains a list of ProjectCluster items.
+type ProjectClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectCluster `json:"items"`
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectForkDeletePolicy determines what happens in GitLab when a
+// ProjectFork is deleted.
+type ProjectForkDeletePolicy string
+
+// ProjectFork delete policies.
+const (
+	// ProjectForkDeleteProject deletes the forked project.
+	ProjectForkDeleteProject ProjectForkDeletePolicy = "DeleteProject"
+
+	// ProjectForkRemoveForkRelation only removes the fork relationship and
+	// keeps the project.
+	ProjectForkRemoveForkRelation ProjectForkDeletePolicy = "RemoveForkRelation"
+)
+
+// ProjectForkParameters define the desired state of a GitLab project fork.
+// https://docs.gitlab.com/ee/api/project_forks.html
+// At least 1 of [ForkedFromProjectID, ForkedFromProjectIDRef,
+// ForkedFromProjectIDSelector] required.
+type ProjectForkParameters struct {
+	// ForkedFromProjectID is the ID or URL-encoded path of the project to
+	// fork from. Changing it moves the fork relationship to the new project.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ForkedFromProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ForkedFromProjectIDSelector
+	ForkedFromProjectID *string `json:"forkedFromProjectId,omitempty"`
+
+	// ForkedFromProjectIDRef is a reference to a project to retrieve its
+	// forkedFromProjectId.
+	// +optional
+	ForkedFromProjectIDRef *xpv1.NamespacedReference `json:"forkedFromProjectIdRef,omitempty"`
+
+	// ForkedFromProjectIDSelector selects reference to a project to retrieve
+	// its forkedFromProjectId.
+	// +optional
+	ForkedFromProjectIDSelector *xpv1.NamespacedSelector `json:"forkedFromProjectIdSelector,omitempty"`
+
+	// ProjectID is the ID of an existing project to manage as fork. If set,
+	// the fork relationship to the source project is created for this
+	// project instead of forking a new one, and the fork options below are
+	// ignored.
+	// +optional
+	// +immutable
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// NamespaceID is the ID of the namespace the project is forked to.
+	// Defaults to the namespace of the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=NamespaceIDRef
+	// +crossplane:generate:reference:selectorFieldName=NamespaceIDSelector
+	NamespaceID *string `json:"namespaceId,omitempty"`
+
+	// NamespaceIDRef is a reference to a group to retrieve its namespaceId.
+	// +optional
+	// +immutable
+	NamespaceIDRef *xpv1.NamespacedReference `json:"namespaceIdRef,omitempty"`
+
+	// NamespaceIDSelector selects reference to a group to retrieve its namespaceId.
+	// +optional
+	// +immutable
+	NamespaceIDSelector *xpv1.NamespacedSelector `json:"namespaceIdSelector,omitempty"`
+
+	// NamespacePath is the path of the namespace the project is forked to.
+	// +optional
+	// +immutable
+	NamespacePath *string `json:"namespacePath,omitempty"`
+
+	// Name assigned to the forked project.
+	// +optional
+	// +immutable
+	Name *string `json:"name,omitempty"`
+
+	// Path assigned to the forked project.
+	// +optional
+	// +immutable
+	Path *string `json:"path,omitempty"`
+
+	// Description assigned to the forked project.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Visibility level assigned to the forked project.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum:=private;internal;public
+	Visibility *VisibilityValue `json:"visibility,omitempty"`
+
+	// Branches to fork, all branches are forked if empty.
+	// +optional
+	// +immutable
+	Branches *string `json:"branches,omitempty"`
+
+	// MergeRequestDefaultTargetSelf determines if merge requests of the
+	// fork target the fork itself instead of the source project.
+	// +optional
+	// +immutable
+	MergeRequestDefaultTargetSelf *bool `json:"mergeRequestDefaultTargetSelf,omitempty"`
+
+	// DeletePolicy determines what happens in GitLab when this resource is
+	// deleted. DeleteProject deletes the forked project including its
+	// repository, RemoveForkRelation only removes the fork relationship.
+	// Defaults to DeleteProject for forks created by this resource and to
+	// RemoveForkRelation for existing projects referenced by ProjectID.
+	// +optional
+	// +kubebuilder:validation:Enum:=DeleteProject;RemoveForkRelation
+	DeletePolicy *ProjectForkDeletePolicy `json:"deletePolicy,omitempty"`
+}
+
+// ProjectForkObservation represents the observed state of a GitLab project fork.
+type ProjectForkObservation struct {
+	ID                int64       `json:"id,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	PathWithNamespace string      `json:"pathWithNamespace,omitempty"`
+	WebURL            string      `json:"webUrl,omitempty"`
+	ForkedFromProject *ForkParent `json:"forkedFromProject,omitempty"`
+}
+
+// A ProjectForkSpec defines the desired state of a GitLab project fork.
+type ProjectForkSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ProjectForkParameters `json:"forProvider"`
+}
+
+// A ProjectForkStatus represents the observed state of a GitLab project fork.
+type ProjectForkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectForkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectFork is a managed resource that represents a fork of a GitLab
+// project. Deleting it deletes the forked project unless the delete policy
+// is RemoveForkRelation.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".status.atProvider.pathWithNamespace"
+// +kubebuilder:printcolumn:name="FORKED-FROM",type="string",JSONPath=".status.atProvider.forkedFromProject.pathWithNamespace"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type ProjectFork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectForkSpec   `json:"spec"`
+	Status ProjectForkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectForkList contains a list of ProjectFork items.
+type ProjectForkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectFork `json:"items"`
+}
@@ -31,6 +31,19 @@ type EnvironmentAccessLevelParameters struct {
 	// +optional
 	GroupID *int64 `json:"groupId,omitempty"`
 
+	// GroupIDRef is a reference to a Group to populate groupId.
+	// +optional
+	GroupIDRef *xpv1.NamespacedReference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects a reference to a Group to populate groupId.
+	// +optional
+	GroupIDSelector *xpv1.NamespacedSelector `json:"groupIdSelector,omitempty"`
+
+	// GroupPath is the full path of a GitLab group allowed to deploy, e.g.
+	// my-group/my-subgroup. It is resolved to the groupId.
+	// +optional
+	GroupPath *string `json:"groupPath,omitempty"`
+
 	// GroupInheritanceType controls how inherited group memberships are treated.
 	// 0 => direct only, 1 => include inherited.
 	// +optional
@@ -51,6 +64,19 @@ type EnvironmentApprovalRuleParameters struct {
 	// +optional
 	GroupID *int64 `json:"groupId,omitempty"`
 
+	// GroupIDRef is a reference to a Group to populate groupId.
+	// +optional
+	GroupIDRef *xpv1.NamespacedReference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects a reference to a Group to populate groupId.
+	// +optional
+	GroupIDSelector *xpv1.NamespacedSelector `json:"groupIdSelector,omitempty"`
+
+	// GroupPath is the full path of a GitLab group allowed to approve, e.g.
+	// my-group/my-subgroup. It is resolved to the groupId.
+	// +optional
+	GroupPath *string `json:"groupPath,omitempty"`
+
 	// RequiredApprovals required for this rule.
 	// +optional
 	RequiredApprovals *int64 `json:"requiredApprovals,omitempty"`
@@ -89,7 +115,6 @@ type ProtectedEnvironmentParameters struct {
 	Name *string `json:"name"`
 
 	// ProjectID is the ID or path of the GitLab project.
-	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
 	// +optional
 	ProjectID *string `json:"projectId,omitempty"`
 
@@ -112,12 +137,20 @@ type ProtectedEnvironmentParameters struct {
 	// RequiredApprovalCount is the unified (legacy) approval count.
 	// +optional
 	RequiredApprovalCount *int64 `json:"requiredApprovalCount,omitempty"`
+
+	// PreventSelfApproval prevents the user who triggered a deployment from
+	// approving it. GitLab stores this setting per project, so it applies to
+	// all protected environments of the project. The server setting is left
+	// untouched when unset.
+	// +optional
+	PreventSelfApproval *bool `json:"preventSelfApproval,omitempty"`
 }
 
 // ProtectedEnvironmentObservation reflects the observed state from GitLab.
 type ProtectedEnvironmentObservation struct {
 	Name                  *string `json:"name,omitempty"`
 	RequiredApprovalCount *int64  `json:"requiredApprovalCount,omitempty"`
+	PreventSelfApproval   *bool   `json:"preventSelfApproval,omitempty"`
 
 	DeployAccessLevels []EnvironmentAccessLevelObservation  `json:"deployAccessLevels,omitempty"`
 	ApprovalRules      []EnvironmentApprovalRuleObservation `json:"approvalRules,omitempty"`
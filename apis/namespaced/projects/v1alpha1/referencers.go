@@ -20,7 +20,9 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -49,6 +51,37 @@ func toPtrValue(v string) (*int64, error) {
 	return &r, nil
 }
 
+// ProjectID extracts the ID of a Project for references to integer fields. It
+// is read from the status as the external name may be the path of the
+// project, and falls back to a numeric external name until the project was
+// observed.
+func ProjectID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*Project)
+		if !ok {
+			return ""
+		}
+		if p.Status.AtProvider.ID != 0 {
+			return strconv.FormatInt(p.Status.AtProvider.ID, 10)
+		}
+		if _, err := strconv.ParseInt(meta.GetExternalName(p), 10, 64); err == nil {
+			return meta.GetExternalName(p)
+		}
+		return ""
+	}
+}
+
+// ProtectedBranchID extracts the ID of a ProtectedBranch from its status.
+func ProtectedBranchID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		b, ok := mg.(*ProtectedBranch)
+		if !ok || b.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.FormatInt(b.Status.AtProvider.ID, 10)
+	}
+}
+
 // ResolveReferences of this Hook
 func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPINamespacedResolver(c, mg)
@@ -59,7 +92,7 @@ func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -87,7 +120,7 @@ func (mg *ApprovalRule) ResolveReferences(ctx context.Context, c client.Reader)
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -115,7 +148,7 @@ func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.NamespaceIDRef,
 		Selector:     mg.Spec.ForProvider.NamespaceIDSelector,
 		To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      v1alpha1.GroupID(),
 	})
 
 	if err != nil {
@@ -143,7 +176,7 @@ func (mg *DeployToken) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -171,7 +204,7 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -199,7 +232,35 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	resolvedID, err := toPtrValue(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider")
+	}
+
+	mg.Spec.ForProvider.ProjectID = resolvedID
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VariableSet
+func (mg *VariableSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -227,7 +288,7 @@ func (mg *Runner) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -255,7 +316,7 @@ func (mg *Badge) ResolveReferences(ctx context.Context, c client.Reader) error {
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -283,7 +344,7 @@ func (mg *IntegrationMattermost) ResolveReferences(ctx context.Context, c client
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -299,3 +360,119 @@ func (mg *IntegrationMattermost) ResolveReferences(ctx context.Context, c client
 
 	return nil
 }
+
+// ResolveReferences of this ProtectedEnvironment
+func (mg *ProtectedEnvironment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	mg.Spec.ForProvider.ProjectID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.deployAccessLevels[*].groupIdRef
+	if mg.Spec.ForProvider.DeployAccessLevels != nil {
+		for i := range *mg.Spec.ForProvider.DeployAccessLevels {
+			l := &(*mg.Spec.ForProvider.DeployAccessLevels)[i]
+			rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: fromPtrValue(l.GroupID),
+				Reference:    l.GroupIDRef,
+				Selector:     l.GroupIDSelector,
+				To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
+				Extract:      v1alpha1.GroupID(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.deployAccessLevels[%d].groupId", i)
+			}
+			if l.GroupID, err = toPtrValue(rsp.ResolvedValue); err != nil {
+				return errors.Wrapf(err, "spec.forProvider.deployAccessLevels[%d]", i)
+			}
+			l.GroupIDRef = rsp.ResolvedReference
+		}
+	}
+
+	// resolve spec.forProvider.approvalRules[*].groupIdRef
+	if mg.Spec.ForProvider.ApprovalRules != nil {
+		for i := range *mg.Spec.ForProvider.ApprovalRules {
+			a := &(*mg.Spec.ForProvider.ApprovalRules)[i]
+			rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: fromPtrValue(a.GroupID),
+				Reference:    a.GroupIDRef,
+				Selector:     a.GroupIDSelector,
+				To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
+				Extract:      v1alpha1.GroupID(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.approvalRules[%d].groupId", i)
+			}
+			if a.GroupID, err = toPtrValue(rsp.ResolvedValue); err != nil {
+				return errors.Wrapf(err, "spec.forProvider.approvalRules[%d]", i)
+			}
+			a.GroupIDRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ProjectExternalStatusCheck
+func (mg *ProjectExternalStatusCheck) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+	if mg.Spec.ForProvider.ProjectID, err = toPtrValue(rsp.ResolvedValue); err != nil {
+		return errors.Wrap(err, "spec.forProvider")
+	}
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.protectedBranchIdRefs
+	current := make([]string, len(mg.Spec.ForProvider.ProtectedBranchIDs))
+	for i, id := range mg.Spec.ForProvider.ProtectedBranchIDs {
+		current[i] = strconv.FormatInt(id, 10)
+	}
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiNamespacedResolutionRequest{
+		CurrentValues: current,
+		References:    mg.Spec.ForProvider.ProtectedBranchIDRefs,
+		Selector:      mg.Spec.ForProvider.ProtectedBranchIDSelector,
+		To:            reference.To{Managed: &ProtectedBranch{}, List: &ProtectedBranchList{}},
+		Extract:       ProtectedBranchID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.protectedBranchIds")
+	}
+	ids := make([]int64, 0, len(mrsp.ResolvedValues))
+	for _, v := range mrsp.ResolvedValues {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.protectedBranchIds")
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) > 0 {
+		mg.Spec.ForProvider.ProtectedBranchIDs = ids
+	}
+	mg.Spec.ForProvider.ProtectedBranchIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -156,6 +156,110 @@ var (
 	ProjectShareGroupGroupVersionKind = SchemeGroupVersion.WithKind(ProjectShareGroupKind)
 )
 
+// Branch type metadata
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
+// Tag type metadata
+var (
+	TagKind             = reflect.TypeOf(Tag{}).Name()
+	TagGroupKind        = schema.GroupKind{Group: Group, Kind: TagKind}.String()
+	TagKindAPIVersion   = TagKind + "." + SchemeGroupVersion.String()
+	TagGroupVersionKind = SchemeGroupVersion.WithKind(TagKind)
+)
+
+// PagesDomain type metadata
+var (
+	PagesDomainKind             = reflect.TypeOf(PagesDomain{}).Name()
+	PagesDomainGroupKind        = schema.GroupKind{Group: Group, Kind: PagesDomainKind}.String()
+	PagesDomainKindAPIVersion   = PagesDomainKind + "." + SchemeGroupVersion.String()
+	PagesDomainGroupVersionKind = SchemeGroupVersion.WithKind(PagesDomainKind)
+)
+
+// ProjectCluster type metadata
+var (
+	ProjectClusterKind             = reflect.TypeOf(ProjectCluster{}).Name()
+	ProjectClusterGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectClusterKind}.String()
+	ProjectClusterKindAPIVersion   = ProjectClusterKind + "." + SchemeGroupVersion.String()
+	ProjectClusterGroupVersionKind = SchemeGroupVersion.WithKind(ProjectClusterKind)
+)
+
+// ProjectFork type metadata
+var (
+	ProjectForkKind             = reflect.TypeOf(ProjectFork{}).Name()
+	ProjectForkGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectForkKind}.String()
+	ProjectForkKindAPIVersion   = ProjectForkKind + "." + SchemeGroupVersion.String()
+	ProjectForkGroupVersionKind = SchemeGroupVersion.WithKind(ProjectForkKind)
+)
+
+// Issue type metadata
+var (
+	IssueKind             = reflect.TypeOf(Issue{}).Name()
+	IssueGroupKind        = schema.GroupKind{Group: Group, Kind: IssueKind}.String()
+	IssueKindAPIVersion   = IssueKind + "." + SchemeGroupVersion.String()
+	IssueGroupVersionKind = SchemeGroupVersion.WithKind(IssueKind)
+)
+
+// WikiPage type metadata
+var (
+	WikiPageKind             = reflect.TypeOf(WikiPage{}).Name()
+	WikiPageGroupKind        = schema.GroupKind{Group: Group, Kind: WikiPageKind}.String()
+	WikiPageKindAPIVersion   = WikiPageKind + "." + SchemeGroupVersion.String()
+	WikiPageGroupVersionKind = SchemeGroupVersion.WithKind(WikiPageKind)
+)
+
+// MergeRequest type metadata
+var (
+	MergeRequestKind             = reflect.TypeOf(MergeRequest{}).Name()
+	MergeRequestGroupKind        = schema.GroupKind{Group: Group, Kind: MergeRequestKind}.String()
+	MergeRequestKindAPIVersion   = MergeRequestKind + "." + SchemeGroupVersion.String()
+	MergeRequestGroupVersionKind = SchemeGroupVersion.WithKind(MergeRequestKind)
+)
+
+// ApprovalConfiguration type metadata
+var (
+	ApprovalConfigurationKind             = reflect.TypeOf(ApprovalConfiguration{}).Name()
+	ApprovalConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalConfigurationKind}.String()
+	ApprovalConfigurationKindAPIVersion   = ApprovalConfigurationKind + "." + SchemeGroupVersion.String()
+	ApprovalConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalConfigurationKind)
+)
+
+// Deployment type metadata
+var (
+	DeploymentKind             = reflect.TypeOf(Deployment{}).Name()
+	DeploymentGroupKind        = schema.GroupKind{Group: Group, Kind: DeploymentKind}.String()
+	DeploymentKindAPIVersion   = DeploymentKind + "." + SchemeGroupVersion.String()
+	DeploymentGroupVersionKind = SchemeGroupVersion.WithKind(DeploymentKind)
+)
+
+// VariableSet type metadata
+var (
+	VariableSetKind             = reflect.TypeOf(VariableSet{}).Name()
+	VariableSetGroupKind        = schema.GroupKind{Group: Group, Kind: VariableSetKind}.String()
+	VariableSetKindAPIVersion   = VariableSetKind + "." + SchemeGroupVersion.String()
+	VariableSetGroupVersionKind = SchemeGroupVersion.WithKind(VariableSetKind)
+)
+
+// ProjectExternalStatusCheck type metadata
+var (
+	ProjectExternalStatusCheckKind             = reflect.TypeOf(ProjectExternalStatusCheck{}).Name()
+	ProjectExternalStatusCheckGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectExternalStatusCheckKind}.String()
+	ProjectExternalStatusCheckKindAPIVersion   = ProjectExternalStatusCheckKind + "." + SchemeGroupVersion.String()
+	ProjectExternalStatusCheckGroupVersionKind = SchemeGroupVersion.WithKind(ProjectExternalStatusCheckKind)
+)
+
+// TerraformState type metadata
+var (
+	TerraformStateKind             = reflect.TypeOf(TerraformState{}).Name()
+	TerraformStateGroupKind        = schema.GroupKind{Group: Group, Kind: TerraformStateKind}.String()
+	TerraformStateKindAPIVersion   = TerraformStateKind + "." + SchemeGroupVersion.String()
+	TerraformStateGroupVersionKind = SchemeGroupVersion.WithKind(TerraformStateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&Hook{}, &HookList{})
@@ -174,4 +278,17 @@ func init() {
 	// Mattermost
 	SchemeBuilder.Register(&IntegrationMattermost{}, &IntegrationMattermostList{})
 	SchemeBuilder.Register(&ProjectShareGroup{}, &ProjectShareGroupList{})
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
+	SchemeBuilder.Register(&Tag{}, &TagList{})
+	SchemeBuilder.Register(&PagesDomain{}, &PagesDomainList{})
+	SchemeBuilder.Register(&ProjectCluster{}, &ProjectClusterList{})
+	SchemeBuilder.Register(&ProjectFork{}, &ProjectForkList{})
+	SchemeBuilder.Register(&Issue{}, &IssueList{})
+	SchemeBuilder.Register(&WikiPage{}, &WikiPageList{})
+	SchemeBuilder.Register(&MergeRequest{}, &MergeRequestList{})
+	SchemeBuilder.Register(&ApprovalConfiguration{}, &ApprovalConfigurationList{})
+	SchemeBuilder.Register(&Deployment{}, &DeploymentList{})
+	SchemeBuilder.Register(&VariableSet{}, &VariableSetList{})
+	SchemeBuilder.Register(&ProjectExternalStatusCheck{}, &ProjectExternalStatusCheckList{})
+	SchemeBuilder.Register(&TerraformState{}, &TerraformStateList{})
 }
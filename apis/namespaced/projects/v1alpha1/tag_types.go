@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TagParameters define the desired state of a GitLab repository tag.
+// https://docs.gitlab.com/ee/api/tags.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type TagParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// TagName is the name of the tag. It cannot be changed once set, since
+	// the tag under the old name would be left behind.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tagName is immutable"
+	// +immutable
+	TagName string `json:"tagName"`
+
+	// Ref is the branch name or commit SHA to create the tag from.
+	// Tags cannot be re-pointed through the API, so the ref cannot be
+	// changed once set.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ref is immutable"
+	// +immutable
+	Ref string `json:"ref"`
+
+	// Message creates an annotated tag when set. Changing the message
+	// recreates the tag.
+	// +optional
+	Message *string `json:"message,omitempty"`
+
+	// ReleaseDescription creates a release for the tag with the given
+	// description. Changing the description recreates the tag.
+	// +optional
+	ReleaseDescription *string `json:"releaseDescription,omitempty"`
+}
+
+// TagObservation represents the observed state of a GitLab repository tag.
+type TagObservation struct {
+	// Name is the name of the tag.
+	Name string `json:"name,omitempty"`
+
+	// Ref is the ref the tag was created or adopted from.
+	Ref string `json:"ref,omitempty"`
+
+	// Message is the annotation message of the tag.
+	Message string `json:"message,omitempty"`
+
+	// Target is the SHA the tag points to. For annotated tags this is the
+	// SHA of the tag object.
+	Target string `json:"target,omitempty"`
+
+	// CommitID is the SHA of the tagged commit.
+	CommitID string `json:"commitId,omitempty"`
+
+	// Protected indicates if the tag matches a protected tag rule.
+	Protected bool `json:"protected,omitempty"`
+
+	// ReleaseDescription is the description of the release attached to the tag.
+	ReleaseDescription string `json:"releaseDescription,omitempty"`
+
+	// CreatedAt is the time the tag was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A TagSpec defines the desired state of a GitLab repository tag.
+type TagSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              TagParameters `json:"forProvider"`
+}
+
+// A TagStatus represents the observed state of a GitLab repository tag.
+type TagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Tag is a managed resource that represents a GitLab repository tag.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.tagName"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.projectId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type Tag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagSpec   `json:"spec"`
+	Status TagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagList contains a list of Tag items.
+type TagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tag `json:"items"`
+}
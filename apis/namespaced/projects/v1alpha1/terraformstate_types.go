@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TerraformStateParameters select a GitLab-managed Terraform state of a
+// project.
+// https://docs.gitlab.com/user/infrastructure/iac/terraform_state/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type TerraformStateParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Name of the state, as configured in the address of the http backend.
+	// +immutable
+	Name string `json:"name"`
+
+	// Locked locks the state if true and unlocks it if false, e.g. to
+	// release a lock left behind by a cancelled job. The lock is left
+	// unchanged if unset.
+	// +optional
+	Locked *bool `json:"locked,omitempty"`
+}
+
+// TerraformStateObservation represents the observed state of a GitLab-managed
+// Terraform state.
+type TerraformStateObservation struct {
+	// ProjectPath is the full path of the project of the state.
+	ProjectPath string `json:"projectPath,omitempty"`
+
+	// Name of the state.
+	Name string `json:"name,omitempty"`
+
+	// Serial of the latest version of the state.
+	Serial uint64 `json:"serial,omitempty"`
+
+	// CreatedAt is the time the state was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the latest version of the state was pushed.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// LockedAt is the time the state was locked, if it is locked.
+	LockedAt *metav1.Time `json:"lockedAt,omitempty"`
+}
+
+// A TerraformStateSpec defines the desired state of a GitLab-managed
+// Terraform state.
+type TerraformStateSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              TerraformStateParameters `json:"forProvider"`
+}
+
+// A TerraformStateStatus represents the observed state of a GitLab-managed
+// Terraform state.
+type TerraformStateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TerraformStateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TerraformState manages the lifecycle of a GitLab-managed Terraform or
+// OpenTofu state, which is pushed by the http backend. Deleting the resource
+// deletes the state with all its versions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERIAL",type="integer",JSONPath=".status.atProvider.serial"
+// +kubebuilder:printcolumn:name="LOCKED-AT",type="date",JSONPath=".status.atProvider.lockedAt"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type TerraformState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TerraformStateSpec   `json:"spec"`
+	Status TerraformStateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TerraformStateList contains a list of TerraformState items.
+type TerraformStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TerraformState `json:"items"`
+}
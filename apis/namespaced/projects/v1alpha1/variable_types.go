@@ -27,15 +27,39 @@ import (
 
 // VariableParameters define the desired state of a Gitlab CI Variable
 // https://docs.gitlab.com/ee/api/project_level_variables.html
+// +kubebuilder:validation:XValidation:rule="!(has(self.valueSecretRef) && has(self.valueTemplate))",message="at most one of valueSecretRef or valueTemplate may be set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.maskedAndHidden) && self.maskedAndHidden && has(self.masked) && !self.masked)",message="masked cannot be false if maskedAndHidden is true"
 type VariableParameters struct {
 	v1alpha1.CommonVariableParameters `json:",inline"`
 
 	// ValueSecretRef is used to obtain the value from a secret. This will set Masked and Raw to true if they
-	// have not been set implicitly. Mutually exclusive with Value.
+	// have not been set implicitly. The value is never written to the spec. Takes precedence over Value, which
+	// is ignored with a warning event if both are set.
 	// +optional
 	// +nullable
 	ValueSecretRef *xpv1.LocalSecretKeySelector `json:"valueSecretRef,omitempty"`
 
+	// ValueTemplate composes the value from several secret keys, e.g. a
+	// connection string from a host and a password. Like ValueSecretRef it
+	// sets Masked and Raw to true if they have not been set explicitly.
+	// Mutually exclusive with Value and ValueSecretRef.
+	// +optional
+	ValueTemplate *VariableValueTemplate `json:"valueTemplate,omitempty"`
+
+	// MaskedAndHidden creates the variable masked and hidden, so GitLab never
+	// returns its value. It implies Masked. GitLab can neither hide nor
+	// unhide an existing variable, so changing it requires recreating the
+	// variable. This feature is available in GitLab 17.4 and above.
+	// +optional
+	MaskedAndHidden *bool `json:"maskedAndHidden,omitempty"`
+
+	// MaskIfPossible masks the variable if GitLab can mask its value and
+	// stores it unmasked otherwise, setting the MaskingSkipped condition
+	// instead of failing. Masked is ignored while it is set. This suits
+	// imports of arbitrary secrets. Defaults to false.
+	// +optional
+	MaskIfPossible *bool `json:"maskIfPossible,omitempty"`
+
 	// ProjectID is the ID of the project to create the variable on.
 	// +optional
 	// +immutable
@@ -50,17 +74,70 @@ type VariableParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
 
+	// ProjectIDSearch finds the project by its name, path or namespace in
+	// GitLab to retrieve its projectId, if neither projectId nor a reference
+	// sets it.
+	// +optional
+	ProjectIDSearch *v1alpha1.GitLabSearch `json:"projectIdSearch,omitempty"`
+
+	// ProjectPath is the full path of the project to create the variable on,
+	// e.g. my-group/my-subgroup/my-project. It is resolved to the projectId
+	// if neither projectId nor a reference sets it, and takes precedence
+	// over projectIdSearch.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// EnvironmentScope indicates the environment scope
 	// that this variable is applied to.
 	// +optional
 	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// PruneOtherScopes also removes the variables with the same key in all
+	// other environment scopes when the variable is deleted, e.g. leftovers
+	// of manual edits. Variables with the same key managed by other
+	// resources are removed as well. Defaults to false.
+	// +optional
+	PruneOtherScopes *bool `json:"pruneOtherScopes,omitempty"`
+}
+
+// VariableValueTemplate composes the value of a variable from the values of
+// several secret keys.
+type VariableValueTemplate struct {
+	// Template is the Go template the value is rendered from. The values of
+	// the secret keys are referred to by their name, e.g.
+	// "postgres://app:{{ .password }}@{{ .host }}:5432/app". Referring to a
+	// name that is not listed in SecretKeyRefs fails the reconciliation.
+	Template string `json:"template"`
+
+	// SecretKeyRefs are the secret keys the template refers to. A secret or
+	// key that does not exist fails the reconciliation.
+	// +listType=map
+	// +listMapKey=name
+	SecretKeyRefs []VariableValueSecretKeyRef `json:"secretKeyRefs"`
+}
+
+// VariableValueSecretKeyRef names a secret key a value template refers to.
+type VariableValueSecretKeyRef struct {
+	// Name the template refers to the value of the secret key by.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// SecretKeyRef selects the secret key holding the value.
+	SecretKeyRef xpv1.LocalSecretKeySelector `json:"secretKeyRef"`
 }
 
 // VariableObservation represents the observed state of a Gitlab CI Variable.
 type VariableObservation struct {
 	v1alpha1.CommonVariableObservation `json:",inline"`
-	EnvironmentScope                   string `json:"environmentScope"`
-	Hidden                             bool   `json:"hidden"`
+	// EnvironmentScope is the environment scope the variable resolved to.
+	EnvironmentScope string `json:"environmentScope"`
+	// Hidden indicates the variable value is hidden in the UI and API.
+	Hidden bool `json:"hidden"`
+	// ValueHash is the HMAC-SHA256 of the value last applied by the provider,
+	// keyed by the value hash key of the provider config and the UID of the
+	// variable. It detects changes of masked values GitLab does not return.
+	ValueHash string `json:"valueHash,omitempty"`
 }
 
 // A VariableSpec defines the desired state of a Gitlab Project CI
@@ -86,6 +163,12 @@ type VariableStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".status.atProvider.key"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.variableType"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".status.atProvider.environmentScope"
+// +kubebuilder:printcolumn:name="MASKED",type="boolean",JSONPath=".status.atProvider.masked"
+// +kubebuilder:printcolumn:name="PROTECTED",type="boolean",JSONPath=".status.atProvider.protected"
+// +kubebuilder:printcolumn:name="RAW",type="boolean",JSONPath=".status.atProvider.raw",priority=1
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
 type Variable struct {
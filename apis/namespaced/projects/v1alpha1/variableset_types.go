@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+// VariableSetParameters define the desired state of a set of GitLab CI
+// variables of a project, one for each key of a ConfigMap and a Secret.
+// https://docs.gitlab.com/ee/api/project_level_variables.html
+// +kubebuilder:validation:XValidation:rule="has(self.configMapRef) || has(self.secretRef)",message="at least one of configMapRef or secretRef is required"
+type VariableSetParameters struct {
+	// ProjectID is the ID of the project to create the variables on.
+	// +optional
+	// +immutable
+	ProjectID *int64 `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// ConfigMapRef references the ConfigMap whose keys are synced as
+	// unmasked variables.
+	// +optional
+	ConfigMapRef *v1alpha1.LocalConfigMapReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references the Secret whose keys are synced as variables.
+	// They are masked and raw. A value GitLab cannot mask fails the
+	// reconcile.
+	// +optional
+	SecretRef *xpv1.LocalSecretReference `json:"secretRef,omitempty"`
+
+	// EnvironmentScope indicates the environment scope that the variables
+	// are applied to. Defaults to *.
+	// +optional
+	// +immutable
+	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// Protected makes the variables only available to pipelines running on
+	// protected branches and tags.
+	// +optional
+	Protected *bool `json:"protected,omitempty"`
+
+	// AdoptExisting takes over variables that already exist in GitLab with
+	// a key of the ConfigMap or Secret. Adopted variables are updated but
+	// never deleted by the set. By default such a key fails the reconcile,
+	// so that variables managed otherwise, e.g. by a Variable, are not
+	// overwritten.
+	// +optional
+	AdoptExisting *bool `json:"adoptExisting,omitempty"`
+}
+
+// VariableSetVariable is a variable of a set in GitLab.
+type VariableSetVariable struct {
+	// Key of the variable.
+	Key string `json:"key"`
+
+	// Masked indicates the value of the variable is masked in job logs.
+	Masked bool `json:"masked"`
+
+	// Adopted indicates the variable existed before the set synced it. It
+	// is left in GitLab when its key is removed or the set is deleted.
+	// +optional
+	Adopted bool `json:"adopted,omitempty"`
+}
+
+// VariableSetObservation represents the observed state of a set of GitLab
+// CI variables.
+type VariableSetObservation struct {
+	// Variables of the set in GitLab. Variables the set created are deleted
+	// when their key is removed from the ConfigMap or Secret.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Variables []VariableSetVariable `json:"variables,omitempty"`
+}
+
+// A VariableSetSpec defines the desired state of a set of GitLab CI
+// variables.
+type VariableSetSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              VariableSetParameters `json:"forProvider"`
+}
+
+// A VariableSetStatus represents the observed state of a set of GitLab CI
+// variables.
+type VariableSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VariableSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VariableSet is a managed resource that syncs the keys of a ConfigMap and
+// a Secret to GitLab CI variables of a project. Variables of keys removed
+// from them are deleted, other variables of the project are left untouched.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.environmentScope"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type VariableSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VariableSetSpec   `json:"spec"`
+	Status VariableSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VariableSetList contains a list of VariableSet items.
+type VariableSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VariableSet `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	// +cluster-scope:delete=1
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WikiPageParameters define the desired state of a GitLab project wiki page.
+// https://docs.gitlab.com/api/wikis/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// Exactly 1 of [Content, ContentSecretRef] required.
+type WikiPageParameters struct {
+	// ProjectID is the ID or URL-encoded path of the project.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.NamespacedReference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.NamespacedSelector `json:"projectIdSelector,omitempty"`
+
+	// Title of the wiki page. Changing the title changes the slug of the page.
+	// +kubebuilder:validation:MinLength:=1
+	Title string `json:"title"`
+
+	// Content of the wiki page.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef references the content of the wiki page.
+	// +optional
+	ContentSecretRef *xpv1.LocalSecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// Format of the wiki page. Defaults to markdown.
+	// +kubebuilder:validation:Enum=markdown;rdoc;asciidoc
+	// +optional
+	Format *string `json:"format,omitempty"`
+}
+
+// WikiPageObservation represents the observed state of a GitLab project wiki page.
+type WikiPageObservation struct {
+	// Slug is the URL-encoded path of the wiki page.
+	Slug string `json:"slug,omitempty"`
+
+	// Title of the wiki page.
+	Title string `json:"title,omitempty"`
+
+	// Format of the wiki page.
+	Format string `json:"format,omitempty"`
+
+	// Encoding of the wiki page content.
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// A WikiPageSpec defines the desired state of a GitLab project wiki page.
+type WikiPageSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              WikiPageParameters `json:"forProvider"`
+}
+
+// A WikiPageStatus represents the observed state of a GitLab project wiki page.
+type WikiPageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WikiPageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WikiPage is a managed resource that represents a GitLab project wiki
+// page. Its external name is the slug of the page.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SLUG",type="string",JSONPath=".status.atProvider.slug"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,gitlab}
+type WikiPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WikiPageSpec   `json:"spec"`
+	Status WikiPageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WikiPageList contains a list of WikiPage items.
+type WikiPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WikiPage `json:"items"`
+}
@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -200,6 +202,174 @@ func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfiguration) DeepCopyInto(out *ApprovalConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfiguration.
+func (in *ApprovalConfiguration) DeepCopy() *ApprovalConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApprovalConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfigurationList) DeepCopyInto(out *ApprovalConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApprovalConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfigurationList.
+func (in *ApprovalConfigurationList) DeepCopy() *ApprovalConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApprovalConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfigurationObservation) DeepCopyInto(out *ApprovalConfigurationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfigurationObservation.
+func (in *ApprovalConfigurationObservation) DeepCopy() *ApprovalConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfigurationParameters) DeepCopyInto(out *ApprovalConfigurationParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResetApprovalsOnPush != nil {
+		in, out := &in.ResetApprovalsOnPush, &out.ResetApprovalsOnPush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SelectiveCodeOwnerRemovals != nil {
+		in, out := &in.SelectiveCodeOwnerRemovals, &out.SelectiveCodeOwnerRemovals
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableOverridingApproversPerMergeRequest != nil {
+		in, out := &in.DisableOverridingApproversPerMergeRequest, &out.DisableOverridingApproversPerMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsAuthorApproval != nil {
+		in, out := &in.MergeRequestsAuthorApproval, &out.MergeRequestsAuthorApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsDisableCommittersApproval != nil {
+		in, out := &in.MergeRequestsDisableCommittersApproval, &out.MergeRequestsDisableCommittersApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequirePasswordToApprove != nil {
+		in, out := &in.RequirePasswordToApprove, &out.RequirePasswordToApprove
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfigurationParameters.
+func (in *ApprovalConfigurationParameters) DeepCopy() *ApprovalConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfigurationSpec) DeepCopyInto(out *ApprovalConfigurationSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfigurationSpec.
+func (in *ApprovalConfigurationSpec) DeepCopy() *ApprovalConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfigurationStatus) DeepCopyInto(out *ApprovalConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfigurationStatus.
+func (in *ApprovalConfigurationStatus) DeepCopy() *ApprovalConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovalRule) DeepCopyInto(out *ApprovalRule) {
 	*out = *in
@@ -542,6 +712,33 @@ func (in *BadgeStatus) DeepCopy() *BadgeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Branch) DeepCopyInto(out *Branch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Branch.
+func (in *Branch) DeepCopy() *Branch {
+	if in == nil {
+		return nil
+	}
+	out := new(Branch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Branch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BranchAccessDescription) DeepCopyInto(out *BranchAccessDescription) {
 	*out = *in
@@ -578,114 +775,269 @@ func (in *BranchAccessDescription) DeepCopy() *BranchAccessDescription {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
+func (in *BranchList) DeepCopyInto(out *BranchList) {
 	*out = *in
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Branch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
-func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
+func (in *BranchList) DeepCopy() *BranchList {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerExpirationPolicy)
+	out := new(BranchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+func (in *BranchObservation) DeepCopyInto(out *BranchObservation) {
 	*out = *in
-	if in.Cadence != nil {
-		in, out := &in.Cadence, &out.Cadence
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeepN != nil {
-		in, out := &in.KeepN, &out.KeepN
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OlderThan != nil {
-		in, out := &in.OlderThan, &out.OlderThan
-		*out = new(string)
-		**out = **in
-	}
-	if in.NameRegexDelete != nil {
-		in, out := &in.NameRegexDelete, &out.NameRegexDelete
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchObservation.
+func (in *BranchObservation) DeepCopy() *BranchObservation {
+	if in == nil {
+		return nil
 	}
-	if in.NameRegexKeep != nil {
-		in, out := &in.NameRegexKeep, &out.NameRegexKeep
+	out := new(BranchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchParameters) DeepCopyInto(out *BranchParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NameRegex != nil {
-		in, out := &in.NameRegex, &out.NameRegex
-		*out = new(string)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
-func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchParameters.
+func (in *BranchParameters) DeepCopy() *BranchParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerExpirationPolicyAttributes)
+	out := new(BranchParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
+func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
 	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
-func (in *CustomAttribute) DeepCopy() *CustomAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
+func (in *BranchSpec) DeepCopy() *BranchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomAttribute)
+	out := new(BranchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKey) DeepCopyInto(out *DeployKey) {
+func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
-func (in *DeployKey) DeepCopy() *DeployKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
+func (in *BranchStatus) DeepCopy() *BranchStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKey)
+	out := new(BranchStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKey) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CICatalogVersionObservation) DeepCopyInto(out *CICatalogVersionObservation) {
+	*out = *in
+	if in.ReleasedAt != nil {
+		in, out := &in.ReleasedAt, &out.ReleasedAt
+		*out = (*in).DeepCopy()
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CICatalogVersionObservation.
+func (in *CICatalogVersionObservation) DeepCopy() *CICatalogVersionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CICatalogVersionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceFrameworkReference) DeepCopyInto(out *ComplianceFrameworkReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceFrameworkReference.
+func (in *ComplianceFrameworkReference) DeepCopy() *ComplianceFrameworkReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceFrameworkReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
+	*out = *in
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
+func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerExpirationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+	*out = *in
+	if in.Cadence != nil {
+		in, out := &in.Cadence, &out.Cadence
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeepN != nil {
+		in, out := &in.KeepN, &out.KeepN
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexDelete != nil {
+		in, out := &in.NameRegexDelete, &out.NameRegexDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexKeep != nil {
+		in, out := &in.NameRegexKeep, &out.NameRegexKeep
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NameRegex != nil {
+		in, out := &in.NameRegex, &out.NameRegex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
+func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerExpirationPolicyAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
+func (in *CustomAttribute) DeepCopy() *CustomAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKey) DeepCopyInto(out *DeployKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
+func (in *DeployKey) DeepCopy() *DeployKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -771,7 +1123,16 @@ func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
 	}
-	in.KeySecretRef.DeepCopyInto(&out.KeySecretRef)
+	if in.KeySecretRef != nil {
+		in, out := &in.KeySecretRef, &out.KeySecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.DeployKeyID != nil {
+		in, out := &in.DeployKeyID, &out.DeployKeyID
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
@@ -971,95 +1332,275 @@ func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentAccessLevelObservation) DeepCopyInto(out *EnvironmentAccessLevelObservation) {
+func (in *Deployment) DeepCopyInto(out *Deployment) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
+func (in *Deployment) DeepCopy() *Deployment {
+	if in == nil {
+		return nil
 	}
-	if in.AccessLevel != nil {
-		in, out := &in.AccessLevel, &out.AccessLevel
-		*out = new(int)
-		**out = **in
+	out := new(Deployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Deployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.AccessLevelDescription != nil {
-		in, out := &in.AccessLevelDescription, &out.AccessLevelDescription
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Deployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentList.
+func (in *DeploymentList) DeepCopy() *DeploymentList {
+	if in == nil {
+		return nil
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int64)
-		**out = **in
+	out := new(DeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.GroupInheritanceType != nil {
-		in, out := &in.GroupInheritanceType, &out.GroupInheritanceType
-		*out = new(int64)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentObservation) DeepCopyInto(out *DeploymentObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccessLevelObservation.
-func (in *EnvironmentAccessLevelObservation) DeepCopy() *EnvironmentAccessLevelObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentObservation.
+func (in *DeploymentObservation) DeepCopy() *DeploymentObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentAccessLevelObservation)
+	out := new(DeploymentObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentAccessLevelParameters) DeepCopyInto(out *EnvironmentAccessLevelParameters) {
+func (in *DeploymentParameters) DeepCopyInto(out *DeploymentParameters) {
 	*out = *in
-	if in.AccessLevel != nil {
-		in, out := &in.AccessLevel, &out.AccessLevel
-		*out = new(int)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(string)
 		**out = **in
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int64)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.GroupInheritanceType != nil {
-		in, out := &in.GroupInheritanceType, &out.GroupInheritanceType
+	if in.DeploymentID != nil {
+		in, out := &in.DeploymentID, &out.DeploymentID
 		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccessLevelParameters.
-func (in *EnvironmentAccessLevelParameters) DeepCopy() *EnvironmentAccessLevelParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentParameters.
+func (in *DeploymentParameters) DeepCopy() *DeploymentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentAccessLevelParameters)
+	out := new(DeploymentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentApprovalRuleObservation) DeepCopyInto(out *EnvironmentApprovalRuleObservation) {
+func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int64)
-		**out = **in
-	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
+func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatus.
+func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentAccessLevelObservation) DeepCopyInto(out *EnvironmentAccessLevelObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AccessLevel != nil {
+		in, out := &in.AccessLevel, &out.AccessLevel
+		*out = new(int)
+		**out = **in
+	}
+	if in.AccessLevelDescription != nil {
+		in, out := &in.AccessLevelDescription, &out.AccessLevelDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupInheritanceType != nil {
+		in, out := &in.GroupInheritanceType, &out.GroupInheritanceType
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccessLevelObservation.
+func (in *EnvironmentAccessLevelObservation) DeepCopy() *EnvironmentAccessLevelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAccessLevelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentAccessLevelParameters) DeepCopyInto(out *EnvironmentAccessLevelParameters) {
+	*out = *in
+	if in.AccessLevel != nil {
+		in, out := &in.AccessLevel, &out.AccessLevel
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupInheritanceType != nil {
+		in, out := &in.GroupInheritanceType, &out.GroupInheritanceType
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccessLevelParameters.
+func (in *EnvironmentAccessLevelParameters) DeepCopy() *EnvironmentAccessLevelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAccessLevelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentApprovalRuleObservation) DeepCopyInto(out *EnvironmentApprovalRuleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
 		*out = new(int64)
 		**out = **in
 	}
@@ -1118,6 +1659,21 @@ func (in *EnvironmentApprovalRuleParameters) DeepCopyInto(out *EnvironmentApprov
 		*out = new(int64)
 		**out = **in
 	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.RequiredApprovals != nil {
 		in, out := &in.RequiredApprovals, &out.RequiredApprovals
 		*out = new(int64)
@@ -1140,6 +1696,21 @@ func (in *EnvironmentApprovalRuleParameters) DeepCopy() *EnvironmentApprovalRule
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckProtectedBranch) DeepCopyInto(out *ExternalStatusCheckProtectedBranch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckProtectedBranch.
+func (in *ExternalStatusCheckProtectedBranch) DeepCopy() *ExternalStatusCheckProtectedBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckProtectedBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ForkParent) DeepCopyInto(out *ForkParent) {
 	*out = *in
@@ -1197,6 +1768,22 @@ func (in *Hook) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookCustomHeader) DeepCopyInto(out *HookCustomHeader) {
+	*out = *in
+	in.ValueSecretRef.DeepCopyInto(&out.ValueSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookCustomHeader.
+func (in *HookCustomHeader) DeepCopy() *HookCustomHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HookCustomHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookList) DeepCopyInto(out *HookList) {
 	*out = *in
@@ -1236,6 +1823,15 @@ func (in *HookObservation) DeepCopyInto(out *HookObservation) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.CustomHeaderKeys != nil {
+		in, out := &in.CustomHeaderKeys, &out.CustomHeaderKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenRotatedAt != nil {
+		in, out := &in.TokenRotatedAt, &out.TokenRotatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
@@ -1256,6 +1852,11 @@ func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
 	if in.ConfidentialNoteEvents != nil {
 		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
 		*out = new(bool)
@@ -1336,6 +1937,23 @@ func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 		*out = new(Token)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RotateEvery != nil {
+		in, out := &in.RotateEvery, &out.RotateEvery
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CustomWebhookTemplate != nil {
+		in, out := &in.CustomWebhookTemplate, &out.CustomWebhookTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomHeaders != nil {
+		in, out := &in.CustomHeaders, &out.CustomHeaders
+		*out = make([]HookCustomHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
@@ -1632,37 +2250,7 @@ func (in *IntegrationMattermostStatus) DeepCopy() *IntegrationMattermostStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
-func (in *LastPipeline) DeepCopy() *LastPipeline {
-	if in == nil {
-		return nil
-	}
-	out := new(LastPipeline)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Links) DeepCopyInto(out *Links) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
-func (in *Links) DeepCopy() *Links {
-	if in == nil {
-		return nil
-	}
-	out := new(Links)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *Issue) DeepCopyInto(out *Issue) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1670,18 +2258,18 @@ func (in *Member) DeepCopyInto(out *Member) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Issue.
+func (in *Issue) DeepCopy() *Issue {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(Issue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Member) DeepCopyObject() runtime.Object {
+func (in *Issue) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1689,31 +2277,31 @@ func (in *Member) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
+func (in *IssueList) DeepCopyInto(out *IssueList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Member, len(*in))
+		*out = make([]Issue, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueList.
+func (in *IssueList) DeepCopy() *IssueList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(IssueList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemberList) DeepCopyObject() runtime.Object {
+func (in *IssueList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1721,30 +2309,38 @@ func (in *MemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+func (in *IssueObservation) DeepCopyInto(out *IssueObservation) {
 	*out = *in
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ClosedAt != nil {
+		in, out := &in.ClosedAt, &out.ClosedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
-func (in *MemberObservation) DeepCopy() *MemberObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueObservation.
+func (in *IssueObservation) DeepCopy() *IssueObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberObservation)
+	out := new(IssueObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+func (in *IssueParameters) DeepCopyInto(out *IssueParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int64)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -1757,94 +2353,109 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
-		*out = new(string)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AssigneeIDs != nil {
+		in, out := &in.AssigneeIDs, &out.AssigneeIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.MilestoneID != nil {
+		in, out := &in.MilestoneID, &out.MilestoneID
+		*out = new(int64)
 		**out = **in
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = new(string)
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(IssueState)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
-func (in *MemberParameters) DeepCopy() *MemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueParameters.
+func (in *IssueParameters) DeepCopy() *IssueParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberParameters)
+	out := new(IssueParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+func (in *IssueSpec) DeepCopyInto(out *IssueSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
-func (in *MemberSpec) DeepCopy() *MemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueSpec.
+func (in *IssueSpec) DeepCopy() *IssueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSpec)
+	out := new(IssueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+func (in *IssueStatus) DeepCopyInto(out *IssueStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
-func (in *MemberStatus) DeepCopy() *MemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueStatus.
+func (in *IssueStatus) DeepCopy() *IssueStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberStatus)
+	out := new(IssueStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Permissions) DeepCopyInto(out *Permissions) {
+func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
 	*out = *in
-	if in.ProjectAccess != nil {
-		in, out := &in.ProjectAccess, &out.ProjectAccess
-		*out = new(ProjectAccess)
-		**out = **in
-	}
-	if in.GroupAccess != nil {
-		in, out := &in.GroupAccess, &out.GroupAccess
-		*out = new(GroupAccess)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
+func (in *LastPipeline) DeepCopy() *LastPipeline {
+	if in == nil {
+		return nil
 	}
+	out := new(LastPipeline)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
-func (in *Permissions) DeepCopy() *Permissions {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Links) DeepCopyInto(out *Links) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
+func (in *Links) DeepCopy() *Links {
 	if in == nil {
 		return nil
 	}
-	out := new(Permissions)
+	out := new(Links)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+func (in *Member) DeepCopyInto(out *Member) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1852,18 +2463,18 @@ func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
-func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineSchedule)
+	out := new(Member)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+func (in *Member) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1871,31 +2482,31 @@ func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+func (in *MemberList) DeepCopyInto(out *MemberList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PipelineSchedule, len(*in))
+		*out = make([]Member, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
-func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleList)
+	out := new(MemberList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+func (in *MemberList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1903,53 +2514,30 @@ func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LastPipeline != nil {
-		in, out := &in.LastPipeline, &out.LastPipeline
-		*out = new(LastPipeline)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
-func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleObservation)
+	out := new(MemberObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(string)
+		*out = new(int64)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -1962,110 +2550,120 @@ func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParamete
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CronTimezone != nil {
-		in, out := &in.CronTimezone, &out.CronTimezone
-		*out = new(string)
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make([]PipelineVariable, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
-func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleParameters)
+	out := new(MemberParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
-func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleSpec)
+	out := new(MemberSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
-func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleStatus)
+	out := new(MemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+func (in *MergeRequest) DeepCopyInto(out *MergeRequest) {
 	*out = *in
-	if in.VariableType != nil {
-		in, out := &in.VariableType, &out.VariableType
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
-func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequest.
+func (in *MergeRequest) DeepCopy() *MergeRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineVariable)
+	out := new(MergeRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MergeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *MergeRequestList) DeepCopyInto(out *MergeRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MergeRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequestList.
+func (in *MergeRequestList) DeepCopy() *MergeRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(MergeRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *MergeRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2073,520 +2671,2523 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+func (in *MergeRequestObservation) DeepCopyInto(out *MergeRequestObservation) {
 	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MergedAt != nil {
+		in, out := &in.MergedAt, &out.MergedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ClosedAt != nil {
+		in, out := &in.ClosedAt, &out.ClosedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
-func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequestObservation.
+func (in *MergeRequestObservation) DeepCopy() *MergeRequestObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectAccess)
+	out := new(MergeRequestObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+func (in *MergeRequestParameters) DeepCopyInto(out *MergeRequestParameters) {
 	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AssigneeIDs != nil {
+		in, out := &in.AssigneeIDs, &out.AssigneeIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveSourceBranch != nil {
+		in, out := &in.RemoveSourceBranch, &out.RemoveSourceBranch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoMerge != nil {
+		in, out := &in.AutoMerge, &out.AutoMerge
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
-func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequestParameters.
+func (in *MergeRequestParameters) DeepCopy() *MergeRequestParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectLicense)
+	out := new(MergeRequestParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *MergeRequestSpec) DeepCopyInto(out *MergeRequestSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequestSpec.
+func (in *MergeRequestSpec) DeepCopy() *MergeRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(MergeRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeRequestStatus) DeepCopyInto(out *MergeRequestStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRequestStatus.
+func (in *MergeRequestStatus) DeepCopy() *MergeRequestStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(MergeRequestStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+func (in *PackagesCleanupPolicy) DeepCopyInto(out *PackagesCleanupPolicy) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
-func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackagesCleanupPolicy.
+func (in *PackagesCleanupPolicy) DeepCopy() *PackagesCleanupPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectNamespace)
+	out := new(PackagesCleanupPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+func (in *PackagesCleanupPolicyObservation) DeepCopyInto(out *PackagesCleanupPolicyObservation) {
 	*out = *in
-	if in.ComplianceFrameworks != nil {
-		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ContainerExpirationPolicy != nil {
-		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
-		*out = new(ContainerExpirationPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
 		*out = (*in).DeepCopy()
 	}
-	if in.CustomAttributes != nil {
-		in, out := &in.CustomAttributes, &out.CustomAttributes
-		*out = make([]CustomAttribute, len(*in))
-		copy(*out, *in)
-	}
-	if in.ForkedFromProject != nil {
-		in, out := &in.ForkedFromProject, &out.ForkedFromProject
-		*out = new(ForkParent)
-		**out = **in
-	}
-	if in.LastActivityAt != nil {
-		in, out := &in.LastActivityAt, &out.LastActivityAt
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackagesCleanupPolicyObservation.
+func (in *PackagesCleanupPolicyObservation) DeepCopy() *PackagesCleanupPolicyObservation {
+	if in == nil {
+		return nil
 	}
-	if in.License != nil {
-		in, out := &in.License, &out.License
-		*out = new(ProjectLicense)
-		**out = **in
+	out := new(PackagesCleanupPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomain) DeepCopyInto(out *PagesDomain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomain.
+func (in *PagesDomain) DeepCopy() *PagesDomain {
+	if in == nil {
+		return nil
 	}
-	if in.Links != nil {
-		in, out := &in.Links, &out.Links
-		*out = new(Links)
-		**out = **in
+	out := new(PagesDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PagesDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MarkedForDeletionAt != nil {
-		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomainCertificate) DeepCopyInto(out *PagesDomainCertificate) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
 		*out = (*in).DeepCopy()
 	}
-	if in.MarkedForDeletionOn != nil {
-		in, out := &in.MarkedForDeletionOn, &out.MarkedForDeletionOn
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainCertificate.
+func (in *PagesDomainCertificate) DeepCopy() *PagesDomainCertificate {
+	if in == nil {
+		return nil
 	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(ProjectNamespace)
-		**out = **in
+	out := new(PagesDomainCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomainList) DeepCopyInto(out *PagesDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PagesDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainList.
+func (in *PagesDomainList) DeepCopy() *PagesDomainList {
+	if in == nil {
+		return nil
 	}
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = new(Permissions)
-		(*in).DeepCopyInto(*out)
+	out := new(PagesDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PagesDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.SharedWithGroups != nil {
-		in, out := &in.SharedWithGroups, &out.SharedWithGroups
-		*out = make([]SharedWithGroups, len(*in))
-		copy(*out, *in)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomainObservation) DeepCopyInto(out *PagesDomainObservation) {
+	*out = *in
+	if in.EnabledUntil != nil {
+		in, out := &in.EnabledUntil, &out.EnabledUntil
+		*out = (*in).DeepCopy()
 	}
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(ProjectStatistics)
-		**out = **in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(PagesDomainCertificate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
-func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainObservation.
+func (in *PagesDomainObservation) DeepCopy() *PagesDomainObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectObservation)
+	out := new(PagesDomainObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+func (in *PagesDomainParameters) DeepCopyInto(out *PagesDomainParameters) {
 	*out = *in
-	if in.AllowMergeOnSkippedPipeline != nil {
-		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ApprovalsBeforeMerge != nil {
-		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
-		*out = new(int64)
-		**out = **in
-	}
-	if in.AutoCancelPendingPipelines != nil {
-		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
 		**out = **in
 	}
-	if in.AutoDevopsDeployStrategy != nil {
-		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
-		*out = new(string)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AutoDevopsEnabled != nil {
-		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AutocloseReferencedIssues != nil {
-		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+	if in.AutoSslEnabled != nil {
+		in, out := &in.AutoSslEnabled, &out.AutoSslEnabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.BuildCoverageRegex != nil {
-		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
 		*out = new(string)
 		**out = **in
 	}
-	if in.BuildGitStrategy != nil {
-		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
-		*out = new(string)
+	if in.KeySecretRef != nil {
+		in, out := &in.KeySecretRef, &out.KeySecretRef
+		*out = new(v1.LocalSecretKeySelector)
 		**out = **in
 	}
-	if in.BuildTimeout != nil {
-		in, out := &in.BuildTimeout, &out.BuildTimeout
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainParameters.
+func (in *PagesDomainParameters) DeepCopy() *PagesDomainParameters {
+	if in == nil {
+		return nil
 	}
-	if in.BuildsAccessLevel != nil {
-		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(PagesDomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomainSpec) DeepCopyInto(out *PagesDomainSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainSpec.
+func (in *PagesDomainSpec) DeepCopy() *PagesDomainSpec {
+	if in == nil {
+		return nil
 	}
-	if in.CIConfigPath != nil {
-		in, out := &in.CIConfigPath, &out.CIConfigPath
-		*out = new(string)
-		**out = **in
+	out := new(PagesDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesDomainStatus) DeepCopyInto(out *PagesDomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainStatus.
+func (in *PagesDomainStatus) DeepCopy() *PagesDomainStatus {
+	if in == nil {
+		return nil
 	}
-	if in.CIDefaultGitDepth != nil {
-		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
-		*out = new(int64)
+	out := new(PagesDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permissions) DeepCopyInto(out *Permissions) {
+	*out = *in
+	if in.ProjectAccess != nil {
+		in, out := &in.ProjectAccess, &out.ProjectAccess
+		*out = new(ProjectAccess)
 		**out = **in
 	}
-	if in.CIForwardDeploymentEnabled != nil {
-		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
-		*out = new(bool)
+	if in.GroupAccess != nil {
+		in, out := &in.GroupAccess, &out.GroupAccess
+		*out = new(GroupAccess)
 		**out = **in
 	}
-	if in.ContainerExpirationPolicyAttributes != nil {
-		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
-		*out = new(ContainerExpirationPolicyAttributes)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
+func (in *Permissions) DeepCopy() *Permissions {
+	if in == nil {
+		return nil
 	}
-	if in.ContainerRegistryEnabled != nil {
-		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(Permissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
+func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+	if in == nil {
+		return nil
 	}
-	if in.ContainerRegistryAccessLevel != nil {
-		in, out := &in.ContainerRegistryAccessLevel, &out.ContainerRegistryAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.DefaultBranch != nil {
-		in, out := &in.DefaultBranch, &out.DefaultBranch
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	out := new(PipelineSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.EmailsDisabled != nil {
-		in, out := &in.EmailsDisabled, &out.EmailsDisabled
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ExternalAuthorizationClassificationLabel != nil {
-		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
+func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+	if in == nil {
+		return nil
 	}
-	if in.ForkingAccessLevel != nil {
-		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(PipelineScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.GroupWithProjectTemplatesID != nil {
-		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(int64)
 		**out = **in
 	}
-	if in.ImportURL != nil {
-		in, out := &in.ImportURL, &out.ImportURL
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImportURLSecretRef != nil {
-		in, out := &in.ImportURLSecretRef, &out.ImportURLSecretRef
-		*out = new(v1.LocalSecretKeySelector)
-		**out = **in
-	}
-	if in.InitializeWithReadme != nil {
-		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
-		*out = new(bool)
-		**out = **in
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
 	}
-	if in.IssuesAccessLevel != nil {
-		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.IssuesTemplate != nil {
-		in, out := &in.IssuesTemplate, &out.IssuesTemplate
-		*out = new(string)
-		**out = **in
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.LFSEnabled != nil {
-		in, out := &in.LFSEnabled, &out.LFSEnabled
-		*out = new(bool)
-		**out = **in
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MergeMethod != nil {
-		in, out := &in.MergeMethod, &out.MergeMethod
-		*out = new(MergeMethodValue)
+	if in.LastPipeline != nil {
+		in, out := &in.LastPipeline, &out.LastPipeline
+		*out = new(LastPipeline)
 		**out = **in
 	}
-	if in.MergeRequestsAccessLevel != nil {
-		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
+func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+	if in == nil {
+		return nil
 	}
-	if in.MergeRequestsTemplate != nil {
-		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+	out := new(PipelineScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MirrorOverwritesDivergedBranches != nil {
-		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MirrorTriggerBuilds != nil {
-		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MirrorUserID != nil {
-		in, out := &in.MirrorUserID, &out.MirrorUserID
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NamespaceID != nil {
-		in, out := &in.NamespaceID, &out.NamespaceID
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NamespaceIDRef != nil {
-		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
 		*out = new(v1.NamespacedReference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NamespaceIDSelector != nil {
-		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
-		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
-		*out = new(bool)
+	if in.CronTimezone != nil {
+		in, out := &in.CronTimezone, &out.CronTimezone
+		*out = new(string)
 		**out = **in
 	}
-	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
-		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
 		*out = new(bool)
 		**out = **in
 	}
-	if in.OnlyMirrorProtectedBranches != nil {
-		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
-		*out = new(bool)
-		**out = **in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PipelineVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OperationsAccessLevel != nil {
-		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
+func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+	if in == nil {
+		return nil
 	}
-	if in.PackagesEnabled != nil {
-		in, out := &in.PackagesEnabled, &out.PackagesEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(PipelineScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
+func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+	if in == nil {
+		return nil
 	}
-	if in.PagesAccessLevel != nil {
-		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(PipelineScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
+func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
+	out := new(PipelineScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+	*out = *in
+	if in.VariableType != nil {
+		in, out := &in.VariableType, &out.VariableType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
+func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
+func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAvatar) DeepCopyInto(out *ProjectAvatar) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(commonv1alpha1.LocalConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAvatar.
+func (in *ProjectAvatar) DeepCopy() *ProjectAvatar {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAvatar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCluster) DeepCopyInto(out *ProjectCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCluster.
+func (in *ProjectCluster) DeepCopy() *ProjectCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterList) DeepCopyInto(out *ProjectClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterList.
+func (in *ProjectClusterList) DeepCopy() *ProjectClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterObservation) DeepCopyInto(out *ProjectClusterObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ManagementProjectID != nil {
+		in, out := &in.ManagementProjectID, &out.ManagementProjectID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PlatformKubernetes != nil {
+		in, out := &in.PlatformKubernetes, &out.PlatformKubernetes
+		*out = new(ProjectClusterPlatformKubernetesObservation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterObservation.
+func (in *ProjectClusterObservation) DeepCopy() *ProjectClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterParameters) DeepCopyInto(out *ProjectClusterParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnvironmentScope != nil {
+		in, out := &in.EnvironmentScope, &out.EnvironmentScope
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagementProjectID != nil {
+		in, out := &in.ManagementProjectID, &out.ManagementProjectID
+		*out = new(string)
+		**out = **in
+	}
+	in.PlatformKubernetes.DeepCopyInto(&out.PlatformKubernetes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterParameters.
+func (in *ProjectClusterParameters) DeepCopy() *ProjectClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterPlatformKubernetes) DeepCopyInto(out *ProjectClusterPlatformKubernetes) {
+	*out = *in
+	in.TokenSecretRef.DeepCopyInto(&out.TokenSecretRef)
+	if in.CaCertSecretRef != nil {
+		in, out := &in.CaCertSecretRef, &out.CaCertSecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizationType != nil {
+		in, out := &in.AuthorizationType, &out.AuthorizationType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterPlatformKubernetes.
+func (in *ProjectClusterPlatformKubernetes) DeepCopy() *ProjectClusterPlatformKubernetes {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterPlatformKubernetes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterPlatformKubernetesObservation) DeepCopyInto(out *ProjectClusterPlatformKubernetesObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterPlatformKubernetesObservation.
+func (in *ProjectClusterPlatformKubernetesObservation) DeepCopy() *ProjectClusterPlatformKubernetesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterPlatformKubernetesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterSpec) DeepCopyInto(out *ProjectClusterSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterSpec.
+func (in *ProjectClusterSpec) DeepCopy() *ProjectClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClusterStatus) DeepCopyInto(out *ProjectClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClusterStatus.
+func (in *ProjectClusterStatus) DeepCopy() *ProjectClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheck) DeepCopyInto(out *ProjectExternalStatusCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheck.
+func (in *ProjectExternalStatusCheck) DeepCopy() *ProjectExternalStatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectExternalStatusCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheckList) DeepCopyInto(out *ProjectExternalStatusCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectExternalStatusCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheckList.
+func (in *ProjectExternalStatusCheckList) DeepCopy() *ProjectExternalStatusCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectExternalStatusCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheckObservation) DeepCopyInto(out *ProjectExternalStatusCheckObservation) {
+	*out = *in
+	if in.ProtectedBranches != nil {
+		in, out := &in.ProtectedBranches, &out.ProtectedBranches
+		*out = make([]ExternalStatusCheckProtectedBranch, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheckObservation.
+func (in *ProjectExternalStatusCheckObservation) DeepCopy() *ProjectExternalStatusCheckObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheckObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheckParameters) DeepCopyInto(out *ProjectExternalStatusCheckParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalURL != nil {
+		in, out := &in.ExternalURL, &out.ExternalURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalURLSecretRef != nil {
+		in, out := &in.ExternalURLSecretRef, &out.ExternalURLSecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.ProtectedBranchIDs != nil {
+		in, out := &in.ProtectedBranchIDs, &out.ProtectedBranchIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectedBranchIDRefs != nil {
+		in, out := &in.ProtectedBranchIDRefs, &out.ProtectedBranchIDRefs
+		*out = make([]v1.NamespacedReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProtectedBranchIDSelector != nil {
+		in, out := &in.ProtectedBranchIDSelector, &out.ProtectedBranchIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheckParameters.
+func (in *ProjectExternalStatusCheckParameters) DeepCopy() *ProjectExternalStatusCheckParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheckParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheckSpec) DeepCopyInto(out *ProjectExternalStatusCheckSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheckSpec.
+func (in *ProjectExternalStatusCheckSpec) DeepCopy() *ProjectExternalStatusCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExternalStatusCheckStatus) DeepCopyInto(out *ProjectExternalStatusCheckStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExternalStatusCheckStatus.
+func (in *ProjectExternalStatusCheckStatus) DeepCopy() *ProjectExternalStatusCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExternalStatusCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectFork) DeepCopyInto(out *ProjectFork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectFork.
+func (in *ProjectFork) DeepCopy() *ProjectFork {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectFork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectFork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectForkList) DeepCopyInto(out *ProjectForkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectFork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectForkList.
+func (in *ProjectForkList) DeepCopy() *ProjectForkList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectForkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectForkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectForkObservation) DeepCopyInto(out *ProjectForkObservation) {
+	*out = *in
+	if in.ForkedFromProject != nil {
+		in, out := &in.ForkedFromProject, &out.ForkedFromProject
+		*out = new(ForkParent)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectForkObservation.
+func (in *ProjectForkObservation) DeepCopy() *ProjectForkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectForkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectForkParameters) DeepCopyInto(out *ProjectForkParameters) {
+	*out = *in
+	if in.ForkedFromProjectID != nil {
+		in, out := &in.ForkedFromProjectID, &out.ForkedFromProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForkedFromProjectIDRef != nil {
+		in, out := &in.ForkedFromProjectIDRef, &out.ForkedFromProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkedFromProjectIDSelector != nil {
+		in, out := &in.ForkedFromProjectIDSelector, &out.ForkedFromProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespacePath != nil {
+		in, out := &in.NamespacePath, &out.NamespacePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = new(string)
+		**out = **in
+	}
+	if in.MergeRequestDefaultTargetSelf != nil {
+		in, out := &in.MergeRequestDefaultTargetSelf, &out.MergeRequestDefaultTargetSelf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletePolicy != nil {
+		in, out := &in.DeletePolicy, &out.DeletePolicy
+		*out = new(ProjectForkDeletePolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectForkParameters.
+func (in *ProjectForkParameters) DeepCopy() *ProjectForkParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectForkParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectForkSpec) DeepCopyInto(out *ProjectForkSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectForkSpec.
+func (in *ProjectForkSpec) DeepCopy() *ProjectForkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectForkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectForkStatus) DeepCopyInto(out *ProjectForkStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectForkStatus.
+func (in *ProjectForkStatus) DeepCopy() *ProjectForkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectForkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
+func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
+func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+	*out = *in
+	if in.ComplianceFrameworks != nil {
+		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContainerExpirationPolicy != nil {
+		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
+		*out = new(ContainerExpirationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]CustomAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForkedFromProject != nil {
+		in, out := &in.ForkedFromProject, &out.ForkedFromProject
+		*out = new(ForkParent)
+		**out = **in
+	}
+	if in.LastActivityAt != nil {
+		in, out := &in.LastActivityAt, &out.LastActivityAt
+		*out = (*in).DeepCopy()
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(ProjectLicense)
+		**out = **in
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = new(Links)
+		**out = **in
+	}
+	if in.MarkedForDeletionAt != nil {
+		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MarkedForDeletionOn != nil {
+		in, out := &in.MarkedForDeletionOn, &out.MarkedForDeletionOn
+		*out = (*in).DeepCopy()
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(ProjectNamespace)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(Permissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroups, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(ProjectStatistics)
+		**out = **in
+	}
+	if in.PackagesCleanupPolicy != nil {
+		in, out := &in.PackagesCleanupPolicy, &out.PackagesCleanupPolicy
+		*out = new(PackagesCleanupPolicyObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CICatalogResource != nil {
+		in, out := &in.CICatalogResource, &out.CICatalogResource
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CICatalogVersion != nil {
+		in, out := &in.CICatalogVersion, &out.CICatalogVersion
+		*out = new(CICatalogVersionObservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
+func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+	*out = *in
+	if in.AllowMergeOnSkippedPipeline != nil {
+		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowTransfer != nil {
+		in, out := &in.AllowTransfer, &out.AllowTransfer
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowPipelineTriggerApproveDeployment != nil {
+		in, out := &in.AllowPipelineTriggerApproveDeployment, &out.AllowPipelineTriggerApproveDeployment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AnalyticsAccessLevel != nil {
+		in, out := &in.AnalyticsAccessLevel, &out.AnalyticsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ApprovalsBeforeMerge != nil {
+		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoCancelPendingPipelines != nil {
+		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsDeployStrategy != nil {
+		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsEnabled != nil {
+		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutocloseReferencedIssues != nil {
+		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BuildCoverageRegex != nil {
+		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildGitStrategy != nil {
+		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildTimeout != nil {
+		in, out := &in.BuildTimeout, &out.BuildTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BuildsAccessLevel != nil {
+		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.CICatalogResource != nil {
+		in, out := &in.CICatalogResource, &out.CICatalogResource
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIConfigPath != nil {
+		in, out := &in.CIConfigPath, &out.CIConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDefaultGitDepth != nil {
+		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CIDeletePipelinesInSeconds != nil {
+		in, out := &in.CIDeletePipelinesInSeconds, &out.CIDeletePipelinesInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CIForwardDeploymentEnabled != nil {
+		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIPipelineVariablesMinimumOverrideRole != nil {
+		in, out := &in.CIPipelineVariablesMinimumOverrideRole, &out.CIPipelineVariablesMinimumOverrideRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContainerExpirationPolicyAttributes != nil {
+		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
+		*out = new(ContainerExpirationPolicyAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRegistryEnabled != nil {
+		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerRegistryAccessLevel != nil {
+		in, out := &in.ContainerRegistryAccessLevel, &out.ContainerRegistryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailsDisabled != nil {
+		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmailsEnabled != nil {
+		in, out := &in.EmailsEnabled, &out.EmailsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnvironmentsAccessLevel != nil {
+		in, out := &in.EnvironmentsAccessLevel, &out.EnvironmentsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ExternalAuthorizationClassificationLabel != nil {
+		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.FeatureFlagsAccessLevel != nil {
+		in, out := &in.FeatureFlagsAccessLevel, &out.FeatureFlagsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ForkingAccessLevel != nil {
+		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.GroupWithProjectTemplatesID != nil {
+		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ImportURL != nil {
+		in, out := &in.ImportURL, &out.ImportURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportURLSecretRef != nil {
+		in, out := &in.ImportURLSecretRef, &out.ImportURLSecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.InfrastructureAccessLevel != nil {
+		in, out := &in.InfrastructureAccessLevel, &out.InfrastructureAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.InitializeWithReadme != nil {
+		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IssuesAccessLevel != nil {
+		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.IssuesTemplate != nil {
+		in, out := &in.IssuesTemplate, &out.IssuesTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeepLatestArtifact != nil {
+		in, out := &in.KeepLatestArtifact, &out.KeepLatestArtifact
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LFSEnabled != nil {
+		in, out := &in.LFSEnabled, &out.LFSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeCommitTemplate != nil {
+		in, out := &in.MergeCommitTemplate, &out.MergeCommitTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.MergeMethod != nil {
+		in, out := &in.MergeMethod, &out.MergeMethod
+		*out = new(MergeMethodValue)
+		**out = **in
+	}
+	if in.MergeRequestDefaultTargetSelf != nil {
+		in, out := &in.MergeRequestDefaultTargetSelf, &out.MergeRequestDefaultTargetSelf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsAccessLevel != nil {
+		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.MergeRequestsTemplate != nil {
+		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorOverwritesDivergedBranches != nil {
+		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorTriggerBuilds != nil {
+		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorUserID != nil {
+		in, out := &in.MirrorUserID, &out.MirrorUserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ModelExperimentsAccessLevel != nil {
+		in, out := &in.ModelExperimentsAccessLevel, &out.ModelExperimentsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ModelRegistryAccessLevel != nil {
+		in, out := &in.ModelRegistryAccessLevel, &out.ModelRegistryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.MonitorAccessLevel != nil {
+		in, out := &in.MonitorAccessLevel, &out.MonitorAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespacePath != nil {
+		in, out := &in.NamespacePath, &out.NamespacePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
+		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
+		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyMirrorProtectedBranches != nil {
+		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OperationsAccessLevel != nil {
+		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.PackagesEnabled != nil {
+		in, out := &in.PackagesEnabled, &out.PackagesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PackagesCleanupPolicy != nil {
+		in, out := &in.PackagesCleanupPolicy, &out.PackagesCleanupPolicy
+		*out = new(PackagesCleanupPolicy)
+		**out = **in
+	}
+	if in.PagesAccessLevel != nil {
+		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreventMergeWithoutJiraIssue != nil {
+		in, out := &in.PreventMergeWithoutJiraIssue, &out.PreventMergeWithoutJiraIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrintingMergeRequestLinkEnabled != nil {
+		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicBuilds != nil {
+		in, out := &in.PublicBuilds, &out.PublicBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicJobs != nil {
+		in, out := &in.PublicJobs, &out.PublicJobs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PushRules != nil {
+		in, out := &in.PushRules, &out.PushRules
+		*out = new(commonv1alpha1.PushRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleasesAccessLevel != nil {
+		in, out := &in.ReleasesAccessLevel, &out.ReleasesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.RemoveSourceBranchAfterMerge != nil {
+		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RepositoryAccessLevel != nil {
+		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.RequestAccessEnabled != nil {
+		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequirementsAccessLevel != nil {
+		in, out := &in.RequirementsAccessLevel, &out.RequirementsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ResolveOutdatedDiffDiscussions != nil {
+		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictUserDefinedVariables != nil {
+		in, out := &in.RestrictUserDefinedVariables, &out.RestrictUserDefinedVariables
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityAndComplianceAccessLevel != nil {
+		in, out := &in.SecurityAndComplianceAccessLevel, &out.SecurityAndComplianceAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.ServiceDeskEnabled != nil {
+		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedRunnersEnabled != nil {
+		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SnippetsAccessLevel != nil {
+		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.SquashCommitTemplate != nil {
+		in, out := &in.SquashCommitTemplate, &out.SquashCommitTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuggestionCommitMessage != nil {
+		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateProjectID != nil {
+		in, out := &in.TemplateProjectID, &out.TemplateProjectID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TemplateProjectPath != nil {
+		in, out := &in.TemplateProjectPath, &out.TemplateProjectPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseCustomTemplate != nil {
+		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.WikiAccessLevel != nil {
+		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.PermanentlyRemove != nil {
+		in, out := &in.PermanentlyRemove, &out.PermanentlyRemove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveFinalizerOnPendingDeletion != nil {
+		in, out := &in.RemoveFinalizerOnPendingDeletion, &out.RemoveFinalizerOnPendingDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VerifyPathOnDelete != nil {
+		in, out := &in.VerifyPathOnDelete, &out.VerifyPathOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreOnPendingDeletion != nil {
+		in, out := &in.RestoreOnPendingDeletion, &out.RestoreOnPendingDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Avatar != nil {
+		in, out := &in.Avatar, &out.Avatar
+		*out = new(ProjectAvatar)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComplianceFrameworks != nil {
+		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
+		*out = new([]ComplianceFrameworkReference)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]ComplianceFrameworkReference, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
+func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroup) DeepCopyInto(out *ProjectShareGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroup.
+func (in *ProjectShareGroup) DeepCopy() *ProjectShareGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectShareGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroupList) DeepCopyInto(out *ProjectShareGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectShareGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupList.
+func (in *ProjectShareGroupList) DeepCopy() *ProjectShareGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectShareGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroupObservation) DeepCopyInto(out *ProjectShareGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupObservation.
+func (in *ProjectShareGroupObservation) DeepCopy() *ProjectShareGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroupParameters) DeepCopyInto(out *ProjectShareGroupParameters) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupSelector != nil {
+		in, out := &in.GroupSelector, &out.GroupSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupParameters.
+func (in *ProjectShareGroupParameters) DeepCopy() *ProjectShareGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroupSpec) DeepCopyInto(out *ProjectShareGroupSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupSpec.
+func (in *ProjectShareGroupSpec) DeepCopy() *ProjectShareGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareGroupStatus) DeepCopyInto(out *ProjectShareGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupStatus.
+func (in *ProjectShareGroupStatus) DeepCopy() *ProjectShareGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+	*out = *in
+	out.StorageStatistics = in.StorageStatistics
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
+func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranch) DeepCopyInto(out *ProtectedBranch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranch.
+func (in *ProtectedBranch) DeepCopy() *ProtectedBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchList) DeepCopyInto(out *ProtectedBranchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedBranch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchList.
+func (in *ProtectedBranchList) DeepCopy() *ProtectedBranchList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchObservation) DeepCopyInto(out *ProtectedBranchObservation) {
+	*out = *in
+	if in.PushAccessLevels != nil {
+		in, out := &in.PushAccessLevels, &out.PushAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MergeAccessLevels != nil {
+		in, out := &in.MergeAccessLevels, &out.MergeAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UnprotectAccessLevels != nil {
+		in, out := &in.UnprotectAccessLevels, &out.UnprotectAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchObservation.
+func (in *ProtectedBranchObservation) DeepCopy() *ProtectedBranchObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchParameters) DeepCopyInto(out *ProtectedBranchParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PushAccessLevels != nil {
+		in, out := &in.PushAccessLevels, &out.PushAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MergeAccessLevels != nil {
+		in, out := &in.MergeAccessLevels, &out.MergeAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UnprotectAccessLevels != nil {
+		in, out := &in.UnprotectAccessLevels, &out.UnprotectAccessLevels
+		*out = make([]*BranchAccessDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BranchAccessDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.PrintingMergeRequestLinkEnabled != nil {
-		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+	if in.AllowForcePush != nil {
+		in, out := &in.AllowForcePush, &out.AllowForcePush
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PublicBuilds != nil {
-		in, out := &in.PublicBuilds, &out.PublicBuilds
+	if in.CodeOwnerApprovalRequired != nil {
+		in, out := &in.CodeOwnerApprovalRequired, &out.CodeOwnerApprovalRequired
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PublicJobs != nil {
-		in, out := &in.PublicJobs, &out.PublicJobs
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchParameters.
+func (in *ProtectedBranchParameters) DeepCopy() *ProtectedBranchParameters {
+	if in == nil {
+		return nil
 	}
-	if in.PushRules != nil {
-		in, out := &in.PushRules, &out.PushRules
-		*out = new(PushRules)
-		(*in).DeepCopyInto(*out)
+	out := new(ProtectedBranchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSpec) DeepCopyInto(out *ProtectedBranchSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSpec.
+func (in *ProtectedBranchSpec) DeepCopy() *ProtectedBranchSpec {
+	if in == nil {
+		return nil
 	}
-	if in.RemoveSourceBranchAfterMerge != nil {
-		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
-		*out = new(bool)
-		**out = **in
+	out := new(ProtectedBranchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchStatus) DeepCopyInto(out *ProtectedBranchStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchStatus.
+func (in *ProtectedBranchStatus) DeepCopy() *ProtectedBranchStatus {
+	if in == nil {
+		return nil
 	}
-	if in.RepositoryAccessLevel != nil {
-		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(ProtectedBranchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironment) DeepCopyInto(out *ProtectedEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironment.
+func (in *ProtectedEnvironment) DeepCopy() *ProtectedEnvironment {
+	if in == nil {
+		return nil
 	}
-	if in.RequestAccessEnabled != nil {
-		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(ProtectedEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ResolveOutdatedDiffDiscussions != nil {
-		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentList) DeepCopyInto(out *ProtectedEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ServiceDeskEnabled != nil {
-		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentList.
+func (in *ProtectedEnvironmentList) DeepCopy() *ProtectedEnvironmentList {
+	if in == nil {
+		return nil
 	}
-	if in.SharedRunnersEnabled != nil {
-		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
-		*out = new(bool)
+	out := new(ProtectedEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentObservation) DeepCopyInto(out *ProtectedEnvironmentObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.SnippetsAccessLevel != nil {
-		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
-		*out = new(AccessControlValue)
+	if in.RequiredApprovalCount != nil {
+		in, out := &in.RequiredApprovalCount, &out.RequiredApprovalCount
+		*out = new(int64)
 		**out = **in
 	}
-	if in.SuggestionCommitMessage != nil {
-		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
-		*out = new(string)
+	if in.PreventSelfApproval != nil {
+		in, out := &in.PreventSelfApproval, &out.PreventSelfApproval
+		*out = new(bool)
 		**out = **in
 	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.DeployAccessLevels != nil {
+		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
+		*out = make([]EnvironmentAccessLevelObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Topics != nil {
-		in, out := &in.Topics, &out.Topics
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ApprovalRules != nil {
+		in, out := &in.ApprovalRules, &out.ApprovalRules
+		*out = make([]EnvironmentApprovalRuleObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TemplateName != nil {
-		in, out := &in.TemplateName, &out.TemplateName
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentObservation.
+func (in *ProtectedEnvironmentObservation) DeepCopy() *ProtectedEnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentParameters) DeepCopyInto(out *ProtectedEnvironmentParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.TemplateProjectID != nil {
-		in, out := &in.TemplateProjectID, &out.TemplateProjectID
-		*out = new(int64)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.UseCustomTemplate != nil {
-		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Visibility != nil {
-		in, out := &in.Visibility, &out.Visibility
-		*out = new(VisibilityValue)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.WikiAccessLevel != nil {
-		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
-		*out = new(AccessControlValue)
+	if in.DeployAccessLevels != nil {
+		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
+		*out = new([]EnvironmentAccessLevelParameters)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]EnvironmentAccessLevelParameters, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.ApprovalRules != nil {
+		in, out := &in.ApprovalRules, &out.ApprovalRules
+		*out = new([]EnvironmentApprovalRuleParameters)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]EnvironmentApprovalRuleParameters, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.RequiredApprovalCount != nil {
+		in, out := &in.RequiredApprovalCount, &out.RequiredApprovalCount
+		*out = new(int64)
 		**out = **in
 	}
-	if in.PermanentlyRemove != nil {
-		in, out := &in.PermanentlyRemove, &out.PermanentlyRemove
+	if in.PreventSelfApproval != nil {
+		in, out := &in.PreventSelfApproval, &out.PreventSelfApproval
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RemoveFinalizerOnPendingDeletion != nil {
-		in, out := &in.RemoveFinalizerOnPendingDeletion, &out.RemoveFinalizerOnPendingDeletion
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentParameters.
+func (in *ProtectedEnvironmentParameters) DeepCopy() *ProtectedEnvironmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentSpec) DeepCopyInto(out *ProtectedEnvironmentSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentSpec.
+func (in *ProtectedEnvironmentSpec) DeepCopy() *ProtectedEnvironmentSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ProtectedEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
-func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentStatus) DeepCopyInto(out *ProtectedEnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentStatus.
+func (in *ProtectedEnvironmentStatus) DeepCopy() *ProtectedEnvironmentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectParameters)
+	out := new(ProtectedEnvironmentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroup) DeepCopyInto(out *ProjectShareGroup) {
+func (in *Runner) DeepCopyInto(out *Runner) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2594,18 +5195,18 @@ func (in *ProjectShareGroup) DeepCopyInto(out *ProjectShareGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroup.
-func (in *ProjectShareGroup) DeepCopy() *ProjectShareGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runner.
+func (in *Runner) DeepCopy() *Runner {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroup)
+	out := new(Runner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectShareGroup) DeepCopyObject() runtime.Object {
+func (in *Runner) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2613,31 +5214,31 @@ func (in *ProjectShareGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroupList) DeepCopyInto(out *ProjectShareGroupList) {
+func (in *RunnerList) DeepCopyInto(out *RunnerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProjectShareGroup, len(*in))
+		*out = make([]Runner, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupList.
-func (in *ProjectShareGroupList) DeepCopy() *ProjectShareGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerList.
+func (in *RunnerList) DeepCopy() *RunnerList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroupList)
+	out := new(RunnerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectShareGroupList) DeepCopyObject() runtime.Object {
+func (in *RunnerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2645,156 +5246,138 @@ func (in *ProjectShareGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroupObservation) DeepCopyInto(out *ProjectShareGroupObservation) {
+func (in *RunnerObservation) DeepCopyInto(out *RunnerObservation) {
 	*out = *in
+	in.CommonRunnerObservation.DeepCopyInto(&out.CommonRunnerObservation)
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]RunnerProject, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupObservation.
-func (in *ProjectShareGroupObservation) DeepCopy() *ProjectShareGroupObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerObservation.
+func (in *RunnerObservation) DeepCopy() *RunnerObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroupObservation)
+	out := new(RunnerObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroupParameters) DeepCopyInto(out *ProjectShareGroupParameters) {
+func (in *RunnerParameters) DeepCopyInto(out *RunnerParameters) {
 	*out = *in
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupRef != nil {
-		in, out := &in.GroupRef, &out.GroupRef
-		*out = new(v1.NamespacedReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GroupSelector != nil {
-		in, out := &in.GroupSelector, &out.GroupSelector
-		*out = new(v1.NamespacedSelector)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(string)
+		*out = new(int64)
 		**out = **in
 	}
-	if in.ProjectRef != nil {
-		in, out := &in.ProjectRef, &out.ProjectRef
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
 		*out = new(v1.NamespacedReference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ProjectSelector != nil {
-		in, out := &in.ProjectSelector, &out.ProjectSelector
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	in.CommonRunnerParameters.DeepCopyInto(&out.CommonRunnerParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupParameters.
-func (in *ProjectShareGroupParameters) DeepCopy() *ProjectShareGroupParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerParameters.
+func (in *RunnerParameters) DeepCopy() *RunnerParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroupParameters)
+	out := new(RunnerParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroupSpec) DeepCopyInto(out *ProjectShareGroupSpec) {
+func (in *RunnerProject) DeepCopyInto(out *RunnerProject) {
 	*out = *in
-	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupSpec.
-func (in *ProjectShareGroupSpec) DeepCopy() *ProjectShareGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerProject.
+func (in *RunnerProject) DeepCopy() *RunnerProject {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroupSpec)
+	out := new(RunnerProject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectShareGroupStatus) DeepCopyInto(out *ProjectShareGroupStatus) {
+func (in *RunnerSpec) DeepCopyInto(out *RunnerSpec) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareGroupStatus.
-func (in *ProjectShareGroupStatus) DeepCopy() *ProjectShareGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerSpec.
+func (in *RunnerSpec) DeepCopy() *RunnerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectShareGroupStatus)
+	out := new(RunnerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *RunnerStatus) DeepCopyInto(out *RunnerStatus) {
 	*out = *in
-	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerStatus.
+func (in *RunnerStatus) DeepCopy() *RunnerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(RunnerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
 	*out = *in
-	out.StorageStatistics = in.StorageStatistics
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
-func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
+func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatistics)
+	out := new(SharedWithGroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *StorageStatistics) DeepCopyInto(out *StorageStatistics) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageStatistics.
+func (in *StorageStatistics) DeepCopy() *StorageStatistics {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(StorageStatistics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranch) DeepCopyInto(out *ProtectedBranch) {
+func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2802,18 +5385,18 @@ func (in *ProtectedBranch) DeepCopyInto(out *ProtectedBranch) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranch.
-func (in *ProtectedBranch) DeepCopy() *ProtectedBranch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranch)
+	out := new(Tag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectedBranch) DeepCopyObject() runtime.Object {
+func (in *Tag) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2821,31 +5404,31 @@ func (in *ProtectedBranch) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranchList) DeepCopyInto(out *ProtectedBranchList) {
+func (in *TagList) DeepCopyInto(out *TagList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProtectedBranch, len(*in))
+		*out = make([]Tag, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchList.
-func (in *ProtectedBranchList) DeepCopy() *ProtectedBranchList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagList.
+func (in *TagList) DeepCopy() *TagList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranchList)
+	out := new(TagList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectedBranchList) DeepCopyObject() runtime.Object {
+func (in *TagList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2853,55 +5436,26 @@ func (in *ProtectedBranchList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranchObservation) DeepCopyInto(out *ProtectedBranchObservation) {
+func (in *TagObservation) DeepCopyInto(out *TagObservation) {
 	*out = *in
-	if in.PushAccessLevels != nil {
-		in, out := &in.PushAccessLevels, &out.PushAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.MergeAccessLevels != nil {
-		in, out := &in.MergeAccessLevels, &out.MergeAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.UnprotectAccessLevels != nil {
-		in, out := &in.UnprotectAccessLevels, &out.UnprotectAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchObservation.
-func (in *ProtectedBranchObservation) DeepCopy() *ProtectedBranchObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagObservation.
+func (in *TagObservation) DeepCopy() *TagObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranchObservation)
+	out := new(TagObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranchParameters) DeepCopyInto(out *ProtectedBranchParameters) {
+func (in *TagParameters) DeepCopyInto(out *TagParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -2909,106 +5463,73 @@ func (in *ProtectedBranchParameters) DeepCopyInto(out *ProtectedBranchParameters
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
-		in, out := &in.ProjectIDRef, &out.ProjectIDRef
-		*out = new(v1.NamespacedReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ProjectIDSelector != nil {
-		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
-		*out = new(v1.NamespacedSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PushAccessLevels != nil {
-		in, out := &in.PushAccessLevels, &out.PushAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.MergeAccessLevels != nil {
-		in, out := &in.MergeAccessLevels, &out.MergeAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.UnprotectAccessLevels != nil {
-		in, out := &in.UnprotectAccessLevels, &out.UnprotectAccessLevels
-		*out = make([]*BranchAccessDescription, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(BranchAccessDescription)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AllowForcePush != nil {
-		in, out := &in.AllowForcePush, &out.AllowForcePush
-		*out = new(bool)
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
 		**out = **in
 	}
-	if in.CodeOwnerApprovalRequired != nil {
-		in, out := &in.CodeOwnerApprovalRequired, &out.CodeOwnerApprovalRequired
-		*out = new(bool)
+	if in.ReleaseDescription != nil {
+		in, out := &in.ReleaseDescription, &out.ReleaseDescription
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchParameters.
-func (in *ProtectedBranchParameters) DeepCopy() *ProtectedBranchParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagParameters.
+func (in *TagParameters) DeepCopy() *TagParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranchParameters)
+	out := new(TagParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranchSpec) DeepCopyInto(out *ProtectedBranchSpec) {
+func (in *TagSpec) DeepCopyInto(out *TagSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSpec.
-func (in *ProtectedBranchSpec) DeepCopy() *ProtectedBranchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpec.
+func (in *TagSpec) DeepCopy() *TagSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranchSpec)
+	out := new(TagSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedBranchStatus) DeepCopyInto(out *ProtectedBranchStatus) {
+func (in *TagStatus) DeepCopyInto(out *TagStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchStatus.
-func (in *ProtectedBranchStatus) DeepCopy() *ProtectedBranchStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagStatus.
+func (in *TagStatus) DeepCopy() *TagStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedBranchStatus)
+	out := new(TagStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironment) DeepCopyInto(out *ProtectedEnvironment) {
+func (in *TerraformState) DeepCopyInto(out *TerraformState) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3016,18 +5537,18 @@ func (in *ProtectedEnvironment) DeepCopyInto(out *ProtectedEnvironment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironment.
-func (in *ProtectedEnvironment) DeepCopy() *ProtectedEnvironment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformState.
+func (in *TerraformState) DeepCopy() *TerraformState {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironment)
+	out := new(TerraformState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectedEnvironment) DeepCopyObject() runtime.Object {
+func (in *TerraformState) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3035,31 +5556,31 @@ func (in *ProtectedEnvironment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironmentList) DeepCopyInto(out *ProtectedEnvironmentList) {
+func (in *TerraformStateList) DeepCopyInto(out *TerraformStateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProtectedEnvironment, len(*in))
+		*out = make([]TerraformState, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentList.
-func (in *ProtectedEnvironmentList) DeepCopy() *ProtectedEnvironmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateList.
+func (in *TerraformStateList) DeepCopy() *TerraformStateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironmentList)
+	out := new(TerraformStateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectedEnvironmentList) DeepCopyObject() runtime.Object {
+func (in *TerraformStateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3067,52 +5588,35 @@ func (in *ProtectedEnvironmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironmentObservation) DeepCopyInto(out *ProtectedEnvironmentObservation) {
+func (in *TerraformStateObservation) DeepCopyInto(out *TerraformStateObservation) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.RequiredApprovalCount != nil {
-		in, out := &in.RequiredApprovalCount, &out.RequiredApprovalCount
-		*out = new(int64)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.DeployAccessLevels != nil {
-		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
-		*out = make([]EnvironmentAccessLevelObservation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.ApprovalRules != nil {
-		in, out := &in.ApprovalRules, &out.ApprovalRules
-		*out = make([]EnvironmentApprovalRuleObservation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LockedAt != nil {
+		in, out := &in.LockedAt, &out.LockedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentObservation.
-func (in *ProtectedEnvironmentObservation) DeepCopy() *ProtectedEnvironmentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateObservation.
+func (in *TerraformStateObservation) DeepCopy() *TerraformStateObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironmentObservation)
+	out := new(TerraformStateObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironmentParameters) DeepCopyInto(out *ProtectedEnvironmentParameters) {
+func (in *TerraformStateParameters) DeepCopyInto(out *TerraformStateParameters) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
@@ -3128,161 +5632,297 @@ func (in *ProtectedEnvironmentParameters) DeepCopyInto(out *ProtectedEnvironment
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DeployAccessLevels != nil {
-		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
-		*out = new([]EnvironmentAccessLevelParameters)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]EnvironmentAccessLevelParameters, len(*in))
-			for i := range *in {
-				(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateParameters.
+func (in *TerraformStateParameters) DeepCopy() *TerraformStateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateSpec) DeepCopyInto(out *TerraformStateSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateSpec.
+func (in *TerraformStateSpec) DeepCopy() *TerraformStateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateStatus) DeepCopyInto(out *TerraformStateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateStatus.
+func (in *TerraformStateStatus) DeepCopy() *TerraformStateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Token) DeepCopyInto(out *Token) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
+func (in *Token) DeepCopy() *Token {
+	if in == nil {
+		return nil
+	}
+	out := new(Token)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastActivityOn != nil {
+		in, out := &in.LastActivityOn, &out.LastActivityOn
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentSignInAt != nil {
+		in, out := &in.CurrentSignInAt, &out.CurrentSignInAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSignInAt != nil {
+		in, out := &in.LastSignInAt, &out.LastSignInAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ConfirmedAt != nil {
+		in, out := &in.ConfirmedAt, &out.ConfirmedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]*UserIdentity, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserIdentity)
+				**out = **in
 			}
 		}
 	}
-	if in.ApprovalRules != nil {
-		in, out := &in.ApprovalRules, &out.ApprovalRules
-		*out = new([]EnvironmentApprovalRuleParameters)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]EnvironmentApprovalRuleParameters, len(*in))
-			for i := range *in {
-				(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]*CustomAttribute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CustomAttribute)
+				**out = **in
 			}
 		}
 	}
-	if in.RequiredApprovalCount != nil {
-		in, out := &in.RequiredApprovalCount, &out.RequiredApprovalCount
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
 	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentParameters.
-func (in *ProtectedEnvironmentParameters) DeepCopy() *ProtectedEnvironmentParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserIdentity) DeepCopyInto(out *UserIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserIdentity.
+func (in *UserIdentity) DeepCopy() *UserIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironmentParameters)
+	out := new(UserIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironmentSpec) DeepCopyInto(out *ProtectedEnvironmentSpec) {
+func (in *Variable) DeepCopyInto(out *Variable) {
 	*out = *in
-	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentSpec.
-func (in *ProtectedEnvironmentSpec) DeepCopy() *ProtectedEnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
+func (in *Variable) DeepCopy() *Variable {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Variable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableList) DeepCopyInto(out *VariableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableList.
+func (in *VariableList) DeepCopy() *VariableList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironmentSpec)
+	out := new(VariableList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VariableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectedEnvironmentStatus) DeepCopyInto(out *ProtectedEnvironmentStatus) {
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.CommonVariableObservation = in.CommonVariableObservation
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentStatus.
-func (in *ProtectedEnvironmentStatus) DeepCopy() *ProtectedEnvironmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectedEnvironmentStatus)
+	out := new(VariableObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PushRules) DeepCopyInto(out *PushRules) {
+func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
-	if in.AuthorEmailRegex != nil {
-		in, out := &in.AuthorEmailRegex, &out.AuthorEmailRegex
-		*out = new(string)
+	in.CommonVariableParameters.DeepCopyInto(&out.CommonVariableParameters)
+	if in.ValueSecretRef != nil {
+		in, out := &in.ValueSecretRef, &out.ValueSecretRef
+		*out = new(v1.LocalSecretKeySelector)
 		**out = **in
 	}
-	if in.BranchNameRegex != nil {
-		in, out := &in.BranchNameRegex, &out.BranchNameRegex
-		*out = new(string)
-		**out = **in
+	if in.ValueTemplate != nil {
+		in, out := &in.ValueTemplate, &out.ValueTemplate
+		*out = new(VariableValueTemplate)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.CommitCommitterCheck != nil {
-		in, out := &in.CommitCommitterCheck, &out.CommitCommitterCheck
+	if in.MaskedAndHidden != nil {
+		in, out := &in.MaskedAndHidden, &out.MaskedAndHidden
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CommitCommitterNameCheck != nil {
-		in, out := &in.CommitCommitterNameCheck, &out.CommitCommitterNameCheck
+	if in.MaskIfPossible != nil {
+		in, out := &in.MaskIfPossible, &out.MaskIfPossible
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CommitMessageNegativeRegex != nil {
-		in, out := &in.CommitMessageNegativeRegex, &out.CommitMessageNegativeRegex
-		*out = new(string)
-		**out = **in
-	}
-	if in.CommitMessageRegex != nil {
-		in, out := &in.CommitMessageRegex, &out.CommitMessageRegex
-		*out = new(string)
-		**out = **in
-	}
-	if in.DenyDeleteTag != nil {
-		in, out := &in.DenyDeleteTag, &out.DenyDeleteTag
-		*out = new(bool)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int64)
 		**out = **in
 	}
-	if in.FileNameRegex != nil {
-		in, out := &in.FileNameRegex, &out.FileNameRegex
-		*out = new(string)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxFileSize != nil {
-		in, out := &in.MaxFileSize, &out.MaxFileSize
-		*out = new(int64)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MemberCheck != nil {
-		in, out := &in.MemberCheck, &out.MemberCheck
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDSearch != nil {
+		in, out := &in.ProjectIDSearch, &out.ProjectIDSearch
+		*out = new(commonv1alpha1.GitLabSearch)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PreventSecrets != nil {
-		in, out := &in.PreventSecrets, &out.PreventSecrets
-		*out = new(bool)
+	if in.ProjectPath != nil {
+		in, out := &in.ProjectPath, &out.ProjectPath
+		*out = new(string)
 		**out = **in
 	}
-	if in.RejectUnsignedCommits != nil {
-		in, out := &in.RejectUnsignedCommits, &out.RejectUnsignedCommits
-		*out = new(bool)
+	if in.EnvironmentScope != nil {
+		in, out := &in.EnvironmentScope, &out.EnvironmentScope
+		*out = new(string)
 		**out = **in
 	}
-	if in.RejectNonDCOCommits != nil {
-		in, out := &in.RejectNonDCOCommits, &out.RejectNonDCOCommits
+	if in.PruneOtherScopes != nil {
+		in, out := &in.PruneOtherScopes, &out.PruneOtherScopes
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRules.
-func (in *PushRules) DeepCopy() *PushRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
+func (in *VariableParameters) DeepCopy() *VariableParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PushRules)
+	out := new(VariableParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Runner) DeepCopyInto(out *Runner) {
+func (in *VariableSet) DeepCopyInto(out *VariableSet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3290,18 +5930,18 @@ func (in *Runner) DeepCopyInto(out *Runner) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runner.
-func (in *Runner) DeepCopy() *Runner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSet.
+func (in *VariableSet) DeepCopy() *VariableSet {
 	if in == nil {
 		return nil
 	}
-	out := new(Runner)
+	out := new(VariableSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Runner) DeepCopyObject() runtime.Object {
+func (in *VariableSet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3309,31 +5949,31 @@ func (in *Runner) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerList) DeepCopyInto(out *RunnerList) {
+func (in *VariableSetList) DeepCopyInto(out *VariableSetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Runner, len(*in))
+		*out = make([]VariableSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerList.
-func (in *RunnerList) DeepCopy() *RunnerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetList.
+func (in *VariableSetList) DeepCopy() *VariableSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerList)
+	out := new(VariableSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RunnerList) DeepCopyObject() runtime.Object {
+func (in *VariableSetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3341,28 +5981,27 @@ func (in *RunnerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerObservation) DeepCopyInto(out *RunnerObservation) {
+func (in *VariableSetObservation) DeepCopyInto(out *VariableSetObservation) {
 	*out = *in
-	in.CommonRunnerObservation.DeepCopyInto(&out.CommonRunnerObservation)
-	if in.Projects != nil {
-		in, out := &in.Projects, &out.Projects
-		*out = make([]RunnerProject, len(*in))
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]VariableSetVariable, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerObservation.
-func (in *RunnerObservation) DeepCopy() *RunnerObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetObservation.
+func (in *VariableSetObservation) DeepCopy() *VariableSetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerObservation)
+	out := new(VariableSetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerParameters) DeepCopyInto(out *RunnerParameters) {
+func (in *VariableSetParameters) DeepCopyInto(out *VariableSetParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -3379,192 +6018,166 @@ func (in *RunnerParameters) DeepCopyInto(out *RunnerParameters) {
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	in.CommonRunnerParameters.DeepCopyInto(&out.CommonRunnerParameters)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerParameters.
-func (in *RunnerParameters) DeepCopy() *RunnerParameters {
-	if in == nil {
-		return nil
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(commonv1alpha1.LocalConfigMapReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalSecretReference)
+		**out = **in
+	}
+	if in.EnvironmentScope != nil {
+		in, out := &in.EnvironmentScope, &out.EnvironmentScope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protected != nil {
+		in, out := &in.Protected, &out.Protected
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdoptExisting != nil {
+		in, out := &in.AdoptExisting, &out.AdoptExisting
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(RunnerParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerProject) DeepCopyInto(out *RunnerProject) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerProject.
-func (in *RunnerProject) DeepCopy() *RunnerProject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetParameters.
+func (in *VariableSetParameters) DeepCopy() *VariableSetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerProject)
+	out := new(VariableSetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerSpec) DeepCopyInto(out *RunnerSpec) {
+func (in *VariableSetSpec) DeepCopyInto(out *VariableSetSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerSpec.
-func (in *RunnerSpec) DeepCopy() *RunnerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetSpec.
+func (in *VariableSetSpec) DeepCopy() *VariableSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerSpec)
+	out := new(VariableSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerStatus) DeepCopyInto(out *RunnerStatus) {
+func (in *VariableSetStatus) DeepCopyInto(out *VariableSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerStatus.
-func (in *RunnerStatus) DeepCopy() *RunnerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetStatus.
+func (in *VariableSetStatus) DeepCopy() *VariableSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerStatus)
+	out := new(VariableSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+func (in *VariableSetVariable) DeepCopyInto(out *VariableSetVariable) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
-func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetVariable.
+func (in *VariableSetVariable) DeepCopy() *VariableSetVariable {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedWithGroups)
+	out := new(VariableSetVariable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageStatistics) DeepCopyInto(out *StorageStatistics) {
+func (in *VariableSpec) DeepCopyInto(out *VariableSpec) {
 	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageStatistics.
-func (in *StorageStatistics) DeepCopy() *StorageStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSpec.
+func (in *VariableSpec) DeepCopy() *VariableSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageStatistics)
+	out := new(VariableSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Token) DeepCopyInto(out *Token) {
+func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
 	*out = *in
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(v1.LocalSecretKeySelector)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
-func (in *Token) DeepCopy() *Token {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
+func (in *VariableStatus) DeepCopy() *VariableStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Token)
+	out := new(VariableStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *User) DeepCopyInto(out *User) {
+func (in *VariableValueSecretKeyRef) DeepCopyInto(out *VariableValueSecretKeyRef) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.LastActivityOn != nil {
-		in, out := &in.LastActivityOn, &out.LastActivityOn
-		*out = (*in).DeepCopy()
-	}
-	if in.CurrentSignInAt != nil {
-		in, out := &in.CurrentSignInAt, &out.CurrentSignInAt
-		*out = (*in).DeepCopy()
-	}
-	if in.LastSignInAt != nil {
-		in, out := &in.LastSignInAt, &out.LastSignInAt
-		*out = (*in).DeepCopy()
-	}
-	if in.ConfirmedAt != nil {
-		in, out := &in.ConfirmedAt, &out.ConfirmedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Identities != nil {
-		in, out := &in.Identities, &out.Identities
-		*out = make([]*UserIdentity, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(UserIdentity)
-				**out = **in
-			}
-		}
-	}
-	if in.CustomAttributes != nil {
-		in, out := &in.CustomAttributes, &out.CustomAttributes
-		*out = make([]*CustomAttribute, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(CustomAttribute)
-				**out = **in
-			}
-		}
-	}
+	in.SecretKeyRef.DeepCopyInto(&out.SecretKeyRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
-func (in *User) DeepCopy() *User {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableValueSecretKeyRef.
+func (in *VariableValueSecretKeyRef) DeepCopy() *VariableValueSecretKeyRef {
 	if in == nil {
 		return nil
 	}
-	out := new(User)
+	out := new(VariableValueSecretKeyRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserIdentity) DeepCopyInto(out *UserIdentity) {
+func (in *VariableValueTemplate) DeepCopyInto(out *VariableValueTemplate) {
 	*out = *in
+	if in.SecretKeyRefs != nil {
+		in, out := &in.SecretKeyRefs, &out.SecretKeyRefs
+		*out = make([]VariableValueSecretKeyRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserIdentity.
-func (in *UserIdentity) DeepCopy() *UserIdentity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableValueTemplate.
+func (in *VariableValueTemplate) DeepCopy() *VariableValueTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(UserIdentity)
+	out := new(VariableValueTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Variable) DeepCopyInto(out *Variable) {
+func (in *WikiPage) DeepCopyInto(out *WikiPage) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3572,18 +6185,18 @@ func (in *Variable) DeepCopyInto(out *Variable) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
-func (in *Variable) DeepCopy() *Variable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPage.
+func (in *WikiPage) DeepCopy() *WikiPage {
 	if in == nil {
 		return nil
 	}
-	out := new(Variable)
+	out := new(WikiPage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Variable) DeepCopyObject() runtime.Object {
+func (in *WikiPage) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3591,31 +6204,31 @@ func (in *Variable) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableList) DeepCopyInto(out *VariableList) {
+func (in *WikiPageList) DeepCopyInto(out *WikiPageList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Variable, len(*in))
+		*out = make([]WikiPage, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableList.
-func (in *VariableList) DeepCopy() *VariableList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageList.
+func (in *WikiPageList) DeepCopy() *WikiPageList {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableList)
+	out := new(WikiPageList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VariableList) DeepCopyObject() runtime.Object {
+func (in *WikiPageList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3623,33 +6236,26 @@ func (in *VariableList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
+func (in *WikiPageObservation) DeepCopyInto(out *WikiPageObservation) {
 	*out = *in
-	out.CommonVariableObservation = in.CommonVariableObservation
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
-func (in *VariableObservation) DeepCopy() *VariableObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageObservation.
+func (in *WikiPageObservation) DeepCopy() *WikiPageObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableObservation)
+	out := new(WikiPageObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
+func (in *WikiPageParameters) DeepCopyInto(out *WikiPageParameters) {
 	*out = *in
-	in.CommonVariableParameters.DeepCopyInto(&out.CommonVariableParameters)
-	if in.ValueSecretRef != nil {
-		in, out := &in.ValueSecretRef, &out.ValueSecretRef
-		*out = new(v1.LocalSecretKeySelector)
-		**out = **in
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int64)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -3662,53 +6268,63 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.EnvironmentScope != nil {
-		in, out := &in.EnvironmentScope, &out.EnvironmentScope
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentSecretRef != nil {
+		in, out := &in.ContentSecretRef, &out.ContentSecretRef
+		*out = new(v1.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
-func (in *VariableParameters) DeepCopy() *VariableParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageParameters.
+func (in *WikiPageParameters) DeepCopy() *WikiPageParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableParameters)
+	out := new(WikiPageParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableSpec) DeepCopyInto(out *VariableSpec) {
+func (in *WikiPageSpec) DeepCopyInto(out *WikiPageSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSpec.
-func (in *VariableSpec) DeepCopy() *VariableSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageSpec.
+func (in *WikiPageSpec) DeepCopy() *WikiPageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableSpec)
+	out := new(WikiPageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
+func (in *WikiPageStatus) DeepCopyInto(out *WikiPageStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
-func (in *VariableStatus) DeepCopy() *VariableStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageStatus.
+func (in *WikiPageStatus) DeepCopy() *WikiPageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableStatus)
+	out := new(WikiPageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
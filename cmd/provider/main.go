@@ -47,6 +47,7 @@ import (
 	apisCluster "github.com/crossplane-contrib/provider-gitlab/apis/cluster"
 	apisNamespaced "github.com/crossplane-contrib/provider-gitlab/apis/namespaced"
 	controllerCluster "github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 	controllerNamespaced "github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller"
 )
 
@@ -62,6 +63,7 @@ func main() {
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
 
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		transientServerErrors    = app.Flag("transient-server-errors", "Treat 5xx responses of GitLab as transient and mark the affected resources GitLabUnavailable.").Default("false").Envar("TRANSIENT_SERVER_ERRORS").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -132,6 +134,11 @@ func main() {
 		log.Info("Beta feature enabled", "flag", feature.EnableBetaManagementPolicies)
 	}
 
+	if *transientServerErrors {
+		o.Features.Enable(common.EnableTransientServerErrors)
+		log.Info("Feature enabled", "flag", common.EnableTransientServerErrors)
+	}
+
 	canSafeStart, err := canWatchCRD(context.Background(), mgr)
 
 	kingpin.FatalIfError(err, "SafeStart precheck failed")
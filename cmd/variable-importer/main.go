@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// variable-importer prints Variable manifests adopting all variables of an
+// existing GitLab project.
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/importer"
+)
+
+func main() {
+	var (
+		app                = kingpin.New(filepath.Base(os.Args[0]), "Generate Variable manifests for the variables of an existing GitLab project.").DefaultEnvars()
+		baseURL            = app.Flag("base-url", "Base URL of the GitLab instance.").Default("https://gitlab.com/").String()
+		token              = app.Flag("token", "GitLab personal access token with the api scope.").Required().String()
+		insecureSkipVerify = app.Flag("insecure-skip-verify", "Skip TLS certificate verification.").Bool()
+		project            = app.Flag("project", "ID or path of the project to import the variables of.").Required().String()
+		namespace          = app.Flag("namespace", "Namespace of the generated resources and the values Secret.").Default("default").String()
+		providerConfig     = app.Flag("provider-config", "Name of the provider config the resources reference.").String()
+		providerConfigKind = app.Flag("provider-config-kind", "Kind of the provider config the namespaced resources reference.").Default("ProviderConfig").Enum("ProviderConfig", "ClusterProviderConfig")
+		valuesSecret       = app.Flag("values-secret", "Write the variable values to a Secret with this name. Values are omitted otherwise.").String()
+		clusterScoped      = app.Flag("cluster-scoped", "Generate cluster scoped resources.").Bool()
+		allowDelete        = app.Flag("allow-delete", "Delete the variables from GitLab when the resources are deleted. Variables are orphaned by default.").Bool()
+		observeOnly        = app.Flag("observe-only", "Only observe the variables, never create, update or delete them.").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	c := importer.NewProjectVariableClient(common.Config{
+		BaseURL:            *baseURL,
+		Token:              *token,
+		InsecureSkipVerify: *insecureSkipVerify,
+	})
+
+	ctx := context.Background()
+	pid, vars, err := importer.ListProjectVariables(ctx, c, *project)
+	kingpin.FatalIfError(err, "cannot import variables of project %s", *project)
+
+	manifests := importer.GenerateProjectVariableManifests(pid, vars, importer.VariableImportOptions{
+		Namespace:          *namespace,
+		ProviderConfigName: *providerConfig,
+		ProviderConfigKind: *providerConfigKind,
+		ValuesSecretName:   *valuesSecret,
+		ClusterScoped:      *clusterScoped,
+		AllowDelete:        *allowDelete,
+		ObserveOnly:        *observeOnly,
+	})
+	kingpin.FatalIfError(importer.WriteManifests(os.Stdout, manifests), "cannot write manifests")
+}
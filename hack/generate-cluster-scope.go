@@ -258,11 +258,19 @@ func getReplacements() []replacement {
 		{"reference.NewAPINamespacedResolver", "reference.NewAPIResolver"},
 		{"reference.NamespacedResolutionRequest", "reference.ResolutionRequest"},
 		{"reference.NamespacedResolutionResponse", "reference.ResolutionResponse"},
+		{"reference.MultiNamespacedResolutionRequest", "reference.MultiResolutionRequest"},
+		{"reference.MultiNamespacedResolutionResponse", "reference.MultiResolutionResponse"},
 		{"kubebuilder:resource:scope=Namespaced", "kubebuilder:resource:scope=Cluster"},
 		{"/namespaced/", "/cluster/"},
 		{"GetTokenValueFromLocalSecret", "GetTokenValueFromSecret"},
+		{"GetSecretVersionFromLocalSecret", "GetSecretVersionFromSecret"},
 		{"TestCreateLocalSecretKeySelector", "TestCreateSecretKeySelector"},
 		{"TestCreateLocalSecretReference", "TestCreateSecretReference"},
+		{"LocalConfigMapKeySelector", "ConfigMapKeySelector"},
+		{"GetValueFromLocalConfigMap", "GetValueFromConfigMap"},
+		{"LocalConfigMapReference", "ConfigMapReference"},
+		{"GetLocalConfigMapData", "GetConfigMapData"},
+		{"GetLocalSecretData", "GetSecretData"},
 	}
 }
 
@@ -19,6 +19,10 @@ limitations under the License.
 package fake
 
 import (
+	"bytes"
+	"context"
+	"io"
+
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
@@ -36,6 +40,8 @@ type MockClient struct {
 	MockDeleteGroup           func(pid interface{}, opt *gitlab.DeleteGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockShareGroupWithGroup   func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	MockUnshareGroupFromGroup func(gid interface{}, groupID int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockUploadAvatar          func(gid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	MockDownloadAvatar        func(gid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error)
 
 	MockGetMember    func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockAddMember    func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
@@ -64,9 +70,25 @@ type MockClient struct {
 	MockGetGroupVariable    func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
 	MockCreateGroupVariable func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
 	MockUpdateGroupVariable func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	MockRemoveGroupVariable func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockRemoveGroupVariable func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetGroupPushRules   func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	MockAddGroupPushRule    func(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	MockEditGroupPushRule   func(gid any, opt *gitlab.EditGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	MockDeleteGroupPushRule func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetGroupHook            func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	MockAddGroupHook            func(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	MockEditGroupHook           func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	MockDeleteGroupHook         func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteGroupCustomHeader func(gid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
-	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockListUsers   func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockCurrentUser func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+
+	MockListServiceAccountPersonalAccessTokens  func(gid any, serviceAccount int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockCreateServiceAccountPersonalAccessToken func(gid any, serviceAccount int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockRevokeServiceAccountPersonalAccessToken func(gid any, serviceAccount, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
 // GetGroup calls the underlying MockGetGroup method.
@@ -84,6 +106,16 @@ func (c *MockClient) UpdateGroup(pid interface{}, opt *gitlab.UpdateGroupOptions
 	return c.MockUpdateGroup(pid, opt)
 }
 
+// UploadAvatar calls the underlying MockUploadAvatar method
+func (c *MockClient) UploadAvatar(gid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.MockUploadAvatar(gid, avatar, filename, options...)
+}
+
+// DownloadAvatar calls the underlying MockDownloadAvatar method
+func (c *MockClient) DownloadAvatar(gid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return c.MockDownloadAvatar(gid, options...)
+}
+
 // DeleteGroup calls the underlying MockDeleteGroup method
 func (c *MockClient) DeleteGroup(pid interface{}, opt *gitlab.DeleteGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockDeleteGroup(pid, opt)
@@ -181,7 +213,7 @@ func (c *MockClient) UpdateVariable(gid interface{}, key string, opt *gitlab.Upd
 
 // RemoveVariable calls the underlying MockRemoveGroupVariable method.
 func (c *MockClient) RemoveVariable(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-	return c.MockRemoveGroupVariable(gid, key)
+	return c.MockRemoveGroupVariable(gid, key, opt)
 }
 
 // ListUsers calls the underlying MockListUsers method.
@@ -189,6 +221,11 @@ func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.R
 	return c.MockListUsers(opt)
 }
 
+// CurrentUser calls the underlying MockCurrentUser method.
+func (c *MockClient) CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockCurrentUser(options...)
+}
+
 // ListGroupLDAPLinks calls the underlying MockListGroupLDAPLink method.
 func (c *MockClient) ListGroupLDAPLinks(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.LDAPGroupLink, *gitlab.Response, error) {
 	return c.MockListGroupLDAPLinks(pid)
@@ -218,3 +255,148 @@ func (c *MockClient) AddGroupSAMLLink(pid interface{}, opt *gitlab.AddGroupSAMLL
 func (c *MockClient) DeleteGroupSAMLLink(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockDeleteGroupSAMLLink(pid, samlGroupName)
 }
+
+// ListServiceAccountPersonalAccessTokens calls the underlying MockListServiceAccountPersonalAccessTokens method.
+func (c *MockClient) ListServiceAccountPersonalAccessTokens(gid any, serviceAccount int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockListServiceAccountPersonalAccessTokens(gid, serviceAccount, opt, options...)
+}
+
+// CreateServiceAccountPersonalAccessToken calls the underlying MockCreateServiceAccountPersonalAccessToken method.
+func (c *MockClient) CreateServiceAccountPersonalAccessToken(gid any, serviceAccount int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockCreateServiceAccountPersonalAccessToken(gid, serviceAccount, opt, options...)
+}
+
+// RevokeServiceAccountPersonalAccessToken calls the underlying MockRevokeServiceAccountPersonalAccessToken method.
+func (c *MockClient) RevokeServiceAccountPersonalAccessToken(gid any, serviceAccount, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRevokeServiceAccountPersonalAccessToken(gid, serviceAccount, token, options...)
+}
+
+// GetGroupPushRules calls the underlying MockGetGroupPushRules method.
+func (c *MockClient) GetGroupPushRules(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+	return c.MockGetGroupPushRules(gid, options...)
+}
+
+// AddGroupPushRule calls the underlying MockAddGroupPushRule method.
+func (c *MockClient) AddGroupPushRule(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+	return c.MockAddGroupPushRule(gid, opt, options...)
+}
+
+// EditGroupPushRule calls the underlying MockEditGroupPushRule method.
+func (c *MockClient) EditGroupPushRule(gid any, opt *gitlab.EditGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+	return c.MockEditGroupPushRule(gid, opt, options...)
+}
+
+// DeleteGroupPushRule calls the underlying MockDeleteGroupPushRule method.
+func (c *MockClient) DeleteGroupPushRule(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupPushRule(gid, options...)
+}
+
+// GetGroupHook calls the underlying MockGetGroupHook method.
+func (c *MockClient) GetGroupHook(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+	return c.MockGetGroupHook(gid, hook, options...)
+}
+
+// AddGroupHook calls the underlying MockAddGroupHook method.
+func (c *MockClient) AddGroupHook(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+	return c.MockAddGroupHook(gid, opt, options...)
+}
+
+// EditGroupHook calls the underlying MockEditGroupHook method.
+func (c *MockClient) EditGroupHook(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+	return c.MockEditGroupHook(gid, hook, opt, options...)
+}
+
+// DeleteGroupHook calls the underlying MockDeleteGroupHook method.
+func (c *MockClient) DeleteGroupHook(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupHook(gid, hook, options...)
+}
+
+// DeleteGroupCustomHeader calls the underlying MockDeleteGroupCustomHeader method.
+func (c *MockClient) DeleteGroupCustomHeader(gid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupCustomHeader(gid, hook, key, options...)
+}
+
+var _ groups.ComplianceFrameworkClient = &MockComplianceFrameworkClient{}
+
+// MockComplianceFrameworkClient is a fake implementation of
+// groups.ComplianceFrameworkClient.
+type MockComplianceFrameworkClient struct {
+	MockGetComplianceFramework    func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error)
+	MockCreateComplianceFramework func(ctx context.Context, group string, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error)
+	MockUpdateComplianceFramework func(ctx context.Context, id int64, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error)
+	MockDeleteComplianceFramework func(ctx context.Context, id int64) error
+	MockGetGroupPath              func(ctx context.Context, group string) (string, error)
+}
+
+// GetComplianceFramework calls the underlying MockGetComplianceFramework method.
+func (c *MockComplianceFrameworkClient) GetComplianceFramework(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+	return c.MockGetComplianceFramework(ctx, group, id)
+}
+
+// CreateComplianceFramework calls the underlying MockCreateComplianceFramework method.
+func (c *MockComplianceFrameworkClient) CreateComplianceFramework(ctx context.Context, group string, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+	return c.MockCreateComplianceFramework(ctx, group, in)
+}
+
+// UpdateComplianceFramework calls the underlying MockUpdateComplianceFramework method.
+func (c *MockComplianceFrameworkClient) UpdateComplianceFramework(ctx context.Context, id int64, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+	return c.MockUpdateComplianceFramework(ctx, id, in)
+}
+
+// DeleteComplianceFramework calls the underlying MockDeleteComplianceFramework method.
+func (c *MockComplianceFrameworkClient) DeleteComplianceFramework(ctx context.Context, id int64) error {
+	return c.MockDeleteComplianceFramework(ctx, id)
+}
+
+// GetGroupPath calls the underlying MockGetGroupPath method.
+func (c *MockComplianceFrameworkClient) GetGroupPath(ctx context.Context, group string) (string, error) {
+	return c.MockGetGroupPath(ctx, group)
+}
+
+var _ groups.AISettingsClient = &MockAISettingsClient{}
+
+// MockAISettingsClient is a fake implementation of groups.AISettingsClient.
+type MockAISettingsClient struct {
+	MockGetGroupAISettings    func(gid int64, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error)
+	MockUpdateGroupAISettings func(gid int64, opt *groups.AISettings, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error)
+}
+
+// GetGroupAISettings calls the underlying MockGetGroupAISettings method.
+func (c *MockAISettingsClient) GetGroupAISettings(gid int64, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error) {
+	return c.MockGetGroupAISettings(gid, options...)
+}
+
+// UpdateGroupAISettings calls the underlying MockUpdateGroupAISettings method.
+func (c *MockAISettingsClient) UpdateGroupAISettings(gid int64, opt *groups.AISettings, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error) {
+	return c.MockUpdateGroupAISettings(gid, opt, options...)
+}
+
+var _ groups.ComputeMinutesClient = &MockComputeMinutesClient{}
+
+// MockComputeMinutesClient is a fake implementation of groups.ComputeMinutesClient.
+type MockComputeMinutesClient struct {
+	MockGetGroupComputeMinutes    func(gid int64, options ...gitlab.RequestOptionFunc) (*groups.ComputeMinutes, *gitlab.Response, error)
+	MockUpdateGroupComputeMinutes func(gid int64, opt *groups.ComputeMinutes, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// GetGroupComputeMinutes calls the underlying MockGetGroupComputeMinutes method.
+func (c *MockComputeMinutesClient) GetGroupComputeMinutes(gid int64, options ...gitlab.RequestOptionFunc) (*groups.ComputeMinutes, *gitlab.Response, error) {
+	return c.MockGetGroupComputeMinutes(gid, options...)
+}
+
+// UpdateGroupComputeMinutes calls the underlying MockUpdateGroupComputeMinutes method.
+func (c *MockComputeMinutesClient) UpdateGroupComputeMinutes(gid int64, opt *groups.ComputeMinutes, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUpdateGroupComputeMinutes(gid, opt, options...)
+}
+
+var _ groups.SearchClient = &MockSearchClient{}
+
+// MockSearchClient is a fake implementation of groups.SearchClient.
+type MockSearchClient struct {
+	MockListGroups func(opt *gitlab.ListGroupsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error)
+}
+
+// ListGroups calls the underlying MockListGroups method.
+func (c *MockSearchClient) ListGroups(opt *gitlab.ListGroupsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+	return c.MockListGroups(opt, options...)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package groups
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	complianceFrameworkGIDType = "ComplianceManagement::Framework"
+
+	complianceFrameworkFields = `id name description color pipelineConfigurationFullPath`
+
+	getComplianceFrameworkQuery = `query($fullPath: ID!, $id: ComplianceManagementFrameworkID!) {
+  namespace(fullPath: $fullPath) {
+    complianceFrameworks(id: $id) {
+      nodes { ` + complianceFrameworkFields + ` }
+    }
+  }
+}`
+
+	createComplianceFrameworkMutation = `mutation($namespacePath: ID!, $params: ComplianceFrameworkInput!) {
+  createComplianceFramework(input: {namespacePath: $namespacePath, params: $params}) {
+    framework { ` + complianceFrameworkFields + ` }
+    errors
+  }
+}`
+
+	updateComplianceFrameworkMutation = `mutation($id: ComplianceManagementFrameworkID!, $params: ComplianceFrameworkInput!) {
+  updateComplianceFramework(input: {id: $id, params: $params}) {
+    complianceFramework { ` + complianceFrameworkFields + ` }
+    errors
+  }
+}`
+
+	destroyComplianceFrameworkMutation = `mutation($id: ComplianceManagementFrameworkID!) {
+  destroyComplianceFramework(input: {id: $id}) {
+    errors
+  }
+}`
+
+	errGetGroup = "cannot get Gitlab group"
+)
+
+// ComplianceFramework is a compliance framework of a top-level group.
+type ComplianceFramework struct {
+	ID                            int64
+	Name                          string
+	Description                   string
+	Color                         string
+	PipelineConfigurationFullPath string
+}
+
+// ComplianceFrameworkInput are the attributes of a compliance framework sent
+// to GitLab when it is created or updated.
+type ComplianceFrameworkInput struct {
+	Name                          string  `json:"name"`
+	Description                   string  `json:"description"`
+	Color                         string  `json:"color"`
+	PipelineConfigurationFullPath *string `json:"pipelineConfigurationFullPath,omitempty"`
+}
+
+// ComplianceFrameworkClient defines the GitLab operations on compliance
+// frameworks. They are only available through the GraphQL API.
+type ComplianceFrameworkClient interface {
+	GetComplianceFramework(ctx context.Context, group string, id int64) (*ComplianceFramework, error)
+	CreateComplianceFramework(ctx context.Context, group string, in *ComplianceFrameworkInput) (*ComplianceFramework, error)
+	UpdateComplianceFramework(ctx context.Context, id int64, in *ComplianceFrameworkInput) (*ComplianceFramework, error)
+	DeleteComplianceFramework(ctx context.Context, id int64) error
+	GetGroupPath(ctx context.Context, group string) (string, error)
+}
+
+// NewComplianceFrameworkClient returns a new GitLab compliance framework
+// client.
+func NewComplianceFrameworkClient(cfg common.Config) ComplianceFrameworkClient {
+	git := common.NewClient(cfg)
+	return &complianceFrameworkClient{graphql: git.GraphQL, groups: git.Groups}
+}
+
+type complianceFrameworkClient struct {
+	graphql gitlab.GraphQLInterface
+	groups  gitlab.GroupsServiceInterface
+}
+
+type complianceFrameworkNode struct {
+	ID                            string `json:"id"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	Color                         string `json:"color"`
+	PipelineConfigurationFullPath string `json:"pipelineConfigurationFullPath"`
+}
+
+func (n *complianceFrameworkNode) framework() (*ComplianceFramework, error) {
+	id, err := common.ParseGraphQLID(complianceFrameworkGIDType, n.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &ComplianceFramework{
+		ID:                            id,
+		Name:                          n.Name,
+		Description:                   n.Description,
+		Color:                         n.Color,
+		PipelineConfigurationFullPath: n.PipelineConfigurationFullPath,
+	}, nil
+}
+
+// GetGroupPath returns the full path of a group given by ID or full path.
+// The GraphQL API only accepts full paths.
+func (c *complianceFrameworkClient) GetGroupPath(ctx context.Context, group string) (string, error) {
+	if _, err := strconv.ParseInt(group, 10, 64); err != nil {
+		return group, nil
+	}
+	grp, _, err := c.groups.GetGroup(group, &gitlab.GetGroupOptions{WithProjects: gitlab.Ptr(false)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, errGetGroup)
+	}
+	return grp.FullPath, nil
+}
+
+// GetComplianceFramework returns the compliance framework of a group, or nil
+// if neither the group nor the framework exist.
+func (c *complianceFrameworkClient) GetComplianceFramework(ctx context.Context, group string, id int64) (*ComplianceFramework, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			Namespace *struct {
+				ComplianceFrameworks struct {
+					Nodes []complianceFrameworkNode `json:"nodes"`
+				} `json:"complianceFrameworks"`
+			} `json:"namespace"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     getComplianceFrameworkQuery,
+		Variables: map[string]any{"fullPath": group, "id": common.GraphQLID(complianceFrameworkGIDType, id)},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	if res.Data.Namespace == nil || len(res.Data.Namespace.ComplianceFrameworks.Nodes) == 0 {
+		return nil, nil
+	}
+	return res.Data.Namespace.ComplianceFrameworks.Nodes[0].framework()
+}
+
+// CreateComplianceFramework creates a compliance framework in a top-level
+// group.
+func (c *complianceFrameworkClient) CreateComplianceFramework(ctx context.Context, group string, in *ComplianceFrameworkInput) (*ComplianceFramework, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			CreateComplianceFramework *struct {
+				Framework *complianceFrameworkNode `json:"framework"`
+				Errors    []string                 `json:"errors"`
+			} `json:"createComplianceFramework"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     createComplianceFrameworkMutation,
+		Variables: map[string]any{"namespacePath": group, "params": in},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	p := res.Data.CreateComplianceFramework
+	if p == nil || p.Framework == nil {
+		if p != nil && len(p.Errors) > 0 {
+			return nil, common.GraphQLMutationErrors(p.Errors)
+		}
+		return nil, common.ErrGraphQLResourceNotAvailable("group " + group)
+	}
+	return p.Framework.framework()
+}
+
+// UpdateComplianceFramework updates a compliance framework.
+func (c *complianceFrameworkClient) UpdateComplianceFramework(ctx context.Context, id int64, in *ComplianceFrameworkInput) (*ComplianceFramework, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			UpdateComplianceFramework *struct {
+				ComplianceFramework *complianceFrameworkNode `json:"complianceFramework"`
+				Errors              []string                 `json:"errors"`
+			} `json:"updateComplianceFramework"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     updateComplianceFrameworkMutation,
+		Variables: map[string]any{"id": common.GraphQLID(complianceFrameworkGIDType, id), "params": in},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	p := res.Data.UpdateComplianceFramework
+	if p == nil || p.ComplianceFramework == nil {
+		if p != nil && len(p.Errors) > 0 {
+			return nil, common.GraphQLMutationErrors(p.Errors)
+		}
+		return nil, common.ErrGraphQLResourceNotAvailable("compliance framework " + strconv.FormatInt(id, 10))
+	}
+	return p.ComplianceFramework.framework()
+}
+
+// DeleteComplianceFramework deletes a compliance framework. It is removed
+// from all projects it is assigned to.
+func (c *complianceFrameworkClient) DeleteComplianceFramework(ctx context.Context, id int64) error {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			DestroyComplianceFramework *struct {
+				Errors []string `json:"errors"`
+			} `json:"destroyComplianceFramework"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     destroyComplianceFrameworkMutation,
+		Variables: map[string]any{"id": common.GraphQLID(complianceFrameworkGIDType, id)},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	if p := res.Data.DestroyComplianceFramework; p != nil {
+		return common.GraphQLMutationErrors(p.Errors)
+	}
+	return nil
+}
+
+// IsComplianceFrameworkUnavailable returns true if the error indicates that
+// compliance frameworks are not available, usually because the license of
+// the GitLab server does not include them.
+func IsComplianceFrameworkUnavailable(err error) bool {
+	return common.IsGraphQLResourceNotAvailable(err)
+}
+
+// NormalizeComplianceFrameworkColor returns the lower case six digit form
+// of a hexadecimal color with a leading #, as GitLab stores it.
+func NormalizeComplianceFrameworkColor(color string) string {
+	c := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(color), "#"))
+	if len(c) == 3 {
+		c = string([]byte{c[0], c[0], c[1], c[1], c[2], c[2]})
+	}
+	return "#" + c
+}
+
+// GenerateComplianceFrameworkInput generates the attributes of a compliance
+// framework sent to GitLab.
+func GenerateComplianceFrameworkInput(p *v1alpha1.ComplianceFrameworkParameters) *ComplianceFrameworkInput {
+	return &ComplianceFrameworkInput{
+		Name:                          p.Name,
+		Description:                   p.Description,
+		Color:                         NormalizeComplianceFrameworkColor(p.Color),
+		PipelineConfigurationFullPath: p.PipelineConfigurationFullPath,
+	}
+}
+
+// LateInitializeComplianceFramework fills the empty fields in the compliance
+// framework spec with the values seen in GitLab.
+func LateInitializeComplianceFramework(in *v1alpha1.ComplianceFrameworkParameters, f *ComplianceFramework) {
+	if f == nil {
+		return
+	}
+	in.PipelineConfigurationFullPath = clients.LateInitializeStringPtr(in.PipelineConfigurationFullPath, f.PipelineConfigurationFullPath)
+}
+
+// IsComplianceFrameworkUpToDate checks whether the observed compliance
+// framework matches the desired one. Colors are compared in their
+// normalized form.
+func IsComplianceFrameworkUpToDate(p *v1alpha1.ComplianceFrameworkParameters, f *ComplianceFramework) bool {
+	if f == nil {
+		return false
+	}
+	return p.Name == f.Name &&
+		p.Description == f.Description &&
+		NormalizeComplianceFrameworkColor(p.Color) == NormalizeComplianceFrameworkColor(f.Color) &&
+		clients.IsComparableEqualToComparablePtr(p.PipelineConfigurationFullPath, f.PipelineConfigurationFullPath)
+}
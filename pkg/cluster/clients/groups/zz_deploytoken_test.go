@@ -25,8 +25,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 func TestGenerateCreateGroupDeployTokenOptions(t *testing.T) {
@@ -80,3 +82,40 @@ func TestGenerateCreateGroupDeployTokenOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestImmutableDeployTokenFields(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	dt := &gitlab.DeployToken{Username: "deployer", ExpiresAt: &expiresAt, Scopes: []string{"read_repository", "read_registry"}}
+
+	cases := map[string]struct {
+		parameters *v1alpha1.DeployTokenParameters
+		want       []string
+	}{
+		"Unset": {
+			parameters: &v1alpha1.DeployTokenParameters{},
+		},
+		"Unchanged": {
+			parameters: &v1alpha1.DeployTokenParameters{
+				Username:  ptr.To("deployer"),
+				ExpiresAt: &v1.Time{Time: expiresAt},
+				Scopes:    []string{"read_registry", "read_repository"},
+			},
+		},
+		"Changed": {
+			parameters: &v1alpha1.DeployTokenParameters{
+				Username:  ptr.To("other"),
+				ExpiresAt: &v1.Time{Time: expiresAt.AddDate(1, 0, 0)},
+				Scopes:    []string{"read_repository"},
+			},
+			want: []string{"forProvider.username", "forProvider.expiresAt", "forProvider.scopes"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := common.ChangedImmutableFields(ImmutableDeployTokenFields(tc.parameters, dt)...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
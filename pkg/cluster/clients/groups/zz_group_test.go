@@ -255,6 +255,7 @@ func TestGenerateCreateGroupOptions(t *testing.T) {
 				AutoDevopsEnabled:              &autoDevopsEnabled,
 				SubGroupCreationLevel:          &gitlabSubGroupCreationLevel,
 				EmailsEnabled:                  &emailsEnabled,
+				EmailsDisabled:                 gitlab.Ptr(!emailsEnabled), //nolint:staticcheck
 				MentionsDisabled:               &mentionsDisabled,
 				LFSEnabled:                     &LFSEnabled,
 				RequestAccessEnabled:           &requestAccessEnabled,
@@ -328,23 +329,22 @@ func TestGenerateEditGroupOptions(t *testing.T) {
 				},
 			},
 			want: &gitlab.UpdateGroupOptions{
-				Name:                           &name,
-				Path:                           &path,
-				Description:                    &description,
-				MembershipLock:                 &membershipLock,
-				Visibility:                     &gitlabVisibility,
-				ShareWithGroupLock:             &shareWithGroupLock,
-				RequireTwoFactorAuth:           &requireTwoFactorAuth,
-				TwoFactorGracePeriod:           &twoFactorGracePeriod,
-				ProjectCreationLevel:           &gitlabProjectCreationLevel,
-				AutoDevopsEnabled:              &autoDevopsEnabled,
-				SubGroupCreationLevel:          &gitlabSubGroupCreationLevel,
-				EmailsEnabled:                  &emailsEnabled,
-				MentionsDisabled:               &mentionsDisabled,
-				LFSEnabled:                     &LFSEnabled,
-				RequestAccessEnabled:           &requestAccessEnabled,
-				SharedRunnersMinutesLimit:      &sharedRunnersMinutesLimit,
-				ExtraSharedRunnersMinutesLimit: &extraSharedRunnersMinutesLimit,
+				Name:                  &name,
+				Path:                  &path,
+				Description:           &description,
+				MembershipLock:        &membershipLock,
+				Visibility:            &gitlabVisibility,
+				ShareWithGroupLock:    &shareWithGroupLock,
+				RequireTwoFactorAuth:  &requireTwoFactorAuth,
+				TwoFactorGracePeriod:  &twoFactorGracePeriod,
+				ProjectCreationLevel:  &gitlabProjectCreationLevel,
+				AutoDevopsEnabled:     &autoDevopsEnabled,
+				SubGroupCreationLevel: &gitlabSubGroupCreationLevel,
+				EmailsEnabled:         &emailsEnabled,
+				EmailsDisabled:        gitlab.Ptr(!emailsEnabled), //nolint:staticcheck
+				MentionsDisabled:      &mentionsDisabled,
+				LFSEnabled:            &LFSEnabled,
+				RequestAccessEnabled:  &requestAccessEnabled,
 			},
 		},
 		"SomeFields": {
@@ -358,11 +358,12 @@ func TestGenerateEditGroupOptions(t *testing.T) {
 				},
 			},
 			want: &gitlab.UpdateGroupOptions{
-				Name:          &name,
-				Path:          &path,
-				Description:   &description,
-				Visibility:    &gitlabVisibility,
-				EmailsEnabled: &emailsEnabled,
+				Name:           &name,
+				Path:           &path,
+				Description:    &description,
+				Visibility:     &gitlabVisibility,
+				EmailsEnabled:  &emailsEnabled,
+				EmailsDisabled: gitlab.Ptr(!emailsEnabled), //nolint:staticcheck
 			},
 		},
 	}
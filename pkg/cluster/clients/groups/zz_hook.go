@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package groups
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// HookClient defines Gitlab group hook service operations
+type HookClient interface {
+	GetGroupHook(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	AddGroupHook(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	EditGroupHook(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error)
+	DeleteGroupHook(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteGroupCustomHeader(gid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewHookClient returns a new Gitlab group hook service
+func NewHookClient(cfg common.Config) HookClient {
+	git := common.NewClient(cfg)
+	return git.Groups
+}
+
+// LateInitializeHook fills the empty fields in the group hook spec with the
+// values seen in gitlab.GroupHook.
+func LateInitializeHook(in *v1alpha1.GroupHookParameters, hook *gitlab.GroupHook) { //nolint:gocyclo
+	if hook == nil {
+		return
+	}
+
+	in.Name = clients.LateInitializeStringPtr(in.Name, hook.Name)
+	in.Description = clients.LateInitializeStringPtr(in.Description, hook.Description)
+	in.PushEventsBranchFilter = clients.LateInitializeStringPtr(in.PushEventsBranchFilter, hook.PushEventsBranchFilter)
+	in.BranchFilterStrategy = clients.LateInitializeStringPtr(in.BranchFilterStrategy, hook.BranchFilterStrategy)
+	in.CustomWebhookTemplate = clients.LateInitializeStringPtr(in.CustomWebhookTemplate, hook.CustomWebhookTemplate)
+
+	for _, f := range []struct {
+		in   **bool
+		from bool
+	}{
+		{&in.PushEvents, hook.PushEvents},
+		{&in.IssuesEvents, hook.IssuesEvents},
+		{&in.ConfidentialIssuesEvents, hook.ConfidentialIssuesEvents},
+		{&in.MergeRequestsEvents, hook.MergeRequestsEvents},
+		{&in.TagPushEvents, hook.TagPushEvents},
+		{&in.NoteEvents, hook.NoteEvents},
+		{&in.ConfidentialNoteEvents, hook.ConfidentialNoteEvents},
+		{&in.JobEvents, hook.JobEvents},
+		{&in.PipelineEvents, hook.PipelineEvents},
+		{&in.WikiPageEvents, hook.WikiPageEvents},
+		{&in.DeploymentEvents, hook.DeploymentEvents},
+		{&in.FeatureFlagEvents, hook.FeatureFlagEvents},
+		{&in.ReleasesEvents, hook.ReleasesEvents},
+		{&in.MilestoneEvents, hook.MilestoneEvents},
+		{&in.SubGroupEvents, hook.SubGroupEvents},
+		{&in.ProjectEvents, hook.ProjectEvents},
+		{&in.MemberEvents, hook.MemberEvents},
+		{&in.EmojiEvents, hook.EmojiEvents},
+		{&in.VulnerabilityEvents, hook.VulnerabilityEvents},
+		{&in.ResourceAccessTokenEvents, hook.ResourceAccessTokenEvents},
+		{&in.EnableSSLVerification, hook.EnableSSLVerification},
+	} {
+		if *f.in == nil {
+			*f.in = gitlab.Ptr(f.from)
+		}
+	}
+}
+
+// GenerateHookObservation is used to produce v1alpha1.GroupHookObservation
+// from gitlab.GroupHook.
+func GenerateHookObservation(hook *gitlab.GroupHook) v1alpha1.GroupHookObservation {
+	if hook == nil {
+		return v1alpha1.GroupHookObservation{}
+	}
+
+	o := v1alpha1.GroupHookObservation{
+		ID:          hook.ID,
+		GroupID:     hook.GroupID,
+		AlertStatus: hook.AlertStatus,
+	}
+
+	for _, h := range hook.CustomHeaders {
+		o.CustomHeaderKeys = append(o.CustomHeaderKeys, h.Key)
+	}
+
+	if hook.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *hook.CreatedAt}
+	}
+	return o
+}
+
+// GenerateAddHookOptions generates group hook creation options
+func GenerateAddHookOptions(p *v1alpha1.GroupHookParameters, token *string, headers []*gitlab.HookCustomHeader) *gitlab.AddGroupHookOptions {
+	o := &gitlab.AddGroupHookOptions{
+		URL:                       p.URL,
+		Name:                      p.Name,
+		Description:               p.Description,
+		PushEvents:                p.PushEvents,
+		PushEventsBranchFilter:    p.PushEventsBranchFilter,
+		BranchFilterStrategy:      p.BranchFilterStrategy,
+		IssuesEvents:              p.IssuesEvents,
+		ConfidentialIssuesEvents:  p.ConfidentialIssuesEvents,
+		MergeRequestsEvents:       p.MergeRequestsEvents,
+		TagPushEvents:             p.TagPushEvents,
+		NoteEvents:                p.NoteEvents,
+		ConfidentialNoteEvents:    p.ConfidentialNoteEvents,
+		JobEvents:                 p.JobEvents,
+		PipelineEvents:            p.PipelineEvents,
+		ProjectEvents:             p.ProjectEvents,
+		WikiPageEvents:            p.WikiPageEvents,
+		DeploymentEvents:          p.DeploymentEvents,
+		FeatureFlagEvents:         p.FeatureFlagEvents,
+		ReleasesEvents:            p.ReleasesEvents,
+		MilestoneEvents:           p.MilestoneEvents,
+		SubGroupEvents:            p.SubGroupEvents,
+		EmojiEvents:               p.EmojiEvents,
+		MemberEvents:              p.MemberEvents,
+		VulnerabilityEvents:       p.VulnerabilityEvents,
+		EnableSSLVerification:     p.EnableSSLVerification,
+		Token:                     token,
+		ResourceAccessTokenEvents: p.ResourceAccessTokenEvents,
+		CustomWebhookTemplate:     p.CustomWebhookTemplate,
+	}
+	if headers != nil {
+		o.CustomHeaders = &headers
+	}
+	return o
+}
+
+// GenerateEditHookOptions generates group hook edit options
+func GenerateEditHookOptions(p *v1alpha1.GroupHookParameters, token *string, headers []*gitlab.HookCustomHeader) *gitlab.EditGroupHookOptions {
+	o := &gitlab.EditGroupHookOptions{
+		URL:                       p.URL,
+		Name:                      p.Name,
+		Description:               p.Description,
+		PushEvents:                p.PushEvents,
+		PushEventsBranchFilter:    p.PushEventsBranchFilter,
+		BranchFilterStrategy:      p.BranchFilterStrategy,
+		IssuesEvents:              p.IssuesEvents,
+		ConfidentialIssuesEvents:  p.ConfidentialIssuesEvents,
+		MergeRequestsEvents:       p.MergeRequestsEvents,
+		TagPushEvents:             p.TagPushEvents,
+		NoteEvents:                p.NoteEvents,
+		ConfidentialNoteEvents:    p.ConfidentialNoteEvents,
+		JobEvents:                 p.JobEvents,
+		PipelineEvents:            p.PipelineEvents,
+		ProjectEvents:             p.ProjectEvents,
+		WikiPageEvents:            p.WikiPageEvents,
+		DeploymentEvents:          p.DeploymentEvents,
+		FeatureFlagEvents:         p.FeatureFlagEvents,
+		ReleasesEvents:            p.ReleasesEvents,
+		MilestoneEvents:           p.MilestoneEvents,
+		SubGroupEvents:            p.SubGroupEvents,
+		EmojiEvents:               p.EmojiEvents,
+		MemberEvents:              p.MemberEvents,
+		VulnerabilityEvents:       p.VulnerabilityEvents,
+		EnableSSLVerification:     p.EnableSSLVerification,
+		Token:                     token,
+		ResourceAccessTokenEvents: p.ResourceAccessTokenEvents,
+		CustomWebhookTemplate:     p.CustomWebhookTemplate,
+	}
+	if headers != nil {
+		o.CustomHeaders = &headers
+	}
+	return o
+}
+
+// IsHookUpToDate checks whether there is a change in any of the modifiable
+// fields. The token is not returned by GitLab and therefore not compared.
+func IsHookUpToDate(p *v1alpha1.GroupHookParameters, g *gitlab.GroupHook) bool { //nolint:gocyclo
+	if g == nil {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.URL, g.URL) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.Name, g.Name) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.Description, g.Description) {
+		return false
+	}
+	if !common.AreHookTriggersUpToDate(hookTriggers(p), observedHookTriggers(g)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.BranchFilterStrategy, g.BranchFilterStrategy) {
+		return false
+	}
+
+	for _, f := range []struct {
+		desired  *bool
+		observed bool
+	}{
+		{p.DeploymentEvents, g.DeploymentEvents},
+		{p.FeatureFlagEvents, g.FeatureFlagEvents},
+		{p.ReleasesEvents, g.ReleasesEvents},
+		{p.MilestoneEvents, g.MilestoneEvents},
+		{p.SubGroupEvents, g.SubGroupEvents},
+		{p.ProjectEvents, g.ProjectEvents},
+		{p.MemberEvents, g.MemberEvents},
+		{p.EmojiEvents, g.EmojiEvents},
+		{p.VulnerabilityEvents, g.VulnerabilityEvents},
+		{p.ResourceAccessTokenEvents, g.ResourceAccessTokenEvents},
+		{p.EnableSSLVerification, g.EnableSSLVerification},
+	} {
+		if !clients.IsBoolEqualToBoolPtr(f.desired, f.observed) {
+			return false
+		}
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.CustomWebhookTemplate, g.CustomWebhookTemplate) {
+		return false
+	}
+
+	return common.AreHookCustomHeaderKeysUpToDate(HookCustomHeaderKeys(p), common.HookCustomHeaderKeys(g.CustomHeaders))
+}
+
+// HookCustomHeaderKeys returns the keys of the desired custom headers of a
+// group hook.
+func HookCustomHeaderKeys(p *v1alpha1.GroupHookParameters) []string {
+	keys := make([]string, 0, len(p.CustomHeaders))
+	for _, h := range p.CustomHeaders {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+func hookTriggers(p *v1alpha1.GroupHookParameters) common.HookTriggers {
+	return common.HookTriggers{
+		PushEvents:               p.PushEvents,
+		PushEventsBranchFilter:   p.PushEventsBranchFilter,
+		IssuesEvents:             p.IssuesEvents,
+		ConfidentialIssuesEvents: p.ConfidentialIssuesEvents,
+		MergeRequestsEvents:      p.MergeRequestsEvents,
+		TagPushEvents:            p.TagPushEvents,
+		NoteEvents:               p.NoteEvents,
+		ConfidentialNoteEvents:   p.ConfidentialNoteEvents,
+		JobEvents:                p.JobEvents,
+		PipelineEvents:           p.PipelineEvents,
+		WikiPageEvents:           p.WikiPageEvents,
+	}
+}
+
+func observedHookTriggers(g *gitlab.GroupHook) common.HookTriggers {
+	return common.HookTriggers{
+		PushEvents:               &g.PushEvents,
+		PushEventsBranchFilter:   &g.PushEventsBranchFilter,
+		IssuesEvents:             &g.IssuesEvents,
+		ConfidentialIssuesEvents: &g.ConfidentialIssuesEvents,
+		MergeRequestsEvents:      &g.MergeRequestsEvents,
+		TagPushEvents:            &g.TagPushEvents,
+		NoteEvents:               &g.NoteEvents,
+		ConfidentialNoteEvents:   &g.ConfidentialNoteEvents,
+		JobEvents:                &g.JobEvents,
+		PipelineEvents:           &g.PipelineEvents,
+		WikiPageEvents:           &g.WikiPageEvents,
+	}
+}
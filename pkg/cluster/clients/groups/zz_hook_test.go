@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package groups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+)
+
+func TestLateInitializeHook(t *testing.T) {
+	hook := &gitlab.GroupHook{
+		Name:                   "ci",
+		PushEvents:             true,
+		PushEventsBranchFilter: "main",
+		SubGroupEvents:         true,
+		EnableSSLVerification:  true,
+	}
+
+	cases := map[string]struct {
+		in   *v1alpha1.GroupHookParameters
+		hook *gitlab.GroupHook
+		want *v1alpha1.GroupHookParameters
+	}{
+		"NilHook": {
+			in:   &v1alpha1.GroupHookParameters{},
+			want: &v1alpha1.GroupHookParameters{},
+		},
+		"KeepsDesired": {
+			in:   &v1alpha1.GroupHookParameters{Name: ptr.To("deploy"), SubGroupEvents: ptr.To(false)},
+			hook: hook,
+			want: &v1alpha1.GroupHookParameters{Name: ptr.To("deploy"), SubGroupEvents: ptr.To(false)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeHook(tc.in, tc.hook)
+			if tc.hook == nil {
+				if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+					t.Errorf("LateInitializeHook(...): -want, +got:\n%s", diff)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.Name, tc.in.Name); diff != "" {
+				t.Errorf("LateInitializeHook(...): -want name, +got name:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.SubGroupEvents, tc.in.SubGroupEvents); diff != "" {
+				t.Errorf("LateInitializeHook(...): -want subGroupEvents, +got subGroupEvents:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To("main"), tc.in.PushEventsBranchFilter); diff != "" {
+				t.Errorf("LateInitializeHook(...): -want branch filter, +got branch filter:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To(false), tc.in.MemberEvents); diff != "" {
+				t.Errorf("LateInitializeHook(...): -want memberEvents, +got memberEvents:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAddHookOptions(t *testing.T) {
+	token := "s3cr3t"
+	headers := []*gitlab.HookCustomHeader{{Key: "X-Auth", Value: "v"}}
+	p := &v1alpha1.GroupHookParameters{
+		URL:                  ptr.To("https://hooks.example.com"),
+		PushEvents:           ptr.To(true),
+		BranchFilterStrategy: ptr.To("regex"),
+		SubGroupEvents:       ptr.To(true),
+	}
+
+	got := GenerateAddHookOptions(p, &token, headers)
+	want := &gitlab.AddGroupHookOptions{
+		URL:                  ptr.To("https://hooks.example.com"),
+		PushEvents:           ptr.To(true),
+		BranchFilterStrategy: ptr.To("regex"),
+		SubGroupEvents:       ptr.To(true),
+		Token:                &token,
+		CustomHeaders:        &headers,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateAddHookOptions(...): -want, +got:\n%s", diff)
+	}
+
+	if got := GenerateEditHookOptions(p, nil, nil); got.Token != nil || got.CustomHeaders != nil {
+		t.Errorf("GenerateEditHookOptions(...): unexpected token or custom headers")
+	}
+}
+
+func TestIsHookUpToDate(t *testing.T) {
+	observed := &gitlab.GroupHook{
+		URL:            "https://hooks.example.com",
+		PushEvents:     true,
+		SubGroupEvents: true,
+		CustomHeaders:  []*gitlab.HookCustomHeader{{Key: "X-Auth"}},
+	}
+	header := []v1alpha1.HookCustomHeader{{Key: "X-Auth"}}
+
+	cases := map[string]struct {
+		p    *v1alpha1.GroupHookParameters
+		g    *gitlab.GroupHook
+		want bool
+	}{
+		"NilHook": {
+			p:    &v1alpha1.GroupHookParameters{},
+			want: false,
+		},
+		"UpToDate": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://hooks.example.com"), PushEvents: ptr.To(true), CustomHeaders: header},
+			g:    observed,
+			want: true,
+		},
+		"TokenIgnored": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://hooks.example.com"), CustomHeaders: header, TokenSecretRef: nil},
+			g:    observed,
+			want: true,
+		},
+		"URLChanged": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://other.example.com"), CustomHeaders: header},
+			g:    observed,
+			want: false,
+		},
+		"SharedTriggerChanged": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://hooks.example.com"), PushEvents: ptr.To(false), CustomHeaders: header},
+			g:    observed,
+			want: false,
+		},
+		"GroupTriggerChanged": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://hooks.example.com"), SubGroupEvents: ptr.To(false), CustomHeaders: header},
+			g:    observed,
+			want: false,
+		},
+		"CustomHeaderRemoved": {
+			p:    &v1alpha1.GroupHookParameters{URL: ptr.To("https://hooks.example.com")},
+			g:    observed,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsHookUpToDate(tc.p, tc.g); got != tc.want {
+				t.Errorf("IsHookUpToDate(...) = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
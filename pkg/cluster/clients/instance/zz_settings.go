@@ -48,8 +48,8 @@ func isDefaultBranchProtectionDefaultsPtrEqualToDefaultsPtr(dbpdv *v1alpha1.Defa
 	}
 	equals := clients.IsComparableEqualToComparablePtr(dbpdv.AllowForcePush, dbpdg.AllowForcePush)
 	equals = equals && clients.IsComparableEqualToComparablePtr(dbpdv.DeveloperCanInitialPush, dbpdg.DeveloperCanInitialPush)
-	equals = equals && clients.IsComparableSliceEqualToComparableSlicePtr(dbpdv.AllowedToMerge, groupAccessSliceToIntSlice(dbpdg.AllowedToMerge))
-	equals = equals && clients.IsComparableSliceEqualToComparableSlicePtr(dbpdv.AllowedToPush, groupAccessSliceToIntSlice(dbpdg.AllowedToPush))
+	equals = equals && clients.IsSameSetAsSlicePtr(dbpdv.AllowedToMerge, groupAccessSliceToIntSlice(dbpdg.AllowedToMerge))
+	equals = equals && clients.IsSameSetAsSlicePtr(dbpdv.AllowedToPush, groupAccessSliceToIntSlice(dbpdg.AllowedToPush))
 	return equals
 }
 
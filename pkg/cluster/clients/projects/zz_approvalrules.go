@@ -21,6 +21,7 @@ package projects
 import (
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
@@ -140,145 +141,33 @@ func IsApprovalRuleUpToDate(p *v1alpha1.ApprovalRuleParameters, g *gitlab.Projec
 }
 
 func isGroupIDsUpToDate(cr *v1alpha1.ApprovalRuleParameters, in *gitlab.ProjectApprovalRule) bool {
-	if cr.GroupIDs == nil {
-		return len(in.Groups) == 0
-	}
-
-	if len(*cr.GroupIDs) != len(in.Groups) {
-		return false
-	}
-
-	inIDs := make(map[int64]any)
+	ids := make([]int64, 0, len(in.Groups))
 	for _, v := range in.Groups {
-		inIDs[v.ID] = nil
-	}
-
-	crIDs := make(map[int64]any)
-	for _, v := range *cr.GroupIDs {
-		crIDs[v] = nil
+		ids = append(ids, v.ID)
 	}
-
-	for ID := range inIDs {
-		_, ok := crIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	for ID := range crIDs {
-		_, ok := inIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	return true
+	return clients.IsSameSet(ptr.Deref(cr.GroupIDs, nil), ids)
 }
 
 func isProtectedBranchesIDsUpToDate(cr *v1alpha1.ApprovalRuleParameters, in *gitlab.ProjectApprovalRule) bool {
-	if cr.ProtectedBranchIDs == nil {
-		return len(in.ProtectedBranches) == 0
-	}
-
-	if len(*cr.ProtectedBranchIDs) != len(in.ProtectedBranches) {
-		return false
-	}
-
-	inIDs := make(map[int64]any)
+	ids := make([]int64, 0, len(in.ProtectedBranches))
 	for _, v := range in.ProtectedBranches {
-		inIDs[v.ID] = nil
-	}
-
-	crIDs := make(map[int64]any)
-	for _, v := range *cr.ProtectedBranchIDs {
-		crIDs[v] = nil
+		ids = append(ids, v.ID)
 	}
-
-	for ID := range inIDs {
-		_, ok := crIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	for ID := range crIDs {
-		_, ok := inIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	return true
+	return clients.IsSameSet(ptr.Deref(cr.ProtectedBranchIDs, nil), ids)
 }
 
 func isUserIDsUpToDate(cr *v1alpha1.ApprovalRuleParameters, in *gitlab.ProjectApprovalRule) bool {
-	if cr.UserIDs == nil {
-		return len(in.Users) == 0
-	}
-
-	if len(*cr.UserIDs) != len(in.Users) {
-		return false
-	}
-
-	inIDs := make(map[int64]any)
+	ids := make([]int64, 0, len(in.Users))
 	for _, v := range in.Users {
-		inIDs[v.ID] = nil
-	}
-
-	crIDs := make(map[int64]any)
-	for _, v := range *cr.UserIDs {
-		crIDs[v] = nil
+		ids = append(ids, v.ID)
 	}
-
-	for ID := range inIDs {
-		_, ok := crIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	for ID := range crIDs {
-		_, ok := inIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	return true
+	return clients.IsSameSet(ptr.Deref(cr.UserIDs, nil), ids)
 }
 
 func isUsernamesUpToDate(cr *v1alpha1.ApprovalRuleParameters, in *gitlab.ProjectApprovalRule) bool {
-	if cr.Usernames == nil {
-		return len(in.Users) == 0
-	}
-
-	if len(*cr.Usernames) != len(in.Users) {
-		return false
-	}
-
-	inIDs := make(map[string]any)
+	names := make([]string, 0, len(in.Users))
 	for _, v := range in.Users {
-		inIDs[v.Username] = nil
-	}
-
-	crIDs := make(map[string]any)
-	for _, v := range *cr.Usernames {
-		crIDs[v] = nil
+		names = append(names, v.Username)
 	}
-
-	for ID := range inIDs {
-		_, ok := crIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	for ID := range crIDs {
-		_, ok := inIDs[ID]
-		if !ok {
-			return false
-		}
-	}
-
-	return true
+	return clients.IsSameSet(ptr.Deref(cr.Usernames, nil), names)
 }
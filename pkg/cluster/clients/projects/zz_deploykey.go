@@ -19,13 +19,49 @@ limitations under the License.
 package projects
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
+const errInvalidDeployKey = "deploy key is not in the OpenSSH authorized_keys format"
+
 // DeployKeyClient is an interface for gitlab DeployKeyClient
 type DeployKeyClient interface {
 	AddDeployKey(pid interface{}, opt *gitlab.AddDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 	DeleteDeployKey(pid interface{}, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	UpdateDeployKey(pid interface{}, deployKey int64, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 	GetDeployKey(pid interface{}, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	ListProjectDeployKeys(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	EnableDeployKey(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+}
+
+// DeployKeyFingerprintSHA256 returns the SHA256 fingerprint of a public key
+// in the OpenSSH authorized_keys format, e.g. "ssh-ed25519 AAAA... comment",
+// as GitLab reports it in fingerprint_sha256.
+func DeployKeyFingerprintSHA256(key string) (string, error) {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return "", errors.New(errInvalidDeployKey)
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", errors.Wrap(err, errInvalidDeployKey)
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// FindDeployKey returns the deploy key with the given ID or, if the ID is
+// zero, the given SHA256 fingerprint. It returns nil if there is no such key.
+func FindDeployKey(keys []*gitlab.ProjectDeployKey, id int64, fingerprint string) *gitlab.ProjectDeployKey {
+	for _, k := range keys {
+		if id != 0 && k.ID == id || id == 0 && fingerprint != "" && k.FingerprintSHA256 == fingerprint {
+			return k
+		}
+	}
+	return nil
 }
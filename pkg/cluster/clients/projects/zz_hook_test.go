@@ -215,7 +215,7 @@ func TestGenerateCreateHookOptions(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := GenerateCreateHookOptions(tc.args.parameters, &tokenValue)
+			got := GenerateCreateHookOptions(tc.args.parameters, &tokenValue, nil)
 
 			if diff := cmp.Diff(tc.want.addProjectHookOptions, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
@@ -270,7 +270,7 @@ func TestGenerateEditHookOptions(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := GenerateEditHookOptions(tc.args.parameters, &tokenValue)
+			got := GenerateEditHookOptions(tc.args.parameters, &tokenValue, nil)
 
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
@@ -360,6 +360,35 @@ func TestIsHookUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"CustomHeaderKeysInAnyOrder": {
+			args: args{
+				p: &v1alpha1.HookParameters{
+					CustomHeaders: []v1alpha1.HookCustomHeader{{Key: "X-B"}, {Key: "X-A"}},
+				},
+				projecthook: &gitlab.ProjectHook{
+					CustomHeaders: []*gitlab.HookCustomHeader{{Key: "X-A"}, {Key: "X-B"}},
+				},
+			},
+			want: true,
+		},
+		"CustomHeaderRemoved": {
+			args: args{
+				p: &v1alpha1.HookParameters{},
+				projecthook: &gitlab.ProjectHook{
+					CustomHeaders: []*gitlab.HookCustomHeader{{Key: "X-A"}},
+				},
+			},
+			want: false,
+		},
+		"CustomWebhookTemplateChanged": {
+			args: args{
+				p: &v1alpha1.HookParameters{
+					CustomWebhookTemplate: gitlab.Ptr(`{"event":"{{object_kind}}"}`),
+				},
+				projecthook: &gitlab.ProjectHook{},
+			},
+			want: false,
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -371,3 +400,27 @@ func TestIsHookUpToDate(t *testing.T) {
 	}
 
 }
+
+func TestFindHookByURL(t *testing.T) {
+	hooks := []*gitlab.ProjectHook{
+		{ID: 3, URL: "https://example.com/b"},
+		{ID: 2, URL: "https://example.com/a"},
+		{ID: 1, URL: "https://example.com/b"},
+	}
+	cases := map[string]struct {
+		url  string
+		want *gitlab.ProjectHook
+	}{
+		"Found":          {url: "https://example.com/a", want: hooks[1]},
+		"LowestIDOfMany": {url: "https://example.com/b", want: hooks[2]},
+		"NotFound":       {url: "https://example.com/c"},
+		"NoPartialMatch": {url: "https://example.com"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindHookByURL(hooks, tc.url)); diff != "" {
+				t.Errorf("FindHookByURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 var (
@@ -107,6 +108,7 @@ var (
 	importURL                                 = "import.url"
 	publicBuilds                              = false
 	allowMergeOnSkippedPipeline               = false
+	allowPipelineTriggerApproveDeployment     = true
 	onlyAllowMergeIfPipelineSucceeds          = true
 	OnlyAllowMergeIfAllDiscussionsAreResolved = true
 	mergeMethod                               = "merge"
@@ -123,6 +125,8 @@ var (
 	ciConfigPath                              = "path/to/ci/config"
 	ciForwardDeploymentEnabled                = false
 	ciDefaultGitDepth                         = int64(50)
+	ciDeletePipelinesInSeconds                = int64(86400)
+	keepLatestArtifact                        = true
 	autoDevopsEnabled                         = true
 	autoDevopsDeployStrategy                  = "continuous"
 	externalAuthorizationClassificationLabel  = "authz-label"
@@ -225,6 +229,7 @@ func TestGenerateObservation(t *testing.T) {
 		JobArtifactsSize: 40,
 	}
 	projectStatisticsCommitCount := int64(0)
+	pipelineArtifactsSize := int64(50)
 	linksSelf := "selflink"
 	customAttributesKey := "customAttrKey"
 	customAttributesValue := "customAttrValue"
@@ -291,11 +296,12 @@ func TestGenerateObservation(t *testing.T) {
 					ServiceDeskAddress: serviceDeskAddress,
 					SharedWithGroups:   sharedWithGroups,
 					Statistics: &gitlab.Statistics{
-						StorageSize:      storageStatistics.StorageSize,
-						RepositorySize:   storageStatistics.RepositorySize,
-						LFSObjectsSize:   storageStatistics.LFSObjectsSize,
-						JobArtifactsSize: storageStatistics.JobArtifactsSize,
-						CommitCount:      projectStatisticsCommitCount,
+						StorageSize:           storageStatistics.StorageSize,
+						RepositorySize:        storageStatistics.RepositorySize,
+						LFSObjectsSize:        storageStatistics.LFSObjectsSize,
+						JobArtifactsSize:      storageStatistics.JobArtifactsSize,
+						PipelineArtifactsSize: pipelineArtifactsSize,
+						CommitCount:           projectStatisticsCommitCount,
 					},
 					Links: &gitlab.Links{
 						Self: linksSelf,
@@ -368,7 +374,8 @@ func TestGenerateObservation(t *testing.T) {
 						LfsObjectsSize:   storageStatistics.LFSObjectsSize,
 						JobArtifactsSize: storageStatistics.JobArtifactsSize,
 					},
-					CommitCount: projectStatisticsCommitCount,
+					CommitCount:           projectStatisticsCommitCount,
+					PipelineArtifactsSize: pipelineArtifactsSize,
 				},
 				Links: &v1alpha1.Links{
 					Self: linksSelf,
@@ -442,59 +449,59 @@ func TestGenerateCreateProjectOptions(t *testing.T) {
 			args: args{
 				name: name,
 				parameters: &v1alpha1.ProjectParameters{
-					Path:                                      &path,
-					NamespaceID:                               &namespaceID,
-					DefaultBranch:                             &defaultBranch,
-					Description:                               &description,
-					IssuesAccessLevel:                         &issuesAccessLevelv1alpha1,
-					RepositoryAccessLevel:                     &repositoryAccessLevelv1alpha1,
-					MergeRequestsAccessLevel:                  &mergeRequestsAccessLevelv1alpha1,
-					ForkingAccessLevel:                        &forkingAccessLevelv1alpha1,
-					BuildsAccessLevel:                         &buildsAccessLevelv1alpha1,
-					WikiAccessLevel:                           &wikiAccessLevelv1alpha1,
-					SnippetsAccessLevel:                       &snippetsAccessLevelv1alpha1,
-					PagesAccessLevel:                          &pagesAccessLevelv1alpha1,
-					OperationsAccessLevel:                     &operationsAccessLevelv1alpha1,
-					EmailsDisabled:                            &emailsDisabled,
-					ResolveOutdatedDiffDiscussions:            &resolveOutdatedDiffDiscussions,
-					ContainerExpirationPolicyAttributes:       &v1alpha1ContainerExpirationPolicyAttributes,
-					ContainerRegistryAccessLevel:              &containerRegistryAccessLevelv1alpha1,
-					SharedRunnersEnabled:                      &sharedRunnersEnabled,
-					Visibility:                                &visibilityv1alpha1,
-					ImportURL:                                 &importURL,
-					PublicBuilds:                              &publicBuilds,
-					AllowMergeOnSkippedPipeline:               &allowMergeOnSkippedPipeline,
-					OnlyAllowMergeIfPipelineSucceeds:          &onlyAllowMergeIfPipelineSucceeds,
+					Path:                                &path,
+					NamespaceID:                         &namespaceID,
+					DefaultBranch:                       &defaultBranch,
+					Description:                         &description,
+					IssuesAccessLevel:                   &issuesAccessLevelv1alpha1,
+					RepositoryAccessLevel:               &repositoryAccessLevelv1alpha1,
+					MergeRequestsAccessLevel:            &mergeRequestsAccessLevelv1alpha1,
+					ForkingAccessLevel:                  &forkingAccessLevelv1alpha1,
+					BuildsAccessLevel:                   &buildsAccessLevelv1alpha1,
+					WikiAccessLevel:                     &wikiAccessLevelv1alpha1,
+					SnippetsAccessLevel:                 &snippetsAccessLevelv1alpha1,
+					PagesAccessLevel:                    &pagesAccessLevelv1alpha1,
+					OperationsAccessLevel:               &operationsAccessLevelv1alpha1,
+					EmailsDisabled:                      &emailsDisabled,
+					ResolveOutdatedDiffDiscussions:      &resolveOutdatedDiffDiscussions,
+					ContainerExpirationPolicyAttributes: &v1alpha1ContainerExpirationPolicyAttributes,
+					ContainerRegistryAccessLevel:        &containerRegistryAccessLevelv1alpha1,
+					SharedRunnersEnabled:                &sharedRunnersEnabled,
+					Visibility:                          &visibilityv1alpha1,
+					ImportURL:                           &importURL,
+					PublicBuilds:                        &publicBuilds,
+					AllowMergeOnSkippedPipeline:         &allowMergeOnSkippedPipeline,
+					OnlyAllowMergeIfPipelineSucceeds:    &onlyAllowMergeIfPipelineSucceeds,
 					OnlyAllowMergeIfAllDiscussionsAreResolved: &OnlyAllowMergeIfAllDiscussionsAreResolved,
-					MergeMethod:                               &mergeMethodv1alpha1,
-					RemoveSourceBranchAfterMerge:              &removeSourceBranchAfterMerge,
-					LFSEnabled:                                &lfsEnabled,
-					RequestAccessEnabled:                      &requestAccessEnabled,
-					Topics:                                    topics,
-					PrintingMergeRequestLinkEnabled:           &printingMergeRequestLinkEnabled,
-					BuildGitStrategy:                          &buildGitStategy,
-					BuildTimeout:                              &buildTimeout,
-					AutoCancelPendingPipelines:                &autoCancelPendingPipelines,
-					BuildCoverageRegex:                        &buildCoverageRegex,
-					CIConfigPath:                              &ciConfigPath,
-					CIForwardDeploymentEnabled:                &ciForwardDeploymentEnabled,
-					CIDefaultGitDepth:                         &ciDefaultGitDepth,
-					AutoDevopsEnabled:                         &autoDevopsEnabled,
-					AutoDevopsDeployStrategy:                  &autoDevopsDeployStrategy,
-					ExternalAuthorizationClassificationLabel:  &externalAuthorizationClassificationLabel,
-					Mirror:                                    &mirror,
-					MirrorTriggerBuilds:                       &mirrorTriggerBuilds,
-					InitializeWithReadme:                      &initializeWithReadme,
-					TemplateName:                              &templateName,
-					TemplateProjectID:                         &templateProjectID,
-					UseCustomTemplate:                         &useCustomTemplate,
-					GroupWithProjectTemplatesID:               &groupWithProjectTemplatesID,
-					PackagesEnabled:                           &packagesEnabled,
-					ServiceDeskEnabled:                        &serviceDeskEnabled,
-					AutocloseReferencedIssues:                 &autocloseReferencedIssues,
-					SuggestionCommitMessage:                   &suggestionCommitMessage,
-					IssuesTemplate:                            &issuesTemplate,
-					MergeRequestsTemplate:                     &mergeRequestsTemplate,
+					MergeMethod:                              &mergeMethodv1alpha1,
+					RemoveSourceBranchAfterMerge:             &removeSourceBranchAfterMerge,
+					LFSEnabled:                               &lfsEnabled,
+					RequestAccessEnabled:                     &requestAccessEnabled,
+					Topics:                                   topics,
+					PrintingMergeRequestLinkEnabled:          &printingMergeRequestLinkEnabled,
+					BuildGitStrategy:                         &buildGitStategy,
+					BuildTimeout:                             &buildTimeout,
+					AutoCancelPendingPipelines:               &autoCancelPendingPipelines,
+					BuildCoverageRegex:                       &buildCoverageRegex,
+					CIConfigPath:                             &ciConfigPath,
+					CIForwardDeploymentEnabled:               &ciForwardDeploymentEnabled,
+					CIDefaultGitDepth:                        &ciDefaultGitDepth,
+					AutoDevopsEnabled:                        &autoDevopsEnabled,
+					AutoDevopsDeployStrategy:                 &autoDevopsDeployStrategy,
+					ExternalAuthorizationClassificationLabel: &externalAuthorizationClassificationLabel,
+					Mirror:                                   &mirror,
+					MirrorTriggerBuilds:                      &mirrorTriggerBuilds,
+					InitializeWithReadme:                     &initializeWithReadme,
+					TemplateName:                             &templateName,
+					TemplateProjectID:                        &templateProjectID,
+					UseCustomTemplate:                        &useCustomTemplate,
+					GroupWithProjectTemplatesID:              &groupWithProjectTemplatesID,
+					PackagesEnabled:                          &packagesEnabled,
+					ServiceDeskEnabled:                       &serviceDeskEnabled,
+					AutocloseReferencedIssues:                &autocloseReferencedIssues,
+					SuggestionCommitMessage:                  &suggestionCommitMessage,
+					IssuesTemplate:                           &issuesTemplate,
+					MergeRequestsTemplate:                    &mergeRequestsTemplate,
 				},
 			},
 			want: &gitlab.CreateProjectOptions{
@@ -512,6 +519,7 @@ func TestGenerateCreateProjectOptions(t *testing.T) {
 				SnippetsAccessLevel:                 clients.AccessControlValueStringToGitlab(snippetsAccessLevel),
 				PagesAccessLevel:                    clients.AccessControlValueStringToGitlab(pagesAccessLevel),
 				OperationsAccessLevel:               clients.AccessControlValueStringToGitlab(operationsAccessLevel),
+				EmailsEnabled:                       gitlab.Ptr(!emailsDisabled),
 				EmailsDisabled:                      &emailsDisabled,
 				ResolveOutdatedDiffDiscussions:      &resolveOutdatedDiffDiscussions,
 				ContainerExpirationPolicyAttributes: &gitlabContainerExpirationPolicyAttributes,
@@ -639,6 +647,7 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 					ImportURL:                                 &importURL,
 					PublicBuilds:                              &publicBuilds,
 					AllowMergeOnSkippedPipeline:               &allowMergeOnSkippedPipeline,
+					AllowPipelineTriggerApproveDeployment:     &allowPipelineTriggerApproveDeployment,
 					OnlyAllowMergeIfPipelineSucceeds:          &onlyAllowMergeIfPipelineSucceeds,
 					OnlyAllowMergeIfAllDiscussionsAreResolved: &OnlyAllowMergeIfAllDiscussionsAreResolved,
 					MergeMethod:                               &mergeMethodv1alpha1,
@@ -652,6 +661,8 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 					BuildCoverageRegex:                        &buildCoverageRegex,
 					CIConfigPath:                              &ciConfigPath,
 					CIForwardDeploymentEnabled:                &ciForwardDeploymentEnabled,
+					CIPipelineVariablesMinimumOverrideRole:    ptr.To("maintainer"),
+					RestrictUserDefinedVariables:              ptr.To(true),
 					CIDefaultGitDepth:                         &ciDefaultGitDepth,
 					AutoDevopsEnabled:                         &autoDevopsEnabled,
 					AutoDevopsDeployStrategy:                  &autoDevopsDeployStrategy,
@@ -666,33 +677,37 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 					AutocloseReferencedIssues:                 &autocloseReferencedIssues,
 					SuggestionCommitMessage:                   &suggestionCommitMessage,
 					IssuesTemplate:                            &issuesTemplate,
+					KeepLatestArtifact:                        &keepLatestArtifact,
+					CIDeletePipelinesInSeconds:                &ciDeletePipelinesInSeconds,
 					MergeRequestsTemplate:                     &mergeRequestsTemplate,
 				},
 			},
 			want: &gitlab.EditProjectOptions{
-				Name:                                &name,
-				Path:                                &path,
-				DefaultBranch:                       &defaultBranch,
-				Description:                         &description,
-				IssuesAccessLevel:                   clients.AccessControlValueStringToGitlab(issuesAccessLevel),
-				RepositoryAccessLevel:               clients.AccessControlValueStringToGitlab(repositoryAccessLevel),
-				MergeRequestsAccessLevel:            clients.AccessControlValueStringToGitlab(mergeRequestsAccessLevel),
-				ForkingAccessLevel:                  clients.AccessControlValueStringToGitlab(forkingAccessLevel),
-				BuildsAccessLevel:                   clients.AccessControlValueStringToGitlab(buildsAccessLevel),
-				WikiAccessLevel:                     clients.AccessControlValueStringToGitlab(wikiAccessLevel),
-				SnippetsAccessLevel:                 clients.AccessControlValueStringToGitlab(snippetsAccessLevel),
-				OperationsAccessLevel:               clients.AccessControlValueStringToGitlab(operationsAccessLevel),
-				EmailsDisabled:                      &emailsDisabled,
-				PagesAccessLevel:                    clients.AccessControlValueStringToGitlab(pagesAccessLevel),
-				ResolveOutdatedDiffDiscussions:      &resolveOutdatedDiffDiscussions,
-				ContainerExpirationPolicyAttributes: &gitlabContainerExpirationPolicyAttributes,
-				ContainerRegistryAccessLevel:        clients.AccessControlValueStringToGitlab(containerRegistryAccessLevel),
-				SharedRunnersEnabled:                &sharedRunnersEnabled,
-				Visibility:                          clients.VisibilityValueStringToGitlab(visibility),
-				ImportURL:                           &importURL,
-				PublicJobs:                          &publicBuilds,
-				AllowMergeOnSkippedPipeline:         &allowMergeOnSkippedPipeline,
-				OnlyAllowMergeIfPipelineSucceeds:    &onlyAllowMergeIfPipelineSucceeds,
+				Name:                                  &name,
+				Path:                                  &path,
+				DefaultBranch:                         &defaultBranch,
+				Description:                           &description,
+				IssuesAccessLevel:                     clients.AccessControlValueStringToGitlab(issuesAccessLevel),
+				RepositoryAccessLevel:                 clients.AccessControlValueStringToGitlab(repositoryAccessLevel),
+				MergeRequestsAccessLevel:              clients.AccessControlValueStringToGitlab(mergeRequestsAccessLevel),
+				ForkingAccessLevel:                    clients.AccessControlValueStringToGitlab(forkingAccessLevel),
+				BuildsAccessLevel:                     clients.AccessControlValueStringToGitlab(buildsAccessLevel),
+				WikiAccessLevel:                       clients.AccessControlValueStringToGitlab(wikiAccessLevel),
+				SnippetsAccessLevel:                   clients.AccessControlValueStringToGitlab(snippetsAccessLevel),
+				OperationsAccessLevel:                 clients.AccessControlValueStringToGitlab(operationsAccessLevel),
+				EmailsEnabled:                         gitlab.Ptr(!emailsDisabled),
+				EmailsDisabled:                        &emailsDisabled,
+				PagesAccessLevel:                      clients.AccessControlValueStringToGitlab(pagesAccessLevel),
+				ResolveOutdatedDiffDiscussions:        &resolveOutdatedDiffDiscussions,
+				ContainerExpirationPolicyAttributes:   &gitlabContainerExpirationPolicyAttributes,
+				ContainerRegistryAccessLevel:          clients.AccessControlValueStringToGitlab(containerRegistryAccessLevel),
+				SharedRunnersEnabled:                  &sharedRunnersEnabled,
+				Visibility:                            clients.VisibilityValueStringToGitlab(visibility),
+				ImportURL:                             &importURL,
+				PublicJobs:                            &publicBuilds,
+				AllowMergeOnSkippedPipeline:           &allowMergeOnSkippedPipeline,
+				AllowPipelineTriggerApproveDeployment: &allowPipelineTriggerApproveDeployment,
+				OnlyAllowMergeIfPipelineSucceeds:      &onlyAllowMergeIfPipelineSucceeds,
 				OnlyAllowMergeIfAllDiscussionsAreResolved: &OnlyAllowMergeIfAllDiscussionsAreResolved,
 				MergeMethod:                              clients.MergeMethodStringToGitlab(mergeMethod),
 				RemoveSourceBranchAfterMerge:             &removeSourceBranchAfterMerge,
@@ -706,6 +721,8 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 				BuildCoverageRegex:                       &buildCoverageRegex,
 				CIConfigPath:                             &ciConfigPath,
 				CIForwardDeploymentEnabled:               &ciForwardDeploymentEnabled,
+				CIPipelineVariablesMinimumOverrideRole:   ptr.To("maintainer"),
+				RestrictUserDefinedVariables:             ptr.To(true),
 				CIDefaultGitDepth:                        &ciDefaultGitDepth,
 				AutoDevopsEnabled:                        &autoDevopsEnabled,
 				AutoDevopsDeployStrategy:                 &autoDevopsDeployStrategy,
@@ -720,6 +737,8 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 				AutocloseReferencedIssues:                &autocloseReferencedIssues,
 				SuggestionCommitMessage:                  &suggestionCommitMessage,
 				IssuesTemplate:                           &issuesTemplate,
+				KeepLatestArtifact:                       &keepLatestArtifact,
+				CIDeletePipelinesInSeconds:               &ciDeletePipelinesInSeconds,
 				MergeRequestsTemplate:                    &mergeRequestsTemplate,
 			},
 		},
@@ -773,3 +792,198 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateFeatureAccessLevelOptions(t *testing.T) {
+	p := &v1alpha1.ProjectParameters{
+		ReleasesAccessLevel:              ptr.To(v1alpha1.PrivateAccessControl),
+		SecurityAndComplianceAccessLevel: ptr.To(v1alpha1.DisabledAccessControl),
+	}
+
+	create := GenerateCreateProjectOptions("project", p)
+	if diff := cmp.Diff(ptr.To(gitlab.PrivateAccessControl), create.ReleasesAccessLevel); diff != "" {
+		t.Errorf("GenerateCreateProjectOptions(...): -want releasesAccessLevel, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(gitlab.DisabledAccessControl), create.SecurityAndComplianceAccessLevel); diff != "" {
+		t.Errorf("GenerateCreateProjectOptions(...): -want securityAndComplianceAccessLevel, +got:\n%s", diff)
+	}
+	if create.MonitorAccessLevel != nil {
+		t.Errorf("GenerateCreateProjectOptions(...): want unset access levels to be omitted")
+	}
+
+	edit := GenerateEditProjectOptions("project", p)
+	if diff := cmp.Diff(ptr.To(gitlab.PrivateAccessControl), edit.ReleasesAccessLevel); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want releasesAccessLevel, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(gitlab.DisabledAccessControl), edit.SecurityAndComplianceAccessLevel); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want securityAndComplianceAccessLevel, +got:\n%s", diff)
+	}
+	if edit.MonitorAccessLevel != nil {
+		t.Errorf("GenerateEditProjectOptions(...): want unset access levels to be omitted")
+	}
+}
+
+func TestTransferNamespaceID(t *testing.T) {
+	prj := &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: 7}}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectParameters
+		want *int64
+	}{
+		"NotAllowed": {
+			p: &v1alpha1.ProjectParameters{NamespaceID: ptr.To(int64(8))},
+		},
+		"SameNamespace": {
+			p: &v1alpha1.ProjectParameters{NamespaceID: ptr.To(int64(7)), AllowTransfer: ptr.To(true)},
+		},
+		"NoNamespaceID": {
+			p: &v1alpha1.ProjectParameters{AllowTransfer: ptr.To(true)},
+		},
+		"OtherNamespace": {
+			p:    &v1alpha1.ProjectParameters{NamespaceID: ptr.To(int64(8)), AllowTransfer: ptr.To(true)},
+			want: ptr.To(int64(8)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TransferNamespaceID(tc.p, prj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TransferNamespaceID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOmitUnsupportedProjectParameters(t *testing.T) {
+	cases := map[string]struct {
+		v       *common.ServerVersion
+		want    *v1alpha1.ProjectParameters
+		omitted []string
+	}{
+		"UnknownVersion": {
+			want: &v1alpha1.ProjectParameters{CIPipelineVariablesMinimumOverrideRole: ptr.To("owner"), RestrictUserDefinedVariables: ptr.To(true)},
+		},
+		"Supported": {
+			v:    &common.ServerVersion{Major: 17, Minor: 1},
+			want: &v1alpha1.ProjectParameters{CIPipelineVariablesMinimumOverrideRole: ptr.To("owner"), RestrictUserDefinedVariables: ptr.To(true)},
+		},
+		"TooOld": {
+			v:       &common.ServerVersion{Major: 17, Minor: 0},
+			want:    &v1alpha1.ProjectParameters{RestrictUserDefinedVariables: ptr.To(true)},
+			omitted: []string{"ciPipelineVariablesMinimumOverrideRole"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &v1alpha1.ProjectParameters{CIPipelineVariablesMinimumOverrideRole: ptr.To("owner"), RestrictUserDefinedVariables: ptr.To(true)}
+			omitted := OmitUnsupportedProjectParameters(p, tc.v)
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("OmitUnsupportedProjectParameters(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.omitted, omitted); diff != "" {
+				t.Errorf("OmitUnsupportedProjectParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAtExpectedPath(t *testing.T) {
+	o := &v1alpha1.ProjectObservation{
+		PathWithNamespace: "my-group/sub/my-project",
+		Namespace:         &v1alpha1.ProjectNamespace{ID: 7, FullPath: "my-group/sub"},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectParameters
+		want bool
+	}{
+		"NothingToCompare": {
+			p:    &v1alpha1.ProjectParameters{},
+			want: true,
+		},
+		"Matches": {
+			p:    &v1alpha1.ProjectParameters{Path: ptr.To("my-project"), NamespacePath: ptr.To("my-group/sub"), NamespaceID: ptr.To(int64(7))},
+			want: true,
+		},
+		"OtherPath": {
+			p: &v1alpha1.ProjectParameters{Path: ptr.To("other-project")},
+		},
+		"OtherNamespacePath": {
+			p: &v1alpha1.ProjectParameters{Path: ptr.To("my-project"), NamespacePath: ptr.To("my-group")},
+		},
+		"OtherNamespaceID": {
+			p: &v1alpha1.ProjectParameters{Path: ptr.To("my-project"), NamespaceID: ptr.To(int64(8))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsAtExpectedPath(tc.p, o); got != tc.want {
+				t.Errorf("IsAtExpectedPath(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsVerifiedProject(t *testing.T) {
+	p := &v1alpha1.ProjectParameters{Path: ptr.To("my-project"), NamespacePath: ptr.To("my-group")}
+
+	cases := map[string]struct {
+		o    *v1alpha1.ProjectObservation
+		want bool
+	}{
+		"VerifiedProject": {
+			o:    &v1alpha1.ProjectObservation{ID: 1, VerifiedID: 1, PathWithNamespace: "my-group/my-project"},
+			want: true,
+		},
+		"VerifiedProjectTransferred": {
+			o:    &v1alpha1.ProjectObservation{ID: 1, VerifiedID: 1, PathWithNamespace: "other-group/my-project"},
+			want: true,
+		},
+		"OtherProjectAtExpectedPath": {
+			o: &v1alpha1.ProjectObservation{ID: 2, VerifiedID: 1, PathWithNamespace: "my-group/my-project"},
+		},
+		"NotVerifiedAtExpectedPath": {
+			o:    &v1alpha1.ProjectObservation{ID: 1, PathWithNamespace: "my-group/my-project"},
+			want: true,
+		},
+		"NotVerifiedAtOtherPath": {
+			o: &v1alpha1.ProjectObservation{ID: 1, PathWithNamespace: "other-group/my-project"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsVerifiedProject(p, tc.o); got != tc.want {
+				t.Errorf("IsVerifiedProject(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCIConfigProject(t *testing.T) {
+	type want struct {
+		project string
+		ok      bool
+	}
+	cases := map[string]struct {
+		ciConfigPath string
+		want         want
+	}{
+		"Empty":          {ciConfigPath: "", want: want{}},
+		"LocalPath":      {ciConfigPath: "path/to/ci.yml", want: want{}},
+		"ExternalPath":   {ciConfigPath: "path/to/ci.yml@group/shared-repo", want: want{project: "group/shared-repo", ok: true}},
+		"ExternalRef":    {ciConfigPath: "path/to/ci.yml@group/sub/shared-repo:v1.2", want: want{project: "group/sub/shared-repo", ok: true}},
+		"MissingProject": {ciConfigPath: "path/to/ci.yml@", want: want{}},
+		"RemoteURL":      {ciConfigPath: "https://user@example.com/ci.yml", want: want{}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			project, ok := CIConfigProject(tc.ciConfigPath)
+			if diff := cmp.Diff(tc.want, want{project: project, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("CIConfigProject(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
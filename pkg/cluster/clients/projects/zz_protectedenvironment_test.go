@@ -3,9 +3,11 @@
 package projects
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"k8s.io/utils/ptr"
 
@@ -442,3 +444,241 @@ func TestFilterRuleSpecs_StableOutput(t *testing.T) {
 	}
 
 }
+
+func TestLateInitializeProtectedEnvironment_AdoptsInheritanceDefaults(t *testing.T) {
+
+	in := &v1alpha1.ProtectedEnvironmentParameters{
+		DeployAccessLevels: &[]v1alpha1.EnvironmentAccessLevelParameters{
+			{AccessLevel: ptr.To(40)},
+			{GroupID: ptr.To(int64(7))},
+		},
+		ApprovalRules: &[]v1alpha1.EnvironmentApprovalRuleParameters{
+			{GroupID: ptr.To(int64(7))},
+		},
+	}
+
+	pe := &gitlab.ProtectedEnvironment{
+		DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{
+			{ID: 1, GroupID: 7, GroupInheritanceType: 1},
+			{ID: 2, AccessLevel: 40},
+		},
+		ApprovalRules: []*gitlab.EnvironmentApprovalRule{
+			{ID: 3, GroupID: 7, RequiredApprovalCount: 1, GroupInheritanceType: 1},
+		},
+	}
+
+	LateInitializeProtectedEnvironment(in, pe)
+
+	want := &v1alpha1.ProtectedEnvironmentParameters{
+		DeployAccessLevels: &[]v1alpha1.EnvironmentAccessLevelParameters{
+			{AccessLevel: ptr.To(40)},
+			{GroupID: ptr.To(int64(7)), GroupInheritanceType: ptr.To(int64(1))},
+		},
+		ApprovalRules: &[]v1alpha1.EnvironmentApprovalRuleParameters{
+			{GroupID: ptr.To(int64(7)), RequiredApprovals: ptr.To(int64(1)), GroupInheritanceType: ptr.To(int64(1))},
+		},
+	}
+
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Fatalf("-want, +got:\n%s", diff)
+	}
+
+	if !IsProtectedEnvironmentUpToDate(in, pe) {
+		t.Fatalf("expected late-initialized spec to be up to date")
+	}
+
+	if u := GenerateUpdateProtectedEnvironmentsOptions(in, pe); u != nil {
+		t.Fatalf("expected no update after late-init, got %#v", u)
+	}
+
+}
+
+func TestBuildDeployAccessLevelsDelta_UpdateInheritanceByID(t *testing.T) {
+
+	spec := []v1alpha1.EnvironmentAccessLevelParameters{
+		{GroupID: ptr.To(int64(7)), GroupInheritanceType: ptr.To(int64(1))},
+	}
+
+	got := []*gitlab.EnvironmentAccessDescription{
+		{ID: 10, GroupID: 7, GroupInheritanceType: 0},
+	}
+
+	out := buildDeployAccessLevelsDelta(&spec, got)
+
+	want := []*gitlab.UpdateEnvironmentAccessOptions{
+		{ID: ptr.To(int64(10)), GroupID: ptr.To(int64(7)), GroupInheritanceType: ptr.To(int64(1))},
+	}
+
+	if diff := cmp.Diff(want, out); diff != "" {
+		t.Fatalf("-want, +got:\n%s", diff)
+	}
+
+}
+
+func TestBuildApprovalRulesDelta_UpdateInheritanceByID(t *testing.T) {
+
+	spec := []v1alpha1.EnvironmentApprovalRuleParameters{
+		{GroupID: ptr.To(int64(7)), RequiredApprovals: ptr.To(int64(1)), GroupInheritanceType: ptr.To(int64(1))},
+	}
+
+	got := []*gitlab.EnvironmentApprovalRule{
+		{ID: 10, GroupID: 7, RequiredApprovalCount: 1, GroupInheritanceType: 0},
+	}
+
+	out := buildApprovalRulesDelta(&spec, got)
+
+	want := []*gitlab.UpdateEnvironmentApprovalRuleOptions{
+		{ID: ptr.To(int64(10)), GroupID: ptr.To(int64(7)), RequiredApprovalCount: ptr.To(int64(1)), GroupInheritanceType: ptr.To(int64(1))},
+	}
+
+	if diff := cmp.Diff(want, out); diff != "" {
+		t.Fatalf("-want, +got:\n%s", diff)
+	}
+
+}
+
+func TestIsSelfApprovalUpToDate(t *testing.T) {
+
+	cases := map[string]struct {
+		spec *bool
+		prj  *gitlab.Project
+		want bool
+	}{
+		"Unmanaged":          {spec: nil, prj: &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, want: true},
+		"PreventedMatches":   {spec: ptr.To(true), prj: &gitlab.Project{AllowPipelineTriggerApproveDeployment: false}, want: true},
+		"PreventedDiffers":   {spec: ptr.To(true), prj: &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, want: false},
+		"AllowedMatches":     {spec: ptr.To(false), prj: &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, want: true},
+		"AllowedDiffers":     {spec: ptr.To(false), prj: &gitlab.Project{AllowPipelineTriggerApproveDeployment: false}, want: false},
+		"ManagedNoneProject": {spec: ptr.To(true), prj: nil, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &v1alpha1.ProtectedEnvironmentParameters{PreventSelfApproval: tc.spec}
+			if got := IsSelfApprovalUpToDate(p, tc.prj); got != tc.want {
+				t.Errorf("IsSelfApprovalUpToDate(...) = %t, want %t", got, tc.want)
+			}
+		})
+	}
+
+}
+
+func TestGenerateSelfApprovalOptions(t *testing.T) {
+
+	cases := map[string]struct {
+		spec *bool
+		want *gitlab.EditProjectOptions
+	}{
+		"Unmanaged": {spec: nil, want: nil},
+		"Prevent":   {spec: ptr.To(true), want: &gitlab.EditProjectOptions{AllowPipelineTriggerApproveDeployment: ptr.To(false)}},
+		"Allow":     {spec: ptr.To(false), want: &gitlab.EditProjectOptions{AllowPipelineTriggerApproveDeployment: ptr.To(true)}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSelfApprovalOptions(&v1alpha1.ProtectedEnvironmentParameters{PreventSelfApproval: tc.spec})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+
+}
+
+type fakeNamespaceClient map[string]*gitlab.Namespace
+
+func (c fakeNamespaceClient) GetNamespace(id any, _ ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+	ns, ok := c[id.(string)]
+	if !ok {
+		return nil, &gitlab.Response{}, errors.New("404 Namespace Not Found")
+	}
+	return ns, &gitlab.Response{}, nil
+}
+
+func TestResolveGroupPaths_ResolvesAccessLevelsAndRules(t *testing.T) {
+
+	c := fakeNamespaceClient{
+		"platform":         {ID: 7, Kind: "group"},
+		"platform/release": {ID: 8, Kind: "group"},
+		"alice":            {ID: 9, Kind: "user"},
+	}
+
+	in := &v1alpha1.ProtectedEnvironmentParameters{
+		DeployAccessLevels: &[]v1alpha1.EnvironmentAccessLevelParameters{
+			{AccessLevel: ptr.To(40)},
+			{GroupPath: ptr.To("platform"), GroupInheritanceType: ptr.To(int64(1))},
+			// The path takes precedence over a stale ID.
+			{GroupPath: ptr.To("platform/release"), GroupID: ptr.To(int64(3))},
+		},
+		ApprovalRules: &[]v1alpha1.EnvironmentApprovalRuleParameters{
+			{GroupPath: ptr.To("platform/release"), RequiredApprovals: ptr.To(int64(1))},
+		},
+	}
+
+	if err := ResolveGroupPaths(context.Background(), c, in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &v1alpha1.ProtectedEnvironmentParameters{
+		DeployAccessLevels: &[]v1alpha1.EnvironmentAccessLevelParameters{
+			{AccessLevel: ptr.To(40)},
+			{GroupPath: ptr.To("platform"), GroupID: ptr.To(int64(7)), GroupInheritanceType: ptr.To(int64(1))},
+			{GroupPath: ptr.To("platform/release"), GroupID: ptr.To(int64(8))},
+		},
+		ApprovalRules: &[]v1alpha1.EnvironmentApprovalRuleParameters{
+			{GroupPath: ptr.To("platform/release"), GroupID: ptr.To(int64(8)), RequiredApprovals: ptr.To(int64(1))},
+		},
+	}
+
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Fatalf("-want, +got:\n%s", diff)
+	}
+
+	// The resolved IDs are compared with the IDs GitLab returns.
+	pe := &gitlab.ProtectedEnvironment{
+		DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{
+			{ID: 1, AccessLevel: 40},
+			{ID: 2, GroupID: 7, GroupInheritanceType: 1},
+			{ID: 3, GroupID: 8, GroupInheritanceType: 0},
+		},
+		ApprovalRules: []*gitlab.EnvironmentApprovalRule{
+			{ID: 4, GroupID: 8, RequiredApprovalCount: 1},
+		},
+	}
+
+	LateInitializeProtectedEnvironment(in, pe)
+
+	if !IsProtectedEnvironmentUpToDate(in, pe) {
+		t.Fatalf("expected resolved spec to be up to date")
+	}
+
+}
+
+func TestResolveGroupPaths_Errors(t *testing.T) {
+
+	c := fakeNamespaceClient{
+		"alice": {ID: 9, Kind: "user"},
+	}
+
+	cases := map[string]string{
+		"NotFound":  "missing",
+		"NotAGroup": "alice",
+	}
+
+	for name, path := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1alpha1.ProtectedEnvironmentParameters{
+				ApprovalRules: &[]v1alpha1.EnvironmentApprovalRuleParameters{
+					{GroupPath: ptr.To(path)},
+				},
+			}
+			if err := ResolveGroupPaths(context.Background(), c, in); err == nil {
+				t.Fatalf("expected an error resolving %q", path)
+			}
+			if (*in.ApprovalRules)[0].GroupID != nil {
+				t.Fatalf("expected no group ID, got %d", *(*in.ApprovalRules)[0].GroupID)
+			}
+		})
+	}
+
+}
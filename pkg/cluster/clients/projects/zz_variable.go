@@ -19,7 +19,10 @@ limitations under the License.
 package projects
 
 import (
+	"strconv"
+
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
@@ -48,7 +51,7 @@ func GenerateVariableObservation(variable *gitlab.ProjectVariable) v1alpha1.Vari
 		CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
 			Key:          variable.Key,
 			Description:  variable.Description,
-			VariableType: commonv1alpha1.VariableType(variable.VariableType),
+			VariableType: common.VariableType(variable.VariableType),
 			Protected:    variable.Protected,
 			Masked:       variable.Masked,
 			Raw:          variable.Raw,
@@ -66,7 +69,7 @@ func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.Pr
 	}
 
 	if in.VariableType == nil {
-		in.VariableType = (*commonv1alpha1.VariableType)(&variable.VariableType)
+		in.VariableType = ptr.To(common.VariableType(variable.VariableType))
 	}
 
 	if in.Description == nil {
@@ -88,6 +91,10 @@ func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.Pr
 	if in.Raw == nil {
 		in.Raw = &variable.Raw
 	}
+
+	if in.MaskedAndHidden == nil && variable.Hidden {
+		in.MaskedAndHidden = &variable.Hidden
+	}
 }
 
 // GenerateCreateVariableOptions generates project creation options
@@ -99,6 +106,7 @@ func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.Creat
 		VariableType:     (*gitlab.VariableTypeValue)(p.VariableType),
 		Protected:        p.Protected,
 		Masked:           p.Masked,
+		MaskedAndHidden:  p.MaskedAndHidden,
 		EnvironmentScope: p.EnvironmentScope,
 		Raw:              p.Raw,
 	}
@@ -122,37 +130,101 @@ func GenerateUpdateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.Updat
 	return variable
 }
 
-// GenerateGetVariableOptions generates project get options
-func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *gitlab.GetProjectVariableOptions {
-	if p.EnvironmentScope == nil {
-		return nil
+// GenerateListVariablesOptions generates the options to list a page of the
+// variables of a project. The project variable endpoints only return the
+// variables defined on the project itself, never those it inherits from its
+// groups or the instance, so there is no option to exclude them.
+func GenerateListVariablesOptions(page, perPage int64) *gitlab.ListProjectVariablesOptions {
+	return &gitlab.ListProjectVariablesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: perPage, Page: page},
 	}
+}
 
+// GenerateGetVariableOptions generates project get options. Like the list,
+// the GET never returns an inherited group variable of the same key.
+func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *gitlab.GetProjectVariableOptions {
 	return &gitlab.GetProjectVariableOptions{
 		Filter: GenerateVariableFilter(p),
 	}
 }
 
-// GenerateRemoveVariableOptions generates project remove options.
+// GenerateRemoveVariableOptions generates project remove options. The
+// environment scope is always filtered, defaulting to *, so that variables
+// sharing the key in other scopes are never removed.
 func GenerateRemoveVariableOptions(p *v1alpha1.VariableParameters) *gitlab.RemoveProjectVariableOptions {
-	if p.EnvironmentScope == nil {
-		return nil
+	return &gitlab.RemoveProjectVariableOptions{
+		Filter: &gitlab.VariableFilter{
+			EnvironmentScope: EnvironmentScope(p),
+		},
 	}
+}
 
-	return &gitlab.RemoveProjectVariableOptions{
-		Filter: GenerateVariableFilter(p),
+// OtherScopeVariables returns the variables with the key of the variable
+// parameters in environment scopes other than theirs.
+func OtherScopeVariables(variables []*gitlab.ProjectVariable, p *v1alpha1.VariableParameters) []*gitlab.ProjectVariable {
+	scope := EnvironmentScope(p)
+	var others []*gitlab.ProjectVariable
+	for _, v := range variables {
+		if v != nil && v.Key == p.Key && v.EnvironmentScope != scope {
+			others = append(others, v)
+		}
+	}
+	return others
+}
+
+// ScopeVariable returns the variable with the key and environment scope of
+// the variable parameters, or nil if there is none.
+func ScopeVariable(variables []*gitlab.ProjectVariable, p *v1alpha1.VariableParameters) *gitlab.ProjectVariable {
+	scope := EnvironmentScope(p)
+	for _, v := range variables {
+		if v != nil && v.Key == p.Key && v.EnvironmentScope == scope {
+			return v
+		}
+	}
+	return nil
+}
+
+// FindVariable returns the variable identified by the key and environment
+// scope of the parameters, or nil if there is none.
+func FindVariable(variables []*gitlab.ProjectVariable, p *v1alpha1.VariableParameters) *gitlab.ProjectVariable {
+	for _, v := range variables {
+		if IsProjectVariable(p, v) {
+			return v
+		}
 	}
+	return nil
+}
+
+// VariableCacheKey returns the key of the variables of a project in the
+// variable cache.
+func VariableCacheKey(projectID int64) string {
+	return strconv.FormatInt(projectID, 10)
+}
+
+// EnvironmentScope returns the environment scope of the variable parameters.
+// An unset scope is the default scope *, which GitLab creates variables in,
+// rather than any scope, so that every resource maps to exactly one variable
+// even if the key is used in several scopes.
+func EnvironmentScope(p *v1alpha1.VariableParameters) string {
+	return ptr.Deref(p.EnvironmentScope, common.DefaultEnvironmentScope)
 }
 
 // GenerateVariableFilter generates a variable filter that matches the variable parameters' environment scope.
 func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilter {
-	if p.EnvironmentScope == nil {
-		return nil
+	return &gitlab.VariableFilter{
+		EnvironmentScope: EnvironmentScope(p),
 	}
+}
 
-	return &gitlab.VariableFilter{
-		EnvironmentScope: *p.EnvironmentScope,
+// IsProjectVariable checks whether a variable returned by GitLab is the
+// project variable identified by the key and environment scope of the
+// parameters. Group variables sharing the key are never returned by the
+// project variable endpoints and must not be mistaken for it either.
+func IsProjectVariable(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariable) bool {
+	if g == nil || p.Key != g.Key {
+		return false
 	}
+	return EnvironmentScope(p) == g.EnvironmentScope
 }
 
 // IsVariableUpToDate checks whether there is a change in any of the modifiable fields.
@@ -168,7 +240,7 @@ func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariabl
 		return false
 	}
 
-	if !clients.IsComparableEqualToComparablePtr(p.Value, g.Value) {
+	if !IsVariableValueHidden(p, g) && !clients.IsComparableEqualToComparablePtr(p.Value, g.Value) {
 		return false
 	}
 
@@ -176,7 +248,7 @@ func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariabl
 		return false
 	}
 
-	if !clients.IsComparableEqualToComparablePtr((*string)(p.VariableType), (string)(g.VariableType)) {
+	if !clients.IsComparableEqualToComparablePtr(p.VariableType, common.VariableType(g.VariableType)) {
 		return false
 	}
 
@@ -196,5 +268,23 @@ func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariabl
 		return false
 	}
 
+	if !clients.IsComparableEqualToComparablePtr(p.MaskedAndHidden, g.Hidden) {
+		return false
+	}
+
 	return true
 }
+
+// ImmutableVariableFields returns the fields of a variable GitLab cannot
+// update.
+func ImmutableVariableFields(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariable) []common.ImmutableField {
+	return []common.ImmutableField{
+		{Name: "forProvider.maskedAndHidden", UpToDate: clients.IsComparableEqualToComparablePtr(p.MaskedAndHidden, g.Hidden)},
+	}
+}
+
+// IsVariableValueHidden returns true if GitLab did not return the value of a
+// hidden or masked variable, so it cannot be compared with the desired value.
+func IsVariableValueHidden(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariable) bool {
+	return g.Hidden || ptr.Deref(p.Masked, false) && g.Value == ""
+}
@@ -191,7 +191,7 @@ func IsRunnerUpToDate(spec *commonv1alpha1.CommonRunnerParameters, observed *git
 		clients.IsComparableEqualToComparablePtr(spec.Paused, observed.Paused),
 		clients.IsComparableEqualToComparablePtr(spec.Locked, observed.Locked),
 		clients.IsComparableEqualToComparablePtr(spec.RunUntagged, observed.RunUntagged),
-		clients.IsComparableSliceEqualToComparableSlicePtr(spec.TagList, observed.TagList),
+		clients.IsSameSetAsSlicePtr(spec.TagList, observed.TagList),
 		clients.IsComparableEqualToComparablePtr(spec.AccessLevel, observed.AccessLevel),
 		clients.IsComparableEqualToComparablePtr(spec.MaximumTimeout, observedMaxTimeout),
 		clients.IsComparableEqualToComparablePtr(spec.MaintenanceNote, observed.MaintenanceNote),
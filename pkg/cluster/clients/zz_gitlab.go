@@ -21,7 +21,9 @@ package clients
 import (
 	"time"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
@@ -184,6 +186,48 @@ func IsComparableSliceEqualToComparableSlicePtr[T comparable](csp *[]T, cs []T)
 	return csp == nil || cmp.Equal(*csp, cs)
 }
 
+// EquateOptions returns the cmp options to compare the parameters of managed
+// resources with: nil and empty slices and maps are equal, and references and
+// selectors are ignored, as they only serve to resolve other fields. opts
+// extend the standard set, e.g. to ignore fields a resource sets on its own.
+func EquateOptions(opts ...cmp.Option) []cmp.Option {
+	return append([]cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(xpv1.Reference{}, &xpv1.Reference{}, xpv1.Selector{}, &xpv1.Selector{}),
+	}, opts...)
+}
+
+// IsEqual compares a and b using EquateOptions extended by opts.
+func IsEqual(a, b any, opts ...cmp.Option) bool {
+	return cmp.Equal(a, b, EquateOptions(opts...)...)
+}
+
+// IsSameSet compares two slices regardless of the order of their elements.
+// Use it for lists GitLab treats as sets, e.g. scopes, tags or IDs, which it
+// may return in another order than they were sent.
+func IsSameSet[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[T]int, len(a))
+	for _, v := range a {
+		seen[v]++
+	}
+	for _, v := range b {
+		if seen[v] == 0 {
+			return false
+		}
+		seen[v]--
+	}
+	return true
+}
+
+// IsSameSetAsSlicePtr compares a *[]T with []T regardless of the order of
+// their elements. Returns true if csp is nil.
+func IsSameSetAsSlicePtr[T comparable](csp *[]T, cs []T) bool {
+	return csp == nil || IsSameSet(*csp, cs)
+}
+
 // IsMapStringToComparableEqualToMapStringToComparablePtr compares a *map[string]T with map[string]T where T is comparable
 func IsMapStringToComparableEqualToMapStringToComparablePtr[T comparable](mp *map[string]T, m map[string]T) bool {
 	return mp == nil || cmp.Equal(*mp, m)
@@ -242,8 +286,13 @@ func IsTimePtrEqualToTimePtr(tp1, tp2 *time.Time) bool {
 
 // IsResponseNotFound returns true of Gitlab Response indicates CR was not found
 func IsResponseNotFound(res *gitlab.Response) bool {
-	if res != nil && res.StatusCode == 404 {
+	if res != nil && res.Response != nil && res.StatusCode == 404 {
 		return true
 	}
 	return false
 }
+
+// IsResponseForbidden returns true if Gitlab Response indicates the request was not permitted
+func IsResponseForbidden(res *gitlab.Response) bool {
+	return res != nil && res.Response != nil && res.StatusCode == 403
+}
@@ -20,23 +20,165 @@ package variables
 
 import (
 	"context"
+	"regexp"
+	"strings"
+	"text/template"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
+const (
+	// TypeValueNotMaskable indicates that GitLab stored a variable unmasked
+	// although masking was requested, because it cannot mask its value.
+	TypeValueNotMaskable xpv1.ConditionType = "ValueNotMaskable"
+
+	// ReasonMaskingIgnored is used when GitLab ignored the requested masking.
+	ReasonMaskingIgnored xpv1.ConditionReason = "MaskingIgnoredByGitLab"
+
+	// ReasonMaskingApplied is used once the variable is masked or masking is
+	// no longer requested.
+	ReasonMaskingApplied xpv1.ConditionReason = "MaskingApplied"
+
+	// TypeMaskingSkipped indicates that a variable that is masked if
+	// possible is stored unmasked, because GitLab cannot mask its value.
+	TypeMaskingSkipped xpv1.ConditionType = "MaskingSkipped"
+
+	// ReasonValueNotMaskable is used when the value of a variable that is
+	// masked if possible breaks the masking rules of GitLab.
+	ReasonValueNotMaskable xpv1.ConditionReason = "ValueNotMaskable"
+
+	// ReasonValueMaskable is used once the value of a variable that is
+	// masked if possible can be masked, or masking is no longer optional.
+	ReasonValueMaskable xpv1.ConditionReason = "ValueMaskable"
+
+	// ConnectionSecretValueKey is the key of the variable value in the
+	// connection secret if PublishValue is set.
+	ConnectionSecretValueKey = "value"
+
+	// AnnotationKeyAdoptedValue records the hash of the value a variable
+	// adopted from GitLab. While the spec holds this value it is only
+	// observed, changing it in the spec takes the value over.
+	AnnotationKeyAdoptedValue = "gitlab.crossplane.io/adopted-value-hash"
+
+	errValueTemplate    = "cannot render value template"
+	errValueTemplateKey = "cannot resolve value template key %s"
+	errMaskedMultiline  = "masked variables must have a single line value, set masked to false for multi-line values such as files"
+	errMaskedTooShort   = "masked variables must have a value of at least %d characters, the value has %d"
+	errMaskedCharacter  = "masked variables can only contain %s with GitLab %s, the value has another character at position %d"
+	errNotMaskable      = "GitLab stored the variable unmasked because it cannot mask its value, use a value GitLab can mask or set masked to false"
+	errMaskingSkipped   = "the variable is stored unmasked"
+)
+
+// minMaskedValueLength is the minimum length of masked values.
+const minMaskedValueLength = 8
+
+// maskedValueCharacters are the characters GitLab accepts in masked values,
+// newest first, by the version that loosened them.
+var maskedValueCharacters = []struct {
+	major, minor int
+	pattern      *regexp.Regexp
+	description  string
+}{
+	{major: 17, minor: 0, pattern: regexp.MustCompile(`^[!-~]$`), description: "printable ASCII characters other than spaces"},
+	{major: 13, minor: 0, pattern: regexp.MustCompile(`^[a-zA-Z0-9_+=/@:.~-]$`), description: "letters, digits and the characters _+=/@:.~-"},
+	{pattern: regexp.MustCompile(`^[a-zA-Z0-9_+=/@:-]$`), description: "letters, digits and the characters _+=/@:-"},
+}
+
+// ValidateVariable returns an error for masked variables GitLab rejects
+// because of their value, naming the rule the value breaks: it must be a
+// single line, at least 8 characters long and only contain the characters
+// the supplied GitLab version can mask. The error never contains the value.
+func ValidateVariable(params *v1alpha1.CommonVariableParameters, version *common.ServerVersion) error {
+	if params.Masked == nil || !*params.Masked || params.Value == nil {
+		return nil
+	}
+	return validateMaskedValue(*params.Value, version)
+}
+
+func validateMaskedValue(value string, version *common.ServerVersion) error {
+	if isMultiline(value) {
+		return errors.New(errMaskedMultiline)
+	}
+	if n := len([]rune(value)); n < minMaskedValueLength {
+		return errors.Errorf(errMaskedTooShort, minMaskedValueLength, n)
+	}
+	for _, c := range maskedValueCharacters {
+		if !version.AtLeast(c.major, c.minor) {
+			continue
+		}
+		for i, r := range []rune(value) {
+			if !c.pattern.MatchString(string(r)) {
+				return errors.Errorf(errMaskedCharacter, c.description, version, i+1)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 // UpdateVariableFromSecret updates the Variable parameters with the value from the secret.
 func UpdateVariableFromSecret(kube client.Client, mg resource.Managed, ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.CommonVariableParameters) error {
 	value, err := common.GetTokenValueFromSecret(ctx, kube, mg, selector)
 	if err != nil {
 		return err
 	}
+	SetSecretValue(params, *value)
+	return nil
+}
+
+// UpdateVariableFromTemplate updates the Variable parameters with the value
+// rendered from the template. The template refers to the values of the secret
+// keys by the names they are mapped to, e.g. "{{ .password }}". It fails if a
+// secret key does not exist or the template refers to an unknown name.
+func UpdateVariableFromTemplate(kube client.Client, mg resource.Managed, ctx context.Context, tmpl string, selectors map[string]*xpv1.SecretKeySelector, params *v1alpha1.CommonVariableParameters) error {
+	values := make(map[string]string, len(selectors))
+	for name, selector := range selectors {
+		value, err := common.GetTokenValueFromSecret(ctx, kube, mg, selector)
+		if err != nil {
+			return errors.Wrapf(err, errValueTemplateKey, name)
+		}
+		values[name] = *value
+	}
+	value, err := RenderValueTemplate(tmpl, values)
+	if err != nil {
+		return err
+	}
+	SetSecretValue(params, value)
+	return nil
+}
+
+// RenderValueTemplate renders a variable value from a Go template and the
+// named values it refers to. Referring to a name that is not given is an
+// error. The error never contains the values.
+func RenderValueTemplate(tmpl string, values map[string]string) (string, error) {
+	t, err := template.New("value").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, errValueTemplate)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, values); err != nil {
+		return "", errors.Wrap(err, errValueTemplate)
+	}
+	return b.String(), nil
+}
 
+// SetSecretValue sets the value of the Variable parameters read from
+// secrets. It masks the variable and makes it raw, unless either has been
+// configured explicitly. A value GitLab cannot mask is never unmasked on the
+// user's behalf, ValidateVariable rejects it instead.
+func SetSecretValue(params *v1alpha1.CommonVariableParameters, value string) {
 	// Mask variable if it hasn't already been explicitly configured.
 	if params.Masked == nil {
 		params.Masked = gitlab.Ptr(true)
@@ -47,6 +189,119 @@ func UpdateVariableFromSecret(kube client.Client, mg resource.Managed, ctx conte
 		params.Raw = gitlab.Ptr(true)
 	}
 
-	params.Value = value
-	return nil
+	params.Value = &value
+}
+
+// DefaultRaw sets Raw to false, the default of GitLab, unless it has been
+// configured explicitly or by SetSecretValue. Variables are thus created with
+// an explicit Raw, and the spec shows whether their value is expanded.
+func DefaultRaw(params *v1alpha1.CommonVariableParameters) {
+	if params.Raw == nil {
+		params.Raw = gitlab.Ptr(false)
+	}
+}
+
+// ResolveMaskIfPossible masks the variable if GitLab can mask its value and
+// unmasks it otherwise, setting the MaskingSkipped condition with the masking
+// rule the value breaks. The condition is reset once the value can be masked
+// or maskIfPossible is unset. Parameters without a value are left unchanged.
+func ResolveMaskIfPossible(mg resource.Managed, params *v1alpha1.CommonVariableParameters, maskIfPossible *bool, version *common.ServerVersion) {
+	var err error
+	if ptr.Deref(maskIfPossible, false) && params.Value != nil {
+		err = validateMaskedValue(*params.Value, version)
+		params.Masked = ptr.To(err == nil)
+	}
+	if err != nil {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeMaskingSkipped,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonValueNotMaskable,
+			Message:            errors.Wrap(err, errMaskingSkipped).Error(),
+		})
+		return
+	}
+	if mg.GetCondition(TypeMaskingSkipped).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeMaskingSkipped,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonValueMaskable,
+		})
+	}
+}
+
+func isMultiline(value string) bool {
+	return strings.ContainsAny(value, "\r\n")
+}
+
+// SetValueNotMaskable sets the ValueNotMaskable condition if masking was
+// requested but GitLab stored the variable unmasked, which some GitLab
+// versions do instead of rejecting a value they cannot mask. The condition is
+// reset once the variable is masked or masking is no longer requested.
+func SetValueNotMaskable(mg resource.Managed, params *v1alpha1.CommonVariableParameters, masked bool) {
+	if ptr.Deref(params.Masked, false) && !masked {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeValueNotMaskable,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonMaskingIgnored,
+			Message:            errNotMaskable,
+		})
+		return
+	}
+	if mg.GetCondition(TypeValueNotMaskable).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeValueNotMaskable,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonMaskingApplied,
+		})
+	}
+}
+
+// IgnoreNotMaskable drops the requested masking from the desired parameters
+// while the ValueNotMaskable condition is set, so that the unmasked variable
+// is considered up to date instead of being updated over and over again. A
+// changed value is still updated and masking requested again.
+func IgnoreNotMaskable(mg resource.Managed, desired *v1alpha1.CommonVariableParameters) {
+	if !ptr.Deref(desired.Masked, false) {
+		SetValueNotMaskable(mg, desired, false)
+		return
+	}
+	if mg.GetCondition(TypeValueNotMaskable).Status == corev1.ConditionTrue {
+		desired.Masked = ptr.To(false)
+	}
+}
+
+// ConnectionDetails returns the value of the variable as connection details
+// if PublishValue is set. Variables without a value publish nothing.
+func ConnectionDetails(params *v1alpha1.CommonVariableParameters) managed.ConnectionDetails {
+	if !ptr.Deref(params.PublishValue, false) || params.Value == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{ConnectionSecretValueKey: []byte(*params.Value)}
+}
+
+// AdoptValue late-initializes the value of a variable that does not set one
+// with the value observed in GitLab and records its hash, unless GitLab hides
+// the value. An adopted value is adopted again whenever it changes in GitLab,
+// so the provider only observes it. A value set in the spec, or taken from a
+// secret or template as indicated by owned, is owned by the provider instead.
+// AdoptValue returns true if the spec or the annotation changed.
+func AdoptValue(mg resource.Managed, params *v1alpha1.CommonVariableParameters, owned bool, observed string, hidden bool) bool {
+	key := common.VariableValueHashKey(nil, mg.GetUID())
+	adopted, ok := mg.GetAnnotations()[AnnotationKeyAdoptedValue]
+	if owned || params.Value != nil && (!ok || adopted != common.HashVariableValue(key, *params.Value)) {
+		if ok {
+			meta.RemoveAnnotations(mg, AnnotationKeyAdoptedValue)
+		}
+		return ok
+	}
+	if hidden || params.Value != nil && *params.Value == observed {
+		return false
+	}
+	params.Value = ptr.To(observed)
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyAdoptedValue: common.HashVariableValue(key, observed)})
+	return true
 }
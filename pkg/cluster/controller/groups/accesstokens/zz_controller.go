@@ -21,6 +21,7 @@ package accesstokens
 import (
 	"context"
 	"strconv"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
@@ -56,7 +57,7 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.AccessTokenGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewAccessTokenClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewAccessTokenClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -146,6 +147,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = groups.GenerateGroupAccessTokenObservation(at)
+	common.SetTokenExpiry(cr, (*time.Time)(at.ExpiresAt))
 
 	if groups.ShouldRotateAccessToken(&cr.Spec.ForProvider, at) {
 		return managed.ExternalObservation{ResourceExists: false}, nil
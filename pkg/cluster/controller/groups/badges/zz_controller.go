@@ -56,7 +56,7 @@ func SetupBadge(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.BadgeGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewBadgeClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewBadgeClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
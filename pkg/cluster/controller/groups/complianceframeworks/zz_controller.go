@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package complianceframeworks
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotComplianceFramework = "managed resource is not a Gitlab compliance framework custom resource"
+	errGroupIDMissing         = "GroupID is missing"
+	errIDNotInt               = "external name is not a Gitlab compliance framework ID"
+	errGetFailed              = "cannot get Gitlab compliance framework"
+	errCreateFailed           = "cannot create Gitlab compliance framework"
+	errUpdateFailed           = "cannot update Gitlab compliance framework"
+	errDeleteFailed           = "cannot delete Gitlab compliance framework"
+
+	featureComplianceFrameworks = "Compliance frameworks"
+)
+
+// SetupComplianceFramework adds a controller that reconciles
+// ComplianceFrameworks.
+func SetupComplianceFramework(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.ComplianceFrameworkGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewComplianceFrameworkClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ComplianceFrameworkGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ComplianceFrameworkList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ComplianceFramework{}).
+		Complete(r)
+}
+
+// SetupComplianceFrameworkGated adds a controller with CRD gate support.
+func SetupComplianceFrameworkGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupComplianceFramework(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ComplianceFrameworkGroupVersionKind.String())
+		}
+	}, v1alpha1.ComplianceFrameworkGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) groups.ComplianceFrameworkClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ComplianceFramework)
+	if !ok {
+		return nil, errors.New(errNotComplianceFramework)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.ComplianceFrameworkClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ComplianceFramework)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotComplianceFramework)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+	path, err := e.groupPath(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	f, err := e.client.GetComplianceFramework(ctx, path, id)
+	if err != nil {
+		if groups.IsComplianceFrameworkUnavailable(err) {
+			common.SetUnsupportedByLicense(cr, featureComplianceFrameworks)
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	if f == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	groups.LateInitializeComplianceFramework(&cr.Spec.ForProvider, f)
+
+	cr.Status.AtProvider = v1alpha1.ComplianceFrameworkObservation{ID: f.ID, GroupPath: path}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        groups.IsComplianceFrameworkUpToDate(&cr.Spec.ForProvider, f),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ComplianceFramework)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotComplianceFramework)
+	}
+
+	path, err := e.groupPath(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	f, err := e.client.CreateComplianceFramework(ctx, path, groups.GenerateComplianceFrameworkInput(&cr.Spec.ForProvider))
+	if err != nil {
+		if groups.IsComplianceFrameworkUnavailable(err) {
+			common.SetUnsupportedByLicense(cr, featureComplianceFrameworks)
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = v1alpha1.ComplianceFrameworkObservation{ID: f.ID, GroupPath: path}
+	meta.SetExternalName(cr, strconv.FormatInt(f.ID, 10))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ComplianceFramework)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotComplianceFramework)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	_, err = e.client.UpdateComplianceFramework(ctx, id, groups.GenerateComplianceFrameworkInput(&cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ComplianceFramework)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotComplianceFramework)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	// GitLab reports frameworks that are already gone like inaccessible ones.
+	err = e.client.DeleteComplianceFramework(ctx, id)
+	if err != nil && !groups.IsComplianceFrameworkUnavailable(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// groupPath returns the full path of the group of the compliance framework.
+// The group cannot change, so the path observed before is reused.
+func (e *external) groupPath(ctx context.Context, cr *v1alpha1.ComplianceFramework) (string, error) {
+	if cr.Status.AtProvider.GroupPath != "" {
+		return cr.Status.AtProvider.GroupPath, nil
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return "", errors.New(errGroupIDMissing)
+	}
+	return e.client.GetGroupPath(ctx, *cr.Spec.ForProvider.GroupID)
+}
@@ -57,7 +57,7 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.DeployTokenGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -147,12 +147,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeGroupDeployToken(&cr.Spec.ForProvider, dt)
 
+	changed := common.ChangedImmutableFields(groups.ImmutableDeployTokenFields(&cr.Spec.ForProvider, dt)...)
+	common.SetImmutableFieldsChanged(cr, changed)
+
 	cr.Status.AtProvider = v1alpha1.DeployTokenObservation{}
+	common.SetTokenExpiry(cr, dt.ExpiresAt)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        len(changed) == 0,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -166,27 +170,39 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalCreation{}, errors.Wrap(errors.New("GroupId must be set directly or via reference"), errCreateFailed)
 	}
+	if err := common.ValidateDeployTokenScopes(cr.Spec.ForProvider.Scopes); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
 
 	dt, _, err := e.client.CreateGroupDeployToken(
 		*cr.Spec.ForProvider.GroupID,
 		groups.GenerateCreateGroupDeployTokenOptions(cr.Name, &cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
-
-	connectionDetails := managed.ConnectionDetails{}
-	connectionDetails["token"] = []byte(dt.Token)
-
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
+	connectionDetails := managed.ConnectionDetails{}
+	connectionDetails["token"] = []byte(dt.Token)
+
 	meta.SetExternalName(cr, strconv.FormatInt(dt.ID, 10))
 	return managed.ExternalCreation{ConnectionDetails: connectionDetails}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// it's not possible to update a GroupDeployToken
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha1.DeployToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployToken)
+	}
+
+	// it's not possible to update a GroupDeployToken, changes are either
+	// rejected or applied by creating the deploy token again.
+	_, err := common.HandleChangedImmutableFields(cr, func() error {
+		_, err := e.Delete(ctx, cr)
+		return err
+	})
+	return managed.ExternalUpdate{}, err
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
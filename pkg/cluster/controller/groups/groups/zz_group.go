@@ -19,8 +19,9 @@ limitations under the License.
 package groups
 
 import (
+	"bytes"
 	"context"
-	"strconv"
+	"io"
 	"strings"
 	"time"
 
@@ -43,12 +44,12 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 const (
 	errNotGroup          = "managed resource is not a Gitlab Group custom resource"
-	errIDNotInt          = "specified ID is not an integer"
 	errGetFailed         = "cannot get Gitlab Group"
 	errCreateFailed      = "cannot create Gitlab Group"
 	errUpdateFailed      = "cannot update Gitlab Group"
@@ -58,6 +59,17 @@ const (
 	errMissingGroupID    = "missing group ID for group to share with"
 	errSWGMissingGroupID = "FOllowing SharedWithGroup is missing GroupID: %v"
 	errLateInitialize    = "Error during LateInitialization: "
+	errGetAISettings     = "cannot get Gitlab Group AI settings"
+	errUpdateAISettings  = "cannot update Gitlab Group AI settings"
+	errGetMinutes        = "cannot get Gitlab Group compute minutes quotas"
+	errUpdateMinutes     = "cannot update Gitlab Group compute minutes quotas"
+	errGetAvatar         = "cannot read Gitlab Group avatar"
+	errInvalidAvatar     = "cannot use Gitlab Group avatar"
+	errUpdateAvatar      = "cannot update Gitlab Group avatar"
+	errDownloadAvatar    = "cannot download Gitlab Group avatar"
+
+	featureAISettings     = "GitLab Duo and AI settings"
+	featureComputeMinutes = "compute minutes quotas"
 )
 
 // SetupGroup adds a controller that reconciles Groups.
@@ -65,7 +77,13 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.GroupKubernetesGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
+			kube:                  mgr.GetClient(),
+			newGitlabClientFn:     groups.NewGroupClient,
+			newAISettingsClientFn: groups.NewAISettingsClient,
+			newMinutesClientFn:    groups.NewComputeMinutesClient,
+			serverVersionFn:       common.GetServerVersion,
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -103,8 +121,11 @@ func SetupGroupGated(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg common.Config) groups.Client
+	kube                  client.Client
+	newGitlabClientFn     func(cfg common.Config) groups.Client
+	newAISettingsClientFn func(cfg common.Config) groups.AISettingsClient
+	newMinutesClientFn    func(cfg common.Config) groups.ComputeMinutesClient
+	serverVersionFn       func(ctx context.Context, cfg common.Config) *common.ServerVersion
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -116,12 +137,31 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	return &external{
+		kube:       c.kube,
+		client:     c.newGitlabClientFn(*cfg),
+		aiSettings: c.newAISettingsClientFn(*cfg),
+		minutes:    c.newMinutesClientFn(*cfg),
+		version:    version,
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.Client
+	kube       client.Client
+	client     groups.Client
+	aiSettings groups.AISettingsClient
+	minutes    groups.ComputeMinutesClient
+	version    *common.ServerVersion
+
+	cache struct {
+		aiSettings       *groups.AISettings
+		minutes          *groups.ComputeMinutes
+		isAvatarUpToDate bool
+	}
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
@@ -135,15 +175,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	groupID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errIDNotInt)
-	}
-
 	//nolint:staticcheck // Keeping this for backward compatibility during deprecation
-	cr.Spec.ForProvider.EmailsEnabled = lateInitializeEmailsEnabled(cr.Spec.ForProvider.EmailsEnabled, cr.Spec.ForProvider.EmailsDisabled)
+	cr.Spec.ForProvider.EmailsEnabled = common.ResolveEmailsEnabledSetting(cr.Spec.ForProvider.EmailsDisabled, cr.Spec.ForProvider.EmailsEnabled)
 
-	grp, res, err := e.client.GetGroup(groupID, nil)
+	grp, res, err := e.client.GetGroup(common.ParseExternalName(externalName), nil)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -151,6 +186,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	//nolint:staticcheck // GitLab versions before 16.5 only report the deprecated field
+	grp.EmailsEnabled = common.ObservedEmailsEnabled(grp.EmailsEnabled, grp.EmailsDisabled, e.version)
+
 	// Check if the group is in a pending deletion state and either remove the
 	// finalizer if specified or keep tracking it.
 	//
@@ -175,17 +213,36 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
-	isResourceLateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
+	isResourceLateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider) || common.UpdateExternalName(cr, grp.ID, grp.FullPath)
 
+	// GitLab only returns the URL of the avatar, keep the hash of the image
+	// last uploaded.
+	avatarHash, avatarURLChecked := cr.Status.AtProvider.AvatarHash, cr.Status.AtProvider.AvatarURLChecked
 	cr.Status.AtProvider = groups.GenerateObservation(grp)
+	cr.Status.AtProvider.AvatarHash = avatarHash
+	cr.Status.AtProvider.AvatarURLChecked = avatarURLChecked
 	isUpToDate, err := isGroupUpToDate(&cr.Spec.ForProvider, grp)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
+	e.cache.isAvatarUpToDate, err = e.isAvatarUpToDate(ctx, cr, grp)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	unsupported := &unsupportedFeatures{}
+	isAISettingsUpToDate, err := e.isAISettingsUpToDate(ctx, cr, grp.ID, unsupported)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	isMinutesUpToDate, err := e.isMinutesUpToDate(ctx, cr, grp.ID, unsupported)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	unsupported.setCondition(cr, e.version)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isUpToDate,
+		ResourceUpToDate:        isUpToDate && isAISettingsUpToDate && isMinutesUpToDate && e.cache.isAvatarUpToDate,
 		ResourceLateInitialized: isResourceLateInitialized,
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(grp.RunnersToken)},
 	}, nil
@@ -205,7 +262,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.FormatInt(grp.ID, 10))
+	meta.SetExternalName(cr, common.FormatExternalName(cr, grp.ID, grp.FullPath))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -224,6 +281,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
 
+	if e.cache.aiSettings != nil {
+		if _, _, err := e.aiSettings.UpdateGroupAISettings(grp.ID, e.cache.aiSettings, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAISettings)
+		}
+	}
+	if e.cache.minutes != nil {
+		if _, err := e.minutes.UpdateGroupComputeMinutes(grp.ID, e.cache.minutes, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMinutes)
+		}
+	}
+	if !e.cache.isAvatarUpToDate {
+		if err := e.updateAvatar(ctx, cr, grp.ID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	if len(cr.Spec.ForProvider.SharedWithGroups) > 0 {
 		for _, sh := range cr.Spec.ForProvider.SharedWithGroups {
 			if sh.GroupID == nil {
@@ -315,6 +388,172 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// unsupportedFeatures collects the settings of a group GitLab does not
+// support, so that the UnsupportedFeatures condition is set once for all of
+// them.
+type unsupportedFeatures struct {
+	byVersion []string
+	byLicense []string
+}
+
+func (u *unsupportedFeatures) setCondition(cr *v1alpha1.Group, v *common.ServerVersion) {
+	if len(u.byLicense) > 0 {
+		common.SetUnsupportedByLicense(cr, strings.Join(u.byLicense, " and "))
+		return
+	}
+	common.SetUnsupportedFeatures(cr, v, u.byVersion)
+}
+
+// isAISettingsUpToDate compares the AI settings of the group with the ones
+// set in the spec, which are kept for the update if they differ. Settings
+// the GitLab version or license does not support are not managed and
+// recorded as unsupported.
+func (e *external) isAISettingsUpToDate(ctx context.Context, cr *v1alpha1.Group, groupID int64, unsupported *unsupportedFeatures) (bool, error) {
+	desired := groups.GenerateAISettings(&cr.Spec.ForProvider)
+	unsupported.byVersion = append(unsupported.byVersion, groups.OmitUnsupportedAISettings(desired, e.version)...)
+	if !desired.IsManaged() {
+		return true, nil
+	}
+
+	observed, _, err := e.aiSettings.GetGroupAISettings(groupID, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, errGetAISettings)
+	}
+	if unavailable := groups.OmitUnavailableAISettings(desired, observed); len(unavailable) > 0 {
+		unsupported.byLicense = append(unsupported.byLicense, featureAISettings)
+	}
+
+	if !desired.IsManaged() || groups.IsAISettingsUpToDate(desired, observed) {
+		return true, nil
+	}
+	e.cache.aiSettings = desired
+	return false, nil
+}
+
+// isMinutesUpToDate compares the compute minutes quotas of the group with the
+// ones set in the spec, which are kept for the update if they differ. GitLab
+// only returns the quotas to administrators of a licensed self-managed
+// instance, they are not managed and recorded as unsupported otherwise.
+func (e *external) isMinutesUpToDate(ctx context.Context, cr *v1alpha1.Group, groupID int64, unsupported *unsupportedFeatures) (bool, error) {
+	desired := groups.GenerateComputeMinutes(&cr.Spec.ForProvider)
+	if !desired.IsManaged() {
+		return true, nil
+	}
+
+	observed, _, err := e.minutes.GetGroupComputeMinutes(groupID, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, errGetMinutes)
+	}
+	if !observed.Available {
+		unsupported.byLicense = append(unsupported.byLicense, featureComputeMinutes)
+		return true, nil
+	}
+
+	if groups.IsComputeMinutesUpToDate(desired, observed) {
+		return true, nil
+	}
+	e.cache.minutes = desired
+	return false, nil
+}
+
+// updateAvatar uploads the avatar image referenced by the spec, or removes
+// the avatar of the group if the spec does not reference an image. The hash
+// of the uploaded image is recorded in the status.
+func (e *external) updateAvatar(ctx context.Context, cr *v1alpha1.Group, groupID int64) error {
+	if cr.Spec.ForProvider.Avatar == nil {
+		return nil
+	}
+
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	if image == nil {
+		if _, _, err := e.client.UpdateGroup(groupID, &gitlab.UpdateGroupOptions{Avatar: &gitlab.GroupAvatar{}}, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrap(err, errUpdateAvatar)
+		}
+		cr.Status.AtProvider.AvatarHash = ""
+		cr.Status.AtProvider.AvatarURLChecked = ""
+		return nil
+	}
+
+	filename, err := projects.ValidateAvatar(image)
+	if err != nil {
+		return errors.Wrap(err, errInvalidAvatar)
+	}
+	if _, _, err := e.client.UploadAvatar(groupID, bytes.NewReader(image), filename, gitlab.WithContext(ctx)); err != nil {
+		return errors.Wrap(err, errUpdateAvatar)
+	}
+	cr.Status.AtProvider.AvatarHash = projects.HashAvatar(image)
+	cr.Status.AtProvider.AvatarURLChecked = ""
+	return nil
+}
+
+// getAvatar returns the avatar image referenced by the spec, or nil if no
+// image is referenced.
+func (e *external) getAvatar(ctx context.Context, cr *v1alpha1.Group) ([]byte, error) {
+	avatar := cr.Spec.ForProvider.Avatar
+	switch {
+	case avatar == nil:
+		return nil, nil
+	case avatar.SecretRef != nil:
+		image, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, avatar.SecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetAvatar)
+		}
+		return []byte(*image), nil
+	case avatar.ConfigMapRef != nil:
+		image, err := common.GetValueFromConfigMap(ctx, e.kube, cr, avatar.ConfigMapRef)
+		return image, errors.Wrap(err, errGetAvatar)
+	}
+	return nil, nil
+}
+
+// isAvatarUpToDate compares the avatar image referenced by the spec with the
+// hash of the image last uploaded, and with the avatar of the group to detect
+// one uploaded outside of the provider. The avatar is only downloaded if its
+// URL changed since it last matched. An avatar without an image is up to date
+// if the group has no uploaded avatar.
+func (e *external) isAvatarUpToDate(ctx context.Context, cr *v1alpha1.Group, grp *gitlab.Group) (bool, error) {
+	if cr.Spec.ForProvider.Avatar == nil {
+		return true, nil
+	}
+
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	if image == nil {
+		return !projects.IsCustomAvatarURL(grp.AvatarURL), nil
+	}
+	hash := projects.HashAvatar(image)
+	if !projects.IsCustomAvatarURL(grp.AvatarURL) || hash != cr.Status.AtProvider.AvatarHash {
+		return false, nil
+	}
+
+	if grp.AvatarURL == cr.Status.AtProvider.AvatarURLChecked {
+		return true, nil
+	}
+
+	current, res, err := e.client.DownloadAvatar(grp.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, errDownloadAvatar)
+	}
+	observed, err := io.ReadAll(current)
+	if err != nil {
+		return false, errors.Wrap(err, errDownloadAvatar)
+	}
+	if projects.HashAvatar(observed) != hash {
+		return false, nil
+	}
+	cr.Status.AtProvider.AvatarURLChecked = grp.AvatarURL
+	return true, nil
+}
+
 // isGroupUpToDate checks whether there is a change in any of the modifiable fields.
 func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error) { //nolint:gocyclo
 	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
@@ -365,12 +604,6 @@ func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error)
 	if !clients.IsInt64EqualToInt64Ptr(p.ParentID, g.ParentID) {
 		return false, nil
 	}
-	if !clients.IsInt64EqualToInt64Ptr(p.SharedRunnersMinutesLimit, g.SharedRunnersMinutesLimit) {
-		return false, nil
-	}
-	if !clients.IsInt64EqualToInt64Ptr(p.ExtraSharedRunnersMinutesLimit, g.ExtraSharedRunnersMinutesLimit) {
-		return false, nil
-	}
 	if ok, err := isSharedWithGroupsUpToDate(p, g); err != nil || !ok {
 		return false, err
 	}
@@ -464,16 +697,8 @@ func lateInitialize(in *v1alpha1.GroupParameters, group *gitlab.Group) error { /
 		val := group.ParentID
 		in.ParentID = &val
 	}
-	// Never late-initialize runner limits when API returns 0 (treat as null)
-	// to avoid sending updates with 0 value, fields that can cause 400 errors (because feature might not be available)
-	if in.SharedRunnersMinutesLimit == nil && group.SharedRunnersMinutesLimit > 0 {
-		val := group.SharedRunnersMinutesLimit
-		in.SharedRunnersMinutesLimit = &val
-	}
-	if in.ExtraSharedRunnersMinutesLimit == nil && group.ExtraSharedRunnersMinutesLimit > 0 {
-		val := group.ExtraSharedRunnersMinutesLimit
-		in.ExtraSharedRunnersMinutesLimit = &val
-	}
+	// Compute minutes quotas are never late-initialized, they stay unmanaged
+	// unless set, see isMinutesUpToDate.
 	return nil
 }
 
@@ -495,15 +720,6 @@ func lateInitializeSubGroupCreationLevelValue(in *v1alpha1.SubGroupCreationLevel
 	return in
 }
 
-func lateInitializeEmailsEnabled(in *bool, from *bool) *bool {
-	if in == nil && from != nil {
-		value := !(*from)
-		return &value
-	}
-
-	return in
-}
-
 // lateInitializeProjectCreationLevelValue returns in if it's non-nil, otherwise returns from
 // which is the backup for the cases in is nil.
 func lateInitializeProjectCreationLevelValue(in *v1alpha1.ProjectCreationLevelValue, from gitlab.ProjectCreationLevelValue) *v1alpha1.ProjectCreationLevelValue {
@@ -19,8 +19,10 @@ limitations under the License.
 package groups
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"testing"
@@ -34,6 +36,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +44,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 var (
@@ -261,20 +266,6 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
-			args: args{
-				group: &fake.MockClient{
-					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
-						return &gitlab.Group{}, &gitlab.Response{}, nil
-					},
-				},
-				cr: group(withExternalName("fr")),
-			},
-			want: want{
-				cr:  group(withExternalName("fr")),
-				err: errors.New(errIDNotInt),
-			},
-		},
 		"FailedGetRequest": {
 			args: args{
 				group: &fake.MockClient{
@@ -490,24 +481,22 @@ func TestObserve(t *testing.T) {
 	}
 
 	isGroupUpToDateCases := map[string]interface{}{
-		"Name":                           "name",
-		"Path":                           "/new/group/path",
-		"Description":                    "description v2",
-		"MembershipLock":                 true,
-		"ProjectCreationLevel":           gitlabProjectCreationLevelNew,
-		"SubGroupCreationLevel":          gitlab.SubGroupCreationLevelValue(*gitlabSubGroupCreationLevelNew),
-		"Visibility":                     gitlabVisibilityNew,
-		"ShareWithGroupLock":             true,
-		"RequireTwoFactorAuth":           true,
-		"TwoFactorGracePeriod":           int64(1),
-		"AutoDevopsEnabled":              true,
-		"EmailsEnabled":                  true,
-		"MentionsDisabled":               true,
-		"LFSEnabled":                     true,
-		"RequestAccessEnabled":           true,
-		"ParentID":                       int64(1),
-		"SharedRunnersMinutesLimit":      int64(1),
-		"ExtraSharedRunnersMinutesLimit": int64(1),
+		"Name":                  "name",
+		"Path":                  "/new/group/path",
+		"Description":           "description v2",
+		"MembershipLock":        true,
+		"ProjectCreationLevel":  gitlabProjectCreationLevelNew,
+		"SubGroupCreationLevel": gitlab.SubGroupCreationLevelValue(*gitlabSubGroupCreationLevelNew),
+		"Visibility":            gitlabVisibilityNew,
+		"ShareWithGroupLock":    true,
+		"RequireTwoFactorAuth":  true,
+		"TwoFactorGracePeriod":  int64(1),
+		"AutoDevopsEnabled":     true,
+		"EmailsEnabled":         true,
+		"MentionsDisabled":      true,
+		"LFSEnabled":            true,
+		"RequestAccessEnabled":  true,
+		"ParentID":              int64(1),
 	}
 
 	for name, value := range isGroupUpToDateCases {
@@ -554,25 +543,8 @@ func TestObserve(t *testing.T) {
 
 		structFieldValue.Set(val)
 
-		// Special handling for runner minute limit fields - they get late-initialized when > 0
 		expectedUpToDate := false
 		expectedLateInitialized := false
-		if (name == "SharedRunnersMinutesLimit" || name == "ExtraSharedRunnersMinutesLimit") && value.(int64) > 0 {
-			expectedUpToDate = true
-			expectedLateInitialized = true
-			// Add the expected value to wantGroupModifier
-			if name == "SharedRunnersMinutesLimit" {
-				wantGroupModifier = append(wantGroupModifier, func(p *v1alpha1.Group) {
-					v := value.(int64)
-					p.Spec.ForProvider.SharedRunnersMinutesLimit = &v
-				})
-			} else {
-				wantGroupModifier = append(wantGroupModifier, func(p *v1alpha1.Group) {
-					v := value.(int64)
-					p.Spec.ForProvider.ExtraSharedRunnersMinutesLimit = &v
-				})
-			}
-		}
 
 		// TwoFactorGracePeriod and ParentID also get late-initialized when > 0
 		if (name == "TwoFactorGracePeriod" || name == "ParentID") && value.(int64) > 0 {
@@ -633,6 +605,481 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveExternalNameFormat(t *testing.T) {
+	type want struct {
+		pid             interface{}
+		externalName    string
+		lateInitialized bool
+	}
+
+	cases := map[string]struct {
+		format       string
+		externalName string
+		want
+	}{
+		"ID": {
+			externalName: extName,
+			want:         want{pid: 1234, externalName: extName},
+		},
+		"PathToID": {
+			externalName: path,
+			want:         want{pid: path, externalName: extName, lateInitialized: true},
+		},
+		"Path": {
+			format:       common.ExternalNameFormatPath,
+			externalName: path,
+			want:         want{pid: path, externalName: path},
+		},
+		"IDToPath": {
+			format:       common.ExternalNameFormatPath,
+			externalName: extName,
+			want:         want{pid: 1234, externalName: path, lateInitialized: true},
+		},
+		"RenamedPath": {
+			format:       common.ExternalNameFormatPath,
+			externalName: "path/to/old-group",
+			want:         want{pid: "path/to/old-group", externalName: path, lateInitialized: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pid interface{}
+			e := &external{client: &fake.MockClient{
+				MockGetGroup: func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+					pid = id
+					return &gitlab.Group{ID: groupID, FullPath: path}, &gitlab.Response{}, nil
+				},
+			}}
+			cr := group(withPath(""), withClientDefaultValues(), withExternalName(tc.externalName))
+			if tc.format != "" {
+				meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyExternalNameFormat: tc.format})
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.pid, pid); diff != "" {
+				t.Errorf("GetGroup(...): -want pid, +got pid:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Observe(...): -want external name, +got:\n%s", diff)
+			}
+			if o.ResourceLateInitialized != tc.want.lateInitialized {
+				t.Errorf("Observe(...): want ResourceLateInitialized %t, got %t", tc.want.lateInitialized, o.ResourceLateInitialized)
+			}
+		})
+	}
+}
+
+func TestAISettings(t *testing.T) {
+	type want struct {
+		upToDate bool
+		reason   xpv1.ConditionReason
+		updated  *groups.AISettings
+	}
+
+	cases := map[string]struct {
+		spec     v1alpha1.GroupParameters
+		version  *common.ServerVersion
+		observed *groups.AISettings
+		want
+	}{
+		"Unmanaged": {
+			want: want{upToDate: true},
+		},
+		"UpToDate": {
+			spec:     v1alpha1.GroupParameters{DuoFeaturesEnabled: ptr.To(true)},
+			observed: &groups.AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(false)},
+			want:     want{upToDate: true},
+		},
+		"NotUpToDate": {
+			spec:     v1alpha1.GroupParameters{DuoFeaturesEnabled: ptr.To(false), LockDuoFeaturesEnabled: ptr.To(true)},
+			observed: &groups.AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(true)},
+			want: want{
+				updated: &groups.AISettings{DuoFeaturesEnabled: ptr.To(false), LockDuoFeaturesEnabled: ptr.To(true)},
+			},
+		},
+		"UnsupportedByLicense": {
+			spec:     v1alpha1.GroupParameters{DuoFeaturesEnabled: ptr.To(true), ExperimentFeaturesEnabled: ptr.To(true)},
+			observed: &groups.AISettings{DuoFeaturesEnabled: ptr.To(false)},
+			want: want{
+				reason:  common.ReasonUnsupportedByLicense,
+				updated: &groups.AISettings{DuoFeaturesEnabled: ptr.To(true)},
+			},
+		},
+		"UnsupportedByVersion": {
+			spec:    v1alpha1.GroupParameters{DuoFeaturesEnabled: ptr.To(true)},
+			version: &common.ServerVersion{Major: 16, Minor: 9},
+			want: want{
+				upToDate: true,
+				reason:   common.ReasonUnsupportedByVersion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := group(withClientDefaultValues(), withExternalName(extName))
+			cr.Spec.ForProvider.DuoFeaturesEnabled = tc.spec.DuoFeaturesEnabled
+			cr.Spec.ForProvider.LockDuoFeaturesEnabled = tc.spec.LockDuoFeaturesEnabled
+			cr.Spec.ForProvider.ExperimentFeaturesEnabled = tc.spec.ExperimentFeaturesEnabled
+
+			var updated *groups.AISettings
+			e := &external{
+				client: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				aiSettings: &fake.MockAISettingsClient{
+					MockGetGroupAISettings: func(gid int64, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error) {
+						if tc.observed == nil {
+							return nil, nil, errors.New("AI settings must not be read")
+						}
+						return tc.observed, &gitlab.Response{}, nil
+					},
+					MockUpdateGroupAISettings: func(gid int64, opt *groups.AISettings, options ...gitlab.RequestOptionFunc) (*groups.AISettings, *gitlab.Response, error) {
+						updated = opt
+						return opt, &gitlab.Response{}, nil
+					},
+				},
+				version: tc.version,
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if o.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("Observe(...): want ResourceUpToDate %t, got %t", tc.want.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.want.reason, cr.GetCondition(common.TypeUnsupportedFeatures).Reason); diff != "" {
+				t.Errorf("Observe(...): -want reason, +got reason:\n%s", diff)
+			}
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Update(...): -want AI settings, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEmailsEnabled(t *testing.T) {
+	type want struct {
+		upToDate bool
+		spec     *bool
+	}
+
+	cases := map[string]struct {
+		emailsDisabled *bool
+		emailsEnabled  *bool
+		version        *common.ServerVersion
+		observed       *gitlab.Group
+		want
+	}{
+		"EmailsEnabled": {
+			emailsEnabled: ptr.To(true),
+			observed:      &gitlab.Group{EmailsEnabled: true},
+			want:          want{upToDate: true, spec: ptr.To(true)},
+		},
+		"EmailsEnabledNotUpToDate": {
+			emailsEnabled: ptr.To(false),
+			observed:      &gitlab.Group{EmailsEnabled: true},
+			want:          want{spec: ptr.To(false)},
+		},
+		"DeprecatedEmailsDisabled": {
+			emailsDisabled: ptr.To(true),
+			observed:       &gitlab.Group{EmailsEnabled: false},
+			want:           want{upToDate: true, spec: ptr.To(false)},
+		},
+		"DeprecatedEmailsDisabledNotUpToDate": {
+			emailsDisabled: ptr.To(true),
+			observed:       &gitlab.Group{EmailsEnabled: true},
+			want:           want{spec: ptr.To(false)},
+		},
+		"ServerBeforeRename": {
+			emailsEnabled: ptr.To(false),
+			version:       &common.ServerVersion{Major: 16, Minor: 4},
+			observed:      &gitlab.Group{EmailsDisabled: true},
+			want:          want{upToDate: true, spec: ptr.To(false)},
+		},
+		"Unmanaged": {
+			observed: &gitlab.Group{EmailsEnabled: true},
+			want:     want{upToDate: true, spec: ptr.To(true)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := group(withClientDefaultValues(), withExternalName(extName))
+			cr.Spec.ForProvider.EmailsDisabled = tc.emailsDisabled
+			cr.Spec.ForProvider.EmailsEnabled = tc.emailsEnabled
+
+			e := &external{
+				client: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						tc.observed.ID = groupID
+						return tc.observed, &gitlab.Response{}, nil
+					},
+				},
+				version: tc.version,
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if o.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("Observe(...): want ResourceUpToDate %t, got %t", tc.want.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.want.spec, cr.Spec.ForProvider.EmailsEnabled); diff != "" {
+				t.Errorf("Observe(...): -want emailsEnabled, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComputeMinutes(t *testing.T) {
+	type want struct {
+		upToDate bool
+		reason   xpv1.ConditionReason
+		updated  *groups.ComputeMinutes
+	}
+
+	cases := map[string]struct {
+		spec     v1alpha1.GroupParameters
+		observed *groups.ComputeMinutes
+		want
+	}{
+		"Unmanaged": {
+			want: want{upToDate: true},
+		},
+		"UpToDate": {
+			spec:     v1alpha1.GroupParameters{SharedRunnersMinutesLimit: ptr.To[int64](400)},
+			observed: &groups.ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](400), Available: true},
+			want:     want{upToDate: true},
+		},
+		"NotUpToDate": {
+			spec:     v1alpha1.GroupParameters{SharedRunnersMinutesLimit: ptr.To[int64](400), ExtraSharedRunnersMinutesLimit: ptr.To[int64](0)},
+			observed: &groups.ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](400), Available: true},
+			want: want{
+				updated: &groups.ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](400), ExtraSharedRunnersMinutesLimit: ptr.To[int64](0)},
+			},
+		},
+		"UnsupportedByLicense": {
+			spec:     v1alpha1.GroupParameters{SharedRunnersMinutesLimit: ptr.To[int64](400)},
+			observed: &groups.ComputeMinutes{},
+			want: want{
+				upToDate: true,
+				reason:   common.ReasonUnsupportedByLicense,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := group(withClientDefaultValues(), withExternalName(extName))
+			cr.Spec.ForProvider.SharedRunnersMinutesLimit = tc.spec.SharedRunnersMinutesLimit
+			cr.Spec.ForProvider.ExtraSharedRunnersMinutesLimit = tc.spec.ExtraSharedRunnersMinutesLimit
+
+			var updated *groups.ComputeMinutes
+			e := &external{
+				client: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.SharedRunnersMinutesLimit != nil || opt.ExtraSharedRunnersMinutesLimit != nil {
+							return nil, nil, errors.New("compute minutes quotas must not be sent with the group")
+						}
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				minutes: &fake.MockComputeMinutesClient{
+					MockGetGroupComputeMinutes: func(gid int64, options ...gitlab.RequestOptionFunc) (*groups.ComputeMinutes, *gitlab.Response, error) {
+						if tc.observed == nil {
+							return nil, nil, errors.New("compute minutes quotas must not be read")
+						}
+						return tc.observed, &gitlab.Response{}, nil
+					},
+					MockUpdateGroupComputeMinutes: func(gid int64, opt *groups.ComputeMinutes, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						updated = opt
+						return &gitlab.Response{}, nil
+					},
+				},
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if o.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("Observe(...): want ResourceUpToDate %t, got %t", tc.want.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.want.reason, cr.GetCondition(common.TypeUnsupportedFeatures).Reason); diff != "" {
+				t.Errorf("Observe(...): -want reason, +got reason:\n%s", diff)
+			}
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Update(...): -want compute minutes quotas, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAvatar(t *testing.T) {
+	image := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	imageHash := projects.HashAvatar(image)
+	avatarURL := "https://gitlab.example.com/uploads/-/system/group/avatar/1234/avatar.png"
+	gravatarURL := "https://secure.gravatar.com/avatar/205e460b479e2e5b48aec07710c08d50?s=80&d=identicon"
+	fromSecret := &v1alpha1.GroupAvatar{SecretRef: &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "avatar"},
+		Key:             "avatar.png",
+	}}
+
+	type want struct {
+		upToDate bool
+		uploaded []byte
+		cleared  bool
+		hash     string
+		checked  string
+	}
+
+	cases := map[string]struct {
+		avatar    *v1alpha1.GroupAvatar
+		avatarURL string
+		observed  []byte
+		hash      string
+		checked   string
+		want
+	}{
+		"Unmanaged": {
+			avatarURL: avatarURL,
+			want:      want{upToDate: true},
+		},
+		"Upload": {
+			avatar: fromSecret,
+			want:   want{uploaded: image, hash: imageHash},
+		},
+		"UpToDate": {
+			avatar:    fromSecret,
+			avatarURL: avatarURL,
+			observed:  image,
+			hash:      imageHash,
+			want:      want{upToDate: true, hash: imageHash, checked: avatarURL},
+		},
+		"URLUnchanged": {
+			avatar:    fromSecret,
+			avatarURL: avatarURL,
+			hash:      imageHash,
+			checked:   avatarURL,
+			want:      want{upToDate: true, hash: imageHash, checked: avatarURL},
+		},
+		"URLChanged": {
+			avatar:    fromSecret,
+			avatarURL: avatarURL,
+			observed:  image,
+			hash:      imageHash,
+			checked:   "https://gitlab.example.com/uploads/-/system/group/avatar/1234/old.png",
+			want:      want{upToDate: true, hash: imageHash, checked: avatarURL},
+		},
+		"ReplacedOutsideProvider": {
+			avatar:    fromSecret,
+			avatarURL: avatarURL,
+			observed:  []byte("GIF89a\x01\x00\x01\x00"),
+			hash:      imageHash,
+			want:      want{uploaded: image, hash: imageHash},
+		},
+		"Clear": {
+			avatar:    &v1alpha1.GroupAvatar{},
+			avatarURL: avatarURL,
+			hash:      imageHash,
+			want:      want{cleared: true},
+		},
+		"ClearedGravatar": {
+			avatar:    &v1alpha1.GroupAvatar{},
+			avatarURL: gravatarURL,
+			want:      want{upToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := group(withClientDefaultValues(), withExternalName(extName), withStatus(v1alpha1.GroupObservation{AvatarHash: tc.hash, AvatarURLChecked: tc.checked}))
+			cr.Spec.ForProvider.Avatar = tc.avatar
+
+			var uploaded []byte
+			cleared := false
+			e := &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.Secret) = corev1.Secret{Data: map[string][]byte{"avatar.png": image}}
+						return nil
+					}),
+				},
+				client: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID, AvatarURL: tc.avatarURL}, &gitlab.Response{}, nil
+					},
+					MockDownloadAvatar: func(gid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+						if tc.observed == nil {
+							return nil, nil, errors.New("the avatar must not be downloaded")
+						}
+						return bytes.NewReader(tc.observed), &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.Avatar != nil {
+							cleared = true
+						}
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockUploadAvatar: func(gid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						uploaded, _ = io.ReadAll(avatar)
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if o.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("Observe(...): want ResourceUpToDate %t, got %t", tc.want.upToDate, o.ResourceUpToDate)
+			}
+			if !o.ResourceUpToDate {
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatalf("Update(...): %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.uploaded, uploaded); diff != "" {
+				t.Errorf("UploadAvatar(...): -want, +got:\n%s", diff)
+			}
+			if cleared != tc.want.cleared {
+				t.Errorf("Update(...): want avatar cleared %t, got %t", tc.want.cleared, cleared)
+			}
+			if diff := cmp.Diff(tc.want.hash, cr.Status.AtProvider.AvatarHash); diff != "" {
+				t.Errorf("status.atProvider.avatarHash: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.checked, cr.Status.AtProvider.AvatarURLChecked); diff != "" {
+				t.Errorf("status.atProvider.avatarUrlChecked: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type want struct {
 		cr     resource.Managed
@@ -670,6 +1117,23 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulCreationWithPathFormat": {
+			args: args{
+				group: &fake.MockClient{
+					MockCreateGroup: func(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID, FullPath: path}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withAnnotations(map[string]string{common.AnnotationKeyExternalNameFormat: common.ExternalNameFormatPath})),
+			},
+			want: want{
+				cr: group(
+					withAnnotations(map[string]string{common.AnnotationKeyExternalNameFormat: common.ExternalNameFormatPath}),
+					withExternalName(path),
+				),
+				result: managed.ExternalCreation{},
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				group: &fake.MockClient{
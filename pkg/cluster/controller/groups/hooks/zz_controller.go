@@ -0,0 +1,367 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package hooks
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotGroupHook     = "managed resource is not a Gitlab group hook custom resource"
+	errGroupIDMissing   = "GroupID is missing"
+	errIDNotInt         = "external name is not a Gitlab group hook ID"
+	errGetFailed        = "cannot get Gitlab group hook"
+	errCreateFailed     = "cannot create Gitlab group hook"
+	errUpdateFailed     = "cannot update Gitlab group hook"
+	errDeleteFailed     = "cannot delete Gitlab group hook"
+	errSecretRefInvalid = "invalid token reference"
+	errHeaderRefInvalid = "invalid custom header value reference"
+	errURLRefInvalid    = "invalid URL reference"
+	errDeleteHeader     = "cannot delete Gitlab group hook custom header"
+)
+
+// SetupGroupHook adds a controller that reconciles GroupHooks.
+func SetupGroupHook(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.GroupHookGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewHookClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GroupHookGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.GroupHookList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GroupHook{}).
+		Complete(r)
+}
+
+// SetupGroupHookGated adds a controller with CRD gate support.
+func SetupGroupHookGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupGroupHook(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.GroupHookGroupVersionKind.String())
+		}
+	}, v1alpha1.GroupHookGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) groups.HookClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupHook)
+	if !ok {
+		return nil, errors.New(errNotGroupHook)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.HookClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupHook)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupHook)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	hookID, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	hook, res, err := e.client.GetGroupHook(*cr.Spec.ForProvider.GroupID, hookID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	url, _, err := e.hookURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	groups.LateInitializeHook(&cr.Spec.ForProvider, hook)
+
+	headersHash := cr.Status.AtProvider.CustomHeadersHash
+	rotatedAt := cr.Status.AtProvider.TokenRotatedAt
+	cr.Status.AtProvider = groups.GenerateHookObservation(hook)
+	cr.Status.AtProvider.CustomHeadersHash = headersHash
+	cr.Status.AtProvider.TokenRotatedAt = rotatedAt
+	cr.Status.SetConditions(xpv1.Available())
+
+	// GitLab does not return the custom header values, so they are compared
+	// against the hash of the values last pushed instead.
+	upToDate := groups.IsHookUpToDate(withResolvedURL(&cr.Spec.ForProvider, url), hook)
+	if upToDate {
+		headers, err := e.getCustomHeaders(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = common.HashHookCustomHeaders(headers) == headersHash
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate && !common.IsHookTokenRotationDue(cr.Spec.ForProvider.RotateEvery, rotatedAt, time.Now()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupHook)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupHook)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	url, secretURL, err := e.hookURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	token, details, err := e.getToken(ctx, cr, true)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	headers, err := e.getCustomHeaders(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	hook, _, err := e.client.AddGroupHook(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateAddHookOptions(withResolvedURL(&cr.Spec.ForProvider, url), token, headers),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, common.RedactError(errors.Wrap(err, errCreateFailed), secretURL)
+	}
+
+	cr.Status.AtProvider.CustomHeadersHash = common.HashHookCustomHeaders(headers)
+	setTokenRotated(cr, details)
+	meta.SetExternalName(cr, strconv.FormatInt(hook.ID, 10))
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GroupHook)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupHook)
+	}
+
+	hookID, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	url, secretURL, err := e.hookURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	rotate := common.IsHookTokenRotationDue(cr.Spec.ForProvider.RotateEvery, cr.Status.AtProvider.TokenRotatedAt, time.Now())
+	token, details, err := e.getToken(ctx, cr, rotate)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	headers, err := e.getCustomHeaders(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = e.client.EditGroupHook(
+		*cr.Spec.ForProvider.GroupID,
+		hookID,
+		groups.GenerateEditHookOptions(withResolvedURL(&cr.Spec.ForProvider, url), token, headers),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, common.RedactError(errors.Wrap(err, errUpdateFailed), secretURL)
+	}
+
+	for _, key := range common.RemovedHookCustomHeaderKeys(groups.HookCustomHeaderKeys(&cr.Spec.ForProvider), cr.Status.AtProvider.CustomHeaderKeys) {
+		res, err := e.client.DeleteGroupCustomHeader(*cr.Spec.ForProvider.GroupID, hookID, key, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteHeader)
+		}
+	}
+	cr.Status.AtProvider.CustomHeadersHash = common.HashHookCustomHeaders(headers)
+	setTokenRotated(cr, details)
+
+	return managed.ExternalUpdate{ConnectionDetails: details}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.GroupHook)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotGroupHook)
+	}
+
+	hookID, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalDelete{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteGroupHook(*cr.Spec.ForProvider.GroupID, hookID, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getToken returns the secret token of the hook, or nil if the hook does not
+// reference one. A token rotated by the provider is only generated if rotate
+// is true, and returned with the connection details publishing it.
+func (e *external) getToken(ctx context.Context, cr *v1alpha1.GroupHook, rotate bool) (*string, managed.ConnectionDetails, error) {
+	if cr.Spec.ForProvider.RotateEvery != nil {
+		if cr.Spec.ForProvider.TokenSecretRef != nil {
+			return nil, nil, errors.New(common.ErrHookTokenRotationConflict)
+		}
+		if !rotate {
+			return nil, nil, nil
+		}
+		token, details, err := common.RotateHookToken(ctx, e.kube, cr)
+		return &token, details, err
+	}
+	if cr.Spec.ForProvider.TokenSecretRef == nil {
+		return nil, nil, nil
+	}
+	token, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, cr.Spec.ForProvider.TokenSecretRef)
+	return token, nil, errors.Wrap(err, errSecretRefInvalid)
+}
+
+// hookURL returns the URL of the hook, read from its secret if it references
+// one. A URL read from a secret is returned as secret as well, so that it can
+// be redacted from the errors GitLab may echo it in.
+func (e *external) hookURL(ctx context.Context, cr *v1alpha1.GroupHook) (*string, string, error) {
+	p := &cr.Spec.ForProvider
+	if p.URLSecretRef == nil {
+		return p.URL, "", nil
+	}
+	url, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, p.URLSecretRef)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errURLRefInvalid)
+	}
+	return url, *url, nil
+}
+
+// withResolvedURL returns a copy of the parameters with the resolved URL,
+// which is never written to the spec or status of the hook.
+func withResolvedURL(p *v1alpha1.GroupHookParameters, url *string) *v1alpha1.GroupHookParameters {
+	p = p.DeepCopy()
+	p.URL = url
+	return p
+}
+
+// setTokenRotated records the rotation of the token once GitLab accepted it.
+func setTokenRotated(cr *v1alpha1.GroupHook, details managed.ConnectionDetails) {
+	if details != nil {
+		cr.Status.AtProvider.TokenRotatedAt = ptr.To(metav1.Now())
+	}
+}
+
+// getCustomHeaders returns the custom headers of the hook with their values
+// read from the referenced secrets.
+func (e *external) getCustomHeaders(ctx context.Context, cr *v1alpha1.GroupHook) ([]*gitlab.HookCustomHeader, error) {
+	if len(cr.Spec.ForProvider.CustomHeaders) == 0 {
+		return nil, nil
+	}
+	headers := make([]*gitlab.HookCustomHeader, 0, len(cr.Spec.ForProvider.CustomHeaders))
+	for _, h := range cr.Spec.ForProvider.CustomHeaders {
+		value, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, &h.ValueSecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errHeaderRefInvalid)
+		}
+		headers = append(headers, &gitlab.HookCustomHeader{Key: h.Key, Value: *value})
+	}
+	return headers, nil
+}
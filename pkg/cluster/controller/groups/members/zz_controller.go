@@ -55,11 +55,11 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.MemberKubernetesGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: groups.NewMemberClient,
 			newUserClientFn:   users.NewUserClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -198,6 +198,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalUpdate{}, errors.New(errMissingUserInfo)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
 
 	_, _, err := e.client.EditGroupMember(
 		*cr.Spec.ForProvider.GroupID,
@@ -221,6 +224,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalDelete{}, errors.New(errMissingUserInfo)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
 
 	_, err := e.client.RemoveGroupMember(
 		*cr.Spec.ForProvider.GroupID,
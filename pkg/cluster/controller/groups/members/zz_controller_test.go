@@ -34,23 +34,25 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups/fake"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 var (
-	unexpecedItem resource.Managed
-	errBoom       = errors.New("boom")
-	ID            = int64(0)
-	username      = "username"
-	userID        = int64(123)
-	name          = "name"
-	state         = "state"
-	avatarURL     = "http://avatarURL"
-	webURL        = "http://webURL"
-	accessLevel   = gitlab.AccessLevelValue(30)
-	now           = time.Now()
-	expiresAt     = gitlab.ISOTime(now.AddDate(0, 0, 7*3))
-	expiresAtNew  = gitlab.ISOTime(now.AddDate(0, 0, 7*4))
-	groupID       = int64(1234)
+	unexpecedItem  resource.Managed
+	errBoom        = errors.New("boom")
+	ID             = int64(0)
+	username       = "username"
+	userID         = int64(123)
+	providerUserID = int64(1)
+	name           = "name"
+	state          = "state"
+	avatarURL      = "http://avatarURL"
+	webURL         = "http://webURL"
+	accessLevel    = gitlab.AccessLevelValue(30)
+	now            = time.Now()
+	expiresAt      = gitlab.ISOTime(now.AddDate(0, 0, 7*3))
+	expiresAtNew   = gitlab.ISOTime(now.AddDate(0, 0, 7*4))
+	groupID        = int64(1234)
 )
 
 type args struct {
@@ -503,6 +505,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"SuccessfulUpdate": {
 			args: args{
+				user: currentUser(providerUserID),
 				groupMember: &fake.MockClient{
 					MockEditMember: func(gid interface{}, user int64, opt *gitlab.EditGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
 						return &gitlab.GroupMember{
@@ -532,6 +535,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedUpdate": {
 			args: args{
+				user: currentUser(providerUserID),
 				groupMember: &fake.MockClient{
 					MockEditMember: func(gid interface{}, user int64, opt *gitlab.EditGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
 						return &gitlab.GroupMember{}, &gitlab.Response{}, errBoom
@@ -551,7 +555,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.groupMember}
+			e := &external{kube: tc.kube, client: tc.groupMember, userClient: tc.user}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -588,6 +592,7 @@ func TestDelete(t *testing.T) {
 		},
 		"SuccessfulDeletion": {
 			args: args{
+				user: currentUser(providerUserID),
 				groupMember: &fake.MockClient{
 					MockRemoveMember: func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
@@ -621,7 +626,7 @@ func TestDelete(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.groupMember}
+			e := &external{kube: tc.kube, client: tc.groupMember, userClient: tc.user}
 			_, err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -634,3 +639,60 @@ func TestDelete(t *testing.T) {
 	}
 
 }
+
+func currentUser(id int64) *fake.MockClient {
+	return &fake.MockClient{
+		MockCurrentUser: func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+			return &gitlab.User{ID: id}, &gitlab.Response{}, nil
+		},
+	}
+}
+
+func TestSelfMembership(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		wantErr     bool
+	}{
+		"Refused": {
+			wantErr: true,
+		},
+		"Allowed": {
+			annotations: map[string]string{common.AnnotationKeyAllowSelfMembershipChange: "true"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var edited, removed bool
+			e := &external{
+				client: &fake.MockClient{
+					MockEditMember: func(gid interface{}, user int64, opt *gitlab.EditGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+						edited = true
+						return &gitlab.GroupMember{}, &gitlab.Response{}, nil
+					},
+					MockRemoveMember: func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						removed = true
+						return &gitlab.Response{}, nil
+					},
+				},
+				// The managed member is the user the provider authenticates as.
+				userClient: currentUser(userID),
+			}
+			cr := groupMember(
+				withGroupID(),
+				withSpec(v1alpha1.MemberParameters{UserID: &userID, GroupID: &groupID}),
+			)
+			cr.SetAnnotations(tc.annotations)
+
+			if _, err := e.Update(context.Background(), cr); (err != nil) != tc.wantErr {
+				t.Errorf("Update(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if _, err := e.Delete(context.Background(), cr); (err != nil) != tc.wantErr {
+				t.Errorf("Delete(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if edited == tc.wantErr || removed == tc.wantErr {
+				t.Errorf("want the membership changed %t, got edited %t, removed %t", !tc.wantErr, edited, removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package pushrules
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotGroupPushRules = "managed resource is not a Gitlab group push rules custom resource"
+	errGetFailed         = "cannot get Gitlab group push rules"
+	errCreateFailed      = "cannot create Gitlab group push rules"
+	errUpdateFailed      = "cannot update Gitlab group push rules"
+	errDeleteFailed      = "cannot delete Gitlab group push rules"
+	errGroupIDMissing    = "GroupID is missing"
+
+	featureGroupPushRules = "group push rules"
+)
+
+// SetupGroupPushRules adds a controller that reconciles GroupPushRules.
+func SetupGroupPushRules(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.GroupPushRulesGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewPushRulesClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GroupPushRulesGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.GroupPushRulesList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GroupPushRules{}).
+		Complete(r)
+}
+
+// SetupGroupPushRulesGated adds a controller with CRD gate support.
+func SetupGroupPushRulesGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupGroupPushRules(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.GroupPushRulesGroupVersionKind.String())
+		}
+	}, v1alpha1.GroupPushRulesGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) groups.PushRulesClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupPushRules)
+	if !ok {
+		return nil, errors.New(errNotGroupPushRules)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.PushRulesClient
+}
+
+// Observe checks whether the group has push rules. A group has at most one
+// set of push rules, so they are looked up by the group alone.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupPushRules)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupPushRules)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	rules, res, err := e.client.GetGroupPushRules(*cr.Spec.ForProvider.GroupID, gitlab.WithContext(ctx))
+	if err != nil {
+		// GitLab answers 404 both when the group has no push rules yet and
+		// when push rules are not available, creating them tells them apart.
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	if groups.GeneratePushRules(rules) == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	groups.LateInitializeGroupPushRules(&cr.Spec.ForProvider, rules)
+
+	cr.Status.AtProvider = groups.GenerateGroupPushRulesObservation(rules)
+	// resets a license restriction reported earlier, e.g. before an upgrade
+	common.SetUnsupportedFeatures(cr, nil, nil)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        groups.IsGroupPushRulesUpToDate(&cr.Spec.ForProvider, rules),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create adds the push rules to the group.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupPushRules)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupPushRules)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, res, err := e.client.AddGroupPushRule(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateAddGroupPushRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		// Without a license including push rules GitLab denies the request,
+		// the Community Edition does not know the endpoint at all.
+		if clients.IsResponseForbidden(res) || clients.IsResponseNotFound(res) {
+			common.SetUnsupportedByLicense(cr, featureGroupPushRules)
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update edits the push rules of the group to match the managed resource.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GroupPushRules)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupPushRules)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	_, _, err := e.client.EditGroupPushRule(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateEditGroupPushRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+// Delete removes the push rules from the group.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.GroupPushRules)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotGroupPushRules)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalDelete{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	res, err := e.client.DeleteGroupPushRule(*cr.Spec.ForProvider.GroupID, gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
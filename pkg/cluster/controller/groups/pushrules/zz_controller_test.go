@@ -0,0 +1,455 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package pushrules
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	groupID        = int64(1234)
+
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	forbidden = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+)
+
+type args struct {
+	client groups.PushRulesClient
+	cr     resource.Managed
+}
+
+type pushRulesModifier func(*v1alpha1.GroupPushRules)
+
+func withGroupID() pushRulesModifier {
+	return func(r *v1alpha1.GroupPushRules) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withRules(p commonv1alpha1.PushRules) pushRulesModifier {
+	return func(r *v1alpha1.GroupPushRules) { r.Spec.ForProvider.PushRules = p }
+}
+
+func withConditions(c ...xpv1.Condition) pushRulesModifier {
+	return func(r *v1alpha1.GroupPushRules) { r.Status.SetConditions(c...) }
+}
+
+func withUnsupportedByLicense() pushRulesModifier {
+	return func(r *v1alpha1.GroupPushRules) { common.SetUnsupportedByLicense(r, featureGroupPushRules) }
+}
+
+func withStatus(s v1alpha1.GroupPushRulesObservation) pushRulesModifier {
+	return func(r *v1alpha1.GroupPushRules) { r.Status.AtProvider = s }
+}
+
+func groupPushRules(m ...pushRulesModifier) *v1alpha1.GroupPushRules {
+	cr := &v1alpha1.GroupPushRules{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// allRules returns the push rules as observed for gitlabRules.
+func allRules(maxFileSize int64, preventSecrets bool) commonv1alpha1.PushRules {
+	return commonv1alpha1.PushRules{
+		AuthorEmailRegex:           ptr.To(""),
+		BranchNameRegex:            ptr.To("^(main|feature/.*)$"),
+		CommitCommitterCheck:       ptr.To(false),
+		CommitCommitterNameCheck:   ptr.To(false),
+		CommitMessageNegativeRegex: ptr.To(""),
+		CommitMessageRegex:         ptr.To(""),
+		DenyDeleteTag:              ptr.To(true),
+		FileNameRegex:              ptr.To(""),
+		MaxFileSize:                ptr.To(maxFileSize),
+		MemberCheck:                ptr.To(false),
+		PreventSecrets:             ptr.To(preventSecrets),
+		RejectUnsignedCommits:      ptr.To(false),
+		RejectNonDCOCommits:        ptr.To(false),
+	}
+}
+
+func gitlabRules() *gitlab.GroupPushRules {
+	return &gitlab.GroupPushRules{
+		ID:              7,
+		BranchNameRegex: "^(main|feature/.*)$",
+		DenyDeleteTag:   true,
+		MaxFileSize:     100,
+		PreventSecrets:  true,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupPushRules)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupPushRules()},
+			want: want{cr: groupPushRules(), err: errors.New(errGroupIDMissing)},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{cr: groupPushRules(withGroupID())},
+		},
+		"NotYetCreated": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return &gitlab.GroupPushRules{}, nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{cr: groupPushRules(withGroupID())},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{cr: groupPushRules(withGroupID()), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return gitlabRules(), nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID(), withRules(allRules(100, true))),
+			},
+			want: want{
+				cr: groupPushRules(
+					withGroupID(),
+					withRules(allRules(100, true)),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupPushRulesObservation{ID: 7}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return gitlabRules(), nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID(), withRules(commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(100))})),
+			},
+			want: want{
+				cr: groupPushRules(
+					withGroupID(),
+					withRules(allRules(100, true)),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupPushRulesObservation{ID: 7}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupPushRules: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return gitlabRules(), nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID(), withRules(commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(50)), PreventSecrets: ptr.To(false)})),
+			},
+			want: want{
+				cr: groupPushRules(
+					withGroupID(),
+					withRules(allRules(50, false)),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupPushRulesObservation{ID: 7}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupPushRules)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupPushRules()},
+			want: want{cr: groupPushRules(), err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupPushRule: func(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						if diff := cmp.Diff(&gitlab.AddGroupPushRuleOptions{MaxFileSize: ptr.To(int64(100))}, opt); diff != "" {
+							return nil, nil, errors.New(diff)
+						}
+						return gitlabRules(), nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID(), withRules(commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(100))})),
+			},
+			want: want{
+				cr: groupPushRules(
+					withGroupID(),
+					withRules(commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(100))}),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupPushRule: func(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{
+				cr:  groupPushRules(withGroupID(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"UnsupportedByLicenseForbidden": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupPushRule: func(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, forbidden, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{
+				cr:  groupPushRules(withGroupID(), withConditions(xpv1.Creating()), withUnsupportedByLicense()),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"UnsupportedByLicenseNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupPushRule: func(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{
+				cr:  groupPushRules(withGroupID(), withConditions(xpv1.Creating()), withUnsupportedByLicense()),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{err: errors.New(errNotGroupPushRules)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupPushRules()},
+			want: want{err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditGroupPushRule: func(gid any, opt *gitlab.EditGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						if diff := cmp.Diff(&gitlab.EditGroupPushRuleOptions{PreventSecrets: ptr.To(false)}, opt); diff != "" {
+							return nil, nil, errors.New(diff)
+						}
+						return gitlabRules(), nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID(), withRules(commonv1alpha1.PushRules{PreventSecrets: ptr.To(false)})),
+			},
+			want: want{},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditGroupPushRule: func(gid any, opt *gitlab.EditGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{err: errors.Wrap(errBoom, errUpdateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{err: errors.New(errNotGroupPushRules)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupPushRules()},
+			want: want{err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupPushRule: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, nil
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupPushRule: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupPushRule: func(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, errBoom
+					},
+				},
+				cr: groupPushRules(withGroupID()),
+			},
+			want: want{err: errors.Wrap(errBoom, errDeleteFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package serviceaccountaccesstokens
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotServiceAccountAccessToken = "managed resource is not a Gitlab service account access token custom resource"
+	errFailedParseID                = "cannot parse service account access token ID to int"
+	errGetFailed                    = "cannot get Gitlab service account access token"
+	errCreateFailed                 = "cannot create Gitlab service account access token"
+	errDeleteFailed                 = "cannot revoke Gitlab service account access token"
+	errMissingGroupID               = "missing Spec.ForProvider.GroupID"
+	errMissingServiceAccountID      = "missing Spec.ForProvider.ServiceAccountID"
+
+	featureServiceAccountAccessTokens = "group service account access tokens"
+	listPageSize                      = 100
+)
+
+// SetupServiceAccountAccessToken adds a controller that reconciles group
+// ServiceAccountAccessTokens.
+func SetupServiceAccountAccessToken(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.ServiceAccountAccessTokenGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewServiceAccountAccessTokenClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceAccountAccessTokenGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ServiceAccountAccessTokenList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ServiceAccountAccessToken{}).
+		Complete(r)
+}
+
+// SetupServiceAccountAccessTokenGated adds a controller with CRD gate support.
+func SetupServiceAccountAccessTokenGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupServiceAccountAccessToken(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ServiceAccountAccessTokenGroupVersionKind.String())
+		}
+	}, v1alpha1.ServiceAccountAccessTokenGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) groups.ServiceAccountAccessTokenClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountAccessToken)
+	if !ok {
+		return nil, errors.New(errNotServiceAccountAccessToken)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.ServiceAccountAccessTokenClient
+}
+
+// Observe looks the token up in the token list of the service account, as
+// GitLab has no endpoint to get a single service account token. An inactive
+// token, i.e. a revoked or expired one, is reported as missing so that a new
+// token is created and published.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountAccessToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccountAccessToken)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	tokenID, err := strconv.ParseInt(externalName, 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFailedParseID)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.ServiceAccountID == nil {
+		return managed.ExternalObservation{}, errors.New(errMissingServiceAccountID)
+	}
+
+	at, res, err := e.getToken(ctx, *cr.Spec.ForProvider.GroupID, *cr.Spec.ForProvider.ServiceAccountID, tokenID)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		if clients.IsResponseForbidden(res) {
+			common.SetUnsupportedByLicense(cr, featureServiceAccountAccessTokens)
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	if at == nil || !at.Active {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = groups.GenerateServiceAccountAccessTokenObservation(at)
+	common.SetTokenExpiry(cr, (*time.Time)(at.ExpiresAt))
+	// resets a license restriction reported earlier, e.g. before an upgrade
+	common.SetUnsupportedFeatures(cr, nil, nil)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: false,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountAccessToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccountAccessToken)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.ServiceAccountID == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingServiceAccountID)
+	}
+
+	at, res, err := e.client.CreateServiceAccountPersonalAccessToken(
+		*cr.Spec.ForProvider.GroupID,
+		*cr.Spec.ForProvider.ServiceAccountID,
+		groups.GenerateCreateServiceAccountAccessTokenOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		if clients.IsResponseForbidden(res) {
+			common.SetUnsupportedByLicense(cr, featureServiceAccountAccessTokens)
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(at.ID, 10))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{"token": []byte(at.Token)},
+	}, nil
+}
+
+// Update is a no-op as all parameters are immutable, expired tokens are
+// replaced by Create.
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountAccessToken)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotServiceAccountAccessToken)
+	}
+
+	tokenID, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errFailedParseID)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalDelete{}, errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.ServiceAccountID == nil {
+		return managed.ExternalDelete{}, errors.New(errMissingServiceAccountID)
+	}
+
+	res, err := e.client.RevokeServiceAccountPersonalAccessToken(
+		*cr.Spec.ForProvider.GroupID,
+		*cr.Spec.ForProvider.ServiceAccountID,
+		tokenID,
+		gitlab.WithContext(ctx),
+	)
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getToken pages through the tokens of the service account until the token
+// with the given ID is found. It returns nil if there is no such token.
+func (e *external) getToken(ctx context.Context, gid, serviceAccount, id int64) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	opt := &gitlab.ListServiceAccountPersonalAccessTokensOptions{
+		ListOptions: gitlab.ListOptions{PerPage: listPageSize, Page: 1},
+	}
+	for {
+		tokens, res, err := e.client.ListServiceAccountPersonalAccessTokens(gid, serviceAccount, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, res, err
+		}
+		if at := groups.FindServiceAccountAccessToken(tokens, id); at != nil {
+			return at, res, nil
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, res, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
@@ -40,6 +40,7 @@ import (
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/instance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 var (
@@ -174,6 +175,10 @@ func withConditions(c ...xpv1.Condition) serviceAccountModifier {
 	return func(r *v1alpha1.ServiceAccount) { r.Status.SetConditions(c...) }
 }
 
+func withUnsupportedByLicense() serviceAccountModifier {
+	return func(r *v1alpha1.ServiceAccount) { common.SetUnsupportedByLicense(r, featureServiceAccounts) }
+}
+
 func withDeletionTimestamp(t metav1.Time) serviceAccountModifier {
 	return func(r *v1alpha1.ServiceAccount) { r.ObjectMeta.DeletionTimestamp = &t }
 }
@@ -340,6 +345,12 @@ func TestCreate(t *testing.T) {
 			}}, cr: serviceAccount(withSpec(desired))},
 			want: want{cr: serviceAccount(withSpec(desired), withConditions(xpv1.Creating())), err: errors.Wrap(errBoom, errCreateFailed)},
 		},
+		"ErrCreateUnlicensed": {
+			args: args{groupsClient: &MockGroupsClient{MockCreateServiceAccount: func(gid any, opt *gitlab.CreateServiceAccountOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupServiceAccount, *gitlab.Response, error) {
+				return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+			}}, cr: serviceAccount(withSpec(desired))},
+			want: want{cr: serviceAccount(withSpec(desired), withConditions(xpv1.Creating()), withUnsupportedByLicense()), err: errors.Wrap(errBoom, errCreateFailed)},
+		},
 		"Successful": {
 			args: args{groupsClient: &MockGroupsClient{MockCreateServiceAccount: func(gid any, opt *gitlab.CreateServiceAccountOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupServiceAccount, *gitlab.Response, error) {
 				assertGroupID(t, gid, desired.GroupID)
@@ -26,11 +26,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
-	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,7 +48,12 @@ const (
 	errCreateFailed   = "cannot create Gitlab variable"
 	errUpdateFailed   = "cannot update Gitlab variable"
 	errDeleteFailed   = "cannot delete Gitlab variable"
+	errListFailed     = "cannot list Gitlab variables"
+	errPruneFailed    = "cannot delete Gitlab variable in environment scope %q"
 	errGroupIDMissing = "GroupID is missing"
+	errSearchFailed   = "cannot resolve GroupID by searching groups"
+
+	listPageSize = 100
 )
 
 // SetupVariable adds a controller that reconciles Variables.
@@ -55,7 +61,7 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.VariableGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient, newSearchClientFn: groups.NewSearchClient, serverVersionFn: common.GetServerVersion, etags: common.NewETagCache[gitlab.GroupVariable]()})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -95,6 +101,9 @@ func SetupVariableGated(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg common.Config) groups.VariableClient
+	newSearchClientFn func(cfg common.Config) groups.SearchClient
+	serverVersionFn   func(ctx context.Context, cfg common.Config) *common.ServerVersion
+	etags             *common.ETagCache[gitlab.GroupVariable]
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -106,12 +115,23 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	var search groups.SearchClient
+	if c.newSearchClientFn != nil {
+		search = c.newSearchClientFn(*cfg)
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), search: search, etags: c.etags, version: version}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.VariableClient
+	kube    client.Client
+	client  groups.VariableClient
+	search  groups.SearchClient
+	etags   *common.ETagCache[gitlab.GroupVariable]
+	version *common.ServerVersion
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -119,20 +139,31 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotVariable)
 	}
+
+	// Report the variable as gone so the finalizer can be removed.
+	if common.SkipExternalDelete(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+	resolved, err := e.searchGroupID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSearchFailed)
+	}
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
 	}
 
-	variable, res, err := e.client.GetVariable(
-		*cr.Spec.ForProvider.GroupID,
-		cr.Spec.ForProvider.Key,
-		groups.GenerateGetVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx))
+	bound := boundParameters(cr)
+	variable, res, err := e.getVariable(ctx, cr, bound)
+	// Update moves the variable to the desired environment scope but cannot
+	// persist the external name, so the variable is looked up there as well.
+	if err != nil && clients.IsResponseNotFound(res) && !ptr.Equal(bound.EnvironmentScope, cr.Spec.ForProvider.EnvironmentScope) {
+		variable, res, err = e.getVariable(ctx, cr, &cr.Spec.ForProvider)
+	}
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
 		}
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		return managed.ExternalObservation{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, errors.Wrap(err, errGetFailed))
 	}
 
 	// Deleting: only need to determine external resource still exists.
@@ -146,16 +177,24 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	name := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, common.VariableExternalName(variable.Key, variable.EnvironmentScope))
+
 	current := cr.Spec.ForProvider.DeepCopy()
+	adopted := variables.AdoptValue(cr, &cr.Spec.ForProvider.CommonVariableParameters, cr.Spec.ForProvider.ValueSecretRef != nil, variable.Value, variable.Hidden)
 	groups.LateInitializeVariable(&cr.Spec.ForProvider, variable)
 
+	desired := cr.Spec.ForProvider.DeepCopy()
+	common.SetUnsupportedFeatures(cr, e.version, common.OmitUnsupportedVariableParameters(&desired.CommonVariableParameters, nil, e.version))
+
 	cr.Status.SetConditions(xpv1.Available())
 	cr.Status.AtProvider = groups.GenerateVariableObservation(variable)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        groups.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        groups.IsVariableUpToDate(desired, variable),
+		ResourceLateInitialized: resolved || adopted || !clients.IsEqual(current, &cr.Spec.ForProvider) || name != meta.GetExternalName(cr),
+		ConnectionDetails:       variables.ConnectionDetails(&current.CommonVariableParameters),
 	}, nil
 }
 
@@ -170,18 +209,39 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 		}
 	}
+	variables.DefaultRaw(&cr.Spec.ForProvider.CommonVariableParameters)
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
 	}
 
+	if err := variables.ValidateVariable(&cr.Spec.ForProvider.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	// A variable named by the external name, e.g. one being migrated, is
+	// adopted instead of created. It is only created if GitLab returns 404.
+	if meta.GetExternalName(cr) != "" {
+		existing, res, err := e.getVariable(ctx, cr, boundParameters(cr))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetFailed)
+		}
+		if err == nil && existing != nil {
+			meta.SetExternalName(cr, common.VariableExternalName(existing.Key, existing.EnvironmentScope))
+			return managed.ExternalCreation{}, nil
+		}
+	}
+
 	cr.Status.SetConditions(xpv1.Creating())
 	_, _, err := e.client.CreateVariable(
 		*cr.Spec.ForProvider.GroupID,
-		groups.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
+		groups.GenerateCreateVariableOptions(e.supportedParameters(cr)),
 		gitlab.WithContext(ctx))
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		// GitLab may echo the value, which must never end up in events or logs.
+		return managed.ExternalCreation{}, common.RedactError(errors.Wrap(err, errCreateFailed), ptr.Deref(cr.Spec.ForProvider.Value, ""))
 	}
+
+	meta.SetExternalName(cr, common.VariableExternalName(cr.Spec.ForProvider.Key, ptr.Deref(cr.Spec.ForProvider.EnvironmentScope, "")))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -200,13 +260,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
 	}
 
+	if err := variables.ValidateVariable(&cr.Spec.ForProvider.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	// The filter matches the environment scope the variable is bound to, so
+	// that a changed scope in the spec moves the variable.
+	opt := groups.GenerateUpdateVariableOptions(e.supportedParameters(cr))
+	opt.Filter = groups.GenerateVariableFilter(boundParameters(cr))
+
 	_, _, err := e.client.UpdateVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
-		groups.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+		opt,
 		gitlab.WithContext(ctx),
 	)
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	return managed.ExternalUpdate{}, common.RedactError(errors.Wrap(err, errUpdateFailed), ptr.Deref(cr.Spec.ForProvider.Value, ""))
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -219,21 +288,135 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errGroupIDMissing)
 	}
 
-	opts := &gitlab.RemoveGroupVariableOptions{
-		Filter: nil,
-	}
-
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.RemoveVariable(
+	// The other scopes are pruned first, since the variable is no longer
+	// observed once it is removed and the delete would not be retried.
+	if ptr.Deref(cr.Spec.ForProvider.PruneOtherScopes, false) {
+		if err := e.pruneOtherScopes(ctx, cr); err != nil {
+			return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, err)
+		}
+	}
+	res, err := e.client.RemoveVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
-		opts,
+		groups.GenerateRemoveVariableOptions(boundParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
-	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	// The variable is already gone, e.g. removed outside the provider or not
+	// in the scope of the filter anymore.
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, errors.Wrap(err, errDeleteFailed))
 }
 
 func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
 }
+
+// supportedParameters returns a copy of the variable parameters without the
+// ones the GitLab server does not support.
+// searchGroupID resolves the GroupID from the GroupIDSearch unless it is
+// already set. It returns true if the GroupID was resolved.
+func (e *external) searchGroupID(ctx context.Context, cr *v1alpha1.Variable) (bool, error) {
+	if cr.Spec.ForProvider.GroupID != nil || cr.Spec.ForProvider.GroupIDSearch == nil || e.search == nil {
+		return false, nil
+	}
+	id, err := groups.SearchGroupID(ctx, e.search, cr.Spec.ForProvider.GroupIDSearch)
+	if err != nil {
+		return false, err
+	}
+	cr.Spec.ForProvider.GroupID = &id
+	return true, nil
+}
+
+func (e *external) supportedParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	common.OmitUnsupportedVariableParameters(&p.CommonVariableParameters, nil, e.version)
+	return p
+}
+
+// getVariable returns the variable identified by the key and environment
+// scope of the given parameters.
+func (e *external) getVariable(ctx context.Context, cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	etagKey := common.ETagCacheKey(cr, *p.GroupID, p.Key, ptr.Deref(p.EnvironmentScope, ""))
+	variable, res, err := e.client.GetVariable(
+		*p.GroupID,
+		p.Key,
+		groups.GenerateGetVariableOptions(p),
+		append(e.etags.RequestOptions(etagKey), gitlab.WithContext(ctx))...)
+	variable, err = e.etags.Resolve(etagKey, variable, res, err)
+	// A GET filtered by environment scope answers 404 both if the key does
+	// not exist and if it only exists in other scopes, and has been seen to
+	// miss a variable in the requested scope. The unfiltered list tells these
+	// apart, so that a variable is only created if its scope really lacks it
+	// rather than failing as a duplicate key.
+	if err != nil && clients.IsResponseNotFound(res) && p.EnvironmentScope != nil {
+		variables, lerr := e.listVariables(ctx, cr, p)
+		if lerr != nil {
+			return nil, nil, lerr
+		}
+		if v := groups.ScopeVariable(variables, p); v != nil {
+			return v, nil, nil
+		}
+	}
+	return variable, res, err
+}
+
+// boundParameters returns a copy of the variable parameters identifying the
+// variable the resource is bound to. The environment scope is taken from the
+// external name unless it only holds the key, as it did for resources created
+// before the environment scope was part of it, or names another key.
+func boundParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	key, scope := common.ParseVariableExternalName(meta.GetExternalName(cr))
+	if key == p.Key && scope != nil {
+		p.EnvironmentScope = scope
+	}
+	return p
+}
+
+// pruneOtherScopes removes the variables with the key of the variable in all
+// environment scopes other than the one it is bound to.
+func (e *external) pruneOtherScopes(ctx context.Context, cr *v1alpha1.Variable) error {
+	bound := boundParameters(cr)
+	variables, err := e.listVariables(ctx, cr, bound)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range groups.OtherScopeVariables(variables, bound) {
+		res, err := e.client.RemoveVariable(
+			*bound.GroupID,
+			v.Key,
+			&gitlab.RemoveGroupVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope}},
+			gitlab.WithContext(ctx),
+		)
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errPruneFailed, v.EnvironmentScope)
+		}
+	}
+	return nil
+}
+
+// listVariables returns all variables of the group of the variable
+// parameters, in every environment scope.
+func (e *external) listVariables(ctx context.Context, cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) ([]*gitlab.GroupVariable, error) {
+	opt := &gitlab.ListGroupVariablesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: listPageSize, Page: 1},
+	}
+	var all []*gitlab.GroupVariable
+	for {
+		variables, res, err := e.client.ListVariables(*p.GroupID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListFailed)
+		}
+		all = append(all, variables...)
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+	return all, nil
+}
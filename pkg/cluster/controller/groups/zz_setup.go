@@ -24,12 +24,16 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/accesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/badges"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/complianceframeworks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/deploytokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/hooks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/ldapgrouplinks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/pushrules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/runners"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/samlgrouplinks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/serviceaccountaccesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/serviceaccounts"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/groups/variables"
 )
@@ -47,6 +51,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		runners.SetupRunner,
 		badges.SetupBadge,
 		serviceaccounts.SetupServiceAccount,
+		serviceaccountaccesstokens.SetupServiceAccountAccessToken,
+		pushrules.SetupGroupPushRules,
+		hooks.SetupGroupHook,
+		complianceframeworks.SetupComplianceFramework,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -69,6 +77,10 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		runners.SetupRunnerGated,
 		badges.SetupBadgeGated,
 		serviceaccounts.SetupServiceAccountGated,
+		serviceaccountaccesstokens.SetupServiceAccountAccessTokenGated,
+		pushrules.SetupGroupPushRulesGated,
+		hooks.SetupGroupHookGated,
+		complianceframeworks.SetupComplianceFrameworkGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
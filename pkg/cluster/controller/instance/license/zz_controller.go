@@ -61,10 +61,10 @@ func SetupLicense(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.LicenseGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: instance.NewLicenseClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
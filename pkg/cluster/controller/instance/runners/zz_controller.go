@@ -59,11 +59,11 @@ func SetupRunner(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.RunnerGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: runners.NewRunnerClient,
 			newRunnerClientFn: users.NewRunnerClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
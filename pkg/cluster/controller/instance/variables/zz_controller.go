@@ -29,8 +29,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
-	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,6 +47,7 @@ const (
 	errCreateFailed = "cannot create Gitlab variable"
 	errUpdateFailed = "cannot update Gitlab variable"
 	errDeleteFailed = "cannot delete Gitlab variable"
+	errNotAdmin     = "managing Gitlab instance variables requires a token of an administrator of a self-managed instance"
 )
 
 // SetupVariable adds a controller that reconciles Instance Variables.
@@ -54,7 +55,7 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.VariableGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: instance.NewVariableClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: instance.NewVariableClient, serverVersionFn: common.GetServerVersion, etags: common.NewETagCache[gitlab.InstanceVariable]()})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -95,6 +96,8 @@ func SetupVariableGated(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg common.Config) instance.VariableClient
+	serverVersionFn   func(ctx context.Context, cfg common.Config) *common.ServerVersion
+	etags             *common.ETagCache[gitlab.InstanceVariable]
 }
 
 // Connect establishes a connection to the external system.
@@ -107,13 +110,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), etags: c.etags, version: version}, nil
 }
 
 // external is an external client for Instance Variables
 type external struct {
-	kube   client.Client
-	client instance.VariableClient
+	kube    client.Client
+	client  instance.VariableClient
+	etags   *common.ETagCache[gitlab.InstanceVariable]
+	version *common.ServerVersion
 }
 
 // Observe checks if the variable exists and if it is up to date.
@@ -123,14 +132,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotVariable)
 	}
 
+	// Report the variable as gone so the finalizer can be removed.
+	if common.SkipExternalDelete(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	etagKey := common.ETagCacheKey(cr, cr.Spec.ForProvider.Key)
 	variable, res, err := e.client.GetVariable(
 		cr.Spec.ForProvider.Key,
-		gitlab.WithContext(ctx))
+		append(e.etags.RequestOptions(etagKey), gitlab.WithContext(ctx))...)
+	variable, err = e.etags.Resolve(etagKey, variable, res, err)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
 		}
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		return managed.ExternalObservation{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, common.WrapForbidden(err, res, errGetFailed, errNotAdmin))
 	}
 
 	// Deleting: only need to determine external resource still exists.
@@ -145,15 +161,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
+	adopted := variables.AdoptValue(cr, &cr.Spec.ForProvider.CommonVariableParameters, cr.Spec.ForProvider.ValueSecretRef != nil, variable.Value, false)
 	instance.LateInitializeVariable(&cr.Spec.ForProvider, variable)
 
+	desired := cr.Spec.ForProvider.DeepCopy()
+	common.SetUnsupportedFeatures(cr, e.version, common.OmitUnsupportedVariableParameters(&desired.CommonVariableParameters, nil, e.version))
+
 	cr.Status.SetConditions(xpv1.Available())
 	cr.Status.AtProvider = instance.GenerateVariableObservation(variable)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        instance.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        instance.IsVariableUpToDate(desired, variable),
+		ResourceLateInitialized: adopted || !clients.IsEqual(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       variables.ConnectionDetails(&current.CommonVariableParameters),
 	}, nil
 }
 
@@ -169,13 +190,19 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 		}
 	}
+	variables.DefaultRaw(&cr.Spec.ForProvider.CommonVariableParameters)
+
+	if err := variables.ValidateVariable(&cr.Spec.ForProvider.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
 
 	cr.Status.SetConditions(xpv1.Creating())
-	_, _, err := e.client.CreateVariable(
-		instance.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
+	_, res, err := e.client.CreateVariable(
+		instance.GenerateCreateVariableOptions(e.supportedParameters(cr)),
 		gitlab.WithContext(ctx))
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		// GitLab may echo the value, which must never end up in events or logs.
+		return managed.ExternalCreation{}, common.RedactError(common.WrapForbidden(err, res, errCreateFailed, errNotAdmin), ptr.Deref(cr.Spec.ForProvider.Value, ""))
 	}
 	return managed.ExternalCreation{}, nil
 }
@@ -193,12 +220,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	_, _, err := e.client.UpdateVariable(
+	if err := variables.ValidateVariable(&cr.Spec.ForProvider.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	_, res, err := e.client.UpdateVariable(
 		cr.Spec.ForProvider.Key,
-		instance.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+		instance.GenerateUpdateVariableOptions(e.supportedParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	return managed.ExternalUpdate{}, common.RedactError(common.WrapForbidden(err, res, errUpdateFailed, errNotAdmin), ptr.Deref(cr.Spec.ForProvider.Value, ""))
 }
 
 // Delete deletes the variable in Gitlab using the Gitlab API.
@@ -209,11 +240,15 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.RemoveVariable(
+	res, err := e.client.RemoveVariable(
 		cr.Spec.ForProvider.Key,
 		gitlab.WithContext(ctx),
 	)
-	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	// The variable is already gone, e.g. removed outside the provider.
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, common.WrapForbidden(err, res, errDeleteFailed, errNotAdmin))
 }
 
 // Disconnect disconnects from the external system (not implemented).
@@ -221,3 +256,11 @@ func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
 }
+
+// supportedParameters returns a copy of the variable parameters without the
+// ones the GitLab server does not support.
+func (e *external) supportedParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	common.OmitUnsupportedVariableParameters(&p.CommonVariableParameters, nil, e.version)
+	return p
+}
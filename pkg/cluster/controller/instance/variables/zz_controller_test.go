@@ -64,7 +64,7 @@ var (
 	errUnexpectedObjectTypeFmt = "unexpected object type %T"
 
 	variableKey         = "VARIABLE_KEY"
-	variableValue       = "1234"
+	variableValue       = "12345678"
 	variableType        = commonv1alpha1.VariableTypeEnvVar
 	variableDescription = "desc"
 	f                   = false
@@ -275,6 +275,15 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errGetFailed)},
 		},
+		"ErrGetForbidden": {
+			args: args{
+				client: &MockClient{MockGetVariable: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+				}},
+				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
+			},
+			want: want{cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
 		"ErrGet404": {
 			args: args{
 				client: &MockClient{MockGetVariable: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
@@ -413,6 +422,15 @@ func TestCreate(t *testing.T) {
 			args: args{cr: unexpectedItem},
 			want: want{cr: unexpectedItem, err: errors.New(errNotVariable)},
 		},
+		"ErrCreateForbidden": {
+			args: args{
+				client: &MockClient{MockCreateVariable: func(opt *gitlab.CreateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+				}},
+				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
+			},
+			want: want{cr: variable(withConditions(xpv1.Creating()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey, Raw: gitlab.Ptr(false)}})), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
 		"ErrCreate": {
 			args: args{
 				client: &MockClient{MockCreateVariable: func(opt *gitlab.CreateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
@@ -420,7 +438,7 @@ func TestCreate(t *testing.T) {
 				}},
 				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
 			},
-			want: want{cr: variable(withConditions(xpv1.Creating()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errCreateFailed)},
+			want: want{cr: variable(withConditions(xpv1.Creating()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey, Raw: gitlab.Ptr(false)}})), err: errors.Wrap(errBoom, errCreateFailed)},
 		},
 		"ValueSecretRef": {
 			args: args{
@@ -478,6 +496,15 @@ func TestUpdate(t *testing.T) {
 			args: args{cr: unexpectedItem},
 			want: want{cr: unexpectedItem, err: errors.New(errNotVariable)},
 		},
+		"ErrUpdateForbidden": {
+			args: args{
+				client: &MockClient{MockUpdateVariable: func(key string, opt *gitlab.UpdateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+				}},
+				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
+			},
+			want: want{cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
 		"ErrUpdate": {
 			args: args{
 				client: &MockClient{MockUpdateVariable: func(key string, opt *gitlab.UpdateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
@@ -549,6 +576,24 @@ func TestDelete(t *testing.T) {
 			},
 			want: want{cr: variable(withConditions(xpv1.Deleting()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errDeleteFailed)},
 		},
+		"ErrDeleteForbidden": {
+			args: args{
+				client: &MockClient{MockRemoveVariable: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+				}},
+				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
+			},
+			want: want{cr: variable(withConditions(xpv1.Deleting()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &MockClient{MockRemoveVariable: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+				}},
+				cr: variable(withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}})),
+			},
+			want: want{cr: variable(withConditions(xpv1.Deleting()), withSpec(v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}}))},
+		},
 		"Successful": {
 			args: args{
 				client: &MockClient{MockRemoveVariable: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
@@ -23,10 +23,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/appearance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/features"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/license"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/runners"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/serviceaccounts"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/settings"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/topics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/userimpersonationtokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/instance/variables"
 )
 
@@ -38,6 +41,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		serviceaccounts.SetupServiceAccount,
 		license.SetupLicense,
 		variables.SetupVariable,
+		features.SetupFeature,
+		topics.SetupTopic,
+		userimpersonationtokens.SetupUserImpersonationToken,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -56,6 +62,9 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		serviceaccounts.SetupServiceAccountGated,
 		license.SetupLicenseGated,
 		variables.SetupVariableGated,
+		features.SetupFeatureGated,
+		topics.SetupTopicGated,
+		userimpersonationtokens.SetupUserImpersonationTokenGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
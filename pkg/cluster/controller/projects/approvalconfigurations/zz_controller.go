@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package approvalconfigurations
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotApprovalConfiguration = "managed resource is not a GitLab approval configuration custom resource"
+	errProjectIDMissing         = "ProjectID is missing"
+	errGetFailed                = "cannot get GitLab approval configuration"
+	errCreateFailed             = "cannot adopt GitLab approval configuration"
+	errUpdateFailed             = "cannot update GitLab approval configuration"
+
+	featureApprovalConfiguration = "Merge request approval settings"
+)
+
+// SetupApprovalConfiguration adds a controller that reconciles
+// ApprovalConfigurations.
+func SetupApprovalConfiguration(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.ApprovalConfigurationGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewApprovalConfigurationClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApprovalConfigurationGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ApprovalConfigurationList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ApprovalConfiguration{}).
+		Complete(r)
+}
+
+// SetupApprovalConfigurationGated adds a controller with CRD gate support.
+func SetupApprovalConfigurationGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupApprovalConfiguration(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ApprovalConfigurationGroupVersionKind.String())
+		}
+	}, v1alpha1.ApprovalConfigurationGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.ApprovalConfigurationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalConfiguration)
+	if !ok {
+		return nil, errors.New(errNotApprovalConfiguration)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ApprovalConfigurationClient
+}
+
+// Observe reads the approval configuration of the project. Every project
+// has one, so it exists as long as the project does.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApprovalConfiguration)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	cfg, res, err := e.client.GetApprovalConfiguration(*cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		// GitLab answers 404 both for missing projects and when approval
+		// settings are not available, adopting them tells them apart.
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// The approval configuration cannot be deleted, so it is reported as
+	// gone to let the managed reconciler remove the finalizer.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeApprovalConfiguration(&cr.Spec.ForProvider, cfg)
+
+	cr.Status.AtProvider = projects.GenerateApprovalConfigurationObservation(cfg)
+	// resets a license restriction reported earlier, e.g. before an upgrade
+	common.SetUnsupportedFeatures(cr, nil, nil)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsApprovalConfigurationUpToDate(&cr.Spec.ForProvider, cfg),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create adopts the approval configuration of the project by changing it to
+// match the managed resource.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApprovalConfiguration)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, res, err := e.client.ChangeApprovalConfiguration(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateChangeApprovalConfigurationOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		// Without a license including approval settings GitLab denies the
+		// request, the Community Edition does not know the endpoint at all.
+		if clients.IsResponseForbidden(res) || clients.IsResponseNotFound(res) {
+			common.SetUnsupportedByLicense(cr, featureApprovalConfiguration)
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update changes the approval configuration of the project to match the
+// managed resource.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApprovalConfiguration)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	_, _, err := e.client.ChangeApprovalConfiguration(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateChangeApprovalConfigurationOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+// Delete leaves the approval configuration unchanged, it cannot be removed
+// from a project.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalConfiguration)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotApprovalConfiguration)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
@@ -54,10 +54,10 @@ func SetupRules(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.ApprovalRuleKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: projects.NewApprovalRulesClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
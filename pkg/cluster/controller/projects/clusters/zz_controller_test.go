@@ -0,0 +1,493 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package clusters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+var (
+	unexpectedItem    resource.Managed
+	errBoom           = errors.New("boom")
+	projectID         = "1234"
+	clusterID         = int64(42)
+	clusterName       = "production"
+	clusterDomain     = "apps.example.com"
+	environmentScope  = "production/*"
+	apiURL            = "https://k8s.example.com"
+	namespace         = "production"
+	authorizationType = "rbac"
+	token             = "k8s-token"
+	caCert            = "-----BEGIN CERTIFICATE-----\nabc\n-----END CERTIFICATE-----"
+	credentialsHash   = projects.HashClusterCredentials(token, &caCert)
+
+	gitlabCluster = &gitlab.ProjectCluster{
+		ID:               clusterID,
+		Name:             clusterName,
+		Domain:           clusterDomain,
+		EnvironmentScope: environmentScope,
+		PlatformType:     "kubernetes",
+		PlatformKubernetes: &gitlab.PlatformKubernetes{
+			APIURL:            apiURL,
+			Namespace:         namespace,
+			AuthorizationType: authorizationType,
+		},
+	}
+)
+
+type args struct {
+	cluster projects.ClusterClient
+	kube    client.Client
+	cr      resource.Managed
+}
+
+type clusterModifier func(*v1alpha1.ProjectCluster)
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *v1alpha1.ProjectCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.ProjectClusterObservation) clusterModifier {
+	return func(r *v1alpha1.ProjectCluster) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) clusterModifier {
+	return func(r *v1alpha1.ProjectCluster) { meta.SetExternalName(r, n) }
+}
+
+func withAPIURL(u string) clusterModifier {
+	return func(r *v1alpha1.ProjectCluster) { r.Spec.ForProvider.PlatformKubernetes.APIURL = u }
+}
+
+func withDefaultSpec() clusterModifier {
+	return func(r *v1alpha1.ProjectCluster) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.Name = clusterName
+		r.Spec.ForProvider.Domain = &clusterDomain
+		r.Spec.ForProvider.EnvironmentScope = &environmentScope
+		r.Spec.ForProvider.PlatformKubernetes = v1alpha1.ProjectClusterPlatformKubernetes{
+			APIURL:            apiURL,
+			TokenSecretRef:    *common.TestCreateSecretKeySelector("cluster-credentials", "token"),
+			CaCertSecretRef:   common.TestCreateSecretKeySelector("cluster-credentials", "ca.crt"),
+			Namespace:         &namespace,
+			AuthorizationType: &authorizationType,
+		}
+	}
+}
+
+func projectCluster(m ...clusterModifier) *v1alpha1.ProjectCluster {
+	cr := &v1alpha1.ProjectCluster{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(hash string) v1alpha1.ProjectClusterObservation {
+	o := projects.GenerateClusterObservation(gitlabCluster)
+	o.CredentialsHash = hash
+	return o
+}
+
+func credentialsSecret(t string) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{
+				Data: map[string][]byte{"token": []byte(t), "ca.crt": []byte(caCert)},
+			}
+			return nil
+		}),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	getCluster := func(c *gitlab.ProjectCluster, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetCluster: func(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+				return c, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotCluster),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: projectCluster(withDefaultSpec()),
+			},
+			want: want{
+				cr: projectCluster(withDefaultSpec()),
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: projectCluster(withDefaultSpec(), withExternalName("production")),
+			},
+			want: want{
+				cr:  projectCluster(withDefaultSpec(), withExternalName("production")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				cluster: getCluster(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+			want: want{
+				cr:  projectCluster(withDefaultSpec(), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ClusterNotFound": {
+			args: args{
+				cluster: getCluster(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+			want: want{
+				cr: projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				kube:    credentialsSecret(token),
+				cluster: getCluster(gitlabCluster, &gitlab.Response{}, nil),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed(credentialsHash))),
+			},
+			want: want{
+				cr: projectCluster(
+					withDefaultSpec(),
+					withExternalName("42"),
+					withStatus(observed(credentialsHash)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TokenRotated": {
+			args: args{
+				kube:    credentialsSecret("rotated"),
+				cluster: getCluster(gitlabCluster, &gitlab.Response{}, nil),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed(credentialsHash))),
+			},
+			want: want{
+				cr: projectCluster(
+					withDefaultSpec(),
+					withExternalName("42"),
+					withStatus(observed(credentialsHash)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"APIURLChanged": {
+			args: args{
+				cluster: getCluster(gitlabCluster, &gitlab.Response{}, nil),
+				cr:      projectCluster(withDefaultSpec(), withAPIURL("https://other.example.com"), withExternalName("42"), withStatus(observed(credentialsHash))),
+			},
+			want: want{
+				cr: projectCluster(
+					withDefaultSpec(),
+					withAPIURL("https://other.example.com"),
+					withExternalName("42"),
+					withStatus(observed(credentialsHash)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cluster}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotCluster),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: projectCluster(),
+			},
+			want: want{
+				cr:  projectCluster(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				kube: credentialsSecret(token),
+				cluster: &fake.MockClient{
+					MockAddCluster: func(pid any, opt *gitlab.AddClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+						if *opt.PlatformKubernetes.Token != token || *opt.PlatformKubernetes.CaCert != caCert {
+							return nil, nil, errBoom
+						}
+						return gitlabCluster, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectCluster(withDefaultSpec()),
+			},
+			want: want{
+				cr: projectCluster(
+					withDefaultSpec(),
+					withExternalName("42"),
+					withStatus(observed(credentialsHash)),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				kube: credentialsSecret(token),
+				cluster: &fake.MockClient{
+					MockAddCluster: func(pid any, opt *gitlab.AddClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectCluster(withDefaultSpec()),
+			},
+			want: want{
+				cr:  projectCluster(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cluster}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotCluster),
+			},
+		},
+		"CredentialsPushed": {
+			args: args{
+				kube: credentialsSecret(token),
+				cluster: &fake.MockClient{
+					MockEditCluster: func(pid any, cluster int64, opt *gitlab.EditClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+						if cluster != clusterID || *opt.PlatformKubernetes.Token != token {
+							return nil, nil, errBoom
+						}
+						return gitlabCluster, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed("outdated"))),
+			},
+			want: want{
+				cr: projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed(credentialsHash))),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				kube: credentialsSecret(token),
+				cluster: &fake.MockClient{
+					MockEditCluster: func(pid any, cluster int64, opt *gitlab.EditClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed("outdated"))),
+			},
+			want: want{
+				cr:  projectCluster(withDefaultSpec(), withExternalName("42"), withStatus(observed("outdated"))),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cluster}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteCluster := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteCluster: func(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotCluster),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				cluster: deleteCluster(&gitlab.Response{}, nil),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+			want: want{
+				cr: projectCluster(withDefaultSpec(), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				cluster: deleteCluster(&gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+			want: want{
+				cr: projectCluster(withDefaultSpec(), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				cluster: deleteCluster(&gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:      projectCluster(withDefaultSpec(), withExternalName("42")),
+			},
+			want: want{
+				cr:  projectCluster(withDefaultSpec(), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cluster}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
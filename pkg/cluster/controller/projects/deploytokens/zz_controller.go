@@ -57,7 +57,7 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.DeployTokenGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDeployTokenClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDeployTokenClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -147,12 +147,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeProjectDeployToken(&cr.Spec.ForProvider, dt)
 
+	changed := common.ChangedImmutableFields(projects.ImmutableDeployTokenFields(&cr.Spec.ForProvider, dt)...)
+	common.SetImmutableFieldsChanged(cr, changed)
+
 	cr.Status.AtProvider = v1alpha1.DeployTokenObservation{}
+	common.SetTokenExpiry(cr, dt.ExpiresAt)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        len(changed) == 0,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -165,6 +169,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
+	if err := common.ValidateDeployTokenScopes(cr.Spec.ForProvider.Scopes); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
 
 	dt, _, err := e.client.CreateProjectDeployToken(
 		*cr.Spec.ForProvider.ProjectID,
@@ -183,8 +190,18 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// it's not possible to update a ProjectDeployToken
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha1.DeployToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployToken)
+	}
+
+	// it's not possible to update a ProjectDeployToken, changes are either
+	// rejected or applied by creating the deploy token again.
+	_, err := common.HandleChangedImmutableFields(cr, func() error {
+		_, err := e.Delete(ctx, cr)
+		return err
+	})
+	return managed.ExternalUpdate{}, err
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -34,12 +34,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
 var (
@@ -47,7 +49,8 @@ var (
 	deployTokenID  = int64(1234)
 	sDeployTokenID = strconv.FormatInt(deployTokenID, 10)
 	unexpecedItem  resource.Managed
-	expiresAt      = time.Now()
+	expiresAt      = time.Now().AddDate(0, 6, 0)
+	expiresSoon    = time.Now().Add(20*24*time.Hour - time.Hour)
 	token          = "Token"
 	username       = "Username"
 	deployTokenObj = gitlab.DeployToken{
@@ -60,6 +63,13 @@ var (
 	}
 
 	extNameAnnotation = map[string]string{meta.AnnotationKeyExternalName: fmt.Sprint(deployTokenID)}
+
+	scopesChanged = xpv1.Condition{
+		Type:    common.TypeImmutableFieldsChanged,
+		Status:  corev1.ConditionTrue,
+		Reason:  common.ReasonRecreateRequired,
+		Message: "field forProvider.scopes is immutable, recreate required",
+	}
 )
 
 type args struct {
@@ -224,6 +234,48 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ExpiresSoon": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockGetProjectDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+						dt := deployTokenObj
+						dt.ExpiresAt = &expiresSoon
+						return &dt, &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresSoon},
+					}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyExpiryWarningDays: "30"}),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresSoon},
+					}),
+					withConditions(xpv1.Available(), xpv1.Condition{
+						Type:    common.TypeTokenExpiring,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonExpiresSoon,
+						Message: "token expires in 19 days on " + expiresSoon.UTC().Format(time.DateOnly),
+					}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyExpiryWarningDays: "30"}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 		"SuccessfulAvailable": {
 			args: args{
 				deployToken: &fake.MockClient{
@@ -257,6 +309,74 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ScopesChanged": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockGetProjectDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+						return &deployTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresAt},
+						Scopes:    []string{"scope2"},
+					}),
+					withExternalName(sDeployTokenID),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresAt},
+						Scopes:    []string{"scope2"},
+					}),
+					withConditions(scopesChanged, xpv1.Available()),
+					withExternalName(sDeployTokenID),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ScopesReordered": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockGetProjectDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+						return &deployTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresAt},
+						Scopes:    []string{"scope2", "scope1"},
+					}),
+					withExternalName(sDeployTokenID),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Username:  &username,
+						ExpiresAt: &metav1.Time{Time: expiresAt},
+						Scopes:    []string{"scope2", "scope1"},
+					}),
+					withConditions(xpv1.Available()),
+					withExternalName(sDeployTokenID),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -311,6 +431,7 @@ func TestCreate(t *testing.T) {
 					withAnnotations(extNameAnnotation),
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry"},
 					}),
 				),
 			},
@@ -319,6 +440,7 @@ func TestCreate(t *testing.T) {
 					withExternalName(sDeployTokenID),
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry"},
 					}),
 				),
 				result: managed.ExternalCreation{
@@ -326,6 +448,31 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"InvalidScopes": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockCreateDeployToken: func(pid interface{}, opt *gitlab.CreateProjectDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+						t.Errorf("Create(...): a deploy token with invalid scopes must not be created")
+						return &deployTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry", "api"},
+					}),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry", "api"},
+					}),
+				),
+				err: errors.Wrap(common.ValidateDeployTokenScopes([]string{"read_registry", "api"}), errCreateFailed),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				deployToken: &fake.MockClient{
@@ -336,6 +483,7 @@ func TestCreate(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry"},
 					}),
 					withExternalName("0"),
 				),
@@ -344,6 +492,7 @@ func TestCreate(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"read_registry"},
 					}),
 					withExternalName("0"),
 				),
@@ -388,6 +537,62 @@ func TestUpdate(t *testing.T) {
 				cr: deployToken(),
 			},
 		},
+		"ImmutableFieldChanged": {
+			args: args{
+				cr: deployToken(withConditions(scopesChanged)),
+			},
+			want: want{
+				cr:  deployToken(withConditions(scopesChanged)),
+				err: errors.New("field forProvider.scopes is immutable, recreate required, set the " + common.AnnotationKeyRecreateOnImmutableChange + " annotation to \"true\" to delete and create it again"),
+			},
+		},
+		"ImmutableFieldChangedRecreate": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockDeleteDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{ProjectID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{ProjectID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+			},
+		},
+		"ImmutableFieldChangedRecreateFailed": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockDeleteDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{ProjectID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{ProjectID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,510 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package forks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	upstreamID     = "10"
+	upstreamPath   = "upstream/app"
+	otherID        = "11"
+	forkID         = "20"
+	namespaceID    = "30"
+	forkName       = "app"
+
+	gitlabFork = &gitlab.Project{
+		ID:                20,
+		Name:              forkName,
+		PathWithNamespace: "internal/app",
+		ForkedFromProject: &gitlab.ForkParent{
+			ID:                10,
+			Name:              forkName,
+			PathWithNamespace: upstreamPath,
+		},
+	}
+)
+
+type args struct {
+	fork projects.ForkClient
+	cr   resource.Managed
+}
+
+type forkModifier func(*v1alpha1.ProjectFork)
+
+func withConditions(c ...xpv1.Condition) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.ProjectForkObservation) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { meta.SetExternalName(r, n) }
+}
+
+func withForkedFromProjectID(id string) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.Spec.ForProvider.ForkedFromProjectID = &id }
+}
+
+func withProjectID(id string) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withDeletePolicy(p v1alpha1.ProjectForkDeletePolicy) forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.Spec.ForProvider.DeletePolicy = &p }
+}
+
+var deletionTime = metav1.Now()
+
+func withDeletionTimestamp() forkModifier {
+	return func(r *v1alpha1.ProjectFork) { r.SetDeletionTimestamp(&deletionTime) }
+}
+
+func withDefaultSpec() forkModifier {
+	return func(r *v1alpha1.ProjectFork) {
+		r.Spec.ForProvider.ForkedFromProjectID = &upstreamID
+		r.Spec.ForProvider.NamespaceID = &namespaceID
+		r.Spec.ForProvider.Name = &forkName
+	}
+}
+
+func projectFork(m ...forkModifier) *v1alpha1.ProjectFork {
+	cr := &v1alpha1.ProjectFork{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func unforked() *gitlab.Project {
+	p := *gitlabFork
+	p.ForkedFromProject = nil
+	return &p
+}
+
+func getProject(p *gitlab.Project, res *gitlab.Response, err error) *fake.MockClient {
+	return &fake.MockClient{
+		MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			return p, res, err
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotFork),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: projectFork(withDefaultSpec()),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec()),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				fork: getProject(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr:  projectFork(withDefaultSpec(), withExternalName(forkID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ForkNotFound": {
+			args: args{
+				fork: getProject(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				fork: getProject(gitlabFork, &gitlab.Response{}, nil),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(
+					withDefaultSpec(),
+					withExternalName(forkID),
+					withStatus(projects.GenerateForkObservation(gitlabFork)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDateByPath": {
+			args: args{
+				fork: getProject(gitlabFork, &gitlab.Response{}, nil),
+				cr:   projectFork(withDefaultSpec(), withForkedFromProjectID(upstreamPath), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(
+					withDefaultSpec(),
+					withForkedFromProjectID(upstreamPath),
+					withExternalName(forkID),
+					withStatus(projects.GenerateForkObservation(gitlabFork)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SourceChanged": {
+			args: args{
+				fork: getProject(gitlabFork, &gitlab.Response{}, nil),
+				cr:   projectFork(withDefaultSpec(), withForkedFromProjectID(otherID), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(
+					withDefaultSpec(),
+					withForkedFromProjectID(otherID),
+					withExternalName(forkID),
+					withStatus(projects.GenerateForkObservation(gitlabFork)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"AdoptExistingProject": {
+			args: args{
+				fork: getProject(unforked(), &gitlab.Response{}, nil),
+				cr:   projectFork(withForkedFromProjectID(upstreamID), withProjectID(forkID)),
+			},
+			want: want{
+				cr: projectFork(
+					withForkedFromProjectID(upstreamID),
+					withProjectID(forkID),
+					withExternalName(forkID),
+					withStatus(projects.GenerateForkObservation(unforked())),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+		"ForkRelationRemoved": {
+			args: args{
+				fork: getProject(unforked(), &gitlab.Response{}, nil),
+				cr:   projectFork(withDefaultSpec(), withDeletePolicy(v1alpha1.ProjectForkRemoveForkRelation), withExternalName(forkID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec(), withDeletePolicy(v1alpha1.ProjectForkRemoveForkRelation), withExternalName(forkID), withDeletionTimestamp()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fork}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotFork),
+			},
+		},
+		"ExistingProjectNotFound": {
+			args: args{
+				cr: projectFork(withDefaultSpec(), withProjectID(forkID)),
+			},
+			want: want{
+				cr:  projectFork(withDefaultSpec(), withProjectID(forkID)),
+				err: errors.New(errProjectNotFound),
+			},
+		},
+		"SuccessfulFork": {
+			args: args{
+				fork: &fake.MockClient{
+					MockForkProject: func(pid any, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if pid != upstreamID || *opt.NamespaceID != 30 || *opt.Name != forkName {
+							return nil, nil, errBoom
+						}
+						return gitlabFork, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectFork(withDefaultSpec()),
+			},
+			want: want{
+				cr: projectFork(
+					withDefaultSpec(),
+					withExternalName(forkID),
+					withStatus(projects.GenerateForkObservation(gitlabFork)),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedFork": {
+			args: args{
+				fork: &fake.MockClient{
+					MockForkProject: func(pid any, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectFork(withDefaultSpec()),
+			},
+			want: want{
+				cr:  projectFork(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fork}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	relink := func(deleted *bool, forkedFrom *int64) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				return &gitlab.Project{ID: 11}, &gitlab.Response{}, nil
+			},
+			MockDeleteProjectForkRelation: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				*deleted = true
+				return &gitlab.Response{}, nil
+			},
+			MockCreateProjectForkRelation: func(pid any, fork int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error) {
+				*forkedFrom = fork
+				return &gitlab.ProjectForkRelation{}, &gitlab.Response{}, nil
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		cr         resource.Managed
+		deleted    bool
+		forkedFrom int64
+		want
+	}{
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotFork),
+			},
+		},
+		"MoveForkRelation": {
+			cr:         projectFork(withDefaultSpec(), withForkedFromProjectID(otherID), withExternalName(forkID), withStatus(projects.GenerateForkObservation(gitlabFork))),
+			deleted:    true,
+			forkedFrom: 11,
+			want: want{
+				cr: projectFork(withDefaultSpec(), withForkedFromProjectID(otherID), withExternalName(forkID), withStatus(projects.GenerateForkObservation(gitlabFork))),
+			},
+		},
+		"CreateForkRelationByPath": {
+			cr:         projectFork(withForkedFromProjectID("other/app"), withProjectID(forkID), withExternalName(forkID)),
+			forkedFrom: 11,
+			want: want{
+				cr: projectFork(withForkedFromProjectID("other/app"), withProjectID(forkID), withExternalName(forkID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted bool
+			var forkedFrom int64
+			e := &external{client: relink(&deleted, &forkedFrom)}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.deleted, deleted); diff != "" {
+				t.Errorf("r: -want relation deleted, +got relation deleted:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.forkedFrom, forkedFrom); diff != "" {
+				t.Errorf("r: -want forked from, +got forked from:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	client := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteProject: func(pid any, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+			MockDeleteProjectForkRelation: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return &gitlab.Response{}, nil
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotFork),
+			},
+		},
+		"DeleteProject": {
+			args: args{
+				fork: client(&gitlab.Response{}, nil),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec(), withExternalName(forkID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeletionPending": {
+			args: args{
+				fork: client(&gitlab.Response{Response: &http.Response{StatusCode: 400}}, errors.New("Project has been already marked for deletion. Deletion pending.")),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec(), withExternalName(forkID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				fork: client(&gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:   projectFork(withDefaultSpec(), withExternalName(forkID)),
+			},
+			want: want{
+				cr:  projectFork(withDefaultSpec(), withExternalName(forkID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+		"ExistingProjectKept": {
+			args: args{
+				fork: client(&gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:   projectFork(withDefaultSpec(), withProjectID(forkID), withExternalName(forkID)),
+			},
+			want: want{
+				cr: projectFork(withDefaultSpec(), withProjectID(forkID), withExternalName(forkID), withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fork}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ package hooks
 import (
 	"context"
 	"strconv"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
@@ -33,6 +34,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -51,6 +54,13 @@ const (
 	errUpdateFailed     = "cannot update Gitlab project hook"
 	errDeleteFailed     = "cannot delete Gitlab project hook"
 	errSecretRefInvalid = "invalid token reference"
+	errHeaderRefInvalid = "invalid custom header value reference"
+	errURLRefInvalid    = "invalid URL reference"
+	errDeleteHeader     = "cannot delete Gitlab project hook custom header"
+	errListFailed       = "cannot list Gitlab project hooks"
+	errReplaceFailed    = "cannot delete Gitlab project hook with the previous URL"
+
+	listPageSize = 100
 )
 
 // SetupHook adds a controller that reconciles Hooks.
@@ -58,8 +68,9 @@ func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.HookGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient})),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(common.WaitForDependency(mgr.GetClient(), "project", projectIDResolved)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -95,6 +106,13 @@ func SetupHookGated(mgr ctrl.Manager, o controller.Options) error {
 	return nil
 }
 
+// projectIDResolved reports whether the ProjectID of a Hook is set, so it
+// does not wait for its project.
+func projectIDResolved(mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.Hook)
+	return !ok || cr.Spec.ForProvider.ProjectID != nil
+}
+
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg common.Config) projects.HookClient
@@ -122,11 +140,31 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotHook)
 	}
+	if cr.Spec.ForProvider.ProjectID == nil && common.IsWaitingForDependency(cr) {
+		return common.WaitingObservation(cr), nil
+	}
+
+	url, _, err := e.hookURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
+	// Hooks have no natural key other than their URL, an existing hook with
+	// the URL of the spec is adopted instead of adding a duplicate.
+	adopted := false
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{
-			ResourceExists: false,
-		}, nil
+		if cr.Spec.ForProvider.ProjectID == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		hook, err := e.findHook(ctx, cr, url)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if hook == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		meta.SetExternalName(cr, strconv.FormatInt(hook.ID, 10))
+		adopted = true
 	}
 
 	hookid, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
@@ -145,16 +183,37 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(projects.IsErrorHookNotFound, err), errGetFailed)
 	}
 
+	// The URL identifies the hook as well, a hook whose URL changed is
+	// reported as missing and replaced by Create.
+	if !meta.WasDeleted(cr) && url != nil && projecthook.URL != *url {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	projects.LateInitializeHook(&cr.Spec.ForProvider, projecthook)
 
+	headersHash := cr.Status.AtProvider.CustomHeadersHash
+	rotatedAt := cr.Status.AtProvider.TokenRotatedAt
 	cr.Status.AtProvider = projects.GenerateHookObservation(projecthook)
+	cr.Status.AtProvider.CustomHeadersHash = headersHash
+	cr.Status.AtProvider.TokenRotatedAt = rotatedAt
 	cr.Status.SetConditions(xpv1.Available())
 
+	// GitLab does not return the custom header values, so they are compared
+	// against the hash of the values last pushed instead.
+	upToDate := projects.IsHookUpToDate(withResolvedURL(&cr.Spec.ForProvider, url), projecthook)
+	if upToDate {
+		headers, err := e.getCustomHeaders(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = common.HashHookCustomHeaders(headers) == headersHash
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        projects.IsHookUpToDate(&cr.Spec.ForProvider, projecthook),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        upToDate && !common.IsHookTokenRotationDue(cr.Spec.ForProvider.RotateEvery, rotatedAt, time.Now()),
+		ResourceLateInitialized: adopted || !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -165,18 +224,36 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
-	token, err := common.GetTokenValueFromSecret(ctx, e.kube, mg, cr.Spec.ForProvider.Token.SecretRef)
+	url, secretURL, err := e.hookURL(ctx, cr)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errSecretRefInvalid)
+		return managed.ExternalCreation{}, err
+	}
+	token, details, err := e.getToken(ctx, cr, true)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	headers, err := e.getCustomHeaders(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	hookOptions := projects.GenerateCreateHookOptions(withResolvedURL(&cr.Spec.ForProvider, url), token, headers)
+
+	// A hook that is known already had its URL changed, see Observe.
+	if hookid, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64); err == nil {
+		res, err := e.client.DeleteProjectHook(*cr.Spec.ForProvider.ProjectID, hookid, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errReplaceFailed)
+		}
 	}
-	hookOptions := projects.GenerateCreateHookOptions(&cr.Spec.ForProvider, token)
 
 	hook, _, err := e.client.AddProjectHook(*cr.Spec.ForProvider.ProjectID, hookOptions, gitlab.WithContext(ctx))
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		return managed.ExternalCreation{}, common.RedactError(errors.Wrap(err, errCreateFailed), secretURL)
 	}
+	cr.Status.AtProvider.CustomHeadersHash = common.HashHookCustomHeaders(headers)
+	setTokenRotated(cr, details)
 	err = e.updateExternalName(ctx, cr, hook)
-	return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
+	return managed.ExternalCreation{ConnectionDetails: details}, errors.Wrap(err, errKubeUpdateFailed)
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -194,19 +271,39 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
 	}
 
-	token, err := common.GetTokenValueFromSecret(ctx, e.kube, mg, cr.Spec.ForProvider.Token.SecretRef)
+	url, secretURL, err := e.hookURL(ctx, cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errSecretRefInvalid)
+		return managed.ExternalUpdate{}, err
 	}
 
-	editHookOptions := projects.GenerateEditHookOptions(&cr.Spec.ForProvider, token)
+	rotate := common.IsHookTokenRotationDue(cr.Spec.ForProvider.RotateEvery, cr.Status.AtProvider.TokenRotatedAt, time.Now())
+	token, details, err := e.getToken(ctx, cr, rotate)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	headers, err := e.getCustomHeaders(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	editHookOptions := projects.GenerateEditHookOptions(withResolvedURL(&cr.Spec.ForProvider, url), token, headers)
 
 	_, _, err = e.client.EditProjectHook(*cr.Spec.ForProvider.ProjectID, hookid, editHookOptions, gitlab.WithContext(ctx))
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		return managed.ExternalUpdate{}, common.RedactError(errors.Wrap(err, errUpdateFailed), secretURL)
 	}
 
-	return managed.ExternalUpdate{}, nil
+	for _, key := range projects.RemovedHookCustomHeaderKeys(&cr.Spec.ForProvider, cr.Status.AtProvider.CustomHeaderKeys) {
+		res, err := e.client.DeleteProjectCustomHeader(*cr.Spec.ForProvider.ProjectID, hookid, key, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteHeader)
+		}
+	}
+	cr.Status.AtProvider.CustomHeadersHash = common.HashHookCustomHeaders(headers)
+	setTokenRotated(cr, details)
+
+	return managed.ExternalUpdate{ConnectionDetails: details}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -229,6 +326,98 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// getToken returns the secret token of the hook, or nil if the hook does not
+// reference one. A token rotated by the provider is only generated if rotate
+// is true, and returned with the connection details publishing it.
+func (e *external) getToken(ctx context.Context, cr *v1alpha1.Hook, rotate bool) (*string, managed.ConnectionDetails, error) {
+	if cr.Spec.ForProvider.RotateEvery != nil {
+		if cr.Spec.ForProvider.Token != nil && cr.Spec.ForProvider.Token.SecretRef != nil {
+			return nil, nil, errors.New(common.ErrHookTokenRotationConflict)
+		}
+		if !rotate {
+			return nil, nil, nil
+		}
+		token, details, err := common.RotateHookToken(ctx, e.kube, cr)
+		return &token, details, err
+	}
+	if cr.Spec.ForProvider.Token == nil {
+		return nil, nil, nil
+	}
+	token, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, cr.Spec.ForProvider.Token.SecretRef)
+	return token, nil, errors.Wrap(err, errSecretRefInvalid)
+}
+
+// setTokenRotated records the rotation of the token once GitLab accepted it.
+func setTokenRotated(cr *v1alpha1.Hook, details managed.ConnectionDetails) {
+	if details != nil {
+		cr.Status.AtProvider.TokenRotatedAt = ptr.To(metav1.Now())
+	}
+}
+
+// getCustomHeaders returns the custom headers of the hook with their values
+// read from the referenced secrets.
+func (e *external) getCustomHeaders(ctx context.Context, cr *v1alpha1.Hook) ([]*gitlab.HookCustomHeader, error) {
+	if len(cr.Spec.ForProvider.CustomHeaders) == 0 {
+		return nil, nil
+	}
+	headers := make([]*gitlab.HookCustomHeader, 0, len(cr.Spec.ForProvider.CustomHeaders))
+	for _, h := range cr.Spec.ForProvider.CustomHeaders {
+		value, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, &h.ValueSecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errHeaderRefInvalid)
+		}
+		headers = append(headers, &gitlab.HookCustomHeader{Key: h.Key, Value: *value})
+	}
+	return headers, nil
+}
+
+// hookURL returns the URL of the hook, read from its secret if it references
+// one. A URL read from a secret is returned as secret as well, so that it can
+// be redacted from the errors GitLab may echo it in.
+func (e *external) hookURL(ctx context.Context, cr *v1alpha1.Hook) (*string, string, error) {
+	p := &cr.Spec.ForProvider
+	if p.URLSecretRef == nil {
+		return p.URL, "", nil
+	}
+	url, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, p.URLSecretRef)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errURLRefInvalid)
+	}
+	return url, *url, nil
+}
+
+// withResolvedURL returns a copy of the parameters with the resolved URL,
+// which is never written to the spec or status of the hook.
+func withResolvedURL(p *v1alpha1.HookParameters, url *string) *v1alpha1.HookParameters {
+	p = p.DeepCopy()
+	p.URL = url
+	return p
+}
+
+// findHook returns the hook of the project with the supplied URL, or nil if
+// there is none.
+func (e *external) findHook(ctx context.Context, cr *v1alpha1.Hook, url *string) (*gitlab.ProjectHook, error) {
+	if url == nil {
+		return nil, nil
+	}
+	opt := &gitlab.ListProjectHooksOptions{
+		ListOptions: gitlab.ListOptions{PerPage: listPageSize, Page: 1},
+	}
+	for {
+		hooks, res, err := e.client.ListProjectHooks(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListFailed)
+		}
+		if h := projects.FindHookByURL(hooks, *url); h != nil {
+			return h, nil
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
 func (e *external) updateExternalName(ctx context.Context, cr *v1alpha1.Hook, projecthook *gitlab.ProjectHook) error {
 	meta.SetExternalName(cr, strconv.FormatInt(projecthook.ID, 10))
 	return e.kube.Update(ctx, cr)
@@ -107,6 +107,17 @@ func withTokenRef() projectHookModifier {
 	}
 }
 
+func withCustomHeaders(keys ...string) projectHookModifier {
+	return func(r *v1alpha1.Hook) {
+		for _, k := range keys {
+			r.Spec.ForProvider.CustomHeaders = append(r.Spec.ForProvider.CustomHeaders, v1alpha1.HookCustomHeader{
+				Key:            k,
+				ValueSecretRef: *common.TestCreateSecretKeySelector("test", "token"),
+			})
+		}
+	}
+}
+
 func withStatus(s v1alpha1.HookObservation) projectHookModifier {
 	return func(r *v1alpha1.Hook) { r.Status.AtProvider = s }
 }
@@ -134,6 +145,18 @@ func TestObserve(t *testing.T) {
 		args
 		want
 	}{
+		"WaitingForProject": {
+			args: args{
+				cr: projecthook(withConditions(common.WaitingForDependency("project", errBoom))),
+			},
+			want: want{
+				cr: projecthook(withConditions(common.WaitingForDependency("project", errBoom))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"SuccessfulAvailable": {
 			args: args{
 				projecthook: &fake.MockClient{
@@ -192,6 +215,70 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"CustomHeaderValueChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.Secret) = tokenSecret
+						return nil
+					}),
+				},
+				projecthook: &fake.MockClient{
+					MockGetHook: func(pid interface{}, projectHookID int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						return &gitlab.ProjectHook{
+							ID:            projectHookID,
+							CustomHeaders: []*gitlab.HookCustomHeader{{Key: "X-Auth"}},
+						}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withCustomHeaders("X-Auth"),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, CustomHeadersHash: "outdated"}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withCustomHeaders("X-Auth"),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, CustomHeaderKeys: []string{"X-Auth"}, CustomHeadersHash: "outdated"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"CustomHeaderAdded": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockGetHook: func(pid interface{}, projectHookID int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						return &gitlab.ProjectHook{ID: projectHookID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withCustomHeaders("X-Auth"),
+					withExternalName(projectHookID),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withCustomHeaders("X-Auth"),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				projecthook: &fake.MockClient{
@@ -385,6 +472,118 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"AddCustomHeader": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.Secret) = tokenSecret
+						return nil
+					}),
+				},
+				projecthook: &fake.MockClient{
+					MockEditHook: func(pid interface{}, hook int64, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						want := []*gitlab.HookCustomHeader{{Key: "X-Auth", Value: tokenValue}}
+						if opt.CustomHeaders == nil || !cmp.Equal(want, *opt.CustomHeaders) {
+							return nil, nil, errBoom
+						}
+						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withCustomHeaders("X-Auth"),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withCustomHeaders("X-Auth"),
+					withStatus(v1alpha1.HookObservation{
+						ID:                projectHookID,
+						CustomHeadersHash: common.HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-Auth", Value: tokenValue}}),
+					}),
+				),
+			},
+		},
+		"RemoveCustomHeader": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.Secret) = tokenSecret
+						return nil
+					}),
+				},
+				projecthook: &fake.MockClient{
+					MockEditHook: func(pid interface{}, hook int64, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					},
+					MockDeleteProjectCustomHeader: func(pid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if key != "X-Old" {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withCustomHeaders("X-Auth"),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, CustomHeaderKeys: []string{"X-Auth", "X-Old"}, CustomHeadersHash: "outdated"}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withCustomHeaders("X-Auth"),
+					withStatus(v1alpha1.HookObservation{
+						ID:                projectHookID,
+						CustomHeaderKeys:  []string{"X-Auth", "X-Old"},
+						CustomHeadersHash: common.HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-Auth", Value: tokenValue}}),
+					}),
+				),
+			},
+		},
+		"FailedRemoveCustomHeader": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.Secret) = tokenSecret
+						return nil
+					}),
+				},
+				projecthook: &fake.MockClient{
+					MockEditHook: func(pid interface{}, hook int64, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					},
+					MockDeleteProjectCustomHeader: func(pid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, CustomHeaderKeys: []string{"X-Old"}}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withTokenRef(),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, CustomHeaderKeys: []string{"X-Old"}}),
+				),
+				err: errors.Wrap(errBoom, errDeleteHeader),
+			},
+		},
 		"FailedEdit": {
 			args: args{
 				kube: &test.MockClient{
@@ -531,3 +730,172 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+// hookStore is a fake project that keeps the hooks it was sent.
+type hookStore struct {
+	hooks  []*gitlab.ProjectHook
+	nextID int64
+}
+
+func (s *hookStore) client() *fake.MockClient {
+	return &fake.MockClient{
+		MockListHooks: func(pid any, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+			return s.hooks, &gitlab.Response{}, nil
+		},
+		MockGetHook: func(pid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+			for _, h := range s.hooks {
+				if h.ID == hook {
+					return h, &gitlab.Response{}, nil
+				}
+			}
+			return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+		},
+		MockAddHook: func(pid any, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+			s.nextID++
+			h := &gitlab.ProjectHook{ID: s.nextID, URL: *opt.URL}
+			s.hooks = append(s.hooks, h)
+			return h, &gitlab.Response{}, nil
+		},
+		MockDeleteHook: func(pid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			for i, h := range s.hooks {
+				if h.ID == hook {
+					s.hooks = append(s.hooks[:i], s.hooks[i+1:]...)
+					return &gitlab.Response{}, nil
+				}
+			}
+			return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+		},
+	}
+}
+
+// reconcile creates the hook if Observe reports it missing, like the managed
+// reconciler does.
+func reconcile(t *testing.T, e *external, cr *v1alpha1.Hook) {
+	t.Helper()
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceExists {
+		return
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+}
+
+func TestHookURLIdentity(t *testing.T) {
+	kube := &test.MockClient{
+		MockUpdate: test.NewMockUpdateFn(nil),
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = tokenSecret
+			return nil
+		}),
+	}
+	withURL := func(url string) projectHookModifier {
+		return func(r *v1alpha1.Hook) { r.Spec.ForProvider.URL = &url }
+	}
+
+	t.Run("ReapplySameURL", func(t *testing.T) {
+		store := &hookStore{}
+		e := &external{kube: kube, client: store.client()}
+
+		first := projecthook(withDefaultValues(), withURL("https://example.com/hook"))
+		reconcile(t, e, first)
+
+		// The manifest is applied again, e.g. after the managed resource was
+		// deleted with an orphan deletion policy.
+		again := projecthook(withDefaultValues(), withURL("https://example.com/hook"))
+		reconcile(t, e, again)
+
+		if len(store.hooks) != 1 {
+			t.Fatalf("want a single hook, got %d", len(store.hooks))
+		}
+		if diff := cmp.Diff(meta.GetExternalName(first), meta.GetExternalName(again)); diff != "" {
+			t.Errorf("external name: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("URLChanged", func(t *testing.T) {
+		store := &hookStore{}
+		e := &external{kube: kube, client: store.client()}
+
+		cr := projecthook(withDefaultValues(), withURL("https://example.com/old"))
+		reconcile(t, e, cr)
+
+		cr.Spec.ForProvider.URL = gitlab.Ptr("https://example.com/new")
+		reconcile(t, e, cr)
+
+		if len(store.hooks) != 1 || store.hooks[0].URL != "https://example.com/new" {
+			t.Fatalf("want a single hook with the new URL, got %+v", store.hooks)
+		}
+		if diff := cmp.Diff(fmt.Sprint(store.hooks[0].ID), meta.GetExternalName(cr)); diff != "" {
+			t.Errorf("external name: -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestHookURLFromSecret(t *testing.T) {
+	url := "https://hooks.internal.example.com/gitlab?key=s3cr3t"
+	kube := &test.MockClient{
+		MockUpdate: test.NewMockUpdateFn(nil),
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{Data: map[string][]byte{
+				"token": []byte(tokenValue),
+				"url":   []byte(url),
+			}}
+			return nil
+		}),
+	}
+	withURLSecretRef := func() projectHookModifier {
+		return func(r *v1alpha1.Hook) {
+			r.Spec.ForProvider.URLSecretRef = common.TestCreateSecretKeySelector("hook", "url")
+		}
+	}
+
+	t.Run("Resolved", func(t *testing.T) {
+		store := &hookStore{}
+		e := &external{kube: kube, client: store.client()}
+
+		cr := projecthook(withDefaultValues(), withURLSecretRef())
+		reconcile(t, e, cr)
+
+		if len(store.hooks) != 1 || store.hooks[0].URL != url {
+			t.Fatalf("want a single hook with the URL of the secret, got %+v", store.hooks)
+		}
+		if cr.Spec.ForProvider.URL != nil {
+			t.Errorf("want no URL in the spec, got %q", *cr.Spec.ForProvider.URL)
+		}
+
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("Observe(...): %v", err)
+		}
+		if !o.ResourceExists || !o.ResourceUpToDate {
+			t.Errorf("Observe(...): want existing and up to date hook, got %+v", o)
+		}
+	})
+
+	t.Run("CreateFailedRedacted", func(t *testing.T) {
+		e := &external{kube: kube, client: &fake.MockClient{
+			MockAddHook: func(pid any, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errors.Errorf("url %s is blocked", *opt.URL)
+			},
+		}}
+
+		_, err := e.Create(context.Background(), projecthook(withDefaultValues(), withURLSecretRef()))
+		want := errors.Errorf("%s: url %s is blocked", errCreateFailed, common.Redacted)
+		if diff := cmp.Diff(want, err, test.EquateErrors()); diff != "" {
+			t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+		}
+	})
+
+	t.Run("SecretMissing", func(t *testing.T) {
+		e := &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}, client: &fake.MockClient{}}
+
+		_, err := e.Observe(context.Background(), projecthook(withDefaultValues(), withURLSecretRef(), withExternalName(projectHookID)))
+		if err == nil {
+			t.Errorf("Observe(...): want error if the URL secret cannot be read")
+		}
+	})
+}
@@ -0,0 +1,463 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package issues
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	issueIIDName   = "7"
+	title          = "Onboarding checklist"
+	description    = "- [ ] Request access"
+
+	gitlabIssue = &gitlab.Issue{
+		ID:          42,
+		IID:         7,
+		ProjectID:   1234,
+		Title:       title,
+		Description: description,
+		State:       "opened",
+		Labels:      gitlab.Labels{"onboarding", "team-a"},
+		Assignees:   []*gitlab.IssueAssignee{{ID: 3}},
+		WebURL:      "https://gitlab.example.com/group/project/-/issues/7",
+	}
+)
+
+type args struct {
+	issue projects.IssueClient
+	cr    resource.Managed
+}
+
+type issueModifier func(*v1alpha1.Issue)
+
+func withConditions(c ...xpv1.Condition) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.IssueObservation) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) issueModifier {
+	return func(r *v1alpha1.Issue) { meta.SetExternalName(r, n) }
+}
+
+func withTitle(t string) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Spec.ForProvider.Title = t }
+}
+
+func withState(s v1alpha1.IssueState) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Spec.ForProvider.State = &s }
+}
+
+func withDefaultSpec() issueModifier {
+	return func(r *v1alpha1.Issue) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.Title = title
+		r.Spec.ForProvider.Description = &description
+		r.Spec.ForProvider.Labels = []string{"team-a", "onboarding"}
+		r.Spec.ForProvider.AssigneeIDs = []int64{3}
+	}
+}
+
+func issue(m ...issueModifier) *v1alpha1.Issue {
+	cr := &v1alpha1.Issue{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	getIssue := func(i *gitlab.Issue, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetIssue: func(pid any, iid int64, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+				return i, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: issue(withDefaultSpec()),
+			},
+			want: want{
+				cr: issue(withDefaultSpec()),
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: issue(withDefaultSpec(), withExternalName("onboarding")),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withExternalName("onboarding")),
+				err: errors.New(errIIDNotInt),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				issue: getIssue(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withExternalName(issueIIDName)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"IssueNotFound": {
+			args: args{
+				issue: getIssue(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr: issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+		},
+		"UpToDateLateInitializesState": {
+			args: args{
+				issue: getIssue(gitlabIssue, &gitlab.Response{}, nil),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr: issue(
+					withDefaultSpec(),
+					withExternalName(issueIIDName),
+					withState(v1alpha1.IssueStateOpened),
+					withStatus(projects.GenerateIssueObservation(gitlabIssue)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"StateChanged": {
+			args: args{
+				issue: getIssue(gitlabIssue, &gitlab.Response{}, nil),
+				cr:    issue(withDefaultSpec(), withState(v1alpha1.IssueStateClosed), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr: issue(
+					withDefaultSpec(),
+					withState(v1alpha1.IssueStateClosed),
+					withExternalName(issueIIDName),
+					withStatus(projects.GenerateIssueObservation(gitlabIssue)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.issue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: issue(withTitle(title)),
+			},
+			want: want{
+				cr:  issue(withTitle(title)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				issue: &fake.MockClient{
+					MockCreateIssue: func(pid any, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return gitlabIssue, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(withDefaultSpec()),
+			},
+			want: want{
+				cr: issue(
+					withDefaultSpec(),
+					withExternalName(issueIIDName),
+					withStatus(projects.GenerateIssueObservation(gitlabIssue)),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				issue: &fake.MockClient{
+					MockCreateIssue: func(pid any, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issue(withDefaultSpec()),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.issue}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	closed := *gitlabIssue
+	closed.State = "closed"
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"CloseIssue": {
+			args: args{
+				issue: &fake.MockClient{
+					MockUpdateIssue: func(pid any, iid int64, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						if iid != 7 || ptr.Deref(opt.StateEvent, "") != "close" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &closed, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(
+					withDefaultSpec(),
+					withState(v1alpha1.IssueStateClosed),
+					withExternalName(issueIIDName),
+					withStatus(projects.GenerateIssueObservation(gitlabIssue)),
+				),
+			},
+			want: want{
+				cr: issue(
+					withDefaultSpec(),
+					withState(v1alpha1.IssueStateClosed),
+					withExternalName(issueIIDName),
+					withStatus(projects.GenerateIssueObservation(&closed)),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				issue: &fake.MockClient{
+					MockUpdateIssue: func(pid any, iid int64, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withExternalName(issueIIDName)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.issue}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteIssue := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteIssue: func(pid any, iid int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				issue: deleteIssue(&gitlab.Response{}, nil),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr: issue(withDefaultSpec(), withExternalName(issueIIDName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				issue: deleteIssue(&gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr: issue(withDefaultSpec(), withExternalName(issueIIDName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeletionForbidden": {
+			args: args{
+				issue: deleteIssue(&gitlab.Response{Response: &http.Response{StatusCode: 403}}, errBoom),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withExternalName(issueIIDName), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteForbidden),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				issue: deleteIssue(&gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:    issue(withDefaultSpec(), withExternalName(issueIIDName)),
+			},
+			want: want{
+				cr:  issue(withDefaultSpec(), withExternalName(issueIIDName), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.issue}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
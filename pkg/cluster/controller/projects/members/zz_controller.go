@@ -28,6 +28,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,6 +48,8 @@ const (
 	errProjectIDMissing = "ProjectID is missing"
 	errUserInfoMissing  = "UserID or UserName is missing"
 	errFetchFailed      = "can not fetch userID by UserName"
+	errInheritedFailed  = "cannot observe inherited Gitlab Project Member"
+	errInheritedAccess  = "user %d inherits the access level %d from an ancestor group, GitLab only adds a direct membership with at least that access level"
 )
 
 // SetupMember adds a controller that reconciles Project Members.
@@ -54,12 +57,13 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.MemberGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: projects.NewMemberClient,
 			newUserClientFn:   users.NewUserClient,
-		}),
+		})),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(common.WaitForDependency(mgr.GetClient(), "project", projectIDResolved)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -95,6 +99,13 @@ func SetupMemberGated(mgr ctrl.Manager, o controller.Options) error {
 	return nil
 }
 
+// projectIDResolved reports whether the ProjectID of a Member is set, so it
+// does not wait for its project.
+func projectIDResolved(mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.Member)
+	return !ok || cr.Spec.ForProvider.ProjectID != nil
+}
+
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg common.Config) projects.MemberClient
@@ -124,6 +135,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotMember)
 	}
+	if cr.Spec.ForProvider.ProjectID == nil && common.IsWaitingForDependency(cr) {
+		return common.WaitingObservation(cr), nil
+	}
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
@@ -146,7 +160,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
-			return managed.ExternalObservation{}, nil
+			return managed.ExternalObservation{}, e.observeInherited(ctx, cr)
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errObserveFailed)
 	}
@@ -169,6 +183,11 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
+	// GitLab rejects direct memberships below the inherited access level,
+	// which would otherwise be retried on every reconcile.
+	if inherited := cr.Status.AtProvider.InheritedAccessLevel; inherited > cr.Spec.ForProvider.AccessLevel {
+		return managed.ExternalCreation{}, errors.Errorf(errInheritedAccess, ptr.Deref(cr.Spec.ForProvider.UserID, 0), inherited)
+	}
 
 	_, _, err := e.client.AddProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
@@ -193,6 +212,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalUpdate{}, errors.New(errUserInfoMissing)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
 
 	_, _, err := e.client.EditProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
@@ -214,6 +236,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalDelete{}, errors.New(errUserInfoMissing)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
 
 	_, err := e.client.DeleteProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
@@ -223,6 +248,21 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
 
+// observeInherited records the access level the user inherits from an
+// ancestor group of the project if the user is not a direct member. The
+// membership is still created as a direct one.
+func (e *external) observeInherited(ctx context.Context, cr *v1alpha1.Member) error {
+	cr.Status.AtProvider = v1alpha1.MemberObservation{}
+	inherited, err := projects.GetInheritedMember(ctx, e.client, *cr.Spec.ForProvider.ProjectID, *cr.Spec.ForProvider.UserID)
+	if err != nil {
+		return errors.Wrap(err, errInheritedFailed)
+	}
+	if inherited != nil {
+		cr.Status.AtProvider.InheritedAccessLevel = v1alpha1.AccessLevelValue(inherited.AccessLevel)
+	}
+	return nil
+}
+
 func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package mergerequests
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// AnnotationKeyAccept merges an opened merge request when set to "true".
+const AnnotationKeyAccept = "gitlab.crossplane.io/accept-merge-request"
+
+const (
+	errNotMergeRequest       = "managed resource is not a GitLab merge request custom resource"
+	errProjectIDMissing      = "ProjectID is missing"
+	errIIDNotInt             = "external name is not a valid GitLab merge request IID"
+	errGetFailed             = "cannot get GitLab merge request"
+	errCreateFailed          = "cannot create GitLab merge request"
+	errUpdateFailed          = "cannot update GitLab merge request"
+	errAcceptFailed          = "cannot accept GitLab merge request"
+	errCancelAutoMergeFailed = "cannot cancel auto-merge of GitLab merge request"
+	errDeleteFailed          = "cannot delete GitLab merge request"
+	errDeleteForbidden       = "cannot delete GitLab merge request, deleting merge requests requires the Owner role on the project or administrator access"
+)
+
+// SetupMergeRequest adds a controller that reconciles MergeRequests.
+func SetupMergeRequest(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.MergeRequestGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewMergeRequestClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.MergeRequestGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.MergeRequestList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.MergeRequest{}).
+		Complete(r)
+}
+
+// SetupMergeRequestGated adds a controller with CRD gate support.
+func SetupMergeRequestGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupMergeRequest(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.MergeRequestGroupVersionKind.String())
+		}
+	}, v1alpha1.MergeRequestGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.MergeRequestClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.MergeRequest)
+	if !ok {
+		return nil, errors.New(errNotMergeRequest)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.MergeRequestClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MergeRequest)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMergeRequest)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := mergeRequestIID(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	mr, res, err := e.client.GetMergeRequest(*cr.Spec.ForProvider.ProjectID, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeMergeRequest(&cr.Spec.ForProvider, mr)
+
+	cr.Status.AtProvider = projects.GenerateMergeRequestObservation(mr)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// Merge requests that were merged or closed, by Crossplane or out-of-band,
+	// are left as they are.
+	upToDate := mr.State != projects.MergeRequestStateOpened ||
+		(projects.IsMergeRequestUpToDate(&cr.Spec.ForProvider, mr) && !acceptRequested(cr))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MergeRequest)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMergeRequest)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	mr, _, err := e.client.CreateMergeRequest(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateMergeRequestOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateMergeRequestObservation(mr)
+	meta.SetExternalName(cr, strconv.FormatInt(mr.IID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MergeRequest)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMergeRequest)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := mergeRequestIID(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	mr, _, err := e.client.UpdateMergeRequest(
+		*cr.Spec.ForProvider.ProjectID,
+		iid,
+		projects.GenerateUpdateMergeRequestOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	autoMerge := cr.Spec.ForProvider.AutoMerge
+	switch {
+	case acceptRequested(cr):
+		mr, _, err = e.client.AcceptMergeRequest(*cr.Spec.ForProvider.ProjectID, iid, &gitlab.AcceptMergeRequestOptions{
+			ShouldRemoveSourceBranch: cr.Spec.ForProvider.RemoveSourceBranch,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAcceptFailed)
+		}
+	case ptr.Deref(autoMerge, false) && !mr.MergeWhenPipelineSucceeds:
+		mr, _, err = e.client.AcceptMergeRequest(*cr.Spec.ForProvider.ProjectID, iid, &gitlab.AcceptMergeRequestOptions{
+			AutoMerge:                autoMerge,
+			ShouldRemoveSourceBranch: cr.Spec.ForProvider.RemoveSourceBranch,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAcceptFailed)
+		}
+	case autoMerge != nil && !*autoMerge && mr.MergeWhenPipelineSucceeds:
+		mr, _, err = e.client.CancelMergeWhenPipelineSucceeds(*cr.Spec.ForProvider.ProjectID, iid, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCancelAutoMergeFailed)
+		}
+	}
+	cr.Status.AtProvider = projects.GenerateMergeRequestObservation(mr)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.MergeRequest)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotMergeRequest)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := mergeRequestIID(cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteMergeRequest(*cr.Spec.ForProvider.ProjectID, iid, gitlab.WithContext(ctx))
+	switch {
+	case err == nil, clients.IsResponseNotFound(res):
+		return managed.ExternalDelete{}, nil
+	case clients.IsResponseForbidden(res):
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteForbidden)
+	default:
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// mergeRequestIID returns the IID of the merge request within its project,
+// which is used as external name.
+func mergeRequestIID(cr *v1alpha1.MergeRequest) (int64, error) {
+	iid, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return 0, errors.New(errIIDNotInt)
+	}
+	return iid, nil
+}
+
+func acceptRequested(cr *v1alpha1.MergeRequest) bool {
+	return cr.GetAnnotations()[AnnotationKeyAccept] == "true"
+}
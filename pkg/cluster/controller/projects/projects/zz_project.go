@@ -19,9 +19,11 @@ limitations under the License.
 package projects
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"net/url"
-	"strconv"
 	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -33,20 +35,38 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
 	commonController "github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/common"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
+const (
+	// TypeCIConfigProjectInaccessible indicates that the project holding the
+	// CI configuration referenced by ciConfigPath cannot be read.
+	TypeCIConfigProjectInaccessible xpv1.ConditionType = "CIConfigProjectInaccessible"
+
+	// ReasonCIConfigProjectNotFound is used when the project referenced by
+	// ciConfigPath does not exist or is not visible to the provider.
+	ReasonCIConfigProjectNotFound xpv1.ConditionReason = "ProjectNotFound"
+
+	// ReasonCIConfigProjectFound is used once the referenced project can be
+	// read or ciConfigPath no longer references another project.
+	ReasonCIConfigProjectFound xpv1.ConditionReason = "ProjectFound"
+
+	reasonTransferred event.Reason = "TransferredProject"
+)
+
 const (
 	errNotProject              = "managed resource is not a Gitlab project custom resource"
 	errKubeUpdateFailed        = "cannot update Gitlab project custom resource"
@@ -54,11 +74,34 @@ const (
 	errUpdateFailed            = "cannot update Gitlab project"
 	errUpdatePushRulesFailed   = "cannot update Gitlab project push rules"
 	errDeleteFailed            = "cannot delete Gitlab project"
+	errRestoreFailed           = "cannot restore Gitlab project pending deletion"
+	errTransferFailed          = "cannot transfer Gitlab project to namespace %d"
+	errGetImportURLSecret      = "cannot get the secret of the import URL"
+	errUnexpectedPath          = "refusing to delete Gitlab project %s, it is not the project observed at path, namespacePath and namespaceId of the spec, set verifyPathOnDelete to false to delete it anyway"
+	errGetTemplateFailed       = "cannot retrieve Gitlab template project"
+	errGetNamespaceFailed      = "cannot retrieve Gitlab namespace %s, it does not exist or cannot be read with the provider credentials"
 	errGetFailed               = "cannot retrieve Gitlab project with"
+	errGetCIConfigProject      = "cannot retrieve Gitlab project referenced by ciConfigPath"
 	errGetPushRulesFailed      = "cannot retrieve Gitlab project push rules"
 	errLateInitialize          = "cannot late-initialize Gitlab project"
 	errLateInitializePushRules = "cannot late-initialize Gitlab project push rules"
 	errCheckPushRulesUpToDate  = "cannot compare project push rules"
+	errGetAvatarFailed         = "cannot read Gitlab project avatar"
+	errInvalidAvatar           = "cannot use Gitlab project avatar"
+	errUpdateAvatarFailed      = "cannot update Gitlab project avatar"
+	errDownloadAvatarFailed    = "cannot download Gitlab project avatar"
+	errCIConfigInaccessible    = "project %s referenced by ciConfigPath does not exist or cannot be read with the provider credentials, pipelines cannot load their CI configuration"
+	errGetComplianceFrameworks = "cannot retrieve Gitlab compliance frameworks"
+	errUpdateComplianceFrames  = "cannot update Gitlab project compliance frameworks"
+	errGetPackagesCleanup      = "cannot retrieve Gitlab project package cleanup policy"
+	errUpdatePackagesCleanup   = "cannot update Gitlab project package cleanup policy"
+	errGetJiraFailed           = "cannot retrieve Gitlab project Jira integration"
+	errJiraNotActive           = "cannot enable preventMergeWithoutJiraIssue, the Jira integration of the project is not active"
+	errGetCatalogResource      = "cannot retrieve Gitlab project CI/CD catalog publication"
+	errUpdateCatalogResource   = "cannot update Gitlab project CI/CD catalog publication"
+	errGetCatalogVersion       = "cannot retrieve Gitlab project CI/CD catalog version"
+
+	featureComplianceFrameworks = "Compliance frameworks"
 )
 
 // SetupProject adds a controller that reconciles Projects.
@@ -66,7 +109,18 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.ProjectGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
+			kube:                           mgr.GetClient(),
+			record:                         event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+			newGitlabClientFn:              projects.NewProjectClient,
+			newComplianceFrameworkClientFn: projects.NewComplianceFrameworkClient,
+			newNamespaceClientFn:           projects.NewNamespaceClient,
+			newPackagesCleanupClientFn:     projects.NewPackagesCleanupPolicyClient,
+			newJiraClientFn:                projects.NewJiraClient,
+			newCatalogResourceClientFn:     projects.NewCatalogResourceClient,
+			serverVersionFn:                common.GetServerVersion,
+			etags:                          common.NewETagCache[gitlab.Project](),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -104,8 +158,16 @@ func SetupProjectGated(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg common.Config) projects.Client
+	kube                           client.Client
+	record                         event.Recorder
+	newGitlabClientFn              func(cfg common.Config) projects.Client
+	newComplianceFrameworkClientFn func(cfg common.Config) projects.ComplianceFrameworkClient
+	newNamespaceClientFn           func(cfg common.Config) projects.NamespaceClient
+	newPackagesCleanupClientFn     func(cfg common.Config) projects.PackagesCleanupPolicyClient
+	newJiraClientFn                func(cfg common.Config) projects.JiraClient
+	newCatalogResourceClientFn     func(cfg common.Config) projects.CatalogResourceClient
+	serverVersionFn                func(ctx context.Context, cfg common.Config) *common.ServerVersion
+	etags                          *common.ETagCache[gitlab.Project]
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -117,16 +179,52 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	e := &external{
+		kube:                 c.kube,
+		record:               c.record,
+		client:               c.newGitlabClientFn(*cfg),
+		complianceFrameworks: c.newComplianceFrameworkClientFn(*cfg),
+		namespaces:           c.newNamespaceClientFn(*cfg),
+		packagesCleanup:      c.newPackagesCleanupClientFn(*cfg),
+		etags:                c.etags,
+		version:              version,
+	}
+	if c.newJiraClientFn != nil {
+		e.jira = c.newJiraClientFn(*cfg)
+	}
+	if c.newCatalogResourceClientFn != nil {
+		e.catalog = c.newCatalogResourceClientFn(*cfg)
+	}
+	return e, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.Client
+	kube                 client.Client
+	record               event.Recorder
+	client               projects.Client
+	complianceFrameworks projects.ComplianceFrameworkClient
+	namespaces           projects.NamespaceClient
+	packagesCleanup      projects.PackagesCleanupPolicyClient
+	jira                 projects.JiraClient
+	catalog              projects.CatalogResourceClient
+	etags                *common.ETagCache[gitlab.Project]
+	version              *common.ServerVersion
 
 	cache struct {
-		externalPushRules   *v1alpha1.PushRules
-		isPushRulesUpToDate bool
+		externalPushRules              *commonv1alpha1.PushRules
+		isPushRulesUpToDate            bool
+		isAvatarUpToDate               bool
+		complianceFrameworkIDs         []int64
+		isComplianceFrameworksUpToDate bool
+		transferNamespaceID            *int64
+		isPackagesCleanupUpToDate      bool
+		isImportURLUpToDate            bool
+		importURLSecretVersion         string
+		isCatalogResourceUpToDate      bool
 	}
 }
 
@@ -141,12 +239,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	projectID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errNotProject)
-	}
-
-	prj, res, err := e.client.GetProject(projectID, nil)
+	etagKey := common.ETagCacheKey(cr, externalName)
+	// Statistics report the storage used by job and pipeline artifacts. They
+	// are only returned to members with at least the Reporter role.
+	prj, res, err := e.client.GetProject(common.ParseExternalName(externalName), &gitlab.GetProjectOptions{Statistics: gitlab.Ptr(true)}, e.etags.RequestOptions(etagKey)...)
+	prj, err = e.etags.Resolve(etagKey, prj, res, err)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -154,19 +251,25 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
-	// Check if the project is in a pending deletion state and either remove the
-	// finalizer if specified or keep tracking it.
+	//nolint:staticcheck // GitLab versions before 16.5 only report the deprecated field
+	prj.EmailsEnabled = common.ObservedEmailsEnabled(prj.EmailsEnabled, prj.EmailsDisabled, e.version)
+
+	// Check if the project is in a pending deletion state and either treat it
+	// as deleted or keep tracking it, e.g. to remove it permanently.
 	//
 	// Mark the resource as unavailable if the project is in a deletion state but
-	// managed resource is not.
-	if prj.MarkedForDeletionOn != nil {
+	// managed resource is not, it is restored by Update if requested.
+	pendingDeletion := projects.IsMarkedForDeletion(prj)
+	restore := false
+	if pendingDeletion {
 		if meta.WasDeleted(cr) {
-			if ptr.Deref(cr.Spec.ForProvider.RemoveFinalizerOnPendingDeletion, false) {
+			if !ptr.Deref(cr.Spec.ForProvider.PermanentlyRemove, false) && ptr.Deref(cr.Spec.ForProvider.RemoveFinalizerOnPendingDeletion, true) {
 				return managed.ExternalObservation{}, nil
 			}
 			cr.SetConditions(xpv1.Deleting().WithMessage("Project is in pending deletion state"))
 		} else {
 			cr.SetConditions(xpv1.Unavailable().WithMessage("Project is in pending deletion state but this managed resource is not"))
+			restore = ptr.Deref(cr.Spec.ForProvider.RestoreOnPendingDeletion, false)
 		}
 	} else {
 		cr.Status.SetConditions(xpv1.Available())
@@ -177,6 +280,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	// Take a snapshot of the spec BEFORE applying secret-derived values.
 	specSnapshot := current.DeepCopy()
 
+	forbidden := common.ForbiddenFields(cr)
+	common.OmitFields(current, forbidden)
+	common.SetForbiddenFieldsSkipped(cr, forbidden)
+	common.SetUnsupportedFeatures(cr, e.version, projects.OmitUnsupportedProjectParameters(current, e.version))
+
 	// Replace the secret in the copied spec to avoid putting sensitive information in the CR spec
 	// This is only required for observation, as this is the only method where spec can be updated.
 	if current.ImportURLSecretRef != nil {
@@ -195,12 +303,75 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckPushRulesUpToDate)
 	}
 
+	e.cache.isAvatarUpToDate, err = e.isAvatarUpToDate(ctx, cr, prj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if err := e.checkCIConfigProject(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	e.cache.isComplianceFrameworksUpToDate, err = e.isComplianceFrameworksUpToDate(ctx, cr, prj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	e.cache.transferNamespaceID = projects.TransferNamespaceID(&cr.Spec.ForProvider, prj)
+
+	// GitLab redacts the credentials of the import URL, changed credentials
+	// are detected by the version of the secret they are read from.
+	e.cache.importURLSecretVersion, err = e.importURLSecretVersion(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	importURLSecretVersion := cr.Status.AtProvider.ImportURLSecretVersion
+	if importURLSecretVersion == "" {
+		importURLSecretVersion = e.cache.importURLSecretVersion
+	}
+	e.cache.isImportURLUpToDate = isImportURLUpToDate(current, prj) && importURLSecretVersion == e.cache.importURLSecretVersion
+
+	packagesCleanup, err := e.observePackagesCleanupPolicy(ctx, cr, prj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.cache.isPackagesCleanupUpToDate = projects.IsPackagesCleanupPolicyUpToDate(cr.Spec.ForProvider.PackagesCleanupPolicy, packagesCleanup)
+
+	catalogResource, err := e.observeCatalogResource(ctx, current, prj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.cache.isCatalogResourceUpToDate = current.CICatalogResource == nil || catalogResource != nil && *catalogResource == *current.CICatalogResource
+	catalogVersion, err := e.observeCatalogVersion(ctx, catalogResource, prj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// GitLab only returns the URL of the avatar, keep the hash of the image
+	// last uploaded to detect changes.
+	avatarHash, avatarURLChecked := cr.Status.AtProvider.AvatarHash, cr.Status.AtProvider.AvatarURLChecked
+	verifiedID := cr.Status.AtProvider.VerifiedID
+	ciConfigPathChecked := cr.Status.AtProvider.CIConfigPathChecked
 	cr.Status.AtProvider = projects.GenerateObservation(prj)
+	renamed := common.UpdateExternalName(cr, prj.ID, prj.PathWithNamespace)
+	// Record the project once it is seen at the expected path, so that a
+	// later transfer or rename of its namespace does not prevent deleting it.
+	if verifiedID == 0 && projects.IsAtExpectedPath(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		verifiedID = prj.ID
+	}
+	cr.Status.AtProvider.VerifiedID = verifiedID
+	cr.Status.AtProvider.CIConfigPathChecked = ciConfigPathChecked
+	cr.Status.AtProvider.AvatarHash = avatarHash
+	cr.Status.AtProvider.AvatarURLChecked = avatarURLChecked
+	cr.Status.AtProvider.ImportURLSecretVersion = importURLSecretVersion
+	cr.Status.AtProvider.PackagesCleanupPolicy = projects.GeneratePackagesCleanupPolicyObservation(packagesCleanup)
+	cr.Status.AtProvider.CICatalogResource = catalogResource
+	cr.Status.AtProvider.CICatalogVersion = projects.GenerateCatalogResourceVersionObservation(catalogVersion)
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: isProjectUpToDate(current, prj) && e.cache.isPushRulesUpToDate,
+		ResourceUpToDate: !restore && isProjectUpToDate(current, prj) && e.cache.isPushRulesUpToDate && e.cache.isAvatarUpToDate && e.cache.isComplianceFrameworksUpToDate && e.cache.transferNamespaceID == nil && e.cache.isPackagesCleanupUpToDate && e.cache.isImportURLUpToDate && e.cache.isCatalogResourceUpToDate,
 		// Compare against specSnapshot (pre-secret-substitution)
-		ResourceLateInitialized: !cmp.Equal(specSnapshot, &cr.Spec.ForProvider),
+		ResourceLateInitialized: !cmp.Equal(specSnapshot, &cr.Spec.ForProvider) || renamed,
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(prj.RunnersToken)},
 	}, nil
 }
@@ -221,6 +392,26 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
+	// Templates only apply at creation, the template project is therefore
+	// resolved here and neither compared nor late-initialized.
+	if current.TemplateProjectID == nil && current.TemplateProjectPath != nil {
+		tpl, _, err := e.client.GetProject(*current.TemplateProjectPath, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetTemplateFailed)
+		}
+		current.TemplateProjectID = &tpl.ID
+	}
+
+	// The namespace path only applies at creation as well, the resolved
+	// namespace is reported in status.atProvider.namespace afterwards.
+	if current.NamespaceID == nil && current.NamespacePath != nil {
+		ns, _, err := e.namespaces.GetNamespace(*current.NamespacePath, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrapf(err, errGetNamespaceFailed, *current.NamespacePath)
+		}
+		current.NamespaceID = &ns.ID
+	}
+
 	prj, _, err := e.client.CreateProject(
 		projects.GenerateCreateProjectOptions(cr.Name, current),
 		gitlab.WithContext(ctx),
@@ -229,7 +420,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.FormatInt(prj.ID, 10))
+	meta.SetExternalName(cr, common.FormatExternalName(cr, prj.ID, prj.PathWithNamespace))
 	return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
 }
 
@@ -249,13 +440,35 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	_, _, err := e.client.EditProject(
-		meta.GetExternalName(cr),
-		projects.GenerateEditProjectOptions(cr.Name, current),
-		gitlab.WithContext(ctx),
-	)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	if ptr.Deref(cr.Spec.ForProvider.RestoreOnPendingDeletion, false) && (cr.Status.AtProvider.MarkedForDeletionOn != nil || cr.Status.AtProvider.MarkedForDeletionAt != nil) {
+		if _, _, err := e.client.RestoreProject(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreFailed)
+		}
+	}
+
+	if e.cache.transferNamespaceID != nil {
+		if err := e.transfer(ctx, cr, *e.cache.transferNamespaceID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	projects.OmitUnsupportedProjectParameters(current, e.version)
+
+	// Sending the import URL may trigger a mirror update, it is therefore
+	// only sent if it changed.
+	if e.cache.isImportURLUpToDate {
+		current.ImportURL = nil
+	}
+
+	if err := e.checkJiraIntegration(ctx, cr, current); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.editProject(ctx, cr, current); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if current.ImportURL != nil {
+		cr.Status.AtProvider.ImportURLSecretVersion = e.cache.importURLSecretVersion
 	}
 
 	if !e.cache.isPushRulesUpToDate {
@@ -263,9 +476,322 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, err
 		}
 	}
+	if !e.cache.isAvatarUpToDate {
+		if err := e.updateAvatar(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if !e.cache.isComplianceFrameworksUpToDate {
+		if err := e.updateComplianceFrameworks(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if !e.cache.isPackagesCleanupUpToDate {
+		if err := e.updatePackagesCleanupPolicy(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if !e.cache.isCatalogResourceUpToDate {
+		if err := e.updateCatalogResource(ctx, cr, current); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
+// editProject updates the project. Fields GitLab rejected before are not sent
+// if the project skips forbidden fields, and fields it rejects now are
+// recorded to be skipped from then on.
+func (e *external) editProject(ctx context.Context, cr *v1alpha1.Project, current *v1alpha1.ProjectParameters) error {
+	if !common.SkipForbiddenFields(cr) {
+		_, _, err := e.client.EditProject(meta.GetExternalName(cr), projects.GenerateEditProjectOptions(cr.Name, current), gitlab.WithContext(ctx))
+		return errors.Wrap(err, errUpdateFailed)
+	}
+
+	common.OmitFields(current, common.ForbiddenFields(cr))
+	forbidden, err := common.UpdateSkippingForbiddenFields(projects.GenerateEditProjectOptions(cr.Name, current), func(opt *gitlab.EditProjectOptions) (*gitlab.Response, error) {
+		_, res, err := e.client.EditProject(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+		return res, err
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateFailed)
+	}
+	return common.RecordForbiddenFields(ctx, e.kube, cr, forbidden)
+}
+
+// checkJiraIntegration makes sure preventMergeWithoutJiraIssue is only sent
+// while the Jira integration of the project is active, GitLab rejects it
+// otherwise. Enabling it without an active integration is an error, while
+// disabling it is not sent as there is nothing to disable.
+func (e *external) checkJiraIntegration(ctx context.Context, cr *v1alpha1.Project, current *v1alpha1.ProjectParameters) error {
+	if current.PreventMergeWithoutJiraIssue == nil || e.jira == nil {
+		return nil
+	}
+	active, err := projects.IsJiraIntegrationActive(e.jira.GetJiraService(meta.GetExternalName(cr), gitlab.WithContext(ctx)))
+	if err != nil {
+		return errors.Wrap(err, errGetJiraFailed)
+	}
+	if active {
+		return nil
+	}
+	if *current.PreventMergeWithoutJiraIssue {
+		return errors.New(errJiraNotActive)
+	}
+	current.PreventMergeWithoutJiraIssue = nil
+	return nil
+}
+
+// transfer moves the project to the supplied namespace. Transfers change the
+// path of the project, which is why they are recorded as an event.
+func (e *external) transfer(ctx context.Context, cr *v1alpha1.Project, namespaceID int64) error {
+	prj, _, err := e.client.TransferProject(meta.GetExternalName(cr), &gitlab.TransferProjectOptions{Namespace: namespaceID}, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, errTransferFailed, namespaceID)
+	}
+	e.record.Event(cr, event.Normal(reasonTransferred, fmt.Sprintf("Transferred project from %s to %s", cr.Status.AtProvider.PathWithNamespace, prj.PathWithNamespace)))
+	cr.Status.AtProvider.PathWithNamespace = prj.PathWithNamespace
+	return nil
+}
+
+// observePackagesCleanupPolicy returns the package cleanup policy of the
+// project if the spec manages it, and nil otherwise.
+func (e *external) observePackagesCleanupPolicy(ctx context.Context, cr *v1alpha1.Project, prj *gitlab.Project) (*projects.PackagesCleanupPolicy, error) {
+	if cr.Spec.ForProvider.PackagesCleanupPolicy == nil {
+		return nil, nil
+	}
+	p, err := e.packagesCleanup.GetPackagesCleanupPolicy(ctx, prj.PathWithNamespace)
+	return p, errors.Wrap(err, errGetPackagesCleanup)
+}
+
+// observeCatalogResource returns whether the project is published in the
+// CI/CD catalog if the spec manages it, and nil otherwise.
+func (e *external) observeCatalogResource(ctx context.Context, current *v1alpha1.ProjectParameters, prj *gitlab.Project) (*bool, error) {
+	if current.CICatalogResource == nil || e.catalog == nil {
+		return nil, nil
+	}
+	published, err := e.catalog.IsCatalogResource(ctx, prj.PathWithNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCatalogResource)
+	}
+	return &published, nil
+}
+
+// observeCatalogVersion returns the latest version of the project published
+// in the CI/CD catalog. Only published projects have versions, so it is only
+// observed if observeCatalogResource found the project published.
+func (e *external) observeCatalogVersion(ctx context.Context, published *bool, prj *gitlab.Project) (*projects.CatalogResourceVersion, error) {
+	if !ptr.Deref(published, false) {
+		return nil, nil
+	}
+	v, err := e.catalog.GetLatestCatalogResourceVersion(ctx, prj.PathWithNamespace)
+	return v, errors.Wrap(err, errGetCatalogVersion)
+}
+
+// updateCatalogResource publishes the project in or removes it from the CI/CD
+// catalog as the spec requires.
+func (e *external) updateCatalogResource(ctx context.Context, cr *v1alpha1.Project, current *v1alpha1.ProjectParameters) error {
+	if current.CICatalogResource == nil || e.catalog == nil {
+		return nil
+	}
+	path := cr.Status.AtProvider.PathWithNamespace
+	if *current.CICatalogResource {
+		return errors.Wrap(e.catalog.CreateCatalogResource(ctx, path), errUpdateCatalogResource)
+	}
+	return errors.Wrap(e.catalog.DestroyCatalogResource(ctx, path), errUpdateCatalogResource)
+}
+
+// updatePackagesCleanupPolicy sets the package cleanup policy of the spec.
+func (e *external) updatePackagesCleanupPolicy(ctx context.Context, cr *v1alpha1.Project) error {
+	if cr.Spec.ForProvider.PackagesCleanupPolicy == nil {
+		return nil
+	}
+	err := e.packagesCleanup.UpdatePackagesCleanupPolicy(ctx, cr.Status.AtProvider.PathWithNamespace, cr.Spec.ForProvider.PackagesCleanupPolicy.KeepNDuplicatedPackageFiles)
+	return errors.Wrap(err, errUpdatePackagesCleanup)
+}
+
+// isComplianceFrameworksUpToDate compares the compliance frameworks assigned
+// to the project with the ones referenced by the spec, whose IDs are kept
+// for the update. Without a license including compliance frameworks the
+// UnsupportedFeatures condition is set and the frameworks are not managed.
+func (e *external) isComplianceFrameworksUpToDate(ctx context.Context, cr *v1alpha1.Project, prj *gitlab.Project) (bool, error) {
+	if cr.Spec.ForProvider.ComplianceFrameworks == nil {
+		return true, nil
+	}
+
+	group := projects.ComplianceFrameworkGroup(prj)
+	available, err := e.complianceFrameworks.ListComplianceFrameworks(ctx, group)
+	if err != nil {
+		if projects.IsComplianceFrameworksUnavailable(err) {
+			common.SetUnsupportedByLicense(cr, featureComplianceFrameworks)
+			return true, nil
+		}
+		return false, errors.Wrap(err, errGetComplianceFrameworks)
+	}
+
+	e.cache.complianceFrameworkIDs, err = projects.ResolveComplianceFrameworkIDs(*cr.Spec.ForProvider.ComplianceFrameworks, available, group)
+	if err != nil {
+		return false, err
+	}
+	observed := projects.ComplianceFrameworkIDsByName(prj.ComplianceFrameworks, available)
+	return projects.AreComplianceFrameworksUpToDate(e.cache.complianceFrameworkIDs, observed), nil
+}
+
+// updateComplianceFrameworks assigns the compliance frameworks resolved by
+// isComplianceFrameworksUpToDate to the project.
+func (e *external) updateComplianceFrameworks(ctx context.Context, cr *v1alpha1.Project) error {
+	if cr.Spec.ForProvider.ComplianceFrameworks == nil {
+		return nil
+	}
+
+	err := e.complianceFrameworks.UpdateProjectComplianceFrameworks(ctx, cr.Status.AtProvider.ID, e.cache.complianceFrameworkIDs)
+	if projects.IsComplianceFrameworksUnavailable(err) {
+		common.SetUnsupportedByLicense(cr, featureComplianceFrameworks)
+	}
+	return errors.Wrap(err, errUpdateComplianceFrames)
+}
+
+// checkCIConfigProject sets the CIConfigProjectInaccessible condition if
+// ciConfigPath references a file in another project, as in
+// path/to/ci.yml@group/shared-repo, that cannot be read. GitLab accepts such
+// paths regardless, the condition only warns that pipelines will fail. The
+// condition is reset once the project can be read. A readable project is
+// only checked again once ciConfigPath changes.
+func (e *external) checkCIConfigProject(ctx context.Context, cr *v1alpha1.Project) error {
+	ciConfigPath := ptr.Deref(cr.Spec.ForProvider.CIConfigPath, "")
+	if ciConfigPath == cr.Status.AtProvider.CIConfigPathChecked {
+		return nil
+	}
+	cr.Status.AtProvider.CIConfigPathChecked = ""
+	path, ok := projects.CIConfigProject(ciConfigPath)
+	if ok {
+		_, res, err := e.client.GetProject(path, nil, gitlab.WithContext(ctx))
+		switch {
+		case err == nil:
+			cr.Status.AtProvider.CIConfigPathChecked = ciConfigPath
+		case clients.IsResponseNotFound(res) || clients.IsResponseForbidden(res):
+			cr.SetConditions(xpv1.Condition{
+				Type:               TypeCIConfigProjectInaccessible,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             ReasonCIConfigProjectNotFound,
+				Message:            fmt.Sprintf(errCIConfigInaccessible, path),
+			})
+			return nil
+		default:
+			return errors.Wrap(err, errGetCIConfigProject)
+		}
+	}
+	if cr.GetCondition(TypeCIConfigProjectInaccessible).Status == corev1.ConditionTrue {
+		cr.SetConditions(xpv1.Condition{
+			Type:               TypeCIConfigProjectInaccessible,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonCIConfigProjectFound,
+		})
+	}
+	return nil
+}
+
+// updateAvatar uploads the avatar image referenced by the spec, or removes
+// the avatar of the project if the spec does not reference an image. The
+// hash of the uploaded image is recorded in the status.
+func (e *external) updateAvatar(ctx context.Context, cr *v1alpha1.Project) error {
+	if cr.Spec.ForProvider.Avatar == nil {
+		return nil
+	}
+
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	pid := meta.GetExternalName(cr)
+
+	if image == nil {
+		if _, _, err := e.client.EditProject(pid, &gitlab.EditProjectOptions{Avatar: &gitlab.ProjectAvatar{}}, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrap(err, errUpdateAvatarFailed)
+		}
+		cr.Status.AtProvider.AvatarHash = ""
+		cr.Status.AtProvider.AvatarURLChecked = ""
+		return nil
+	}
+
+	filename, err := projects.ValidateAvatar(image)
+	if err != nil {
+		return errors.Wrap(err, errInvalidAvatar)
+	}
+	if _, _, err := e.client.UploadAvatar(pid, bytes.NewReader(image), filename, gitlab.WithContext(ctx)); err != nil {
+		return errors.Wrap(err, errUpdateAvatarFailed)
+	}
+	cr.Status.AtProvider.AvatarHash = projects.HashAvatar(image)
+	cr.Status.AtProvider.AvatarURLChecked = ""
+	return nil
+}
+
+// getAvatar returns the avatar image referenced by the spec, or nil if no
+// image is referenced.
+func (e *external) getAvatar(ctx context.Context, cr *v1alpha1.Project) ([]byte, error) {
+	avatar := cr.Spec.ForProvider.Avatar
+	switch {
+	case avatar == nil:
+		return nil, nil
+	case avatar.SecretRef != nil:
+		image, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, avatar.SecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetAvatarFailed)
+		}
+		return []byte(*image), nil
+	case avatar.ConfigMapRef != nil:
+		image, err := common.GetValueFromConfigMap(ctx, e.kube, cr, avatar.ConfigMapRef)
+		return image, errors.Wrap(err, errGetAvatarFailed)
+	}
+	return nil, nil
+}
+
+// isAvatarUpToDate compares the avatar image referenced by the spec with the
+// hash of the image last uploaded, and with the avatar of the project to
+// detect one uploaded outside of the provider. The avatar is only downloaded
+// if its URL changed since it last matched. An avatar without an image is up
+// to date if the project has no uploaded avatar.
+func (e *external) isAvatarUpToDate(ctx context.Context, cr *v1alpha1.Project, prj *gitlab.Project) (bool, error) {
+	if cr.Spec.ForProvider.Avatar == nil {
+		return true, nil
+	}
+
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	if image == nil {
+		return !projects.IsCustomAvatarURL(prj.AvatarURL), nil
+	}
+	hash := projects.HashAvatar(image)
+	if !projects.IsCustomAvatarURL(prj.AvatarURL) || hash != cr.Status.AtProvider.AvatarHash {
+		return false, nil
+	}
+
+	if prj.AvatarURL == cr.Status.AtProvider.AvatarURLChecked {
+		return true, nil
+	}
+
+	current, res, err := e.client.DownloadAvatar(prj.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, errDownloadAvatarFailed)
+	}
+	observed, err := io.ReadAll(current)
+	if err != nil {
+		return false, errors.Wrap(err, errDownloadAvatarFailed)
+	}
+	if projects.HashAvatar(observed) != hash {
+		return false, nil
+	}
+	cr.Status.AtProvider.AvatarURLChecked = prj.AvatarURL
+	return true, nil
+}
+
 // updatePushRules reconciles push rules for a project. It decides whether to
 // add (POST) or edit (PUT) push rules based on whether they already exist in
 // GitLab (cached in e.cache.externalPushRules). If neither cached rules nor
@@ -295,17 +821,34 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotProject)
 	}
 
-	_, err := e.client.DeleteProject(meta.GetExternalName(cr), &gitlab.DeleteProjectOptions{}, gitlab.WithContext(ctx))
-	// if the project is for some reason already marked for deletion, we ignore the error and continue to delete the project permanently
-	if err != nil && !strings.Contains(err.Error(), "Deletion pending.") {
-		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	// The external name may have been changed to that of another project,
+	// which must never be deleted instead.
+	if ptr.Deref(cr.Spec.ForProvider.VerifyPathOnDelete, true) && !projects.IsVerifiedProject(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		return managed.ExternalDelete{}, errors.Errorf(errUnexpectedPath, cr.Status.AtProvider.PathWithNamespace)
 	}
 
-	if cr.Spec.ForProvider.PermanentlyRemove != nil && *cr.Spec.ForProvider.PermanentlyRemove {
-		_, err = e.client.DeleteProject(meta.GetExternalName(cr), &gitlab.DeleteProjectOptions{
-			PermanentlyRemove: cr.Spec.ForProvider.PermanentlyRemove,
-			FullPath:          &cr.Status.AtProvider.PathWithNamespace,
-		}, gitlab.WithContext(ctx))
+	// a project has to be marked for deletion before it can be removed permanently
+	if cr.Status.AtProvider.MarkedForDeletionOn == nil && cr.Status.AtProvider.MarkedForDeletionAt == nil {
+		res, err := e.client.DeleteProject(meta.GetExternalName(cr), &gitlab.DeleteProjectOptions{}, gitlab.WithContext(ctx))
+		if err != nil && clients.IsResponseNotFound(res) {
+			return managed.ExternalDelete{}, nil
+		}
+		// if the project is for some reason already marked for deletion, we ignore the error and continue to delete the project permanently
+		if err != nil && !strings.Contains(err.Error(), "Deletion pending.") {
+			return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+		}
+	}
+
+	if !ptr.Deref(cr.Spec.ForProvider.PermanentlyRemove, false) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	res, err := e.client.DeleteProject(meta.GetExternalName(cr), &gitlab.DeleteProjectOptions{
+		PermanentlyRemove: cr.Spec.ForProvider.PermanentlyRemove,
+		FullPath:          &cr.Status.AtProvider.PathWithNamespace,
+	}, gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
 	}
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
@@ -326,6 +869,9 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 	if in.AllowMergeOnSkippedPipeline == nil {
 		in.AllowMergeOnSkippedPipeline = &project.AllowMergeOnSkippedPipeline
 	}
+	if in.AllowPipelineTriggerApproveDeployment == nil {
+		in.AllowPipelineTriggerApproveDeployment = &project.AllowPipelineTriggerApproveDeployment
+	}
 	//nolint:staticcheck // SA1019 ApprovalsBeforeMerge is deprecated by GitLab API, will migrate to Merge Request Approvals API later
 	if in.ApprovalsBeforeMerge == nil && project.ApprovalsBeforeMerge != 0 {
 		val := project.ApprovalsBeforeMerge
@@ -334,6 +880,10 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 	if in.AutocloseReferencedIssues == nil {
 		in.AutocloseReferencedIssues = &project.AutocloseReferencedIssues
 	}
+	if in.AutoDevopsEnabled == nil {
+		in.AutoDevopsEnabled = &project.AutoDevopsEnabled
+	}
+	in.AutoDevopsDeployStrategy = clients.LateInitializeStringPtr(in.AutoDevopsDeployStrategy, project.AutoDevopsDeployStrategy)
 
 	in.BuildCoverageRegex = clients.LateInitializeStringPtr(in.BuildCoverageRegex, project.BuildCoverageRegex)
 	in.BuildsAccessLevel = clients.LateInitializeAccessControlValue(in.BuildsAccessLevel, project.BuildsAccessLevel)
@@ -343,6 +893,10 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 		val := project.CIDefaultGitDepth
 		in.CIDefaultGitDepth = &val
 	}
+	if in.CIDeletePipelinesInSeconds == nil && project.CIDeletePipelinesInSeconds != 0 {
+		val := project.CIDeletePipelinesInSeconds
+		in.CIDeletePipelinesInSeconds = &val
+	}
 	if in.CIForwardDeploymentEnabled == nil {
 		in.CIForwardDeploymentEnabled = &project.CIForwardDeploymentEnabled
 	}
@@ -355,10 +909,21 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 
 	in.DefaultBranch = clients.LateInitializeStringPtr(in.DefaultBranch, project.DefaultBranch)
 	in.Description = clients.LateInitializeStringPtr(in.Description, project.Description)
+
+	//nolint:staticcheck // Keeping this for backward compatibility during deprecation
+	in.EmailsEnabled = common.ResolveEmailsEnabledSetting(in.EmailsDisabled, in.EmailsEnabled)
+	if in.EmailsEnabled == nil {
+		in.EmailsEnabled = &project.EmailsEnabled
+	}
+
 	in.ForkingAccessLevel = clients.LateInitializeAccessControlValue(in.ForkingAccessLevel, project.ForkingAccessLevel)
 	in.IssuesAccessLevel = clients.LateInitializeAccessControlValue(in.IssuesAccessLevel, project.IssuesAccessLevel)
 	in.IssuesTemplate = clients.LateInitializeStringPtr(in.IssuesTemplate, project.IssuesTemplate)
 
+	if in.KeepLatestArtifact == nil {
+		in.KeepLatestArtifact = &project.KeepLatestArtifact
+	}
+
 	if in.LFSEnabled == nil {
 		in.LFSEnabled = &project.LFSEnabled
 	}
@@ -367,6 +932,10 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 	in.MergeRequestsAccessLevel = clients.LateInitializeAccessControlValue(in.MergeRequestsAccessLevel, project.MergeRequestsAccessLevel)
 	in.MergeRequestsTemplate = clients.LateInitializeStringPtr(in.MergeRequestsTemplate, project.MergeRequestsTemplate)
 
+	if in.MergeRequestDefaultTargetSelf == nil {
+		in.MergeRequestDefaultTargetSelf = &project.MergeRequestDefaultTargetSelf
+	}
+
 	if in.Mirror == nil {
 		in.Mirror = &project.Mirror
 	}
@@ -391,6 +960,16 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 	}
 
 	in.OperationsAccessLevel = clients.LateInitializeAccessControlValue(in.OperationsAccessLevel, project.OperationsAccessLevel)
+	in.AnalyticsAccessLevel = clients.LateInitializeAccessControlValue(in.AnalyticsAccessLevel, project.AnalyticsAccessLevel)
+	in.EnvironmentsAccessLevel = clients.LateInitializeAccessControlValue(in.EnvironmentsAccessLevel, project.EnvironmentsAccessLevel)
+	in.FeatureFlagsAccessLevel = clients.LateInitializeAccessControlValue(in.FeatureFlagsAccessLevel, project.FeatureFlagsAccessLevel)
+	in.InfrastructureAccessLevel = clients.LateInitializeAccessControlValue(in.InfrastructureAccessLevel, project.InfrastructureAccessLevel)
+	in.ModelExperimentsAccessLevel = clients.LateInitializeAccessControlValue(in.ModelExperimentsAccessLevel, project.ModelExperimentsAccessLevel)
+	in.ModelRegistryAccessLevel = clients.LateInitializeAccessControlValue(in.ModelRegistryAccessLevel, project.ModelRegistryAccessLevel)
+	in.MonitorAccessLevel = clients.LateInitializeAccessControlValue(in.MonitorAccessLevel, project.MonitorAccessLevel)
+	in.ReleasesAccessLevel = clients.LateInitializeAccessControlValue(in.ReleasesAccessLevel, project.ReleasesAccessLevel)
+	in.RequirementsAccessLevel = clients.LateInitializeAccessControlValue(in.RequirementsAccessLevel, project.RequirementsAccessLevel)
+	in.SecurityAndComplianceAccessLevel = clients.LateInitializeAccessControlValue(in.SecurityAndComplianceAccessLevel, project.SecurityAndComplianceAccessLevel)
 
 	if in.PackagesEnabled == nil {
 		in.PackagesEnabled = &project.PackagesEnabled
@@ -399,6 +978,10 @@ func (e *external) lateInitialize(ctx context.Context, cr *v1alpha1.Project, pro
 	in.PagesAccessLevel = clients.LateInitializeAccessControlValue(in.PagesAccessLevel, project.PagesAccessLevel)
 	in.Path = clients.LateInitializeStringPtr(in.Path, project.Path)
 
+	if in.PrintingMergeRequestLinkEnabled == nil {
+		in.PrintingMergeRequestLinkEnabled = &project.PrintingMergeRequestLinkEnabled
+	}
+
 	// Late-initialize publicJobs and publicBuilds for backward compatibility
 	// Only initialize if both fields are unset
 	//nolint:staticcheck // We intentionally use the deprecated field for backward compatibility
@@ -461,25 +1044,11 @@ func (e *external) lateInitializePushRules(ctx context.Context, cr *v1alpha1.Pro
 		return nil
 	}
 
-	cr.Spec.ForProvider.PushRules = &v1alpha1.PushRules{
-		AuthorEmailRegex:           clients.LateInitialize(cr.Spec.ForProvider.PushRules.AuthorEmailRegex, pr.AuthorEmailRegex),
-		BranchNameRegex:            clients.LateInitialize(cr.Spec.ForProvider.PushRules.BranchNameRegex, pr.BranchNameRegex),
-		CommitCommitterCheck:       clients.LateInitialize(cr.Spec.ForProvider.PushRules.CommitCommitterCheck, pr.CommitCommitterCheck),
-		CommitCommitterNameCheck:   clients.LateInitialize(cr.Spec.ForProvider.PushRules.CommitCommitterNameCheck, pr.CommitCommitterNameCheck),
-		CommitMessageNegativeRegex: clients.LateInitialize(cr.Spec.ForProvider.PushRules.CommitMessageNegativeRegex, pr.CommitMessageNegativeRegex),
-		CommitMessageRegex:         clients.LateInitialize(cr.Spec.ForProvider.PushRules.CommitMessageRegex, pr.CommitMessageRegex),
-		DenyDeleteTag:              clients.LateInitialize(cr.Spec.ForProvider.PushRules.DenyDeleteTag, pr.DenyDeleteTag),
-		FileNameRegex:              clients.LateInitialize(cr.Spec.ForProvider.PushRules.FileNameRegex, pr.FileNameRegex),
-		MaxFileSize:                clients.LateInitialize(cr.Spec.ForProvider.PushRules.MaxFileSize, pr.MaxFileSize),
-		MemberCheck:                clients.LateInitialize(cr.Spec.ForProvider.PushRules.MemberCheck, pr.MemberCheck),
-		PreventSecrets:             clients.LateInitialize(cr.Spec.ForProvider.PushRules.PreventSecrets, pr.PreventSecrets),
-		RejectUnsignedCommits:      clients.LateInitialize(cr.Spec.ForProvider.PushRules.RejectUnsignedCommits, pr.RejectUnsignedCommits),
-		RejectNonDCOCommits:        clients.LateInitialize(cr.Spec.ForProvider.PushRules.RejectNonDCOCommits, pr.RejectNonDCOCommits),
-	}
+	cr.Spec.ForProvider.PushRules = common.LateInitializePushRules(cr.Spec.ForProvider.PushRules, pr)
 	return nil
 }
 
-func (e *external) getProjectPushRules(ctx context.Context, cr *v1alpha1.Project) (*v1alpha1.PushRules, error) {
+func (e *external) getProjectPushRules(ctx context.Context, cr *v1alpha1.Project) (*commonv1alpha1.PushRules, error) {
 	if e.cache.externalPushRules != nil {
 		return e.cache.externalPushRules, nil
 	}
@@ -508,7 +1077,7 @@ func (e *external) getProjectPushRules(ctx context.Context, cr *v1alpha1.Project
 	if res == nil || res.ID == 0 {
 		return nil, nil
 	}
-	e.cache.externalPushRules = &v1alpha1.PushRules{
+	e.cache.externalPushRules = &commonv1alpha1.PushRules{
 		AuthorEmailRegex:           &res.AuthorEmailRegex,
 		BranchNameRegex:            &res.BranchNameRegex,
 		CommitCommitterCheck:       &res.CommitCommitterCheck,
@@ -534,12 +1103,21 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.AllowMergeOnSkippedPipeline, g.AllowMergeOnSkippedPipeline) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.AllowPipelineTriggerApproveDeployment, g.AllowPipelineTriggerApproveDeployment) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.ApprovalsBeforeMerge, g.ApprovalsBeforeMerge) { //nolint:staticcheck
 		return false
 	}
 	if !clients.IsComparableEqualToComparablePtr(p.AutocloseReferencedIssues, g.AutocloseReferencedIssues) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.AutoDevopsEnabled, g.AutoDevopsEnabled) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.AutoDevopsDeployStrategy, g.AutoDevopsDeployStrategy) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.BuildCoverageRegex, g.BuildCoverageRegex) {
 		return false
 	}
@@ -552,15 +1130,27 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.CIDefaultGitDepth, g.CIDefaultGitDepth) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.CIDeletePipelinesInSeconds, g.CIDeletePipelinesInSeconds) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.CIForwardDeploymentEnabled, g.CIForwardDeploymentEnabled) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.CIPipelineVariablesMinimumOverrideRole, g.CIPipelineVariablesMinimumOverrideRole) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.RestrictUserDefinedVariables, g.RestrictUserDefinedVariables) { //nolint:staticcheck
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.ContainerRegistryEnabled, g.ContainerRegistryEnabled) { //nolint:staticcheck
 		return false
 	}
 	if !clients.IsComparableEqualToComparablePtr((*string)(p.ContainerRegistryAccessLevel), string(g.ContainerRegistryAccessLevel)) {
 		return false
 	}
+	if !projects.IsContainerExpirationPolicyUpToDate(p.ContainerExpirationPolicyAttributes, g.ContainerExpirationPolicy) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.DefaultBranch, g.DefaultBranch) {
 		return false
 	}
@@ -576,12 +1166,22 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.IssuesTemplate, g.IssuesTemplate) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.KeepLatestArtifact, g.KeepLatestArtifact) {
+		return false
+	}
+	//nolint:staticcheck // Keeping this for backward compatibility during deprecation
+	if !clients.IsComparableEqualToComparablePtr(common.ResolveEmailsEnabledSetting(p.EmailsDisabled, p.EmailsEnabled), g.EmailsEnabled) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.LFSEnabled, g.LFSEnabled) {
 		return false
 	}
 	if !clients.IsComparableEqualToComparablePtr((*string)(p.MergeMethod), string(g.MergeMethod)) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.MergeRequestDefaultTargetSelf, g.MergeRequestDefaultTargetSelf) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr((*string)(p.MergeRequestsAccessLevel), string(g.MergeRequestsAccessLevel)) {
 		return false
 	}
@@ -609,9 +1209,42 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.OnlyMirrorProtectedBranches, g.OnlyMirrorProtectedBranches) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.PreventMergeWithoutJiraIssue, g.PreventMergeWithoutJiraIssue) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr((*string)(p.OperationsAccessLevel), string(g.OperationsAccessLevel)) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.AnalyticsAccessLevel), string(g.AnalyticsAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.EnvironmentsAccessLevel), string(g.EnvironmentsAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.FeatureFlagsAccessLevel), string(g.FeatureFlagsAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.InfrastructureAccessLevel), string(g.InfrastructureAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.ModelExperimentsAccessLevel), string(g.ModelExperimentsAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.ModelRegistryAccessLevel), string(g.ModelRegistryAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.MonitorAccessLevel), string(g.MonitorAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.ReleasesAccessLevel), string(g.ReleasesAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.RequirementsAccessLevel), string(g.RequirementsAccessLevel)) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr((*string)(p.SecurityAndComplianceAccessLevel), string(g.SecurityAndComplianceAccessLevel)) {
+		return false
+	}
 	if !clients.IsComparableEqualToComparablePtr(p.PackagesEnabled, g.PackagesEnabled) {
 		return false
 	}
@@ -621,6 +1254,9 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.Path, g.Path) {
 		return false
 	}
+	if !clients.IsComparableEqualToComparablePtr(p.PrintingMergeRequestLinkEnabled, g.PrintingMergeRequestLinkEnabled) {
+		return false
+	}
 	// Use the resolved publicJobs value for comparison
 	//nolint:staticcheck // We intentionally use the deprecated field for backward compatibility
 	effectiveValue, _ := common.ResolvePublicJobsSetting(p.PublicBuilds, p.PublicJobs)
@@ -651,10 +1287,16 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.SuggestionCommitMessage, g.SuggestionCommitMessage) {
 		return false
 	}
-	if !cmp.Equal(p.TagList, g.TagList, cmpopts.EquateEmpty()) { //nolint:staticcheck
+	if !clients.IsComparableEqualToComparablePtr(p.MergeCommitTemplate, g.MergeCommitTemplate) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.SquashCommitTemplate, g.SquashCommitTemplate) {
+		return false
+	}
+	if !clients.IsSameSet(p.TagList, g.TagList) { //nolint:staticcheck
 		return false
 	}
-	if !cmp.Equal(p.Topics, g.Topics, cmpopts.EquateEmpty()) {
+	if !clients.IsSameSet(p.Topics, g.Topics) {
 		return false
 	}
 	if !clients.IsComparableEqualToComparablePtr((*string)(p.Visibility), string(g.Visibility)) {
@@ -666,14 +1308,27 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsComparableEqualToComparablePtr(p.BuildGitStrategy, g.BuildGitStrategy) {
 		return false
 	}
+	return isImportURLUpToDate(p, g)
+}
+
+// isImportURLUpToDate checks whether the import URL of the project matches
+// the one of the parameters.
+func isImportURLUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	// GitLab always strips userinfo (credentials) from ImportURL in API responses.
 	// For example, "https://TOKEN:@github.com/org/repo.git" is returned as "https://github.com/org/repo.git".
 	// Note: a pure credential rotation (same host/path, different token) cannot
 	// be detected through the API – GitLab will still report the sanitized URL.
-	if p.ImportURL != nil && sanitizeImportURL(*p.ImportURL) != g.ImportURL {
-		return false
+	return p.ImportURL == nil || sanitizeImportURL(*p.ImportURL) == g.ImportURL
+}
+
+// importURLSecretVersion returns the resource version of the secret the
+// import URL is read from, or an empty string if it is not read from one.
+func (e *external) importURLSecretVersion(ctx context.Context, cr *v1alpha1.Project) (string, error) {
+	if cr.Spec.ForProvider.ImportURLSecretRef == nil {
+		return "", nil
 	}
-	return true
+	v, err := common.GetSecretVersionFromSecret(ctx, e.kube, cr, cr.Spec.ForProvider.ImportURLSecretRef)
+	return v, errors.Wrap(err, errGetImportURLSecret)
 }
 
 // sanitizeImportURL strips the userinfo (username / password / token) from a
@@ -694,86 +1349,11 @@ func sanitizeImportURL(rawURL string) string {
 	return u.String()
 }
 
-func isPushRulesEmpty(rules *v1alpha1.PushRules) bool { //nolint:gocyclo
-	if rules == nil {
-		return true
-	}
-
-	// Check string fields - if any is non-empty, rules are not empty
-	if rules.AuthorEmailRegex != nil && *rules.AuthorEmailRegex != "" {
-		return false
-	}
-	if rules.BranchNameRegex != nil && *rules.BranchNameRegex != "" {
-		return false
-	}
-	if rules.CommitMessageNegativeRegex != nil && *rules.CommitMessageNegativeRegex != "" {
-		return false
-	}
-	if rules.CommitMessageRegex != nil && *rules.CommitMessageRegex != "" {
-		return false
-	}
-	if rules.FileNameRegex != nil && *rules.FileNameRegex != "" {
-		return false
-	}
-
-	// Check boolean fields - if any is true, rules are not empty
-	if rules.CommitCommitterCheck != nil && *rules.CommitCommitterCheck {
-		return false
-	}
-	if rules.CommitCommitterNameCheck != nil && *rules.CommitCommitterNameCheck {
-		return false
-	}
-	if rules.DenyDeleteTag != nil && *rules.DenyDeleteTag {
-		return false
-	}
-	if rules.MemberCheck != nil && *rules.MemberCheck {
-		return false
-	}
-	if rules.PreventSecrets != nil && *rules.PreventSecrets {
-		return false
-	}
-	if rules.RejectNonDCOCommits != nil && *rules.RejectNonDCOCommits {
-		return false
-	}
-	if rules.RejectUnsignedCommits != nil && *rules.RejectUnsignedCommits {
-		return false
-	}
-
-	// Check integer fields - if any is non-zero, rules are not empty
-	if rules.MaxFileSize != nil && *rules.MaxFileSize != 0 {
-		return false
-	}
-
-	// If we reach here, all fields are empty/default
-	return true
-}
-
 func (e *external) isPushRulesUpToDate(ctx context.Context, cr *v1alpha1.Project) (bool, error) {
 	current, err := e.getProjectPushRules(ctx, cr)
 	if err != nil {
 		return false, err
 	}
 
-	// If push rules are not available (e.g., GitLab Community Edition),
-	// consider them up to date if no push rules are specified in the spec
-	if current == nil {
-		return cr.Spec.ForProvider.PushRules == nil, nil
-	}
-
-	// Check if current push rules are effectively empty (all default values)
-	isCurrentEmpty := isPushRulesEmpty(current)
-
-	// If current rules are empty and spec has no rules, they're up to date
-	if isCurrentEmpty && cr.Spec.ForProvider.PushRules == nil {
-		return true, nil
-	}
-
-	// If push rules are available in GitLab but not specified in spec,
-	// they need to be cleared (not up to date) - unless they're already effectively empty
-	if cr.Spec.ForProvider.PushRules == nil {
-		return isCurrentEmpty, nil
-	}
-
-	// Both exist, compare them
-	return cmp.Equal(cr.Spec.ForProvider.PushRules, current), nil
+	return common.IsPushRulesUpToDate(cr.Spec.ForProvider.PushRules, current), nil
 }
@@ -50,6 +50,7 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 )
 
@@ -66,6 +67,8 @@ var (
 type args struct {
 	env projects.ProtectedEnvironmentClient
 
+	project projects.Client
+
 	kube client.Client
 
 	cr resource.Managed
@@ -117,6 +120,12 @@ func protectedEnvironment(m ...peModifier) *v1alpha1.ProtectedEnvironment {
 
 }
 
+func withPreventSelfApproval(v *bool) peModifier {
+
+	return func(r *v1alpha1.ProtectedEnvironment) { r.Spec.ForProvider.PreventSelfApproval = v }
+
+}
+
 func withApprovalRules(rules *[]v1alpha1.EnvironmentApprovalRuleParameters) peModifier {
 
 	return func(r *v1alpha1.ProtectedEnvironment) {
@@ -490,13 +499,186 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+
+		"SelfApprovalUpToDate": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return &gitlab.Project{AllowPipelineTriggerApproveDeployment: false}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+
+					withConditions(xpv1.Available()),
+
+					withStatus(v1alpha1.ProtectedEnvironmentObservation{
+
+						Name: ptr.To(envName),
+
+						RequiredApprovalCount: ptr.To(int64(0)),
+
+						PreventSelfApproval: ptr.To(true),
+					}),
+				),
+
+				result: managed.ExternalObservation{
+
+					ResourceExists: true,
+
+					ResourceUpToDate: true,
+				},
+			},
+		},
+
+		"SelfApprovalToggled": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+
+					withConditions(xpv1.Available()),
+
+					withStatus(v1alpha1.ProtectedEnvironmentObservation{
+
+						Name: ptr.To(envName),
+
+						RequiredApprovalCount: ptr.To(int64(0)),
+
+						PreventSelfApproval: ptr.To(false),
+					}),
+				),
+
+				result: managed.ExternalObservation{
+
+					ResourceExists: true,
+
+					ResourceUpToDate: false,
+				},
+			},
+		},
+
+		"FailedGetProject": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return nil, &gitlab.Response{}, errBoom
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+
+				err: errors.Wrap(errBoom, errGetProjectFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 
 		t.Run(name, func(t *testing.T) {
 
-			e := &external{client: tc.env}
+			e := &external{client: tc.env, projectClient: tc.project}
 
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
@@ -650,13 +832,68 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+
+		"SuccessfulCreationWithSelfApproval": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					createFn: func(pid interface{}, opt *gitlab.ProtectRepositoryEnvironmentsOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						if opt.AllowPipelineTriggerApproveDeployment == nil || *opt.AllowPipelineTriggerApproveDeployment {
+
+							return nil, &gitlab.Response{}, errors.New("unexpected self-approval setting")
+
+						}
+
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+
+					withConditions(xpv1.Creating()),
+				),
+
+				result: managed.ExternalCreation{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 
 		t.Run(name, func(t *testing.T) {
 
-			e := &external{client: tc.env}
+			e := &external{client: tc.env, projectClient: tc.project}
 
 			o, err := e.Create(context.Background(), tc.args.cr)
 
@@ -867,13 +1104,154 @@ func TestUpdate(t *testing.T) {
 
 			want: want{err: errors.Wrap(errBoom, errUpdateFailed)},
 		},
+
+		"EnablePreventSelfApproval": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, &gitlab.Response{}, nil
+
+					},
+
+					MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						if opt.AllowPipelineTriggerApproveDeployment == nil || *opt.AllowPipelineTriggerApproveDeployment != false {
+
+							return nil, &gitlab.Response{}, errors.New("unexpected self-approval setting")
+
+						}
+
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{},
+		},
+
+		"DisablePreventSelfApproval": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return &gitlab.Project{AllowPipelineTriggerApproveDeployment: false}, &gitlab.Response{}, nil
+
+					},
+
+					MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						if opt.AllowPipelineTriggerApproveDeployment == nil || *opt.AllowPipelineTriggerApproveDeployment != true {
+
+							return nil, &gitlab.Response{}, errors.New("unexpected self-approval setting")
+
+						}
+
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(false)),
+				),
+			},
+
+			want: want{},
+		},
+
+		"FailedSelfApprovalUpdate": {
+
+			args: args{
+
+				env: &mockProtectedEnvironmentClient{
+
+					getFn: func(pid interface{}, name string, _ ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+
+						return &gitlab.ProtectedEnvironment{Name: envName}, &gitlab.Response{}, nil
+
+					},
+				},
+
+				project: &fake.MockClient{
+
+					MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						return &gitlab.Project{AllowPipelineTriggerApproveDeployment: true}, &gitlab.Response{}, nil
+
+					},
+
+					MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+
+						if opt.AllowPipelineTriggerApproveDeployment == nil || *opt.AllowPipelineTriggerApproveDeployment != false {
+
+							return nil, &gitlab.Response{}, errors.New("unexpected self-approval setting")
+
+						}
+
+						return &gitlab.Project{}, &gitlab.Response{}, errBoom
+
+					},
+				},
+
+				cr: protectedEnvironment(
+
+					withName(ptr.To(envName)),
+
+					withProjectID(ptr.To(projectID)),
+
+					withPreventSelfApproval(ptr.To(true)),
+				),
+			},
+
+			want: want{err: errors.Wrap(errBoom, errSelfApprovalFailed)},
+		},
 	}
 
 	for name, tc := range cases {
 
 		t.Run(name, func(t *testing.T) {
 
-			e := &external{client: tc.env}
+			e := &external{client: tc.env, projectClient: tc.project}
 
 			_, err := e.Update(context.Background(), tc.args.cr)
 
@@ -1023,7 +1401,7 @@ func TestDelete(t *testing.T) {
 
 		t.Run(name, func(t *testing.T) {
 
-			e := &external{client: tc.env}
+			e := &external{client: tc.env, projectClient: tc.project}
 
 			o, err := e.Delete(context.Background(), tc.args.cr)
 
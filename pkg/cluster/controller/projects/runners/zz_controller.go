@@ -20,7 +20,6 @@ package runners
 
 import (
 	"context"
-	"slices"
 	"strconv"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -62,11 +61,11 @@ func SetupRunner(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName("cluster." + v1alpha1.RunnerGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: runners.NewRunnerClient,
 			newRunnerClientFn: users.NewRunnerClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -285,7 +284,7 @@ func isRunnerUpToDate(p *v1alpha1.RunnerParameters, r *gitlab.RunnerDetails) boo
 	if p.RunUntagged != nil && *p.RunUntagged != r.RunUntagged {
 		return false
 	}
-	if p.TagList != nil && !slices.Equal(*p.TagList, r.TagList) {
+	if p.TagList != nil && !clients.IsSameSet(*p.TagList, r.TagList) {
 		return false
 	}
 	if p.AccessLevel != nil && *p.AccessLevel != r.AccessLevel {
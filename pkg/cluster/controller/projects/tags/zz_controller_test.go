@@ -0,0 +1,527 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package tags
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	tagName        = "v1.0.0"
+	ref            = "main"
+	message        = "Release v1.0.0"
+	description    = "First stable release"
+	commitID       = "0123456789abcdef"
+
+	gitlabTag = &gitlab.Tag{
+		Name:    tagName,
+		Message: message,
+		Target:  "fedcba9876543210",
+		Commit:  &gitlab.Commit{ID: commitID},
+	}
+
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: 404}}
+	forbidden = &gitlab.Response{Response: &http.Response{StatusCode: 403}}
+)
+
+type args struct {
+	tag  projects.TagClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type tagModifier func(*v1alpha1.Tag)
+
+func withConditions(c ...xpv1.Condition) tagModifier {
+	return func(r *v1alpha1.Tag) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.TagObservation) tagModifier {
+	return func(r *v1alpha1.Tag) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) tagModifier {
+	return func(r *v1alpha1.Tag) { meta.SetExternalName(r, n) }
+}
+
+func withRef(ref string) tagModifier {
+	return func(r *v1alpha1.Tag) { r.Spec.ForProvider.Ref = ref }
+}
+
+func withMessage(m string) tagModifier {
+	return func(r *v1alpha1.Tag) { r.Spec.ForProvider.Message = &m }
+}
+
+func withReleaseDescription(d string) tagModifier {
+	return func(r *v1alpha1.Tag) { r.Spec.ForProvider.ReleaseDescription = &d }
+}
+
+func withDefaultSpec() tagModifier {
+	return func(r *v1alpha1.Tag) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.TagName = tagName
+		r.Spec.ForProvider.Ref = ref
+		r.Spec.ForProvider.Message = &message
+	}
+}
+
+func tag(m ...tagModifier) *v1alpha1.Tag {
+	cr := &v1alpha1.Tag{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedTag(ref string) v1alpha1.TagObservation {
+	return v1alpha1.TagObservation{
+		Name:     tagName,
+		Ref:      ref,
+		Message:  message,
+		Target:   gitlabTag.Target,
+		CommitID: commitID,
+	}
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		result managed.ExternalClient
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errNotTag),
+			},
+		},
+		"ProviderConfigRefNotGivenError": {
+			args: args{
+				cr:   tag(),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+			want: want{
+				err: errors.New("providerConfigRef is not given"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newGitlabClientFn: func(cfg common.Config) projects.TagClient {
+				return tc.tag
+			}}
+			o, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	getTag := func(t *gitlab.Tag, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetTag: func(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+				return t, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotTag),
+			},
+		},
+		"NoTagName": {
+			args: args{
+				cr: tag(),
+			},
+			want: want{
+				cr: tag(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				tag: getTag(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr:  tag(withDefaultSpec()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"TagNotFound": {
+			args: args{
+				tag: getTag(nil, notFound, errBoom),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr: tag(withDefaultSpec()),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				tag: getTag(gitlabTag, &gitlab.Response{}, nil),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr: tag(
+					withDefaultSpec(),
+					withStatus(observedTag(ref)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MessageChanged": {
+			args: args{
+				tag: getTag(gitlabTag, &gitlab.Response{}, nil),
+				cr:  tag(withDefaultSpec(), withMessage("other")),
+			},
+			want: want{
+				cr: tag(
+					withDefaultSpec(),
+					withMessage("other"),
+					withStatus(observedTag(ref)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ReleaseMissing": {
+			args: args{
+				tag: getTag(gitlabTag, &gitlab.Response{}, nil),
+				cr:  tag(withDefaultSpec(), withReleaseDescription(description)),
+			},
+			want: want{
+				cr: tag(
+					withDefaultSpec(),
+					withReleaseDescription(description),
+					withStatus(observedTag(ref)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tag}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotTag),
+			},
+		},
+		"TagNameMissing": {
+			args: args{
+				cr: tag(withRef(ref)),
+			},
+			want: want{
+				cr:  tag(withRef(ref)),
+				err: errors.New(errTagNameMissing),
+			},
+		},
+		"SuccessfulCreationWithRelease": {
+			args: args{
+				tag: &fake.MockClient{
+					MockCreateTag: func(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+						return gitlabTag, &gitlab.Response{}, nil
+					},
+					MockCreateRelease: func(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						if *opts.TagName != tagName || *opts.Description != description {
+							return nil, nil, errBoom
+						}
+						return &gitlab.Release{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: tag(withDefaultSpec(), withReleaseDescription(description)),
+			},
+			want: want{
+				cr: tag(
+					withDefaultSpec(),
+					withReleaseDescription(description),
+					withExternalName(tagName),
+					withStatus(func() v1alpha1.TagObservation {
+						o := observedTag(ref)
+						o.ReleaseDescription = description
+						return o
+					}()),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"ProtectedTagForbidden": {
+			args: args{
+				tag: &fake.MockClient{
+					MockCreateTag: func(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+						return nil, forbidden, errBoom
+					},
+				},
+				cr: tag(withDefaultSpec()),
+			},
+			want: want{
+				cr:  tag(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrapf(errors.Wrap(errBoom, errCreateFailed), errTagProtected, tagName),
+			},
+		},
+		"FailedRelease": {
+			args: args{
+				tag: &fake.MockClient{
+					MockCreateTag: func(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+						return gitlabTag, &gitlab.Response{}, nil
+					},
+					MockCreateRelease: func(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: tag(withDefaultSpec(), withReleaseDescription(description)),
+			},
+			want: want{
+				cr:  tag(withDefaultSpec(), withReleaseDescription(description), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateReleaseFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tag}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		deleted bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotTag),
+			},
+		},
+		"Recreate": {
+			args: args{
+				cr: tag(withDefaultSpec(), withMessage("other"), withStatus(observedTag(ref))),
+			},
+			want: want{
+				cr:      tag(withDefaultSpec(), withMessage("other"), withStatus(observedTag(ref))),
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			client := &fake.MockClient{
+				MockDeleteTag: func(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					deleted = true
+					return &gitlab.Response{}, nil
+				},
+				MockCreateTag: func(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+					if !deleted {
+						return nil, nil, errBoom
+					}
+					return gitlabTag, &gitlab.Response{}, nil
+				},
+			}
+			e := &external{kube: tc.kube, client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteTag := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteTag: func(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotTag),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				tag: deleteTag(&gitlab.Response{}, nil),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr: tag(withDefaultSpec(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				tag: deleteTag(notFound, errBoom),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr: tag(withDefaultSpec(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ProtectedTagForbidden": {
+			args: args{
+				tag: deleteTag(forbidden, errBoom),
+				cr:  tag(withDefaultSpec()),
+			},
+			want: want{
+				cr:  tag(withDefaultSpec(), withConditions(xpv1.Deleting())),
+				err: errors.Wrapf(errors.Wrap(errBoom, errDeleteFailed), errTagProtected, tagName),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tag}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/generate-cluster-scope.go - DO NOT EDIT.
+
+package wikipages
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNotWikiPage         = "managed resource is not a GitLab wiki page custom resource"
+	errProjectIDMissing    = "ProjectID is missing"
+	errContentMissing      = "one of content or contentSecretRef is required"
+	errContentSecretFailed = "cannot get wiki page content from secret"
+	errGetFailed           = "cannot get GitLab wiki page"
+	errCreateFailed        = "cannot create GitLab wiki page"
+	errUpdateFailed        = "cannot update GitLab wiki page"
+	errDeleteFailed        = "cannot delete GitLab wiki page"
+)
+
+// SetupWikiPage adds a controller that reconciles WikiPages.
+func SetupWikiPage(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName("cluster." + v1alpha1.WikiPageGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewWikiPageClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WikiPageGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.WikiPageList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.WikiPage{}).
+		Complete(r)
+}
+
+// SetupWikiPageGated adds a controller with CRD gate support.
+func SetupWikiPageGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupWikiPage(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.WikiPageGroupVersionKind.String())
+		}
+	}, v1alpha1.WikiPageGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.WikiPageClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WikiPage)
+	if !ok {
+		return nil, errors.New(errNotWikiPage)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.WikiPageClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WikiPage)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWikiPage)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	page, res, err := e.client.GetWikiPage(*cr.Spec.ForProvider.ProjectID, externalName, nil, gitlab.WithContext(ctx))
+	// Renaming a page changes its slug. The external name is not persisted
+	// after an update, so the slug is looked up from the status instead.
+	if err != nil && clients.IsResponseNotFound(res) && cr.Status.AtProvider.Slug != "" && cr.Status.AtProvider.Slug != externalName {
+		page, res, err = e.client.GetWikiPage(*cr.Spec.ForProvider.ProjectID, cr.Status.AtProvider.Slug, nil, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeWikiPage(&cr.Spec.ForProvider, page)
+
+	meta.SetExternalName(cr, page.Slug)
+	cr.Status.AtProvider = projects.GenerateWikiPageObservation(page)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsWikiPageUpToDate(&cr.Spec.ForProvider, content, page),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider) || page.Slug != externalName,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WikiPage)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWikiPage)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	page, _, err := e.client.CreateWikiPage(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateWikiPageOptions(&cr.Spec.ForProvider, content),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateWikiPageObservation(page)
+	meta.SetExternalName(cr, page.Slug)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WikiPage)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWikiPage)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	page, _, err := e.client.EditWikiPage(
+		*cr.Spec.ForProvider.ProjectID,
+		meta.GetExternalName(cr),
+		projects.GenerateEditWikiPageOptions(&cr.Spec.ForProvider, content),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateWikiPageObservation(page)
+	meta.SetExternalName(cr, page.Slug)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.WikiPage)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotWikiPage)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteWikiPage(*cr.Spec.ForProvider.ProjectID, meta.GetExternalName(cr), gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getContent returns the content of the wiki page, read from the referenced
+// secret if contentSecretRef is set.
+func (e *external) getContent(ctx context.Context, cr *v1alpha1.WikiPage) (string, error) {
+	if cr.Spec.ForProvider.ContentSecretRef != nil {
+		content, err := common.GetTokenValueFromSecret(ctx, e.kube, cr, cr.Spec.ForProvider.ContentSecretRef)
+		if err != nil {
+			return "", errors.Wrap(err, errContentSecretFailed)
+		}
+		return *content, nil
+	}
+	if cr.Spec.ForProvider.Content == nil {
+		return "", errors.New(errContentMissing)
+	}
+	return *cr.Spec.ForProvider.Content, nil
+}
@@ -23,20 +23,33 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/accesstokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/approvalconfigurations"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/approvalrules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/badges"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/branches"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/clusters"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/deploykeys"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/deployments"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/deploytokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/externalstatuschecks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/forks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/hooks"
 	integrationmattermost "github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/integrationmattermost"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/issues"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/mergerequests"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/pagesdomains"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/pipelineschedules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/projectsharegroups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/protectedbranches"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/protectedenvironments"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/runners"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/tags"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/terraformstates"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/variablesets"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/cluster/controller/projects/wikipages"
 )
 
 // Setup all project controllers
@@ -51,12 +64,25 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		deploykeys.SetupDeployKey,
 		pipelineschedules.SetupPipelineSchedule,
 		approvalrules.SetupRules,
+		approvalconfigurations.SetupApprovalConfiguration,
 		runners.SetupRunner,
 		protectedbranches.SetupProtectedBranch,
 		badges.SetupBadge,
 		integrationmattermost.SetupIntegrationMattermost,
 		protectedenvironments.SetupProtectedEnvironment,
 		projectsharegroups.SetupProjectShareGroup,
+		branches.SetupBranch,
+		tags.SetupTag,
+		pagesdomains.SetupPagesDomain,
+		clusters.SetupCluster,
+		forks.SetupFork,
+		issues.SetupIssue,
+		wikipages.SetupWikiPage,
+		mergerequests.SetupMergeRequest,
+		deployments.SetupDeployment,
+		variablesets.SetupVariableSet,
+		externalstatuschecks.SetupProjectExternalStatusCheck,
+		terraformstates.SetupTerraformState,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -78,12 +104,25 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		deploykeys.SetupDeployKeyGated,
 		pipelineschedules.SetupPipelineScheduleGated,
 		approvalrules.SetupRulesGated,
+		approvalconfigurations.SetupApprovalConfigurationGated,
 		runners.SetupRunnerGated,
 		protectedbranches.SetupProtectedBranchGated,
 		badges.SetupBadgeGated,
 		integrationmattermost.SetupIntegrationMattermostGated,
 		protectedenvironments.SetupProtectedEnvironmentGated,
 		projectsharegroups.SetupProjectShareGroupGated,
+		branches.SetupBranchGated,
+		tags.SetupTagGated,
+		pagesdomains.SetupPagesDomainGated,
+		clusters.SetupClusterGated,
+		forks.SetupForkGated,
+		issues.SetupIssueGated,
+		wikipages.SetupWikiPageGated,
+		mergerequests.SetupMergeRequestGated,
+		deployments.SetupDeploymentGated,
+		variablesets.SetupVariableSetGated,
+		externalstatuschecks.SetupProjectExternalStatusCheckGated,
+		terraformstates.SetupTerraformStateGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
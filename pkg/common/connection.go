@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	errCurrentUser = "cannot get the authenticated user"
+)
+
+// requiredTokenScopes are the scopes an access token needs to manage
+// resources.
+var requiredTokenScopes = []string{"api"}
+
+// Connection describes the user and instance a Config authenticates against.
+type Connection struct {
+	Username string
+	UserID   int64
+	// Scopes granted to the access token, nil if they cannot be read.
+	Scopes  []string
+	Version string
+	// RateLimit is the rate limit budget last reported for the credentials,
+	// nil if GitLab does not enforce rate limits.
+	RateLimit *RateLimitBudget
+}
+
+// CheckConnection authenticates against Gitlab with cfg and returns the
+// authenticated user, the scopes of the access token and the version of the
+// instance. Only a failure to get the user is an error; the scopes and the
+// version are best effort since not every token may read them.
+func CheckConnection(ctx context.Context, cfg Config) (*Connection, error) {
+	cl := NewClient(cfg)
+
+	u, _, err := cl.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(RedactError(err, cfg.Token, cfg.ReadToken), errCurrentUser)
+	}
+	c := &Connection{Username: u.Username, UserID: u.ID}
+
+	if t, _, err := cl.PersonalAccessTokens.GetSinglePersonalAccessToken(gitlab.WithContext(ctx)); err == nil {
+		c.Scopes = t.Scopes
+	}
+	if v, _, err := cl.Version.GetVersion(gitlab.WithContext(ctx)); err == nil {
+		c.Version = v.Version
+	}
+	c.RateLimit = LastRateLimitBudget(cfg)
+	return c, nil
+}
+
+// MissingScopes returns the scopes required to manage resources that the
+// access token of the connection lacks. Unknown scopes are not reported.
+func (c *Connection) MissingScopes() []string {
+	if c.Scopes == nil {
+		return nil
+	}
+	var missing []string
+	for _, s := range requiredTokenScopes {
+		if !slices.Contains(c.Scopes, s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
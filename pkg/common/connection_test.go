@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckConnection(t *testing.T) {
+	cases := map[string]struct {
+		handler http.HandlerFunc
+		want    *Connection
+		err     bool
+	}{
+		"PersonalAccessToken": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v4/user":
+					fmt.Fprint(w, `{"id":42,"username":"provider-bot"}`)
+				case "/api/v4/personal_access_tokens/self":
+					fmt.Fprint(w, `{"id":1,"scopes":["read_api"]}`)
+				case "/api/v4/version":
+					fmt.Fprint(w, `{"version":"17.2.1-ee"}`)
+				}
+			},
+			want: &Connection{Username: "provider-bot", UserID: 42, Scopes: []string{"read_api"}, Version: "17.2.1-ee"},
+		},
+		"ScopesAndVersionUnreadable": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v4/user" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id":42,"username":"provider-bot"}`)
+			},
+			want: &Connection{Username: "provider-bot", UserID: 42},
+		},
+		"Unauthorized": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			err: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(tc.handler)
+			defer srv.Close()
+
+			got, err := CheckConnection(context.Background(), Config{BaseURL: srv.URL, Token: "token"})
+			if (err != nil) != tc.err {
+				t.Fatalf("CheckConnection(...): want error %t, got %v", tc.err, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CheckConnection(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	cases := map[string]struct {
+		scopes []string
+		want   []string
+	}{
+		"Unknown": {
+			scopes: nil,
+		},
+		"Granted": {
+			scopes: []string{"api", "read_repository"},
+		},
+		"ReadOnly": {
+			scopes: []string{"read_api"},
+			want:   []string{"api"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &Connection{Scopes: tc.scopes}
+			if diff := cmp.Diff(tc.want, c.MissingScopes()); diff != "" {
+				t.Errorf("MissingScopes(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
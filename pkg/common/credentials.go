@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// authHeaders are the headers the gitlab.AuthSource implementations
+// authenticate requests with.
+var authHeaders = []string{gitlab.AccessTokenHeaderName, gitlab.JobTokenHeaderName, "Authorization"}
+
+// readCredentials authenticates the requests that only read from GitLab
+// with a separate, usually less privileged, credential. All other requests
+// keep the credentials the client was created with.
+type readCredentials struct {
+	source gitlab.AuthSource
+	client *gitlab.Client
+
+	once    sync.Once
+	initErr error
+}
+
+func isReadRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead
+}
+
+// intercept replaces the authentication header of read requests by the one
+// of the read credentials.
+func (r *readCredentials) intercept(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !isReadRequest(req) {
+			return next.RoundTrip(req)
+		}
+
+		r.once.Do(func() {
+			r.initErr = r.source.Init(req.Context(), r.client)
+		})
+		if r.initErr != nil {
+			return nil, r.initErr
+		}
+		key, value, err := r.source.Header(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		for _, h := range authHeaders {
+			req.Header.Del(h)
+		}
+		req.Header.Set(key, value)
+		return next.RoundTrip(req)
+	})
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	namespacedV1Beta1 "github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+	auth "github.com/crossplane-contrib/provider-gitlab/pkg/common/auth"
+)
+
+func TestReadCredentials(t *testing.T) {
+	type want struct {
+		get    map[string]string
+		create map[string]string
+	}
+	cases := map[string]struct {
+		cfg  Config
+		want want
+	}{
+		"SingleToken": {
+			cfg: Config{Token: "write"},
+			want: want{
+				get:    map[string]string{gitlab.AccessTokenHeaderName: "write"},
+				create: map[string]string{gitlab.AccessTokenHeaderName: "write"},
+			},
+		},
+		"ReadToken": {
+			cfg: Config{Token: "write", ReadToken: "read"},
+			want: want{
+				get:    map[string]string{gitlab.AccessTokenHeaderName: "read"},
+				create: map[string]string{gitlab.AccessTokenHeaderName: "write"},
+			},
+		},
+		"ReadTokenWithOtherMethod": {
+			cfg: Config{Token: "write", ReadToken: "read", ReadAuthMethod: auth.OAuthToken},
+			want: want{
+				get:    map[string]string{"Authorization": "Bearer read"},
+				create: map[string]string{gitlab.AccessTokenHeaderName: "write"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := map[string]map[string]string{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				h := map[string]string{}
+				for _, k := range authHeaders {
+					if v := r.Header.Get(k); v != "" {
+						h[k] = v
+					}
+				}
+				got[r.Method] = h
+				_, _ = w.Write([]byte(`{"id":1}`))
+			}))
+			defer srv.Close()
+
+			tc.cfg.BaseURL = srv.URL
+			cl := NewClient(tc.cfg)
+			if _, _, err := cl.Projects.GetProject(1, nil); err != nil {
+				t.Fatalf("GetProject(...): unexpected error: %v", err)
+			}
+			if _, _, err := cl.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.Ptr("p")}); err != nil {
+				t.Fatalf("CreateProject(...): unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want.get, got[http.MethodGet]); diff != "" {
+				t.Errorf("GET headers: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.create, got[http.MethodPost]); diff != "" {
+				t.Errorf("POST headers: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadCredentialsToken(t *testing.T) {
+	errBoom := errors.New("boom")
+	secretRef := &xpv1.SecretKeySelector{
+		Key:             "token",
+		SecretReference: xpv1.SecretReference{Name: "gitlab-read", Namespace: "default"},
+	}
+
+	type want struct {
+		token  string
+		method auth.AuthType
+		err    error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		rc   *namespacedV1Beta1.ProviderCredentials
+		want want
+	}{
+		"NotConfigured": {
+			want: want{},
+		},
+		"FromSecret": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("read")}
+					return nil
+				}),
+			},
+			rc: &namespacedV1Beta1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				Method:                    auth.OAuthToken,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef},
+			},
+			want: want{token: "read", method: auth.OAuthToken},
+		},
+		"UnsupportedSource": {
+			rc: &namespacedV1Beta1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			want: want{
+				err: errors.New("read credentials source Environment is not currently supported"),
+			},
+		},
+		"NoSecretReferenced": {
+			rc: &namespacedV1Beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			want: want{
+				err: errors.New("no read credentials secret referenced"),
+			},
+		},
+		"SecretNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			rc: &namespacedV1Beta1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, ErrSecretNotFound), "cannot get read credentials"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			token, method, err := readCredentialsToken(context.Background(), tc.kube, &mockManagedResource{}, tc.rc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("readCredentialsToken(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, token); diff != "" {
+				t.Errorf("readCredentialsToken(...): -want token, +got token:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.method, method); diff != "" {
+				t.Errorf("readCredentialsToken(...): -want method, +got method:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValueHashSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	secretRef := &xpv1.SecretKeySelector{
+		Key:             "key",
+		SecretReference: xpv1.SecretReference{Name: "gitlab-value-hash-key", Namespace: "default"},
+	}
+
+	type want struct {
+		secret []byte
+		err    error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		ref  *xpv1.SecretKeySelector
+		want want
+	}{
+		"NotConfigured": {
+			want: want{},
+		},
+		"FromSecret": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"key": []byte("s3cr3t")}
+					return nil
+				}),
+			},
+			ref:  secretRef,
+			want: want{secret: []byte("s3cr3t")},
+		},
+		"SecretNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			ref: secretRef,
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, ErrSecretNotFound), "cannot get value hash key"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			secret, err := valueHashSecret(context.Background(), tc.kube, &mockManagedResource{}, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("valueHashSecret(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.secret, secret); diff != "" {
+				t.Errorf("valueHashSecret(...): -want secret, +got secret:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeySkipExternalDelete skips the delete call against GitLab
+	// when set to "true", so the finalizer is removed without touching the
+	// external resource.
+	AnnotationKeySkipExternalDelete = "gitlab.crossplane.io/skip-external-delete"
+
+	// AnnotationKeySkipExternalDeleteAfterFailures skips the delete call
+	// against GitLab once deleting the external resource has failed the
+	// given number of consecutive times.
+	AnnotationKeySkipExternalDeleteAfterFailures = "gitlab.crossplane.io/skip-external-delete-after-failures"
+
+	// AnnotationKeyExternalDeleteFailures records the number of consecutive
+	// failed attempts to delete the external resource.
+	AnnotationKeyExternalDeleteFailures = "gitlab.crossplane.io/external-delete-failures"
+
+	errRecordDeleteFailure = "cannot record failed external delete"
+)
+
+// SkipExternalDelete returns true if the external resource of a managed
+// resource that is being deleted should be left alone, either because it
+// was explicitly requested or because deleting it failed too often.
+// External deletes are never skipped by default.
+func SkipExternalDelete(mg resource.Managed) bool {
+	if !meta.WasDeleted(mg) {
+		return false
+	}
+
+	a := mg.GetAnnotations()
+	if a[AnnotationKeySkipExternalDelete] == "true" {
+		return true
+	}
+
+	threshold, ok := deleteFailureThreshold(mg)
+	if !ok {
+		return false
+	}
+	failures, _ := strconv.Atoi(a[AnnotationKeyExternalDeleteFailures])
+	return failures >= threshold
+}
+
+// RecordExternalDeleteFailure increments the consecutive delete failure
+// counter of a managed resource that is being deleted and returns the
+// supplied error. Nothing is recorded unless a failure threshold has been
+// configured.
+func RecordExternalDeleteFailure(ctx context.Context, kube client.Client, mg resource.Managed, err error) error {
+	if err == nil || !meta.WasDeleted(mg) {
+		return err
+	}
+	if _, ok := deleteFailureThreshold(mg); !ok {
+		return err
+	}
+
+	failures, _ := strconv.Atoi(mg.GetAnnotations()[AnnotationKeyExternalDeleteFailures])
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyExternalDeleteFailures: strconv.Itoa(failures + 1)})
+	if uerr := kube.Update(ctx, mg); uerr != nil {
+		return errors.Wrap(uerr, errRecordDeleteFailure)
+	}
+	return err
+}
+
+func deleteFailureThreshold(mg resource.Managed) (int, bool) {
+	v, ok := mg.GetAnnotations()[AnnotationKeySkipExternalDeleteAfterFailures]
+	if !ok {
+		return 0, false
+	}
+	threshold, err := strconv.Atoi(v)
+	if err != nil || threshold < 1 {
+		return 0, false
+	}
+	return threshold, true
+}
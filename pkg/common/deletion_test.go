@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func managedWithAnnotations(deleted bool, a map[string]string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetAnnotations(a)
+	if deleted {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+	return mg
+}
+
+func TestSkipExternalDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg   *fake.Managed
+		want bool
+	}{
+		"NotDeleted": {
+			mg:   managedWithAnnotations(false, map[string]string{AnnotationKeySkipExternalDelete: "true"}),
+			want: false,
+		},
+		"NoAnnotations": {
+			mg:   managedWithAnnotations(true, nil),
+			want: false,
+		},
+		"ExplicitlyRequested": {
+			mg:   managedWithAnnotations(true, map[string]string{AnnotationKeySkipExternalDelete: "true"}),
+			want: true,
+		},
+		"BelowThreshold": {
+			mg: managedWithAnnotations(true, map[string]string{
+				AnnotationKeySkipExternalDeleteAfterFailures: "3",
+				AnnotationKeyExternalDeleteFailures:          "2",
+			}),
+			want: false,
+		},
+		"ThresholdReached": {
+			mg: managedWithAnnotations(true, map[string]string{
+				AnnotationKeySkipExternalDeleteAfterFailures: "3",
+				AnnotationKeyExternalDeleteFailures:          "3",
+			}),
+			want: true,
+		},
+		"InvalidThreshold": {
+			mg: managedWithAnnotations(true, map[string]string{
+				AnnotationKeySkipExternalDeleteAfterFailures: "0",
+				AnnotationKeyExternalDeleteFailures:          "3",
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SkipExternalDelete(tc.mg)); diff != "" {
+				t.Errorf("SkipExternalDelete(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordExternalDeleteFailure(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		kube client.Client
+		mg   *fake.Managed
+		err  error
+	}
+	type want struct {
+		failures string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoError": {
+			args: args{
+				mg: managedWithAnnotations(true, map[string]string{AnnotationKeySkipExternalDeleteAfterFailures: "3"}),
+			},
+			want: want{},
+		},
+		"NoThreshold": {
+			args: args{
+				mg:  managedWithAnnotations(true, nil),
+				err: errBoom,
+			},
+			want: want{err: errBoom},
+		},
+		"NotDeleted": {
+			args: args{
+				mg:  managedWithAnnotations(false, map[string]string{AnnotationKeySkipExternalDeleteAfterFailures: "3"}),
+				err: errBoom,
+			},
+			want: want{err: errBoom},
+		},
+		"FailureRecorded": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: managedWithAnnotations(true, map[string]string{
+					AnnotationKeySkipExternalDeleteAfterFailures: "3",
+					AnnotationKeyExternalDeleteFailures:          "1",
+				}),
+				err: errBoom,
+			},
+			want: want{failures: "2", err: errBoom},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   managedWithAnnotations(true, map[string]string{AnnotationKeySkipExternalDeleteAfterFailures: "3"}),
+				err:  errBoom,
+			},
+			want: want{failures: "1", err: errors.Wrap(errBoom, errRecordDeleteFailure)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := RecordExternalDeleteFailure(context.Background(), tc.args.kube, tc.args.mg, tc.args.err)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("RecordExternalDeleteFailure(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.failures, tc.args.mg.GetAnnotations()[AnnotationKeyExternalDeleteFailures]); diff != "" {
+				t.Errorf("RecordExternalDeleteFailure(): -want failures, +got failures:\n%s", diff)
+			}
+		})
+	}
+}
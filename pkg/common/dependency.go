@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReasonWaitingForDependency is used when a managed resource waits for a
+// resource it references, e.g. a Project that is applied together with its
+// Variables but not created yet.
+const ReasonWaitingForDependency xpv1.ConditionReason = "WaitingForDependency"
+
+// WaitingForDependency returns a condition that indicates that a managed
+// resource waits for the referenced resource of the given kind.
+func WaitingForDependency(kind string, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForDependency,
+		Message:            fmt.Sprintf("waiting for %s: %s", kind, err),
+	}
+}
+
+// IsWaitingForDependency returns true if the managed resource waits for a
+// resource it references.
+func IsWaitingForDependency(mg resource.Managed) bool {
+	return mg.GetCondition(xpv1.TypeReady).Reason == ReasonWaitingForDependency
+}
+
+// WaitForDependency returns a ReferenceResolver that lets a managed resource
+// wait for the resource of the given kind it depends on. References are
+// resolved as usual; if that fails and resolved reports that the dependency
+// is still unresolved, the WaitingForDependency condition is set instead of
+// reporting a reconcile error. The external client must then skip the
+// resource, see WaitingObservation.
+func WaitForDependency(c client.Client, kind string, resolved func(mg resource.Managed) bool) managed.ReferenceResolver {
+	r := managed.NewAPISimpleReferenceResolver(c)
+	return managed.ReferenceResolverFn(func(ctx context.Context, mg resource.Managed) error {
+		err := r.ResolveReferences(ctx, mg)
+		if err == nil || resolved(mg) {
+			return err
+		}
+		mg.SetConditions(WaitingForDependency(kind, err))
+		return nil
+	})
+}
+
+// WaitingObservation returns the observation of a managed resource that
+// waits for a dependency. It is reported as up to date, so it is neither
+// created nor updated and is checked again after the poll interval. A
+// resource deleted while waiting was never created.
+func WaitingObservation(mg resource.Managed) managed.ExternalObservation {
+	return managed.ExternalObservation{ResourceExists: !meta.WasDeleted(mg), ResourceUpToDate: true}
+}
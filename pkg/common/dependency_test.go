@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type referencer struct {
+	fake.Managed
+	err error
+}
+
+func (r *referencer) ResolveReferences(_ context.Context, _ client.Reader) error {
+	return r.err
+}
+
+func TestWaitForDependency(t *testing.T) {
+	errUnresolved := errors.New("mg.Spec.ForProvider.ProjectID: referenced field was empty (referenced resource may not yet be ready)")
+
+	cases := map[string]struct {
+		err      error
+		resolved bool
+		wantErr  bool
+		wantWait bool
+	}{
+		"Resolved": {
+			resolved: true,
+		},
+		"WaitingForProject": {
+			err:      errUnresolved,
+			wantWait: true,
+		},
+		"OtherReferenceFailed": {
+			err:      errUnresolved,
+			resolved: true,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockPatch: test.NewMockPatchFn(nil)}
+			mg := &referencer{err: tc.err}
+			r := WaitForDependency(kube, "project", func(_ resource.Managed) bool { return tc.resolved })
+
+			err := r.ResolveReferences(context.Background(), mg)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ResolveReferences(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if got := IsWaitingForDependency(mg); got != tc.wantWait {
+				t.Errorf("IsWaitingForDependency(...): want %t, got %t", tc.wantWait, got)
+			}
+		})
+	}
+}
+
+func TestWaitingForDependency(t *testing.T) {
+	want := xpv1.Condition{
+		Type:    xpv1.TypeReady,
+		Status:  "False",
+		Reason:  ReasonWaitingForDependency,
+		Message: "waiting for project: boom",
+	}
+	got := WaitingForDependency("project", errors.New("boom"))
+	if !got.Equal(want) {
+		t.Errorf("WaitingForDependency(...): want %v, got %v", want, got)
+	}
+}
+
+func TestWaitingObservation(t *testing.T) {
+	mg := &fake.Managed{}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, WaitingObservation(mg)); diff != "" {
+		t.Errorf("WaitingObservation(...): -want, +got:\n%s", diff)
+	}
+
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceUpToDate: true}, WaitingObservation(mg)); diff != "" {
+		t.Errorf("WaitingObservation(...): deleted: -want, +got:\n%s", diff)
+	}
+}
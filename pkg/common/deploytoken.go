@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errNoDeployTokenScopes      = "at least one deploy token scope is required"
+	errInvalidDeployTokenScopes = "invalid deploy token scopes %s, must be one of %s"
+)
+
+// DeployTokenScopes are the scopes GitLab accepts for project and group
+// deploy tokens.
+var DeployTokenScopes = []string{
+	"read_repository",
+	"read_registry",
+	"write_registry",
+	"read_package_registry",
+	"write_package_registry",
+}
+
+// ValidateDeployTokenScopes returns an error unless scopes holds at least one
+// scope and only scopes GitLab accepts for deploy tokens. The CRDs validate
+// the scopes as well, this catches resources created before they did.
+func ValidateDeployTokenScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errors.New(errNoDeployTokenScopes)
+	}
+	var invalid []string
+	for _, s := range scopes {
+		if !slices.Contains(DeployTokenScopes, s) {
+			invalid = append(invalid, s)
+		}
+	}
+	if len(invalid) > 0 {
+		return errors.Errorf(errInvalidDeployTokenScopes, strings.Join(invalid, ", "), strings.Join(DeployTokenScopes, ", "))
+	}
+	return nil
+}
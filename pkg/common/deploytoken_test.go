@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestValidateDeployTokenScopes(t *testing.T) {
+	cases := map[string]struct {
+		scopes []string
+		want   error
+	}{
+		"AllScopes": {
+			scopes: DeployTokenScopes,
+		},
+		"LeastPrivilege": {
+			scopes: []string{"read_registry"},
+		},
+		"NoScopes": {
+			want: errors.New(errNoDeployTokenScopes),
+		},
+		"InvalidScopes": {
+			scopes: []string{"read_repository", "api", "write_repository"},
+			want:   errors.Errorf(errInvalidDeployTokenScopes, "api, write_repository", "read_repository, read_registry, write_registry, read_package_registry, write_package_registry"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDeployTokenScopes(tc.scopes)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateDeployTokenScopes(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const errNotModifiedWithoutCache = "received 304 Not Modified without a cached response"
+
+// ETagCache remembers the ETag and the body of the last successful GET
+// request per managed resource, so that unchanged GitLab resources can be
+// observed with a conditional request. Endpoints that do not return an ETag
+// are never cached. A nil ETagCache disables conditional requests.
+type ETagCache[T any] struct {
+	mu      sync.Mutex
+	entries map[types.UID]etagEntry
+}
+
+type etagEntry struct {
+	request string
+	etag    string
+	body    []byte
+}
+
+// NewETagCache returns an empty ETagCache.
+func NewETagCache[T any]() *ETagCache[T] {
+	return &ETagCache[T]{entries: map[types.UID]etagEntry{}}
+}
+
+// An ETagKey identifies a GET request made on behalf of a managed resource.
+type ETagKey struct {
+	uid     types.UID
+	request string
+	deleted bool
+}
+
+// ETagCacheKey returns a cache key for a request made on behalf of the
+// supplied managed resource. The parts should identify the requested
+// GitLab resource, so that changing the spec never yields a stale entry.
+// Requests of a resource that is being deleted are never cached, which
+// drops its entry whether or not the external resource is deleted.
+func ETagCacheKey(mg resource.Managed, parts ...any) ETagKey {
+	request := make([]string, 0, len(parts))
+	for _, p := range parts {
+		request = append(request, fmt.Sprint(p))
+	}
+	return ETagKey{uid: mg.GetUID(), request: strings.Join(request, "/"), deleted: meta.WasDeleted(mg)}
+}
+
+// RequestOptions returns the If-None-Match header for the cached ETag of
+// key, if any.
+func (c *ETagCache[T]) RequestOptions(key ETagKey) []gitlab.RequestOptionFunc {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key.deleted {
+		delete(c.entries, key.uid)
+		return nil
+	}
+	e, ok := c.entries[key.uid]
+	if !ok || e.request != key.request {
+		return nil
+	}
+	return []gitlab.RequestOptionFunc{gitlab.WithHeader("If-None-Match", e.etag)}
+}
+
+// Resolve returns the value to use for the result of a GET request sent
+// with RequestOptions. A 304 Not Modified response yields a copy of the
+// cached value, so callers may modify it. Any other successful response is
+// cached if it carries an ETag, replacing the entry of an earlier request
+// of the resource, while errors, e.g. because the GitLab resource was not
+// found, evict it so that the next request is unconditional.
+func (c *ETagCache[T]) Resolve(key ETagKey, value *T, res *gitlab.Response, err error) (*T, error) {
+	if c == nil {
+		return value, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if res != nil && res.Response != nil && res.StatusCode == http.StatusNotModified {
+		if e, ok := c.entries[key.uid]; ok && e.request == key.request {
+			cached := new(T)
+			if err := json.Unmarshal(e.body, cached); err == nil {
+				return cached, nil
+			}
+		}
+		return nil, errors.New(errNotModifiedWithoutCache)
+	}
+
+	etag := ""
+	if err == nil && value != nil && res != nil && res.Response != nil && !key.deleted {
+		etag = res.Header.Get("ETag")
+	}
+	if etag == "" {
+		delete(c.entries, key.uid)
+		return value, err
+	}
+	// The value is stored encoded, so that callers modifying it do not
+	// modify the cached one.
+	body, jerr := json.Marshal(value)
+	if jerr != nil {
+		delete(c.entries, key.uid)
+		return value, nil
+	}
+	c.entries[key.uid] = etagEntry{request: key.request, etag: etag, body: body}
+	return value, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type etagValue struct {
+	Name string
+}
+
+func etagResponse(status int, etag string) *gitlab.Response {
+	h := http.Header{}
+	if etag != "" {
+		h.Set("ETag", etag)
+	}
+	return &gitlab.Response{Response: &http.Response{StatusCode: status, Header: h}}
+}
+
+func etagManaged(deleted bool) *fake.Managed {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "uid"}}
+	if deleted {
+		mg.SetDeletionTimestamp(ptr.To(metav1.Now()))
+	}
+	return mg
+}
+
+func ifNoneMatch(t *testing.T, opts []gitlab.RequestOptionFunc) string {
+	t.Helper()
+	req, err := ApplyRequestOptions(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req.Header.Get("If-None-Match")
+}
+
+func TestETagCache(t *testing.T) {
+	errBoom := errors.New("boom")
+	cached := &etagValue{Name: "cached"}
+	fresh := &etagValue{Name: "fresh"}
+
+	key := ETagCacheKey(etagManaged(false), "key")
+
+	type args struct {
+		seed  *etagValue
+		key   *ETagKey
+		value *etagValue
+		res   *gitlab.Response
+		err   error
+	}
+	type want struct {
+		header string
+		value  *etagValue
+		err    error
+		next   string
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EmptyCacheStoresETag": {
+			args: args{value: fresh, res: etagResponse(http.StatusOK, `W/"v1"`)},
+			want: want{value: fresh, next: `W/"v1"`},
+		},
+		"NotModifiedReusesCachedValue": {
+			args: args{seed: cached, res: etagResponse(http.StatusNotModified, `W/"v1"`), err: io.EOF},
+			want: want{header: `W/"v1"`, value: cached, next: `W/"v1"`},
+		},
+		"NotModifiedWithoutCache": {
+			args: args{res: etagResponse(http.StatusNotModified, ""), err: io.EOF},
+			want: want{err: errors.New(errNotModifiedWithoutCache)},
+		},
+		"ModifiedReplacesCachedValue": {
+			args: args{seed: cached, value: fresh, res: etagResponse(http.StatusOK, `W/"v2"`)},
+			want: want{header: `W/"v1"`, value: fresh, next: `W/"v2"`},
+		},
+		"NoETagSupportEvicts": {
+			args: args{seed: cached, value: fresh, res: etagResponse(http.StatusOK, "")},
+			want: want{header: `W/"v1"`, value: fresh},
+		},
+		"ErrorEvicts": {
+			args: args{seed: cached, res: etagResponse(http.StatusInternalServerError, ""), err: errBoom},
+			want: want{header: `W/"v1"`, err: errBoom},
+		},
+		"NotFoundEvicts": {
+			args: args{seed: cached, res: etagResponse(http.StatusNotFound, ""), err: errBoom},
+			want: want{header: `W/"v1"`, err: errBoom},
+		},
+		"OtherRequestReplacesEntry": {
+			args: args{seed: cached, key: ptr.To(ETagCacheKey(etagManaged(false), "other")), value: fresh, res: etagResponse(http.StatusOK, `W/"v2"`)},
+			want: want{header: `W/"v1"`, value: fresh},
+		},
+		"DeletedResourceEvicts": {
+			args: args{seed: cached, key: ptr.To(ETagCacheKey(etagManaged(true), "key")), value: fresh, res: etagResponse(http.StatusOK, `W/"v2"`)},
+			want: want{header: `W/"v1"`, value: fresh},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewETagCache[etagValue]()
+			if tc.args.seed != nil {
+				_, _ = c.Resolve(key, tc.args.seed, etagResponse(http.StatusOK, `W/"v1"`), nil)
+			}
+			k := key
+			if tc.args.key != nil {
+				k = *tc.args.key
+			}
+
+			if diff := cmp.Diff(tc.want.header, ifNoneMatch(t, c.RequestOptions(key))); diff != "" {
+				t.Errorf("RequestOptions(...): -want, +got:\n%s", diff)
+			}
+			got, err := c.Resolve(k, tc.args.value, tc.args.res, tc.args.err)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Resolve(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("Resolve(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.next, ifNoneMatch(t, c.RequestOptions(key))); diff != "" {
+				t.Errorf("RequestOptions(...) after Resolve: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestETagCacheNotModifiedCopy(t *testing.T) {
+	key := ETagCacheKey(etagManaged(false), "key")
+	c := NewETagCache[etagValue]()
+	v := &etagValue{Name: "cached"}
+	_, _ = c.Resolve(key, v, etagResponse(http.StatusOK, `W/"v1"`), nil)
+	v.Name = "modified after caching"
+
+	for i := 0; i < 2; i++ {
+		got, err := c.Resolve(key, nil, etagResponse(http.StatusNotModified, ""), io.EOF)
+		if err != nil {
+			t.Fatalf("Resolve(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(&etagValue{Name: "cached"}, got); diff != "" {
+			t.Errorf("Resolve(...): -want, +got:\n%s", diff)
+		}
+		got.Name = "modified by caller"
+	}
+}
+
+func TestETagCacheNil(t *testing.T) {
+	var c *ETagCache[etagValue]
+	key := ETagCacheKey(etagManaged(false), "key")
+	if opts := c.RequestOptions(key); opts != nil {
+		t.Errorf("RequestOptions(...): want nil, got %v", opts)
+	}
+	v := &etagValue{Name: "fresh"}
+	got, err := c.Resolve(key, v, etagResponse(http.StatusOK, `W/"v1"`), nil)
+	if err != nil || got != v {
+		t.Errorf("Resolve(...): want value passed through, got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationKeyExpiryWarningDays overrides the number of days before the
+	// expiry of a token at which the TokenExpiring condition is set. "0"
+	// disables the warning.
+	AnnotationKeyExpiryWarningDays = "gitlab.crossplane.io/expiry-warning-days"
+
+	// DefaultExpiryWarningDays is the number of days before the expiry of a
+	// token at which the TokenExpiring condition is set by default.
+	DefaultExpiryWarningDays = 14
+
+	// TypeTokenExpiring indicates that a token expires soon or has expired.
+	TypeTokenExpiring xpv1.ConditionType = "TokenExpiring"
+
+	// ReasonExpiresSoon is used when a token expires within the warning
+	// window.
+	ReasonExpiresSoon xpv1.ConditionReason = "ExpiresSoon"
+
+	// ReasonNotExpiringSoon is used once a token that expired soon has been
+	// rotated or its expiry moved beyond the warning window.
+	ReasonNotExpiringSoon xpv1.ConditionReason = "NotExpiringSoon"
+)
+
+// ExpiryWarningDays returns the number of days before the expiry of a token
+// at which the TokenExpiring condition is set for the managed resource.
+func ExpiryWarningDays(mg resource.Managed) int {
+	v, ok := mg.GetAnnotations()[AnnotationKeyExpiryWarningDays]
+	if !ok {
+		return DefaultExpiryWarningDays
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 0 {
+		return DefaultExpiryWarningDays
+	}
+	return days
+}
+
+// SetTokenExpiry sets the TokenExpiring condition of a managed resource
+// whose token expires within its warning window, and resets a condition set
+// before once the token no longer does. Tokens without expiry never expire.
+func SetTokenExpiry(mg resource.Managed, expiresAt *time.Time) {
+	setTokenExpiry(mg, expiresAt, time.Now())
+}
+
+func setTokenExpiry(mg resource.Managed, expiresAt *time.Time, now time.Time) {
+	window := ExpiryWarningDays(mg)
+	if expiresAt != nil && window > 0 {
+		remaining := int(expiresAt.Sub(now).Hours() / 24)
+		if expiresAt.Before(now) {
+			remaining = 0
+		}
+		if remaining < window {
+			mg.SetConditions(xpv1.Condition{
+				Type:               TypeTokenExpiring,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             ReasonExpiresSoon,
+				Message:            tokenExpiryMessage(*expiresAt, now, remaining),
+			})
+			return
+		}
+	}
+	if mg.GetCondition(TypeTokenExpiring).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeTokenExpiring,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonNotExpiringSoon,
+		})
+	}
+}
+
+func tokenExpiryMessage(expiresAt, now time.Time, remaining int) string {
+	date := expiresAt.UTC().Format(time.DateOnly)
+	switch {
+	case expiresAt.Before(now):
+		return fmt.Sprintf("token expired on %s", date)
+	case remaining == 1:
+		return fmt.Sprintf("token expires in 1 day on %s", date)
+	default:
+		return fmt.Sprintf("token expires in %d days on %s", remaining, date)
+	}
+}
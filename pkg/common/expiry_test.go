@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestExpiryWarningDays(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		want        int
+	}{
+		"Default": {
+			want: DefaultExpiryWarningDays,
+		},
+		"Configured": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "30"},
+			want:        30,
+		},
+		"Disabled": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "0"},
+			want:        0,
+		},
+		"Invalid": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "a week"},
+			want:        DefaultExpiryWarningDays,
+		},
+		"Negative": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "-1"},
+			want:        DefaultExpiryWarningDays,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			if got := ExpiryWarningDays(mg); got != tc.want {
+				t.Errorf("ExpiryWarningDays(...): want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSetTokenExpiry(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	expiring := func(msg string) xpv1.Condition {
+		return xpv1.Condition{
+			Type:    TypeTokenExpiring,
+			Status:  corev1.ConditionTrue,
+			Reason:  ReasonExpiresSoon,
+			Message: msg,
+		}
+	}
+	notExpiring := xpv1.Condition{
+		Type:   TypeTokenExpiring,
+		Status: corev1.ConditionFalse,
+		Reason: ReasonNotExpiringSoon,
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		conditions  []xpv1.Condition
+		expiresAt   *time.Time
+		want        []xpv1.Condition
+	}{
+		"NoExpiry": {},
+		"OutsideWindow": {
+			expiresAt: ptr.To(now.AddDate(0, 0, 30)),
+		},
+		"WithinWindow": {
+			expiresAt: ptr.To(now.AddDate(0, 0, 10)),
+			want:      []xpv1.Condition{expiring("token expires in 10 days on 2026-03-11")},
+		},
+		"OneDayLeft": {
+			expiresAt: ptr.To(now.Add(30 * time.Hour)),
+			want:      []xpv1.Condition{expiring("token expires in 1 day on 2026-03-02")},
+		},
+		"Today": {
+			expiresAt: ptr.To(now.Add(time.Hour)),
+			want:      []xpv1.Condition{expiring("token expires in 0 days on 2026-03-01")},
+		},
+		"Expired": {
+			expiresAt: ptr.To(now.AddDate(0, 0, -2)),
+			want:      []xpv1.Condition{expiring("token expired on 2026-02-27")},
+		},
+		"ConfiguredWindow": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "60"},
+			expiresAt:   ptr.To(now.AddDate(0, 0, 30)),
+			want:        []xpv1.Condition{expiring("token expires in 30 days on 2026-03-31")},
+		},
+		"Disabled": {
+			annotations: map[string]string{AnnotationKeyExpiryWarningDays: "0"},
+			expiresAt:   ptr.To(now.AddDate(0, 0, 1)),
+		},
+		"Rotated": {
+			conditions: []xpv1.Condition{expiring("token expires in 1 day on 2026-03-02")},
+			expiresAt:  ptr.To(now.AddDate(0, 6, 0)),
+			want:       []xpv1.Condition{notExpiring},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			mg.SetConditions(tc.conditions...)
+
+			setTokenExpiry(mg, tc.expiresAt, now)
+
+			want := &fake.Managed{}
+			want.SetConditions(tc.want...)
+			if diff := cmp.Diff(want.Conditions, mg.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("setTokenExpiry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
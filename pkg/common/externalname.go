@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	// AnnotationKeyExternalNameFormat selects how the external name of a
+	// resource that GitLab addresses by ID or by path is written, either
+	// ExternalNameFormatID or ExternalNameFormatPath. Both formats are
+	// accepted when the resource is observed.
+	AnnotationKeyExternalNameFormat = "gitlab.crossplane.io/external-name-format"
+
+	// ExternalNameFormatID writes the numeric ID as external name. This is
+	// the default.
+	ExternalNameFormatID = "id"
+
+	// ExternalNameFormatPath writes the full path as external name, e.g.
+	// group/subgroup/project.
+	ExternalNameFormatPath = "path"
+)
+
+// FormatExternalName returns the external name of a resource with the
+// supplied ID and full path in the format the managed resource is annotated
+// with, the ID unless AnnotationKeyExternalNameFormat is set to
+// ExternalNameFormatPath.
+func FormatExternalName(mg resource.Managed, id int64, path string) string {
+	if isPathFormat(mg) && path != "" {
+		return path
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// UpdateExternalName sets the external name of an observed resource with the
+// supplied ID and full path to the format the managed resource is annotated
+// with and returns true if it changed. An ID is kept as it never changes,
+// while a path follows renames and transfers of the resource.
+func UpdateExternalName(mg resource.Managed, id int64, path string) bool {
+	current := meta.GetExternalName(mg)
+	if _, err := strconv.ParseInt(current, 10, 64); err == nil && !isPathFormat(mg) {
+		return false
+	}
+	name := FormatExternalName(mg, id, path)
+	meta.SetExternalName(mg, name)
+	return name != current
+}
+
+func isPathFormat(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyExternalNameFormat] == ExternalNameFormatPath
+}
+
+// ParseExternalName returns the ID an external name refers to if it is
+// numeric and the path otherwise. GitLab accepts either to address a project
+// or group.
+func ParseExternalName(name string) interface{} {
+	if id, err := strconv.Atoi(name); err == nil {
+		return id
+	}
+	return name
+}
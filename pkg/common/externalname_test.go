@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpdateExternalName(t *testing.T) {
+	type want struct {
+		name    string
+		changed bool
+	}
+
+	cases := map[string]struct {
+		format string
+		name   string
+		want
+	}{
+		"KeepID":         {name: "42", want: want{name: "42"}},
+		"PathToID":       {name: "group/project", want: want{name: "42", changed: true}},
+		"UnknownFormat":  {format: "slug", name: "group/project", want: want{name: "42", changed: true}},
+		"KeepPath":       {format: ExternalNameFormatPath, name: "group/project", want: want{name: "group/project"}},
+		"IDToPath":       {format: ExternalNameFormatPath, name: "42", want: want{name: "group/project", changed: true}},
+		"RenamedPath":    {format: ExternalNameFormatPath, name: "group/old-project", want: want{name: "group/project", changed: true}},
+		"ExplicitFormat": {format: ExternalNameFormatID, name: "group/project", want: want{name: "42", changed: true}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			meta.SetExternalName(mg, tc.name)
+			if tc.format != "" {
+				meta.AddAnnotations(mg, map[string]string{AnnotationKeyExternalNameFormat: tc.format})
+			}
+
+			changed := UpdateExternalName(mg, 42, "group/project")
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("UpdateExternalName(...): -want, +got:\n%s", diff)
+			}
+			if changed != tc.want.changed {
+				t.Errorf("UpdateExternalName(...): want changed %t, got %t", tc.want.changed, changed)
+			}
+		})
+	}
+}
+
+func TestParseExternalName(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want interface{}
+	}{
+		"ID":   {name: "42", want: 42},
+		"Path": {name: "group/project", want: "group/project"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ParseExternalName(tc.name)); diff != "" {
+				t.Errorf("ParseExternalName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xperrors "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeySkipForbiddenFields makes updates skip the fields GitLab
+	// rejects with 403 Forbidden when set to "true", e.g. because the token
+	// lacks the permission or the license does not include them, so the
+	// other fields still converge. Updates fail as a whole by default.
+	AnnotationKeySkipForbiddenFields = "gitlab.crossplane.io/skip-forbidden-fields"
+
+	// AnnotationKeyForbiddenFields records the comma-separated fields that
+	// were skipped. Remove it to try updating them again.
+	AnnotationKeyForbiddenFields = "gitlab.crossplane.io/forbidden-fields"
+
+	// TypeForbiddenFieldsSkipped indicates that fields of a managed resource
+	// are not updated because GitLab rejects them.
+	TypeForbiddenFieldsSkipped xpv1.ConditionType = "ForbiddenFieldsSkipped"
+
+	// ReasonFieldsForbidden is used when fields are skipped.
+	ReasonFieldsForbidden xpv1.ConditionReason = "FieldsForbidden"
+
+	// ReasonNoFieldsSkipped is used once no fields are skipped anymore.
+	ReasonNoFieldsSkipped xpv1.ConditionReason = "NoFieldsSkipped"
+
+	errRecordForbiddenFields = "cannot record forbidden fields"
+)
+
+// SkipForbiddenFields returns true if updates of a managed resource should
+// skip the fields GitLab rejects instead of failing.
+func SkipForbiddenFields(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeySkipForbiddenFields] == "true"
+}
+
+// ForbiddenFields returns the fields recorded as rejected by GitLab, or nil
+// unless SkipForbiddenFields is true.
+func ForbiddenFields(mg resource.Managed) []string {
+	v := mg.GetAnnotations()[AnnotationKeyForbiddenFields]
+	if !SkipForbiddenFields(mg) || v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// RecordForbiddenFields adds the supplied fields to the forbidden fields of
+// a managed resource and persists them.
+func RecordForbiddenFields(ctx context.Context, kube client.Client, mg resource.Managed, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	all := append(ForbiddenFields(mg), fields...)
+	slices.Sort(all)
+	all = slices.Compact(all)
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyForbiddenFields: strings.Join(all, ",")})
+	if err := kube.Update(ctx, mg); err != nil {
+		return errors.Wrap(err, errRecordForbiddenFields)
+	}
+	SetForbiddenFieldsSkipped(mg, all)
+	return nil
+}
+
+// SetForbiddenFieldsSkipped sets the ForbiddenFieldsSkipped condition if any
+// fields are skipped and resets it once none are.
+func SetForbiddenFieldsSkipped(mg resource.Managed, fields []string) {
+	if len(fields) > 0 {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeForbiddenFieldsSkipped,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonFieldsForbidden,
+			Message:            "GitLab rejected " + strings.Join(fields, ", ") + " with 403 Forbidden, the fields are not updated until the " + AnnotationKeyForbiddenFields + " annotation is removed",
+		})
+		return
+	}
+	if mg.GetCondition(TypeForbiddenFieldsSkipped).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeForbiddenFieldsSkipped,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonNoFieldsSkipped,
+		})
+	}
+}
+
+// OmitFields sets the fields of the supplied parameters struct whose JSON
+// names are given to their zero value, so nil pointers are not managed.
+func OmitFields(params any, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	v := reflect.ValueOf(params).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if slices.Contains(fields, jsonName(v.Type().Field(i))) {
+			v.Field(i).SetZero()
+		}
+	}
+}
+
+// UpdateSkippingForbiddenFields calls update with the supplied options. If
+// GitLab rejects them with 403 Forbidden, every set field is sent on its
+// own to find the rejected ones, which are returned with their names in
+// camel case, e.g. serviceDeskEnabled for service_desk_enabled. The error
+// is returned if every field is rejected, since the whole update is
+// forbidden then.
+func UpdateSkippingForbiddenFields[T any](opt *T, update func(opt *T) (*gitlab.Response, error)) ([]string, error) {
+	res, err := update(opt)
+	if err == nil || !isResponseForbidden(res) {
+		return nil, err
+	}
+
+	var forbidden []string
+	set := 0
+	v := reflect.ValueOf(opt).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsZero() {
+			continue
+		}
+		set++
+		single := new(T)
+		reflect.ValueOf(single).Elem().Field(i).Set(v.Field(i))
+		res, ferr := update(single)
+		if ferr == nil {
+			continue
+		}
+		if !isResponseForbidden(res) {
+			return nil, ferr
+		}
+		forbidden = append(forbidden, snakeToCamel(jsonName(v.Type().Field(i))))
+	}
+	if len(forbidden) == set {
+		return nil, err
+	}
+	return forbidden, nil
+}
+
+// WrapForbidden wraps err with forbidden if GitLab refused the request with
+// 403 Forbidden, e.g. to hint at missing administrator access, and with msg
+// otherwise. It returns nil if err is nil.
+func WrapForbidden(err error, res *gitlab.Response, msg, forbidden string) error {
+	if isResponseForbidden(res) {
+		return xperrors.Wrap(err, forbidden)
+	}
+	return xperrors.Wrap(err, msg)
+}
+
+func isResponseForbidden(res *gitlab.Response) bool {
+	return res != nil && res.Response != nil && res.StatusCode == http.StatusForbidden
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xperrors "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+type testOptions struct {
+	Name               *string `json:"name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	ServiceDeskEnabled *bool   `json:"service_desk_enabled,omitempty"`
+}
+
+type testParameters struct {
+	Description        *string `json:"description,omitempty"`
+	ServiceDeskEnabled *bool   `json:"serviceDeskEnabled,omitempty"`
+}
+
+func forbiddenResponse() *gitlab.Response {
+	return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+}
+
+func TestUpdateSkippingForbiddenFields(t *testing.T) {
+	errBoom := errors.New("boom")
+	errForbidden := errors.New("403 Forbidden")
+
+	type want struct {
+		forbidden []string
+		updates   int
+		err       error
+	}
+
+	cases := map[string]struct {
+		opt    *testOptions
+		update func(opt *testOptions) (*gitlab.Response, error)
+		want   want
+	}{
+		"Success": {
+			opt: &testOptions{Name: ptr.To("name"), ServiceDeskEnabled: ptr.To(true)},
+			update: func(opt *testOptions) (*gitlab.Response, error) {
+				return &gitlab.Response{}, nil
+			},
+			want: want{updates: 1},
+		},
+		"OtherError": {
+			opt: &testOptions{Name: ptr.To("name")},
+			update: func(opt *testOptions) (*gitlab.Response, error) {
+				return nil, errBoom
+			},
+			want: want{updates: 1, err: errBoom},
+		},
+		"OneFieldForbidden": {
+			opt: &testOptions{Name: ptr.To("name"), ServiceDeskEnabled: ptr.To(true)},
+			update: func(opt *testOptions) (*gitlab.Response, error) {
+				if opt.ServiceDeskEnabled != nil {
+					return forbiddenResponse(), errForbidden
+				}
+				return &gitlab.Response{}, nil
+			},
+			want: want{forbidden: []string{"serviceDeskEnabled"}, updates: 3},
+		},
+		"AllFieldsForbidden": {
+			opt: &testOptions{Name: ptr.To("name"), ServiceDeskEnabled: ptr.To(true)},
+			update: func(opt *testOptions) (*gitlab.Response, error) {
+				return forbiddenResponse(), errForbidden
+			},
+			want: want{updates: 3, err: errForbidden},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updates := 0
+			got, err := UpdateSkippingForbiddenFields(tc.opt, func(opt *testOptions) (*gitlab.Response, error) {
+				updates++
+				return tc.update(opt)
+			})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateSkippingForbiddenFields(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.forbidden, got); diff != "" {
+				t.Errorf("UpdateSkippingForbiddenFields(...): -want, +got:\n%s", diff)
+			}
+			if updates != tc.want.updates {
+				t.Errorf("UpdateSkippingForbiddenFields(...): want %d updates, got %d", tc.want.updates, updates)
+			}
+		})
+	}
+}
+
+func TestOmitFields(t *testing.T) {
+	p := &testParameters{Description: ptr.To("description"), ServiceDeskEnabled: ptr.To(true)}
+	OmitFields(p, []string{"serviceDeskEnabled"})
+
+	if diff := cmp.Diff(&testParameters{Description: ptr.To("description")}, p); diff != "" {
+		t.Errorf("OmitFields(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestForbiddenFields(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		want        []string
+	}{
+		"NotOptedIn": {
+			annotations: map[string]string{AnnotationKeyForbiddenFields: "serviceDeskEnabled"},
+		},
+		"NoneRecorded": {
+			annotations: map[string]string{AnnotationKeySkipForbiddenFields: "true"},
+		},
+		"Recorded": {
+			annotations: map[string]string{
+				AnnotationKeySkipForbiddenFields: "true",
+				AnnotationKeyForbiddenFields:     "mirror,serviceDeskEnabled",
+			},
+			want: []string{"mirror", "serviceDeskEnabled"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ForbiddenFields(managedWithAnnotations(false, tc.annotations))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ForbiddenFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordForbiddenFields(t *testing.T) {
+	mg := managedWithAnnotations(false, map[string]string{
+		AnnotationKeySkipForbiddenFields: "true",
+		AnnotationKeyForbiddenFields:     "serviceDeskEnabled",
+	})
+	kube := &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+
+	if err := RecordForbiddenFields(context.Background(), kube, mg, []string{"mirror", "serviceDeskEnabled"}); err != nil {
+		t.Fatalf("RecordForbiddenFields(...): %v", err)
+	}
+	if diff := cmp.Diff("mirror,serviceDeskEnabled", mg.GetAnnotations()[AnnotationKeyForbiddenFields]); diff != "" {
+		t.Errorf("RecordForbiddenFields(...): -want, +got:\n%s", diff)
+	}
+	if mg.GetCondition(TypeForbiddenFieldsSkipped).Status != corev1.ConditionTrue {
+		t.Errorf("RecordForbiddenFields(...): want the %s condition to be true", TypeForbiddenFieldsSkipped)
+	}
+
+	SetForbiddenFieldsSkipped(mg, nil)
+	if diff := cmp.Diff(ReasonNoFieldsSkipped, mg.GetCondition(TypeForbiddenFieldsSkipped).Reason); diff != "" {
+		t.Errorf("SetForbiddenFieldsSkipped(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWrapForbidden(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		err  error
+		res  *gitlab.Response
+		want error
+	}{
+		"NoError": {
+			res: forbiddenResponse(),
+		},
+		"Forbidden": {
+			err:  errBoom,
+			res:  forbiddenResponse(),
+			want: xperrors.Wrap(errBoom, "not admin"),
+		},
+		"OtherError": {
+			err:  errBoom,
+			res:  &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want: xperrors.Wrap(errBoom, "failed"),
+		},
+		"NoResponse": {
+			err:  errBoom,
+			want: xperrors.Wrap(errBoom, "failed"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := WrapForbidden(tc.err, tc.res, "failed", "not admin")
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("WrapForbidden(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -46,15 +47,24 @@ type BasicAuth struct {
 // Config provides gitlab configurations for the Gitlab client
 type Config struct {
 	Token              string
+	ReadToken          string
+	ReadAuthMethod     auth.AuthType
 	BaseURL            string
 	InsecureSkipVerify bool
 	AuthMethod         auth.AuthType
+	RequestsPerSecond  int
+	RequestBurst       int
+	LogHTTPBodies      bool
+	VariableCacheTTL   time.Duration
+	MaxRetries         *int
+	MinRetryBackoff    time.Duration
+	MaxRetryBackoff    time.Duration
+	AllowSudo          bool
+	ValueHashSecret    []byte
 }
 
 // NewClient creates new Gitlab Client with provided Gitlab Configurations/Credentials.
 func NewClient(c Config) *gitlab.Client {
-	var cl *gitlab.Client
-	var err error
 	options := []gitlab.ClientOptionFunc{}
 	if c.BaseURL != "" {
 		options = append(options, gitlab.WithBaseURL(c.BaseURL))
@@ -72,29 +82,50 @@ func NewClient(c Config) *gitlab.Client {
 		}
 		options = append(options, gitlab.WithHTTPClient(httpclient))
 	}
+	if l := limiters.sharedLimiter(c); l != nil {
+		options = append(options, gitlab.WithCustomLimiter(l))
+	}
+	options = append(options, gitlab.WithInterceptor(budgets.recordRateLimit(limiterKey(c))))
+	options = append(options, retryOptions(c)...)
+	if c.LogHTTPBodies {
+		options = append(options, gitlab.WithInterceptor(logBodies(httpLog)))
+	}
+
+	var rc *readCredentials
+	if c.ReadToken != "" {
+		rc = &readCredentials{source: authSource(c.ReadAuthMethod, c.ReadToken)}
+		options = append(options, gitlab.WithInterceptor(rc.intercept))
+	}
+
+	cl, err := gitlab.NewAuthSourceClient(authSource(c.AuthMethod, c.Token), options...)
+	if err != nil {
+		panic(err)
+	}
+	if rc != nil {
+		rc.client = cl
+	}
 
-	switch c.AuthMethod {
+	return cl
+}
+
+// authSource returns the gitlab.AuthSource authenticating with the given
+// token using the given method.
+func authSource(method auth.AuthType, token string) gitlab.AuthSource {
+	switch method {
 	case auth.BasicAuth:
 		ba := &BasicAuth{}
-		if err = json.Unmarshal([]byte(c.Token), ba); err != nil {
+		if err := json.Unmarshal([]byte(token), ba); err != nil {
 			panic(err)
 		}
-		cl, err = gitlab.NewBasicAuthClient(ba.Username, ba.Password, options...) //nolint:staticcheck
+		return &gitlab.PasswordCredentialsAuthSource{Username: ba.Username, Password: ba.Password}
 	case auth.JobToken:
-		cl, err = gitlab.NewJobClient(c.Token, options...)
+		return gitlab.JobTokenAuthSource{Token: token}
 	case auth.OAuthToken:
-		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.Token})
-		cl, err = gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, options...)
-	case auth.PersonalAccessToken:
-		cl, err = gitlab.NewClient(c.Token, options...)
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+		return gitlab.OAuthTokenSource{TokenSource: ts}
 	default:
-		cl, err = gitlab.NewClient(c.Token, options...)
-	}
-	if err != nil {
-		panic(err)
+		return gitlab.AccessTokenAuthSource{Token: token}
 	}
-
-	return cl
 }
 
 // GetConfig constructs a Config that can be used to authenticate to Gitlab
@@ -142,12 +173,33 @@ func UseLegacyProviderConfig(ctx context.Context, c client.Client, mg resource.L
 		if err != nil {
 			return nil, err
 		}
+		rps, burst := rateLimit((*namespacedV1Beta1.RateLimit)(pc.Spec.RateLimit))
+		maxRetries, minBackoff, maxBackoff := retry((*namespacedV1Beta1.Retry)(pc.Spec.Retry))
+		readToken, readMethod, err := readCredentialsToken(ctx, c, mg, (*namespacedV1Beta1.ProviderCredentials)(pc.Spec.ReadCredentials))
+		if err != nil {
+			return nil, err
+		}
+		valueHashSecret, err := valueHashSecret(ctx, c, mg, pc.Spec.ValueHashKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
 
 		return &Config{
 			BaseURL:            pc.Spec.BaseURL,
 			Token:              *token,
+			ReadToken:          readToken,
+			ReadAuthMethod:     readMethod,
 			InsecureSkipVerify: ptr.Deref(pc.Spec.InsecureSkipVerify, false),
 			AuthMethod:         pc.Spec.Credentials.Method,
+			RequestsPerSecond:  rps,
+			RequestBurst:       burst,
+			LogHTTPBodies:      ptr.Deref(pc.Spec.LogHTTPBodies, false),
+			VariableCacheTTL:   variableCacheTTL((*namespacedV1Beta1.VariableCache)(pc.Spec.VariableCache)),
+			MaxRetries:         maxRetries,
+			MinRetryBackoff:    minBackoff,
+			MaxRetryBackoff:    maxBackoff,
+			AllowSudo:          ptr.Deref(pc.Spec.AllowSudo, false),
+			ValueHashSecret:    valueHashSecret,
 		}, nil
 	default:
 		return nil, errors.Errorf("credentials source %s is not currently supported", s)
@@ -179,13 +231,29 @@ func buildConfigFromSpec(ctx context.Context, c client.Client, mg resource.Moder
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return ConfigFromSpec(ctx, c, spec)
+}
+
+// ConfigFromSpec constructs a Config from the spec of a ProviderConfig or
+// ClusterProviderConfig without tracking its usage.
+func ConfigFromSpec(ctx context.Context, c client.Client, spec namespacedV1Beta1.ProviderConfigSpec) (*Config, error) {
 	switch s := spec.Credentials.Source; s {
 	case xpv1.CredentialsSourceSecret:
 		if spec.Credentials.SecretRef == nil {
 			return nil, errors.New("no credentials secret referenced")
 		}
 
-		token, err := GetTokenValueFromSecret(ctx, c, mg, spec.Credentials.SecretRef)
+		token, err := GetTokenValueFromSecret(ctx, c, nil, spec.Credentials.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		rps, burst := rateLimit(spec.RateLimit)
+		maxRetries, minBackoff, maxBackoff := retry(spec.Retry)
+		readToken, readMethod, err := readCredentialsToken(ctx, c, nil, spec.ReadCredentials)
+		if err != nil {
+			return nil, err
+		}
+		valueHashSecret, err := valueHashSecret(ctx, c, nil, spec.ValueHashKeySecretRef)
 		if err != nil {
 			return nil, err
 		}
@@ -193,10 +261,89 @@ func buildConfigFromSpec(ctx context.Context, c client.Client, mg resource.Moder
 		return &Config{
 			BaseURL:            spec.BaseURL,
 			Token:              *token,
+			ReadToken:          readToken,
+			ReadAuthMethod:     readMethod,
 			InsecureSkipVerify: ptr.Deref(spec.InsecureSkipVerify, false),
 			AuthMethod:         spec.Credentials.Method,
+			RequestsPerSecond:  rps,
+			RequestBurst:       burst,
+			LogHTTPBodies:      ptr.Deref(spec.LogHTTPBodies, false),
+			VariableCacheTTL:   variableCacheTTL(spec.VariableCache),
+			MaxRetries:         maxRetries,
+			MinRetryBackoff:    minBackoff,
+			MaxRetryBackoff:    maxBackoff,
+			AllowSudo:          ptr.Deref(spec.AllowSudo, false),
+			ValueHashSecret:    valueHashSecret,
 		}, nil
 	default:
 		return nil, errors.Errorf("credentials source %s is not currently supported", s)
 	}
 }
+
+// rateLimit returns the requests per second and burst configured on a
+// ProviderConfig, zero disables rate limiting.
+func rateLimit(rl *namespacedV1Beta1.RateLimit) (int, int) {
+	if rl == nil {
+		return 0, 0
+	}
+	return rl.RequestsPerSecond, ptr.Deref(rl.Burst, 0)
+}
+
+// retry returns the retry count and backoff bounds configured on a
+// ProviderConfig. Unset values keep the defaults of the client.
+func retry(r *namespacedV1Beta1.Retry) (*int, time.Duration, time.Duration) {
+	if r == nil {
+		return nil, 0, 0
+	}
+	var minBackoff, maxBackoff time.Duration
+	if r.MinBackoff != nil {
+		minBackoff = r.MinBackoff.Duration
+	}
+	if r.MaxBackoff != nil {
+		maxBackoff = r.MaxBackoff.Duration
+	}
+	return r.MaxRetries, minBackoff, maxBackoff
+}
+
+// variableCacheTTL returns the TTL of the variable cache configured on a
+// ProviderConfig, zero disables the cache.
+func variableCacheTTL(vc *namespacedV1Beta1.VariableCache) time.Duration {
+	if vc == nil {
+		return 0
+	}
+	return vc.TTL.Duration
+}
+
+// readCredentialsToken returns the token and authentication method of the
+// read credentials of a ProviderConfig. The token is empty if no read
+// credentials are configured.
+func readCredentialsToken(ctx context.Context, c client.Client, mg resource.Managed, rc *namespacedV1Beta1.ProviderCredentials) (string, auth.AuthType, error) {
+	if rc == nil {
+		return "", "", nil
+	}
+	if rc.Source != xpv1.CredentialsSourceSecret {
+		return "", "", errors.Errorf("read credentials source %s is not currently supported", rc.Source)
+	}
+	if rc.SecretRef == nil {
+		return "", "", errors.New("no read credentials secret referenced")
+	}
+
+	token, err := GetTokenValueFromSecret(ctx, c, mg, rc.SecretRef)
+	if err != nil {
+		return "", "", errors.Wrap(err, "cannot get read credentials")
+	}
+	return *token, rc.Method, nil
+}
+
+// valueHashSecret returns the secret keying the hashes of masked variable
+// values, or nil if none is referenced.
+func valueHashSecret(ctx context.Context, c client.Client, mg resource.Managed, ref *xpv1.SecretKeySelector) ([]byte, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	secret, err := GetTokenValueFromSecret(ctx, c, mg, ref)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get value hash key")
+	}
+	return []byte(*secret), nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GitLab reports features missing from its license like resources the user
+// cannot access.
+const errGraphQLResourceNotAvailable = "does not exist or you don't have permission to perform this action"
+
+// GraphQLErrors holds the top-level errors of a GitLab GraphQL response.
+// Embed it in response types and check Err after the request.
+type GraphQLErrors struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Err returns the errors of the response joined into one, or nil.
+func (e GraphQLErrors) Err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(e.Errors))
+	for _, m := range e.Errors {
+		msgs = append(msgs, m.Message)
+	}
+	return errors.New(strings.Join(msgs, ", "))
+}
+
+// GraphQLMutationErrors returns the errors reported by a GraphQL mutation
+// joined into one, or nil.
+func GraphQLMutationErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, ", "))
+}
+
+// ErrGraphQLResourceNotAvailable returns the error GitLab reports for a
+// resource that does not exist or cannot be accessed.
+func ErrGraphQLResourceNotAvailable(resource string) error {
+	return errors.Errorf("%s %s", resource, errGraphQLResourceNotAvailable)
+}
+
+// IsGraphQLResourceNotAvailable returns true if the error indicates that a
+// GraphQL resource does not exist, cannot be accessed or is not included in
+// the license of the GitLab server.
+func IsGraphQLResourceNotAvailable(err error) bool {
+	return err != nil && strings.Contains(err.Error(), errGraphQLResourceNotAvailable)
+}
+
+// GraphQLID returns the global ID of a GitLab resource of the given type,
+// for example gid://gitlab/Project/1234.
+func GraphQLID(typ string, id int64) string {
+	return "gid://gitlab/" + typ + "/" + strconv.FormatInt(id, 10)
+}
+
+// ParseGraphQLID returns the numeric ID of a global ID of the given type.
+func ParseGraphQLID(typ, gid string) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(gid, "gid://gitlab/"+typ+"/"), 10, 64)
+	return id, errors.Wrapf(err, "cannot parse %s ID %s", typ, gid)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestGraphQLErrors(t *testing.T) {
+	var e GraphQLErrors
+	if err := e.Err(); err != nil {
+		t.Errorf("Err(): unexpected error: %v", err)
+	}
+
+	e.Errors = append(e.Errors, struct {
+		Message string `json:"message"`
+	}{Message: "boom"}, struct {
+		Message string `json:"message"`
+	}{Message: "bang"})
+	if diff := cmp.Diff(errors.New("boom, bang"), e.Err(), test.EquateErrors()); diff != "" {
+		t.Errorf("Err(): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsGraphQLResourceNotAvailable(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":          {err: nil, want: false},
+		"Other":        {err: errors.New("boom"), want: false},
+		"NotAvailable": {err: ErrGraphQLResourceNotAvailable("group acme"), want: true},
+		"FromGitLab":   {err: errors.New("The resource that you are attempting to access does not exist or you don't have permission to perform this action"), want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsGraphQLResourceNotAvailable(tc.err); got != tc.want {
+				t.Errorf("IsGraphQLResourceNotAvailable(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseGraphQLID(t *testing.T) {
+	gid := GraphQLID("Project", 1234)
+	if diff := cmp.Diff("gid://gitlab/Project/1234", gid); diff != "" {
+		t.Errorf("GraphQLID(...): -want, +got:\n%s", diff)
+	}
+
+	id, err := ParseGraphQLID("Project", gid)
+	if err != nil {
+		t.Fatalf("ParseGraphQLID(...): unexpected error: %v", err)
+	}
+	if id != 1234 {
+		t.Errorf("ParseGraphQLID(...): want 1234, got %d", id)
+	}
+
+	if _, err := ParseGraphQLID("Project", "gid://gitlab/Group/1234"); err == nil {
+		t.Errorf("ParseGraphQLID(...): expected error for ID of another type")
+	}
+}
@@ -24,13 +24,20 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 )
 
 const (
 	ErrSecretNotFound    = "Cannot find referenced secret"
 	ErrSecretKeyNotFound = "Cannot find key in referenced secret"
 	ErrSecretSelectorNil = "Secret selector is nil"
+
+	ErrConfigMapNotFound    = "Cannot find referenced config map"
+	ErrConfigMapKeyNotFound = "Cannot find key in referenced config map"
+	ErrConfigMapSelectorNil = "Config map selector is nil"
 )
 
 func GetTokenValueFromSecret(ctx context.Context, client client.Client, m resource.Managed, selector *xpv1.SecretKeySelector) (*string, error) {
@@ -68,6 +75,132 @@ func GetTokenValueFromLocalSecret(ctx context.Context, client client.Client, m r
 	})
 }
 
+// GetSecretVersionFromSecret returns the resource version of the secret
+// specified by a SecretKeySelector, which changes whenever the secret does.
+func GetSecretVersionFromSecret(ctx context.Context, client client.Client, m resource.Managed, selector *xpv1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", errors.Errorf(ErrSecretSelectorNil)
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Name: selector.SecretReference.Name, Namespace: selector.SecretReference.Namespace}, secret); err != nil {
+		return "", errors.Wrap(err, ErrSecretNotFound)
+	}
+	return secret.ResourceVersion, nil
+}
+
+// GetSecretVersionFromLocalSecret returns the resource version of the secret
+// specified by a LocalSecretKeySelector in the namespace of the managed
+// resource.
+func GetSecretVersionFromLocalSecret(ctx context.Context, client client.Client, m resource.Managed, l *xpv1.LocalSecretKeySelector) (string, error) {
+	if l == nil {
+		return "", errors.Errorf(ErrSecretSelectorNil)
+	}
+
+	return GetSecretVersionFromSecret(ctx, client, m, &xpv1.SecretKeySelector{
+		Key: l.Key,
+		SecretReference: xpv1.SecretReference{
+			Name:      l.Name,
+			Namespace: m.GetNamespace(),
+		},
+	})
+}
+
+// GetValueFromConfigMap retrieves the value of a config map key. Keys in
+// binaryData take precedence over keys in data.
+func GetValueFromConfigMap(ctx context.Context, client client.Client, m resource.Managed, selector *commonv1alpha1.ConfigMapKeySelector) ([]byte, error) {
+	if selector == nil {
+		return nil, errors.Errorf(ErrConfigMapSelectorNil)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: selector.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, ErrConfigMapNotFound)
+	}
+
+	if value, ok := cm.BinaryData[selector.Key]; ok {
+		return value, nil
+	}
+	if value, ok := cm.Data[selector.Key]; ok {
+		return []byte(value), nil
+	}
+	return nil, errors.Errorf(ErrConfigMapKeyNotFound)
+}
+
+// GetValueFromLocalConfigMap retrieves the value of a config map key in the
+// namespace of the managed resource.
+func GetValueFromLocalConfigMap(ctx context.Context, client client.Client, m resource.Managed, l *commonv1alpha1.LocalConfigMapKeySelector) ([]byte, error) {
+	if l == nil {
+		return nil, errors.Errorf(ErrConfigMapSelectorNil)
+	}
+
+	return GetValueFromConfigMap(ctx, client, m, &commonv1alpha1.ConfigMapKeySelector{
+		Name:      l.Name,
+		Namespace: m.GetNamespace(),
+		Key:       l.Key,
+	})
+}
+
+// GetSecretData returns all keys of a secret with their values.
+func GetSecretData(ctx context.Context, client client.Client, m resource.Managed, ref *xpv1.SecretReference) (map[string][]byte, error) {
+	if ref == nil {
+		return nil, errors.Errorf(ErrSecretSelectorNil)
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+		return nil, errors.Wrap(err, ErrSecretNotFound)
+	}
+	return secret.Data, nil
+}
+
+// GetLocalSecretData returns all keys of a secret in the namespace of the
+// managed resource with their values.
+func GetLocalSecretData(ctx context.Context, client client.Client, m resource.Managed, l *xpv1.LocalSecretReference) (map[string][]byte, error) {
+	if l == nil {
+		return nil, errors.Errorf(ErrSecretSelectorNil)
+	}
+
+	return GetSecretData(ctx, client, m, &xpv1.SecretReference{
+		Name:      l.Name,
+		Namespace: m.GetNamespace(),
+	})
+}
+
+// GetConfigMapData returns all keys of a config map with their values. Keys
+// in binaryData take precedence over keys in data.
+func GetConfigMapData(ctx context.Context, client client.Client, m resource.Managed, ref *commonv1alpha1.ConfigMapReference) (map[string][]byte, error) {
+	if ref == nil {
+		return nil, errors.Errorf(ErrConfigMapSelectorNil)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, ErrConfigMapNotFound)
+	}
+	data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		data[k] = []byte(v)
+	}
+	for k, v := range cm.BinaryData {
+		data[k] = v
+	}
+	return data, nil
+}
+
+// GetLocalConfigMapData returns all keys of a config map in the namespace of
+// the managed resource with their values.
+func GetLocalConfigMapData(ctx context.Context, client client.Client, m resource.Managed, l *commonv1alpha1.LocalConfigMapReference) (map[string][]byte, error) {
+	if l == nil {
+		return nil, errors.Errorf(ErrConfigMapSelectorNil)
+	}
+
+	return GetConfigMapData(ctx, client, m, &commonv1alpha1.ConfigMapReference{
+		Name:      l.Name,
+		Namespace: m.GetNamespace(),
+	})
+}
+
 // ResolvePublicJobsSetting determines the effective publicJobs value
 // prioritizing publicJobs over the deprecated publicBuilds field.
 // Returns the resolved value and whether the deprecated publicBuilds field was used.
@@ -82,3 +215,36 @@ func ResolvePublicJobsSetting(publicBuilds, publicJobs *bool) (*bool, bool) {
 	}
 	return nil, false
 }
+
+// ResolveEmailsEnabledSetting determines the effective emailsEnabled value
+// prioritizing emailsEnabled over the deprecated emailsDisabled field, which
+// has the inverse meaning. It returns nil if neither field is set.
+func ResolveEmailsEnabledSetting(emailsDisabled, emailsEnabled *bool) *bool {
+	if emailsEnabled != nil {
+		return emailsEnabled
+	}
+	if emailsDisabled != nil {
+		return ptr.To(!*emailsDisabled)
+	}
+	return nil
+}
+
+// EmailsDisabledSetting returns the value of the deprecated emails_disabled
+// option for the effective emailsEnabled value. It is sent along with
+// emails_enabled so GitLab versions before 16.5 apply the setting too.
+func EmailsDisabledSetting(emailsEnabled *bool) *bool {
+	if emailsEnabled == nil {
+		return nil
+	}
+	return ptr.To(!*emailsEnabled)
+}
+
+// ObservedEmailsEnabled returns whether emails are enabled on a project or
+// group as reported by a GitLab server of the supplied version. Versions
+// before 16.5 only report the deprecated emails_disabled field.
+func ObservedEmailsEnabled(emailsEnabled, emailsDisabled bool, v *ServerVersion) bool {
+	if !v.AtLeast(16, 5) {
+		return !emailsDisabled
+	}
+	return emailsEnabled
+}
@@ -26,7 +26,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 )
 
 type mockManagedResource struct {
@@ -270,6 +273,278 @@ func TestGetTokenValueFromLocalSecret(t *testing.T) {
 	}
 }
 
+func TestGetSecretVersionFromLocalSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	selector := &xpv1.LocalSecretKeySelector{
+		Key:                  "url",
+		LocalSecretReference: xpv1.LocalSecretReference{Name: "test-secret"},
+	}
+
+	cases := map[string]struct {
+		kube    client.Client
+		want    string
+		wantErr error
+	}{
+		"SuccessfulVersionRetrieval": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Namespace != "test-namespace" || key.Name != "test-secret" {
+						return errors.New("unexpected secret")
+					}
+					obj.(*corev1.Secret).ResourceVersion = "42"
+					return nil
+				},
+			},
+			want: "42",
+		},
+		"SecretNotFound": {
+			kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			wantErr: errors.Wrap(errBoom, ErrSecretNotFound),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &mockManagedResource{namespace: "test-namespace"}
+
+			got, err := GetSecretVersionFromLocalSecret(context.Background(), tc.kube, mg, selector)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetSecretVersionFromLocalSecret() -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetSecretVersionFromLocalSecret() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetValueFromLocalConfigMap(t *testing.T) {
+	testNamespace := "test-namespace"
+	testKey := "avatar.png"
+
+	type args struct {
+		selector *commonv1alpha1.LocalConfigMapKeySelector
+		kube     client.Client
+	}
+	type want struct {
+		value []byte
+		err   error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"BinaryData": {
+			args: args{
+				selector: &commonv1alpha1.LocalConfigMapKeySelector{Name: "branding", Key: testKey},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.ConfigMap) = corev1.ConfigMap{
+							BinaryData: map[string][]byte{testKey: []byte("\x89PNG")},
+							Data:       map[string]string{testKey: "text"},
+						}
+						return nil
+					}),
+				},
+			},
+			want: want{
+				value: []byte("\x89PNG"),
+			},
+		},
+		"Data": {
+			args: args{
+				selector: &commonv1alpha1.LocalConfigMapKeySelector{Name: "branding", Key: testKey},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.ConfigMap) = corev1.ConfigMap{
+							Data: map[string]string{testKey: "text"},
+						}
+						return nil
+					}),
+				},
+			},
+			want: want{
+				value: []byte("text"),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				selector: &commonv1alpha1.LocalConfigMapKeySelector{Name: "branding", Key: testKey},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errors.New("config map not found")),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("config map not found"), ErrConfigMapNotFound),
+			},
+		},
+		"KeyNotFound": {
+			args: args{
+				selector: &commonv1alpha1.LocalConfigMapKeySelector{Name: "branding", Key: "wrong-key"},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*corev1.ConfigMap) = corev1.ConfigMap{
+							BinaryData: map[string][]byte{testKey: []byte("\x89PNG")},
+						}
+						return nil
+					}),
+				},
+			},
+			want: want{
+				err: errors.New(ErrConfigMapKeyNotFound),
+			},
+		},
+		"SelectorNil": {
+			args: args{
+				kube: &test.MockClient{},
+			},
+			want: want{
+				err: errors.New(ErrConfigMapSelectorNil),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &mockManagedResource{namespace: testNamespace}
+
+			got, err := GetValueFromLocalConfigMap(context.Background(), tc.args.kube, mg, tc.args.selector)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetValueFromLocalConfigMap() error = %v, want %v\ndiff: %s", err, tc.want.err, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("GetValueFromLocalConfigMap() value = %v, want %v\ndiff: %s", got, tc.want.value, diff)
+			}
+		})
+	}
+}
+
+func TestGetLocalConfigMapData(t *testing.T) {
+	testNamespace := "test-namespace"
+
+	type want struct {
+		data map[string][]byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		ref  *commonv1alpha1.LocalConfigMapReference
+		kube client.Client
+		want want
+	}{
+		"DataAndBinaryData": {
+			ref: &commonv1alpha1.LocalConfigMapReference{Name: "variables"},
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Namespace != testNamespace {
+						return errors.Errorf("unexpected namespace %q", key.Namespace)
+					}
+					*obj.(*corev1.ConfigMap) = corev1.ConfigMap{
+						BinaryData: map[string][]byte{"LOGO": []byte("\x89PNG"), "REGION": []byte("binary")},
+						Data:       map[string]string{"REGION": "eu-west-1", "STAGE": "prod"},
+					}
+					return nil
+				},
+			},
+			want: want{
+				data: map[string][]byte{"LOGO": []byte("\x89PNG"), "REGION": []byte("binary"), "STAGE": []byte("prod")},
+			},
+		},
+		"ConfigMapNotFound": {
+			ref: &commonv1alpha1.LocalConfigMapReference{Name: "variables"},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errors.New("config map not found")),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("config map not found"), ErrConfigMapNotFound),
+			},
+		},
+		"ReferenceNil": {
+			kube: &test.MockClient{},
+			want: want{
+				err: errors.New(ErrConfigMapSelectorNil),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &mockManagedResource{namespace: testNamespace}
+
+			got, err := GetLocalConfigMapData(context.Background(), tc.kube, mg, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetLocalConfigMapData() -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, got); diff != "" {
+				t.Errorf("GetLocalConfigMapData() -want data, +got data:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetLocalSecretData(t *testing.T) {
+	testNamespace := "test-namespace"
+
+	type want struct {
+		data map[string][]byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		ref  *xpv1.LocalSecretReference
+		kube client.Client
+		want want
+	}{
+		"Data": {
+			ref: &xpv1.LocalSecretReference{Name: "variables"},
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Namespace != testNamespace {
+						return errors.Errorf("unexpected namespace %q", key.Namespace)
+					}
+					*obj.(*corev1.Secret) = corev1.Secret{Data: map[string][]byte{"TOKEN": []byte("s3cr3t-token")}}
+					return nil
+				},
+			},
+			want: want{
+				data: map[string][]byte{"TOKEN": []byte("s3cr3t-token")},
+			},
+		},
+		"SecretNotFound": {
+			ref: &xpv1.LocalSecretReference{Name: "variables"},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errors.New("secret not found")),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("secret not found"), ErrSecretNotFound),
+			},
+		},
+		"ReferenceNil": {
+			kube: &test.MockClient{},
+			want: want{
+				err: errors.New(ErrSecretSelectorNil),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &mockManagedResource{namespace: testNamespace}
+
+			got, err := GetLocalSecretData(context.Background(), tc.kube, mg, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetLocalSecretData() -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, got); diff != "" {
+				t.Errorf("GetLocalSecretData() -want data, +got data:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestResolvePublicJobsSetting(t *testing.T) {
 	trueVal := true
 	falseVal := false
@@ -383,3 +658,90 @@ func TestResolvePublicJobsSetting(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveEmailsEnabledSetting(t *testing.T) {
+	trueVal := true
+	falseVal := false
+
+	cases := map[string]struct {
+		emailsDisabled *bool
+		emailsEnabled  *bool
+		want           *bool
+	}{
+		"BothSet_EmailsEnabledWins": {
+			emailsDisabled: &falseVal,
+			emailsEnabled:  &falseVal,
+			want:           &falseVal,
+		},
+		"OnlyEmailsDisabled_True": {
+			emailsDisabled: &trueVal,
+			want:           &falseVal,
+		},
+		"OnlyEmailsDisabled_False": {
+			emailsDisabled: &falseVal,
+			want:           &trueVal,
+		},
+		"OnlyEmailsEnabled": {
+			emailsEnabled: &trueVal,
+			want:          &trueVal,
+		},
+		"NeitherSet": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResolveEmailsEnabledSetting(tc.emailsDisabled, tc.emailsEnabled)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ResolveEmailsEnabledSetting() diff (-want +got):\n%s", diff)
+			}
+			want := (*bool)(nil)
+			if tc.want != nil {
+				want = ptr.To(!*tc.want)
+			}
+			if diff := cmp.Diff(want, EmailsDisabledSetting(got)); diff != "" {
+				t.Errorf("EmailsDisabledSetting() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObservedEmailsEnabled(t *testing.T) {
+	cases := map[string]struct {
+		emailsEnabled  bool
+		emailsDisabled bool
+		version        *ServerVersion
+		want           bool
+	}{
+		"EmailsEnabled": {
+			emailsEnabled: true,
+			version:       &ServerVersion{Major: 17, Minor: 0},
+			want:          true,
+		},
+		"EmailsDisabledIgnored": {
+			emailsDisabled: true,
+			emailsEnabled:  true,
+			version:        &ServerVersion{Major: 16, Minor: 5},
+			want:           true,
+		},
+		"UnknownVersion": {
+			emailsEnabled: true,
+			want:          true,
+		},
+		"BeforeRename": {
+			version: &ServerVersion{Major: 16, Minor: 4},
+			want:    true,
+		},
+		"BeforeRenameDisabled": {
+			emailsDisabled: true,
+			version:        &ServerVersion{Major: 15, Minor: 11},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ObservedEmailsEnabled(tc.emailsEnabled, tc.emailsDisabled, tc.version); got != tc.want {
+				t.Errorf("ObservedEmailsEnabled() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+)
+
+// HookTriggers are the events triggering both project and group hooks.
+type HookTriggers struct {
+	PushEvents               *bool
+	PushEventsBranchFilter   *string
+	IssuesEvents             *bool
+	ConfidentialIssuesEvents *bool
+	MergeRequestsEvents      *bool
+	TagPushEvents            *bool
+	NoteEvents               *bool
+	ConfidentialNoteEvents   *bool
+	JobEvents                *bool
+	PipelineEvents           *bool
+	WikiPageEvents           *bool
+}
+
+// AreHookTriggersUpToDate checks whether the observed triggers of a hook
+// match the desired ones. Unset desired triggers are not compared, except
+// for the push events branch filter which GitLab reports as empty if unset.
+func AreHookTriggersUpToDate(desired, observed HookTriggers) bool {
+	return isUnsetOrEqual(desired.PushEvents, observed.PushEvents) &&
+		ptr.Deref(desired.PushEventsBranchFilter, "") == ptr.Deref(observed.PushEventsBranchFilter, "") &&
+		isUnsetOrEqual(desired.IssuesEvents, observed.IssuesEvents) &&
+		isUnsetOrEqual(desired.ConfidentialIssuesEvents, observed.ConfidentialIssuesEvents) &&
+		isUnsetOrEqual(desired.MergeRequestsEvents, observed.MergeRequestsEvents) &&
+		isUnsetOrEqual(desired.TagPushEvents, observed.TagPushEvents) &&
+		isUnsetOrEqual(desired.NoteEvents, observed.NoteEvents) &&
+		isUnsetOrEqual(desired.ConfidentialNoteEvents, observed.ConfidentialNoteEvents) &&
+		isUnsetOrEqual(desired.JobEvents, observed.JobEvents) &&
+		isUnsetOrEqual(desired.PipelineEvents, observed.PipelineEvents) &&
+		isUnsetOrEqual(desired.WikiPageEvents, observed.WikiPageEvents)
+}
+
+// AreHookCustomHeaderKeysUpToDate checks whether the custom headers of a hook
+// have the desired keys, regardless of their order. Their values are not
+// returned by GitLab and have to be compared by the caller.
+func AreHookCustomHeaderKeysUpToDate(desired, observed []string) bool {
+	d := slices.Clone(desired)
+	slices.Sort(d)
+	o := slices.Clone(observed)
+	slices.Sort(o)
+	return slices.Equal(d, o)
+}
+
+// RemovedHookCustomHeaderKeys returns the observed custom header keys which
+// are no longer desired.
+func RemovedHookCustomHeaderKeys(desired, observed []string) []string {
+	var removed []string
+	for _, k := range observed {
+		if !slices.Contains(desired, k) {
+			removed = append(removed, k)
+		}
+	}
+	return removed
+}
+
+// HashHookCustomHeaders returns the hex encoded SHA-256 hash of the custom
+// headers of a hook, or an empty string if there are none.
+func HashHookCustomHeaders(headers []*gitlab.HookCustomHeader) string {
+	if len(headers) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, c := range headers {
+		h.Write([]byte(c.Key))
+		h.Write([]byte{0})
+		h.Write([]byte(c.Value))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HookCustomHeaderKeys returns the keys of the custom headers of a hook.
+func HookCustomHeaderKeys(headers []*gitlab.HookCustomHeader) []string {
+	keys := make([]string, 0, len(headers))
+	for _, h := range headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+func isUnsetOrEqual[T comparable](desired, observed *T) bool {
+	return desired == nil || observed != nil && *desired == *observed
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+)
+
+func TestAreHookTriggersUpToDate(t *testing.T) {
+	observed := HookTriggers{
+		PushEvents:             ptr.To(true),
+		PushEventsBranchFilter: ptr.To("main"),
+		IssuesEvents:           ptr.To(false),
+	}
+
+	cases := map[string]struct {
+		desired HookTriggers
+		want    bool
+	}{
+		"Unset": {
+			desired: HookTriggers{PushEventsBranchFilter: ptr.To("main")},
+			want:    true,
+		},
+		"BranchFilterUnset": {
+			desired: HookTriggers{},
+			want:    false,
+		},
+		"Equal": {
+			desired: HookTriggers{PushEvents: ptr.To(true), PushEventsBranchFilter: ptr.To("main"), IssuesEvents: ptr.To(false)},
+			want:    true,
+		},
+		"TriggerChanged": {
+			desired: HookTriggers{PushEventsBranchFilter: ptr.To("main"), IssuesEvents: ptr.To(true)},
+			want:    false,
+		},
+		"BranchFilterChanged": {
+			desired: HookTriggers{PushEventsBranchFilter: ptr.To("release/*")},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AreHookTriggersUpToDate(tc.desired, observed); got != tc.want {
+				t.Errorf("AreHookTriggersUpToDate(...) = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHookCustomHeaderKeys(t *testing.T) {
+	desired := []string{"X-B", "X-A"}
+	observed := HookCustomHeaderKeys([]*gitlab.HookCustomHeader{{Key: "X-A"}, {Key: "X-C"}, {Key: "X-B"}})
+
+	if AreHookCustomHeaderKeysUpToDate(desired, observed) {
+		t.Errorf("AreHookCustomHeaderKeysUpToDate(...) = true, want false")
+	}
+	if !AreHookCustomHeaderKeysUpToDate(desired, []string{"X-A", "X-B"}) {
+		t.Errorf("AreHookCustomHeaderKeysUpToDate(...) = false, want true")
+	}
+	if diff := cmp.Diff([]string{"X-C"}, RemovedHookCustomHeaderKeys(desired, observed)); diff != "" {
+		t.Errorf("RemovedHookCustomHeaderKeys(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestHashHookCustomHeaders(t *testing.T) {
+	a := HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-A", Value: "1"}, {Key: "X-B", Value: "2"}})
+	b := HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-A", Value: "1"}, {Key: "X-B", Value: "2"}})
+	c := HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-A", Value: "changed"}, {Key: "X-B", Value: "2"}})
+
+	if a != b {
+		t.Errorf("HashHookCustomHeaders(...) is not deterministic")
+	}
+	if HashHookCustomHeaders(nil) != "" {
+		t.Errorf("HashHookCustomHeaders(nil) is not empty")
+	}
+	if a == c {
+		t.Errorf("HashHookCustomHeaders(...) ignores header values")
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConnectionKeyHookToken is the connection secret key of the secret
+	// token of a hook whose token is rotated by the provider.
+	ConnectionKeyHookToken = "token"
+
+	// ConnectionKeyPreviousHookToken is the connection secret key of the
+	// token replaced by the last rotation. Receivers should accept it until
+	// the next rotation, as GitLab may already send the new token before
+	// they read it.
+	ConnectionKeyPreviousHookToken = "previousToken"
+
+	// ErrHookTokenRotationConflict is returned if a hook both references its
+	// token and has it rotated by the provider.
+	ErrHookTokenRotationConflict = "rotateEvery cannot be combined with a token secret reference, the provider generates the token"
+
+	errGenerateHookToken = "cannot generate hook token"
+	errGetConnection     = "cannot get connection secret"
+
+	hookTokenBytes = 32
+)
+
+// IsHookTokenRotationDue returns true if the token of a hook rotated every
+// interval must be rotated at now, which is the case if it was never rotated
+// by the provider or the interval elapsed since rotatedAt. Tokens without an
+// interval are never rotated.
+func IsHookTokenRotationDue(every *metav1.Duration, rotatedAt *metav1.Time, now time.Time) bool {
+	if every == nil {
+		return false
+	}
+	if rotatedAt == nil {
+		return true
+	}
+	return !now.Before(rotatedAt.Add(every.Duration))
+}
+
+// RotateHookToken generates a new secret token for a hook and returns it with
+// the connection details to publish. They include the token it replaces, as
+// read from the connection secret of the hook, so receivers can accept both
+// during the rotation.
+func RotateHookToken(ctx context.Context, kube client.Client, mg resource.Managed) (string, managed.ConnectionDetails, error) {
+	b := make([]byte, hookTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, errors.Wrap(err, errGenerateHookToken)
+	}
+	token := hex.EncodeToString(b)
+
+	details := managed.ConnectionDetails{ConnectionKeyHookToken: []byte(token)}
+	previous, err := getConnectionSecretValue(ctx, kube, mg, ConnectionKeyHookToken)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(previous) > 0 {
+		details[ConnectionKeyPreviousHookToken] = previous
+	}
+	return token, details, nil
+}
+
+// getConnectionSecretValue returns the value of the key in the connection
+// secret of the managed resource, or nil if the resource does not write a
+// connection secret or it does not exist yet.
+func getConnectionSecretValue(ctx context.Context, kube client.Client, mg resource.Managed, key string) ([]byte, error) {
+	var ref *xpv1.SecretReference
+	switch o := mg.(type) {
+	case resource.LocalConnectionSecretWriterTo:
+		if r := o.GetWriteConnectionSecretToReference(); r != nil {
+			ref = &xpv1.SecretReference{Name: r.Name, Namespace: mg.GetNamespace()}
+		}
+	case resource.ConnectionSecretWriterTo:
+		ref = o.GetWriteConnectionSecretToReference()
+	}
+	if ref == nil {
+		return nil, nil
+	}
+
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errGetConnection)
+	}
+	return s.Data[key], nil
+}
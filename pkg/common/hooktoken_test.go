@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsHookTokenRotationDue(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := &metav1.Duration{Duration: 24 * time.Hour}
+
+	cases := map[string]struct {
+		every     *metav1.Duration
+		rotatedAt *metav1.Time
+		want      bool
+	}{
+		"NotRotated": {
+			rotatedAt: &metav1.Time{Time: now.Add(-48 * time.Hour)},
+		},
+		"NeverRotated": {
+			every: day,
+			want:  true,
+		},
+		"WithinInterval": {
+			every:     day,
+			rotatedAt: &metav1.Time{Time: now.Add(-23 * time.Hour)},
+		},
+		"IntervalElapsed": {
+			every:     day,
+			rotatedAt: &metav1.Time{Time: now.Add(-24 * time.Hour)},
+			want:      true,
+		},
+		"Overdue": {
+			every:     day,
+			rotatedAt: &metav1.Time{Time: now.Add(-72 * time.Hour)},
+			want:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsHookTokenRotationDue(tc.every, tc.rotatedAt, now); got != tc.want {
+				t.Errorf("IsHookTokenRotationDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
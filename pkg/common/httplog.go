@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// maxLoggedBodySize limits the size of logged bodies, larger bodies are
+// truncated after redaction.
+const maxLoggedBodySize = 16 * 1024
+
+// httpLog receives the request and response bodies logged for ProviderConfigs
+// with logHttpBodies set. The controller-runtime logger only writes when the
+// provider runs with --debug.
+var httpLog = logging.NewLogrLogger(ctrl.Log.WithName("gitlab-http"))
+
+// sensitiveFields are request and response fields whose values are never
+// logged. Fields containing one of sensitiveFieldParts are redacted as well,
+// as are private keys in PEM format regardless of their field. Hook URLs are
+// redacted as a whole, since services such as Slack carry the secret in the
+// path rather than in the user info or query.
+var (
+	sensitiveFields     = []string{"value", "import_url", "url"}
+	sensitiveFieldParts = []string{"token", "password", "secret", "private_key"}
+)
+
+// logBodies returns an interceptor that logs sanitized request and response
+// bodies at debug level. Headers are never logged, since they carry the
+// credentials of the ProviderConfig.
+func logBodies(log logging.Logger) gitlab.Interceptor {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			reqBody, err := peekBody(&req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := next.RoundTrip(req)
+			if err != nil {
+				log.Debug("GitLab API request failed",
+					"method", req.Method, "url", redactURL(req.URL), "requestBody", redactBody(reqBody), "error", err.Error())
+				return res, err
+			}
+
+			resBody, err := peekBody(&res.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			log.Debug("GitLab API request",
+				"method", req.Method, "url", redactURL(req.URL), "status", res.StatusCode,
+				"requestBody", redactBody(reqBody), "responseBody", redactBody(resBody))
+			return res, nil
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// peekBody reads the body and replaces it by a reader over the read content.
+func peekBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// redactBody returns the body with the values of sensitive fields redacted.
+// JSON and form encoded bodies are redacted field by field, other bodies are
+// only logged by their size.
+func redactBody(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	var out string
+	var v any
+	if err := json.Unmarshal(b, &v); err == nil {
+		r, err := json.Marshal(redactJSON(v))
+		if err != nil {
+			return fmt.Sprintf("<%d bytes>", len(b))
+		}
+		out = string(r)
+	} else if q, err := url.ParseQuery(string(b)); err == nil && strings.Contains(string(b), "=") {
+		out = redactValues(q).Encode()
+	} else {
+		return fmt.Sprintf("<%d bytes>", len(b))
+	}
+
+	if len(out) > maxLoggedBodySize {
+		return out[:maxLoggedBodySize] + "...(truncated)"
+	}
+	return out
+}
+
+func redactJSON(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if isSensitiveField(k) || isPrivateKey(val) {
+				t[k] = redactedValue(val)
+				continue
+			}
+			t[k] = redactJSON(val)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = redactJSON(val)
+		}
+	}
+	return v
+}
+
+func redactValues(q url.Values) url.Values {
+	for k, vals := range q {
+		for i, val := range vals {
+			if isSensitiveField(k) || isPrivateKey(val) {
+				vals[i] = redactedValue(val).(string)
+			}
+		}
+	}
+	return q
+}
+
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	c := *u
+	c.User = nil
+	c.RawQuery = redactValues(c.Query()).Encode()
+	return c.String()
+}
+
+// redactedValue returns the replacement of a sensitive value. The length of
+// strings is kept, which helps diagnosing rejected masked variables.
+func redactedValue(v any) any {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return v
+	}
+	return fmt.Sprintf("%s (%d characters)", Redacted, len(s))
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	// Form encoded nested fields look like variables[0][value].
+	if i := strings.LastIndex(name, "["); i >= 0 {
+		name = strings.TrimSuffix(name[i+1:], "]")
+	}
+	for _, f := range sensitiveFields {
+		if name == f {
+			return true
+		}
+	}
+	for _, p := range sensitiveFieldParts {
+		if strings.Contains(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivateKey(v any) bool {
+	s, ok := v.(string)
+	return ok && strings.Contains(s, "PRIVATE KEY-----")
+}
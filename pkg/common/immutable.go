@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationKeyRecreateOnImmutableChange deletes and recreates the
+	// external resource when set to "true" and an immutable field of the
+	// managed resource changed. Everything stored in the external resource
+	// is lost when it is recreated.
+	AnnotationKeyRecreateOnImmutableChange = "gitlab.crossplane.io/recreate-on-immutable-change"
+
+	// TypeImmutableFieldsChanged indicates that immutable fields of a managed
+	// resource differ from the external resource, which therefore has to be
+	// recreated.
+	TypeImmutableFieldsChanged xpv1.ConditionType = "ImmutableFieldsChanged"
+
+	// ReasonRecreateRequired is used when immutable fields changed.
+	ReasonRecreateRequired xpv1.ConditionReason = "RecreateRequired"
+
+	// ReasonImmutableFieldsUnchanged is used once the immutable fields match
+	// the external resource again.
+	ReasonImmutableFieldsUnchanged xpv1.ConditionReason = "ImmutableFieldsUnchanged"
+)
+
+// ImmutableField is a field of a managed resource that GitLab cannot update.
+type ImmutableField struct {
+	// Name is the path of the field below spec, e.g. forProvider.scopes.
+	Name string
+
+	// UpToDate is true if the desired value matches the observed one.
+	UpToDate bool
+}
+
+// ChangedImmutableFields returns the names of the supplied fields that are
+// not up to date.
+func ChangedImmutableFields(fields ...ImmutableField) []string {
+	var changed []string
+	for _, f := range fields {
+		if !f.UpToDate {
+			changed = append(changed, f.Name)
+		}
+	}
+	return changed
+}
+
+// RecreateOnImmutableChange returns true if the external resource of a
+// managed resource should be deleted and created again when immutable
+// fields changed. Changes are rejected by default.
+func RecreateOnImmutableChange(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyRecreateOnImmutableChange] == "true"
+}
+
+// ImmutableFieldsChangedError returns the error reported when changed
+// immutable fields cannot be applied.
+func ImmutableFieldsChangedError(changed []string) error {
+	if len(changed) == 1 {
+		return errors.Errorf("field %s is immutable, recreate required", changed[0])
+	}
+	return errors.Errorf("fields %s are immutable, recreate required", strings.Join(changed, ", "))
+}
+
+// SetImmutableFieldsChanged sets the ImmutableFieldsChanged condition if any
+// immutable fields changed and resets it once they match again.
+func SetImmutableFieldsChanged(mg resource.Managed, changed []string) {
+	if len(changed) > 0 {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeImmutableFieldsChanged,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonRecreateRequired,
+			Message:            ImmutableFieldsChangedError(changed).Error(),
+		})
+		return
+	}
+	if mg.GetCondition(TypeImmutableFieldsChanged).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeImmutableFieldsChanged,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonImmutableFieldsUnchanged,
+		})
+	}
+}
+
+// HandleChangedImmutableFields applies the immutable field changes found by
+// SetImmutableFieldsChanged during the preceding observation. It returns
+// false if there are none. Otherwise the changes are rejected with an error,
+// or the external resource is deleted using the supplied function if
+// RecreateOnImmutableChange is true. The next observation then does not find
+// the external resource anymore and it is created again.
+func HandleChangedImmutableFields(mg resource.Managed, deleteExternal func() error) (bool, error) {
+	c := mg.GetCondition(TypeImmutableFieldsChanged)
+	if c.Status != corev1.ConditionTrue {
+		return false, nil
+	}
+	if !RecreateOnImmutableChange(mg) {
+		return true, errors.Errorf("%s, set the %s annotation to \"true\" to delete and create it again", c.Message, AnnotationKeyRecreateOnImmutableChange)
+	}
+	return true, deleteExternal()
+}
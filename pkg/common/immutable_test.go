@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSetImmutableFieldsChanged(t *testing.T) {
+	cases := map[string]struct {
+		existing []xpv1.Condition
+		changed  []string
+		want     xpv1.Condition
+	}{
+		"Changed": {
+			changed: []string{"forProvider.scopes"},
+			want: xpv1.Condition{
+				Type:    TypeImmutableFieldsChanged,
+				Status:  corev1.ConditionTrue,
+				Reason:  ReasonRecreateRequired,
+				Message: "field forProvider.scopes is immutable, recreate required",
+			},
+		},
+		"SeveralChanged": {
+			changed: []string{"forProvider.username", "forProvider.scopes"},
+			want: xpv1.Condition{
+				Type:    TypeImmutableFieldsChanged,
+				Status:  corev1.ConditionTrue,
+				Reason:  ReasonRecreateRequired,
+				Message: "fields forProvider.username, forProvider.scopes are immutable, recreate required",
+			},
+		},
+		"Reverted": {
+			existing: []xpv1.Condition{{Type: TypeImmutableFieldsChanged, Status: corev1.ConditionTrue, Reason: ReasonRecreateRequired}},
+			want:     xpv1.Condition{Type: TypeImmutableFieldsChanged, Status: corev1.ConditionFalse, Reason: ReasonImmutableFieldsUnchanged},
+		},
+		"NeverChanged": {
+			want: xpv1.Condition{Type: TypeImmutableFieldsChanged, Status: corev1.ConditionUnknown},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.existing...)
+			SetImmutableFieldsChanged(mg, tc.changed)
+			if diff := cmp.Diff(tc.want, mg.GetCondition(TypeImmutableFieldsChanged), test.EquateConditions()); diff != "" {
+				t.Errorf("SetImmutableFieldsChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleChangedImmutableFields(t *testing.T) {
+	errBoom := errors.New("boom")
+	changed := xpv1.Condition{
+		Type:    TypeImmutableFieldsChanged,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonRecreateRequired,
+		Message: "field forProvider.scopes is immutable, recreate required",
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		conditions  []xpv1.Condition
+		deleteErr   error
+		wantHandled bool
+		wantDeleted bool
+		wantErr     error
+	}{
+		"Unchanged": {},
+		"Rejected": {
+			conditions:  []xpv1.Condition{changed},
+			wantHandled: true,
+			wantErr:     errors.New("field forProvider.scopes is immutable, recreate required, set the " + AnnotationKeyRecreateOnImmutableChange + " annotation to \"true\" to delete and create it again"),
+		},
+		"Recreated": {
+			annotations: map[string]string{AnnotationKeyRecreateOnImmutableChange: "true"},
+			conditions:  []xpv1.Condition{changed},
+			wantHandled: true,
+			wantDeleted: true,
+		},
+		"DeleteFailed": {
+			annotations: map[string]string{AnnotationKeyRecreateOnImmutableChange: "true"},
+			conditions:  []xpv1.Condition{changed},
+			deleteErr:   errBoom,
+			wantHandled: true,
+			wantDeleted: true,
+			wantErr:     errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			mg.SetConditions(tc.conditions...)
+
+			deleted := false
+			handled, err := HandleChangedImmutableFields(mg, func() error {
+				deleted = true
+				return tc.deleteErr
+			})
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("HandleChangedImmutableFields(...): -want error, +got error:\n%s", diff)
+			}
+			if handled != tc.wantHandled {
+				t.Errorf("HandleChangedImmutableFields(...): handled = %v, want %v", handled, tc.wantHandled)
+			}
+			if deleted != tc.wantDeleted {
+				t.Errorf("HandleChangedImmutableFields(...): deleted = %v, want %v", deleted, tc.wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReasonUnsupportedByLicense is used when the license of the GitLab server
+// does not include a feature the resource depends on.
+const ReasonUnsupportedByLicense xpv1.ConditionReason = "UnsupportedByGitLabLicense"
+
+// SetUnsupportedByLicense sets the UnsupportedFeatures condition for a
+// feature that is not available with the license of the GitLab server.
+func SetUnsupportedByLicense(mg resource.Managed, feature string) {
+	mg.SetConditions(xpv1.Condition{
+		Type:               TypeUnsupportedFeatures,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnsupportedByLicense,
+		Message:            fmt.Sprintf("%s require a GitLab Premium or Ultimate license, or the provider credentials lack the permission to manage them", feature),
+	})
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	namespacedV1Beta1 "github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+)
+
+// providerConfigKube serves ProviderConfigs and ClusterProviderConfigs keyed
+// by their namespaced and cluster-scoped name, each pointing at its own GitLab instance.
+func providerConfigKube(pcs, cpcs map[string]string) client.Client {
+	spec := func(baseURL string) namespacedV1Beta1.ProviderConfigSpec {
+		return namespacedV1Beta1.ProviderConfigSpec{
+			BaseURL: baseURL,
+			Credentials: namespacedV1Beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "gitlab", Namespace: "crossplane-system"},
+						Key:             "token",
+					},
+				},
+			},
+		}
+	}
+	notFound := func(key client.ObjectKey) error {
+		return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+	}
+
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *namespacedV1Beta1.ProviderConfig:
+				u, ok := pcs[key.String()]
+				if !ok {
+					return notFound(key)
+				}
+				o.Spec = spec(u)
+			case *namespacedV1Beta1.ClusterProviderConfig:
+				u, ok := cpcs[key.Name]
+				if !ok {
+					return notFound(key)
+				}
+				o.Spec = spec(u)
+			case *corev1.Secret:
+				o.Data = map[string][]byte{"token": []byte("token")}
+			default:
+				return notFound(key)
+			}
+			return nil
+		},
+		MockCreate: test.NewMockCreateFn(nil),
+	}
+}
+
+func TestGetConfigProviderConfigRef(t *testing.T) {
+	pcs := map[string]string{
+		"team-a/gitlab": "https://gitlab-a.example.com/",
+		"team-b/gitlab": "https://gitlab-b.example.com/",
+	}
+	cpcs := map[string]string{
+		"gitlab": "https://gitlab-cluster.example.com/",
+	}
+
+	managed := func(namespace string, ref *xpv1.ProviderConfigReference) *fake.ModernManaged {
+		mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: "mg", Namespace: namespace}}
+		mg.SetProviderConfigReference(ref)
+		return mg
+	}
+
+	type want struct {
+		baseURL string
+		err     error
+	}
+	cases := map[string]struct {
+		mg   *fake.ModernManaged
+		want want
+	}{
+		"ProviderConfig": {
+			mg:   managed("team-a", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "gitlab"}),
+			want: want{baseURL: pcs["team-a/gitlab"]},
+		},
+		"ProviderConfigInResourceNamespace": {
+			mg:   managed("team-b", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "gitlab"}),
+			want: want{baseURL: pcs["team-b/gitlab"]},
+		},
+		"ClusterProviderConfig": {
+			mg:   managed("team-a", &xpv1.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "gitlab"}),
+			want: want{baseURL: cpcs["gitlab"]},
+		},
+		"ProviderConfigNotFound": {
+			mg: managed("team-c", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "gitlab"}),
+			want: want{err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "gitlab"),
+				"cannot get referenced ProviderConfig")},
+		},
+		"ClusterProviderConfigNotFound": {
+			mg: managed("team-a", &xpv1.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "other"}),
+			want: want{err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "other"),
+				"cannot get referenced ClusterProviderConfig")},
+		},
+		"NoReference": {
+			mg:   managed("team-a", nil),
+			want: want{err: errors.New("providerConfigRef is not given")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := GetConfig(context.Background(), providerConfigKube(pcs, cpcs), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetConfig(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.baseURL, cfg.BaseURL); diff != "" {
+				t.Errorf("GetConfig(...): -want base URL, +got base URL:\n%s", diff)
+			}
+		})
+	}
+}
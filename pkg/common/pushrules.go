@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+// IsPushRulesEmpty reports whether no push rule is in effect, i.e. all rules
+// are unset or carry their default value.
+func IsPushRulesEmpty(rules *commonv1alpha1.PushRules) bool { //nolint:gocyclo
+	if rules == nil {
+		return true
+	}
+
+	// Check string fields - if any is non-empty, rules are not empty
+	if rules.AuthorEmailRegex != nil && *rules.AuthorEmailRegex != "" {
+		return false
+	}
+	if rules.BranchNameRegex != nil && *rules.BranchNameRegex != "" {
+		return false
+	}
+	if rules.CommitMessageNegativeRegex != nil && *rules.CommitMessageNegativeRegex != "" {
+		return false
+	}
+	if rules.CommitMessageRegex != nil && *rules.CommitMessageRegex != "" {
+		return false
+	}
+	if rules.FileNameRegex != nil && *rules.FileNameRegex != "" {
+		return false
+	}
+
+	// Check boolean fields - if any is true, rules are not empty
+	if rules.CommitCommitterCheck != nil && *rules.CommitCommitterCheck {
+		return false
+	}
+	if rules.CommitCommitterNameCheck != nil && *rules.CommitCommitterNameCheck {
+		return false
+	}
+	if rules.DenyDeleteTag != nil && *rules.DenyDeleteTag {
+		return false
+	}
+	if rules.MemberCheck != nil && *rules.MemberCheck {
+		return false
+	}
+	if rules.PreventSecrets != nil && *rules.PreventSecrets {
+		return false
+	}
+	if rules.RejectNonDCOCommits != nil && *rules.RejectNonDCOCommits {
+		return false
+	}
+	if rules.RejectUnsignedCommits != nil && *rules.RejectUnsignedCommits {
+		return false
+	}
+
+	// Check integer fields - if any is non-zero, rules are not empty
+	if rules.MaxFileSize != nil && *rules.MaxFileSize != 0 {
+		return false
+	}
+
+	// If we reach here, all fields are empty/default
+	return true
+}
+
+// IsPushRulesUpToDate compares the desired push rules with the ones observed
+// in GitLab. A nil current means push rules are not available, e.g. on the
+// GitLab Community Edition.
+func IsPushRulesUpToDate(spec, current *commonv1alpha1.PushRules) bool {
+	// If push rules are not available (e.g., GitLab Community Edition),
+	// consider them up to date if no push rules are specified in the spec
+	if current == nil {
+		return spec == nil
+	}
+
+	// If push rules are available in GitLab but not specified in spec,
+	// they need to be cleared (not up to date) - unless they're already effectively empty
+	if spec == nil {
+		return IsPushRulesEmpty(current)
+	}
+
+	// Both exist, compare them
+	return cmp.Equal(spec, current)
+}
+
+// LateInitializePushRules fills the unset rules of spec with the ones
+// observed in GitLab. Unset spec push rules are not late-initialized.
+func LateInitializePushRules(spec, current *commonv1alpha1.PushRules) *commonv1alpha1.PushRules {
+	if spec == nil || current == nil {
+		return spec
+	}
+
+	return &commonv1alpha1.PushRules{
+		AuthorEmailRegex:           lateInitialize(spec.AuthorEmailRegex, current.AuthorEmailRegex),
+		BranchNameRegex:            lateInitialize(spec.BranchNameRegex, current.BranchNameRegex),
+		CommitCommitterCheck:       lateInitialize(spec.CommitCommitterCheck, current.CommitCommitterCheck),
+		CommitCommitterNameCheck:   lateInitialize(spec.CommitCommitterNameCheck, current.CommitCommitterNameCheck),
+		CommitMessageNegativeRegex: lateInitialize(spec.CommitMessageNegativeRegex, current.CommitMessageNegativeRegex),
+		CommitMessageRegex:         lateInitialize(spec.CommitMessageRegex, current.CommitMessageRegex),
+		DenyDeleteTag:              lateInitialize(spec.DenyDeleteTag, current.DenyDeleteTag),
+		FileNameRegex:              lateInitialize(spec.FileNameRegex, current.FileNameRegex),
+		MaxFileSize:                lateInitialize(spec.MaxFileSize, current.MaxFileSize),
+		MemberCheck:                lateInitialize(spec.MemberCheck, current.MemberCheck),
+		PreventSecrets:             lateInitialize(spec.PreventSecrets, current.PreventSecrets),
+		RejectUnsignedCommits:      lateInitialize(spec.RejectUnsignedCommits, current.RejectUnsignedCommits),
+		RejectNonDCOCommits:        lateInitialize(spec.RejectNonDCOCommits, current.RejectNonDCOCommits),
+	}
+}
+
+func lateInitialize[T any](in, from *T) *T {
+	if in != nil {
+		return in
+	}
+	return from
+}
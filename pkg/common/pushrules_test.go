@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+func TestIsPushRulesEmpty(t *testing.T) {
+	cases := map[string]struct {
+		rules *commonv1alpha1.PushRules
+		want  bool
+	}{
+		"Nil":          {rules: nil, want: true},
+		"Unset":        {rules: &commonv1alpha1.PushRules{}, want: true},
+		"Defaults":     {rules: &commonv1alpha1.PushRules{BranchNameRegex: ptr.To(""), DenyDeleteTag: ptr.To(false), MaxFileSize: ptr.To(int64(0))}, want: true},
+		"RegexSet":     {rules: &commonv1alpha1.PushRules{BranchNameRegex: ptr.To("^main$")}, want: false},
+		"CheckEnabled": {rules: &commonv1alpha1.PushRules{PreventSecrets: ptr.To(true)}, want: false},
+		"SizeLimited":  {rules: &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(10))}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPushRulesEmpty(tc.rules); got != tc.want {
+				t.Errorf("IsPushRulesEmpty(...) = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsPushRulesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec    *commonv1alpha1.PushRules
+		current *commonv1alpha1.PushRules
+		want    bool
+	}{
+		"UnavailableUnmanaged": {spec: nil, current: nil, want: true},
+		"UnavailableManaged":   {spec: &commonv1alpha1.PushRules{}, current: nil, want: false},
+		"UnmanagedEmpty":       {spec: nil, current: &commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(false)}, want: true},
+		"UnmanagedNotEmpty":    {spec: nil, current: &commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}, want: false},
+		"Equal":                {spec: &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(5))}, current: &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(5))}, want: true},
+		"Different":            {spec: &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(5))}, current: &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(10))}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPushRulesUpToDate(tc.spec, tc.current); got != tc.want {
+				t.Errorf("IsPushRulesUpToDate(...) = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializePushRules(t *testing.T) {
+	current := &commonv1alpha1.PushRules{BranchNameRegex: ptr.To("^main$"), MaxFileSize: ptr.To(int64(10))}
+
+	cases := map[string]struct {
+		spec    *commonv1alpha1.PushRules
+		current *commonv1alpha1.PushRules
+		want    *commonv1alpha1.PushRules
+	}{
+		"UnmanagedIsKept": {spec: nil, current: current, want: nil},
+		"Unavailable":     {spec: &commonv1alpha1.PushRules{}, current: nil, want: &commonv1alpha1.PushRules{}},
+		"FillsUnset": {
+			spec:    &commonv1alpha1.PushRules{MaxFileSize: ptr.To(int64(5))},
+			current: current,
+			want:    &commonv1alpha1.PushRules{BranchNameRegex: ptr.To("^main$"), MaxFileSize: ptr.To(int64(5))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LateInitializePushRules(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LateInitializePushRules(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
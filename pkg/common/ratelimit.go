@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"golang.org/x/time/rate"
+)
+
+const (
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+
+	// rateLimitLowPercent is the share of the rate limit of GitLab below
+	// which the remaining budget is considered low.
+	rateLimitLowPercent = 10
+)
+
+// limiters holds one token bucket per GitLab instance and credentials, so
+// that all clients created for them share the same request budget.
+var limiters = &limiterRegistry{limiters: map[string]*rate.Limiter{}}
+
+type limiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// sharedLimiter returns the limiter shared by all clients of the given
+// configuration, or nil if no rate limit is configured. Changes of the
+// configured rate are applied to the existing limiter.
+func (r *limiterRegistry) sharedLimiter(c Config) *rate.Limiter {
+	if c.RequestsPerSecond <= 0 {
+		return nil
+	}
+	limit := rate.Limit(c.RequestsPerSecond)
+	burst := c.RequestBurst
+	if burst <= 0 {
+		burst = c.RequestsPerSecond
+	}
+
+	key := limiterKey(c)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.limiters[key]
+	if !ok {
+		l = rate.NewLimiter(limit, burst)
+		r.limiters[key] = l
+		return l
+	}
+	if l.Limit() != limit {
+		l.SetLimit(limit)
+	}
+	if l.Burst() != burst {
+		l.SetBurst(burst)
+	}
+	return l
+}
+
+// limiterKey identifies the rate limit bucket GitLab applies to requests of
+// the given configuration without keeping the token itself around.
+func limiterKey(c Config) string {
+	h := sha256.Sum256([]byte(c.BaseURL + "\x00" + string(c.AuthMethod) + "\x00" + c.Token))
+	return hex.EncodeToString(h[:])
+}
+
+// RateLimitBudget is the rate limit budget GitLab reported in the headers of
+// a response.
+type RateLimitBudget struct {
+	// Limit is the number of requests allowed per period.
+	Limit int
+	// Remaining is the number of requests left in the current period.
+	Remaining int
+	// ResetTime is when the budget is replenished.
+	ResetTime time.Time
+	// ObservedTime is when the response was received.
+	ObservedTime time.Time
+}
+
+// IsLow returns true if less than rateLimitLowPercent of the limit remains.
+func (b *RateLimitBudget) IsLow() bool {
+	return b.Remaining*100 < b.Limit*rateLimitLowPercent
+}
+
+// ParseRateLimitHeaders returns the rate limit budget of the response headers
+// received at now, or nil if GitLab did not send them. GitLab only sends them
+// if rate limits are enabled on the instance.
+func ParseRateLimitHeaders(h http.Header, now time.Time) *RateLimitBudget {
+	limit, err := strconv.Atoi(h.Get(headerRateLimitLimit))
+	if err != nil {
+		return nil
+	}
+	remaining, err := strconv.Atoi(h.Get(headerRateLimitRemaining))
+	if err != nil {
+		return nil
+	}
+	b := &RateLimitBudget{Limit: limit, Remaining: remaining, ObservedTime: now}
+	if reset, err := strconv.ParseInt(h.Get(headerRateLimitReset), 10, 64); err == nil {
+		b.ResetTime = time.Unix(reset, 0)
+	}
+	return b
+}
+
+// budgets holds the rate limit budget last reported for each GitLab instance
+// and credentials, keyed like the limiters.
+var budgets = &budgetRegistry{budgets: map[string]RateLimitBudget{}}
+
+type budgetRegistry struct {
+	mu      sync.Mutex
+	budgets map[string]RateLimitBudget
+}
+
+// LastRateLimitBudget returns the rate limit budget GitLab last reported to
+// any client of the given configuration, or nil if none was reported yet.
+func LastRateLimitBudget(c Config) *RateLimitBudget {
+	return budgets.get(limiterKey(c))
+}
+
+func (r *budgetRegistry) get(key string) *RateLimitBudget {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.budgets[key]
+	if !ok {
+		return nil
+	}
+	return &b
+}
+
+func (r *budgetRegistry) set(key string, b RateLimitBudget) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if last, ok := r.budgets[key]; ok && last.ObservedTime.After(b.ObservedTime) {
+		return
+	}
+	r.budgets[key] = b
+}
+
+// recordRateLimit returns an interceptor that records the rate limit budget
+// of every response under the given key.
+func (r *budgetRegistry) recordRateLimit(key string) gitlab.Interceptor {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			res, err := next.RoundTrip(req)
+			if err != nil || res == nil {
+				return res, err
+			}
+			if b := ParseRateLimitHeaders(res.Header, time.Now()); b != nil {
+				r.set(key, *b)
+			}
+			return res, err
+		})
+	}
+}
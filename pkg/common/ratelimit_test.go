@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/ptr"
+
+	namespacedV1Beta1 "github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+)
+
+func TestSharedLimiter(t *testing.T) {
+	cfg := Config{BaseURL: "https://gitlab.example.com", Token: "token", RequestsPerSecond: 5}
+
+	type want struct {
+		limit rate.Limit
+		burst int
+		same  bool
+		isNil bool
+	}
+	cases := map[string]struct {
+		seed *Config
+		cfg  Config
+		want want
+	}{
+		"Disabled": {
+			cfg:  Config{BaseURL: cfg.BaseURL, Token: cfg.Token},
+			want: want{isNil: true},
+		},
+		"BurstDefaultsToRate": {
+			cfg:  cfg,
+			want: want{limit: 5, burst: 5},
+		},
+		"SameCredentialsShareLimiter": {
+			seed: &cfg,
+			cfg:  cfg,
+			want: want{limit: 5, burst: 5, same: true},
+		},
+		"OtherCredentialsUseOwnLimiter": {
+			seed: &cfg,
+			cfg:  Config{BaseURL: cfg.BaseURL, Token: "other", RequestsPerSecond: 5},
+			want: want{limit: 5, burst: 5},
+		},
+		"RateChangeUpdatesSharedLimiter": {
+			seed: &cfg,
+			cfg:  Config{BaseURL: cfg.BaseURL, Token: cfg.Token, RequestsPerSecond: 2, RequestBurst: 10},
+			want: want{limit: 2, burst: 10, same: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &limiterRegistry{limiters: map[string]*rate.Limiter{}}
+			var seeded *rate.Limiter
+			if tc.seed != nil {
+				seeded = r.sharedLimiter(*tc.seed)
+			}
+
+			l := r.sharedLimiter(tc.cfg)
+			if tc.want.isNil {
+				if l != nil {
+					t.Errorf("r.sharedLimiter(...): want nil, got %v", l)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.limit, l.Limit()); diff != "" {
+				t.Errorf("r.sharedLimiter(...).Limit(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.burst, l.Burst()); diff != "" {
+				t.Errorf("r.sharedLimiter(...).Burst(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.same, seeded == l); diff != "" {
+				t.Errorf("r.sharedLimiter(...) shared: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	type want struct {
+		rps   int
+		burst int
+	}
+	cases := map[string]struct {
+		rl   *namespacedV1Beta1.RateLimit
+		want want
+	}{
+		"Unset": {},
+		"RateOnly": {
+			rl:   &namespacedV1Beta1.RateLimit{RequestsPerSecond: 10},
+			want: want{rps: 10},
+		},
+		"RateAndBurst": {
+			rl:   &namespacedV1Beta1.RateLimit{RequestsPerSecond: 10, Burst: ptr.To(20)},
+			want: want{rps: 10, burst: 20},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rps, burst := rateLimit(tc.rl)
+			if diff := cmp.Diff(tc.want, want{rps: rps, burst: burst}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("rateLimit(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := map[string]struct {
+		header http.Header
+		want   *RateLimitBudget
+	}{
+		"NotEnforced": {
+			header: http.Header{},
+		},
+		"Budget": {
+			header: http.Header{
+				"Ratelimit-Limit":     {"2000"},
+				"Ratelimit-Remaining": {"1990"},
+				"Ratelimit-Reset":     {"1767323100"},
+			},
+			want: &RateLimitBudget{Limit: 2000, Remaining: 1990, ResetTime: time.Unix(1767323100, 0), ObservedTime: now},
+		},
+		"WithoutReset": {
+			header: http.Header{
+				"Ratelimit-Limit":     {"600"},
+				"Ratelimit-Remaining": {"0"},
+			},
+			want: &RateLimitBudget{Limit: 600, ObservedTime: now},
+		},
+		"Malformed": {
+			header: http.Header{
+				"Ratelimit-Limit":     {"600"},
+				"Ratelimit-Remaining": {"many"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ParseRateLimitHeaders(tc.header, now)); diff != "" {
+				t.Errorf("ParseRateLimitHeaders(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitBudgetIsLow(t *testing.T) {
+	cases := map[string]struct {
+		budget RateLimitBudget
+		want   bool
+	}{
+		"Plenty":      {budget: RateLimitBudget{Limit: 2000, Remaining: 1500}},
+		"AtThreshold": {budget: RateLimitBudget{Limit: 2000, Remaining: 200}},
+		"Low":         {budget: RateLimitBudget{Limit: 2000, Remaining: 199}, want: true},
+		"Exhausted":   {budget: RateLimitBudget{Limit: 2000}, want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.budget.IsLow(); got != tc.want {
+				t.Errorf("IsLow(): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordRateLimit(t *testing.T) {
+	r := &budgetRegistry{budgets: map[string]RateLimitBudget{}}
+	remaining := []string{"10", ""}
+	rt := r.recordRateLimit("key")(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		h := http.Header{}
+		if remaining[0] != "" {
+			h.Set(headerRateLimitLimit, "100")
+			h.Set(headerRateLimitRemaining, remaining[0])
+		}
+		remaining = remaining[1:]
+		return &http.Response{StatusCode: http.StatusOK, Header: h}, nil
+	}))
+
+	for range 2 {
+		if _, err := rt.RoundTrip(&http.Request{}); err != nil {
+			t.Fatalf("RoundTrip(...): %v", err)
+		}
+		got := r.get("key")
+		if got == nil {
+			t.Fatalf("get(...): want the budget of the first response to be recorded")
+		}
+		if got.Limit != 100 || got.Remaining != 10 {
+			t.Errorf("get(...): want 10 of 100 requests remaining, got %d of %d", got.Remaining, got.Limit)
+		}
+	}
+	if got := r.get("other"); got != nil {
+		t.Errorf("get(...): want no budget for other credentials, got %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Redacted replaces sensitive values in redacted error messages.
+const Redacted = "[REDACTED]"
+
+// RedactError returns an error with every occurrence of the supplied
+// secrets, including their URL and JSON encoded forms, replaced by
+// Redacted. GitLab errors may echo the request, so errors of requests
+// carrying secrets must be redacted before they are surfaced in logs,
+// events or conditions. The original error is dropped so that it cannot be
+// surfaced by accident.
+func RedactError(err error, secrets ...string) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	redacted := false
+	for _, s := range secrets {
+		if s == "" {
+			continue
+		}
+		for _, form := range encodings(s) {
+			if strings.Contains(msg, form) {
+				msg = strings.ReplaceAll(msg, form, Redacted)
+				redacted = true
+			}
+		}
+	}
+	if !redacted {
+		return err
+	}
+	return errors.New(msg)
+}
+
+func encodings(s string) []string {
+	forms := []string{s, url.QueryEscape(s), url.PathEscape(s)}
+	if b, err := json.Marshal(s); err == nil {
+		forms = append(forms, strings.Trim(string(b), `"`))
+	}
+	return forms
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestRedactError(t *testing.T) {
+	errBoom := errors.New(`boom: value "s3cr&t" is invalid`)
+
+	cases := map[string]struct {
+		err     error
+		secrets []string
+		want    error
+	}{
+		"NoError": {
+			secrets: []string{"s3cr&t"},
+		},
+		"NoSecrets": {
+			err:  errBoom,
+			want: errBoom,
+		},
+		"EmptySecret": {
+			err:     errBoom,
+			secrets: []string{""},
+			want:    errBoom,
+		},
+		"Plain": {
+			err:     errBoom,
+			secrets: []string{"s3cr&t"},
+			want:    errors.New(`boom: value "[REDACTED]" is invalid`),
+		},
+		"QueryEscaped": {
+			err:     errors.New("POST https://gitlab.com/api/v4/projects/1/variables?value=s3cr%26t: 400"),
+			secrets: []string{"s3cr&t"},
+			want:    errors.New("POST https://gitlab.com/api/v4/projects/1/variables?value=[REDACTED]: 400"),
+		},
+		"JSONEscaped": {
+			err:     errors.New(`{"value":"a\"b"}`),
+			secrets: []string{`a"b`},
+			want:    errors.New(`{"value":"[REDACTED]"}`),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RedactError(tc.err, tc.secrets...)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("RedactError(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/hashicorp/go-retryablehttp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationKeySudo sends the GitLab requests of a managed resource on
+	// behalf of the named user or user ID. This requires an administrator
+	// token with the sudo scope and a ProviderConfig with allowSudo set,
+	// the annotation is ignored otherwise.
+	AnnotationKeySudo = "gitlab.crossplane.io/sudo"
+
+	// TypeSudoIgnored indicates that the sudo annotation of a managed
+	// resource is ignored because its ProviderConfig does not allow sudo.
+	TypeSudoIgnored xpv1.ConditionType = "SudoIgnored"
+
+	// ReasonSudoNotAllowed is used when the sudo annotation is ignored.
+	ReasonSudoNotAllowed xpv1.ConditionReason = "SudoNotAllowed"
+
+	// ReasonSudoNotIgnored is used once the sudo annotation is no longer
+	// ignored.
+	ReasonSudoNotIgnored xpv1.ConditionReason = "SudoNotIgnored"
+)
+
+// Sudo returns the user the GitLab requests of a managed resource are sent
+// as, or an empty string. The AnnotationKeySudo annotation is only honoured
+// if the ProviderConfig allows sudo, since anyone able to annotate the
+// resource could otherwise act as any GitLab user. An ignored annotation is
+// reported with the SudoIgnored condition.
+func Sudo(cfg Config, mg resource.Managed) string {
+	sudo := mg.GetAnnotations()[AnnotationKeySudo]
+	if sudo != "" && !cfg.AllowSudo {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeSudoIgnored,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonSudoNotAllowed,
+			Message:            "the " + AnnotationKeySudo + " annotation is ignored because the ProviderConfig does not set allowSudo",
+		})
+		return ""
+	}
+	if mg.GetCondition(TypeSudoIgnored).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeSudoIgnored,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonSudoNotIgnored,
+		})
+	}
+	return sudo
+}
+
+// RequestOptions returns the request options for a GitLab request made on
+// behalf of a managed resource: the reconcile context and, unless empty, the
+// user to act as as returned by Sudo.
+func RequestOptions(ctx context.Context, sudo string) []gitlab.RequestOptionFunc {
+	options := []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)}
+	if sudo != "" {
+		options = append(options, gitlab.WithSudo(sudo))
+	}
+	return options
+}
+
+// ApplyRequestOptions applies the supplied request options to an empty GET
+// request and returns it, so that fake clients can inspect the options they
+// were called with.
+func ApplyRequestOptions(options ...gitlab.RequestOptionFunc) (*retryablehttp.Request, error) {
+	req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range options {
+		if err := o(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type requestContextKey struct{}
+
+func TestRequestOptions(t *testing.T) {
+	cases := map[string]struct {
+		sudo string
+	}{
+		"NoSudo": {},
+		"Sudo": {
+			sudo: "root",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), requestContextKey{}, name)
+
+			req, err := ApplyRequestOptions(RequestOptions(ctx, tc.sudo)...)
+			if err != nil {
+				t.Fatalf("ApplyRequestOptions(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(name, req.Context().Value(requestContextKey{})); diff != "" {
+				t.Errorf("context: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.sudo, req.Header.Get("Sudo")); diff != "" {
+				t.Errorf("Sudo: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSudo(t *testing.T) {
+	ignored := xpv1.Condition{
+		Type:    TypeSudoIgnored,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonSudoNotAllowed,
+		Message: "the " + AnnotationKeySudo + " annotation is ignored because the ProviderConfig does not set allowSudo",
+	}
+	notIgnored := xpv1.Condition{
+		Type:   TypeSudoIgnored,
+		Status: corev1.ConditionFalse,
+		Reason: ReasonSudoNotIgnored,
+	}
+
+	type want struct {
+		sudo      string
+		condition xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		allowSudo   bool
+		conditions  []xpv1.Condition
+		want        want
+	}{
+		"NoAnnotation": {
+			want: want{
+				condition: xpv1.Condition{Type: TypeSudoIgnored, Status: corev1.ConditionUnknown},
+			},
+		},
+		"NotAllowed": {
+			annotations: map[string]string{AnnotationKeySudo: "root"},
+			want: want{
+				condition: ignored,
+			},
+		},
+		"Allowed": {
+			annotations: map[string]string{AnnotationKeySudo: "root"},
+			allowSudo:   true,
+			want: want{
+				sudo:      "root",
+				condition: xpv1.Condition{Type: TypeSudoIgnored, Status: corev1.ConditionUnknown},
+			},
+		},
+		"AllowedAfterIgnored": {
+			annotations: map[string]string{AnnotationKeySudo: "root"},
+			allowSudo:   true,
+			conditions:  []xpv1.Condition{ignored},
+			want: want{
+				sudo:      "root",
+				condition: notIgnored,
+			},
+		},
+		"AnnotationRemovedAfterIgnored": {
+			conditions: []xpv1.Condition{ignored},
+			want: want{
+				condition: notIgnored,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			mg.SetConditions(tc.conditions...)
+
+			sudo := Sudo(Config{AllowSudo: tc.allowSudo}, mg)
+			if diff := cmp.Diff(tc.want.sudo, sudo); diff != "" {
+				t.Errorf("Sudo(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypeSudoIgnored), test.EquateConditions()); diff != "" {
+				t.Errorf("Sudo(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
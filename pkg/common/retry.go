@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+
+	defaultMinRetryBackoff = 100 * time.Millisecond
+	defaultMaxRetryBackoff = 10 * time.Second
+)
+
+// retryOptions returns the client options retrying failed requests. Only
+// idempotent requests are retried after a server or transport error, see
+// retryCheck. How often and how long to wait is left to the client unless
+// configured.
+func retryOptions(c Config) []gitlab.ClientOptionFunc {
+	options := []gitlab.ClientOptionFunc{gitlab.WithCustomRetry(retryCheck)}
+	if c.MaxRetries == nil && c.MinRetryBackoff <= 0 && c.MaxRetryBackoff <= 0 {
+		return options
+	}
+
+	minBackoff, maxBackoff := c.MinRetryBackoff, c.MaxRetryBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultMinRetryBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxRetryBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	options = append(options,
+		gitlab.WithCustomBackoff(retryBackoff),
+		gitlab.WithCustomRetryWaitMinMax(minBackoff, maxBackoff),
+	)
+	if c.MaxRetries != nil {
+		options = append(options, gitlab.WithCustomRetryMax(*c.MaxRetries))
+	}
+	return options
+}
+
+// retryCheck reports whether a failed request is retried. A rate limited
+// request was not processed by GitLab and is always retried. Server and
+// transport errors may have happened after GitLab processed the request, so
+// they are only retried for idempotent methods. Retrying e.g. a POST could
+// otherwise create a resource twice.
+func retryCheck(ctx context.Context, res *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if res != nil && res.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if !isIdempotent(requestMethod(res, err)) {
+		return false, nil
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, res, err)
+}
+
+// requestMethod returns the method of the request that yielded res or err,
+// or an empty string if it is not known.
+func requestMethod(res *http.Response, err error) string {
+	if res != nil && res.Request != nil {
+		return res.Request.Method
+	}
+	// The HTTP client names the method of a failed request in its error.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return strings.ToUpper(urlErr.Op)
+	}
+	return ""
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryBackoff returns how long to wait before the given retry of a request.
+// A rate limited or unavailable response is retried when its Retry-After or
+// RateLimit-Reset header says so, but not before min nor after max, so that
+// a far away reset does not hold up the reconcile. Other failures back off
+// exponentially from min up to max.
+func retryBackoff(min, max time.Duration, attempt int, res *http.Response) time.Duration {
+	if res != nil && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable) {
+		if wait := retryAfter(res.Header, time.Now()); wait > 0 {
+			switch {
+			case wait < min:
+				return min
+			case wait > max:
+				return max
+			}
+			return wait
+		}
+	}
+
+	wait := min
+	for i := 0; i < attempt && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		return max
+	}
+	return wait
+}
+
+// retryAfter returns how long Gitlab asked to wait in the headers of a
+// response received at now, or zero if it did not ask to. Retry-After is
+// either a number of seconds or a date, RateLimit-Reset a Unix timestamp.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if v := h.Get(headerRetryAfter); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			return time.Duration(s) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return t.Sub(now)
+		}
+	}
+	if reset, err := strconv.ParseInt(h.Get(headerRateLimitReset), 10, 64); err == nil && reset > 0 {
+		return time.Unix(reset, 0).Sub(now)
+	}
+	return 0
+}
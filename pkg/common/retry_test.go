@@ -0,0 +1,361 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	namespacedV1Beta1 "github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+)
+
+func TestNewClientRetries(t *testing.T) {
+	type want struct {
+		requests int
+		err      bool
+	}
+	cases := map[string]struct {
+		maxRetries *int
+		status     int
+		failed     int
+		create     bool
+		want       want
+	}{
+		"RetriesRateLimitedRequest": {
+			status: http.StatusTooManyRequests,
+			failed: 1,
+			want:   want{requests: 2},
+		},
+		"GivesUpAfterMaxRetries": {
+			maxRetries: ptr.To(2),
+			status:     http.StatusTooManyRequests,
+			failed:     5,
+			want:       want{requests: 3, err: true},
+		},
+		"RetriesDisabled": {
+			maxRetries: ptr.To(0),
+			status:     http.StatusTooManyRequests,
+			failed:     1,
+			want:       want{requests: 1, err: true},
+		},
+		"RetriesServerErrorOfGet": {
+			status: http.StatusBadGateway,
+			failed: 1,
+			want:   want{requests: 2},
+		},
+		"RetriesRateLimitedPost": {
+			status: http.StatusTooManyRequests,
+			failed: 1,
+			create: true,
+			want:   want{requests: 2},
+		},
+		"DoesNotRetryServerErrorOfPost": {
+			status: http.StatusBadGateway,
+			failed: 1,
+			create: true,
+			want:   want{requests: 1, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= tc.failed {
+					w.Header().Set(headerRetryAfter, "0")
+					w.WriteHeader(tc.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":1}`))
+			}))
+			defer srv.Close()
+
+			cl := NewClient(Config{
+				BaseURL:         srv.URL,
+				Token:           "token",
+				MaxRetries:      tc.maxRetries,
+				MinRetryBackoff: time.Millisecond,
+				MaxRetryBackoff: time.Millisecond,
+			})
+			var err error
+			if tc.create {
+				_, _, err = cl.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: ptr.To("project")})
+			} else {
+				_, _, err = cl.Projects.GetProject(1, nil)
+			}
+
+			if diff := cmp.Diff(tc.want, want{requests: requests, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("request: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	limited := func(status int, retryAfter string) *http.Response {
+		return &http.Response{StatusCode: status, Header: http.Header{headerRetryAfter: []string{retryAfter}}}
+	}
+
+	cases := map[string]struct {
+		min     time.Duration
+		max     time.Duration
+		attempt int
+		res     *http.Response
+		want    time.Duration
+	}{
+		"FirstRetryWaitsMin": {
+			min:  100 * time.Millisecond,
+			max:  10 * time.Second,
+			want: 100 * time.Millisecond,
+		},
+		"DoublesPerRetry": {
+			min:     100 * time.Millisecond,
+			max:     10 * time.Second,
+			attempt: 3,
+			want:    800 * time.Millisecond,
+		},
+		"CappedAtMax": {
+			min:     100 * time.Millisecond,
+			max:     time.Second,
+			attempt: 10,
+			want:    time.Second,
+		},
+		"RateLimitedWaitsRetryAfter": {
+			min:  100 * time.Millisecond,
+			max:  time.Minute,
+			res:  limited(http.StatusTooManyRequests, "30"),
+			want: 30 * time.Second,
+		},
+		"RetryAfterCappedAtMax": {
+			min:  100 * time.Millisecond,
+			max:  time.Second,
+			res:  limited(http.StatusTooManyRequests, "30"),
+			want: time.Second,
+		},
+		"UnavailableWaitsRetryAfter": {
+			min:  100 * time.Millisecond,
+			max:  10 * time.Second,
+			res:  limited(http.StatusServiceUnavailable, "5"),
+			want: 5 * time.Second,
+		},
+		"RetryAfterBelowMin": {
+			min:  2 * time.Second,
+			max:  10 * time.Second,
+			res:  limited(http.StatusTooManyRequests, "1"),
+			want: 2 * time.Second,
+		},
+		"RetryAfterIgnoredForServerError": {
+			min:     100 * time.Millisecond,
+			max:     10 * time.Second,
+			attempt: 1,
+			res:     limited(http.StatusInternalServerError, "30"),
+			want:    200 * time.Millisecond,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := retryBackoff(tc.min, tc.max, tc.attempt, tc.res)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("retryBackoff(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryCheck(t *testing.T) {
+	response := func(method string, status int) *http.Response {
+		return &http.Response{StatusCode: status, Request: &http.Request{Method: method}}
+	}
+	transportErr := func(op string) error {
+		return &url.Error{Op: op, URL: "https://gitlab.example.com", Err: errors.New("connection reset by peer")}
+	}
+
+	cases := map[string]struct {
+		res  *http.Response
+		err  error
+		want bool
+	}{
+		"RateLimitedGet": {
+			res:  response(http.MethodGet, http.StatusTooManyRequests),
+			want: true,
+		},
+		"RateLimitedPost": {
+			res:  response(http.MethodPost, http.StatusTooManyRequests),
+			want: true,
+		},
+		"ServerErrorOfGet": {
+			res:  response(http.MethodGet, http.StatusInternalServerError),
+			want: true,
+		},
+		"ServerErrorOfPut": {
+			res:  response(http.MethodPut, http.StatusBadGateway),
+			want: true,
+		},
+		"ServerErrorOfDelete": {
+			res:  response(http.MethodDelete, http.StatusServiceUnavailable),
+			want: true,
+		},
+		"ServerErrorOfPost": {
+			res: response(http.MethodPost, http.StatusInternalServerError),
+		},
+		"ServerErrorOfPatch": {
+			res: response(http.MethodPatch, http.StatusBadGateway),
+		},
+		"NotImplemented": {
+			res: response(http.MethodGet, http.StatusNotImplemented),
+		},
+		"ClientError": {
+			res: response(http.MethodGet, http.StatusNotFound),
+		},
+		"TransportErrorOfGet": {
+			err:  transportErr("Get"),
+			want: true,
+		},
+		"TransportErrorOfPost": {
+			err: transportErr("Post"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := retryCheck(context.Background(), tc.res, tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("retryCheck(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryOptions(t *testing.T) {
+	cases := map[string]struct {
+		c    Config
+		want int
+	}{
+		"Unconfigured": {
+			want: 1,
+		},
+		"Backoff": {
+			c:    Config{MinRetryBackoff: time.Second},
+			want: 3,
+		},
+		"MaxRetries": {
+			c:    Config{MaxRetries: ptr.To(3)},
+			want: 4,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, len(retryOptions(tc.c))); diff != "" {
+				t.Errorf("retryOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	reset := strconv.FormatInt(now.Add(20*time.Second).Unix(), 10)
+	header := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	cases := map[string]struct {
+		header http.Header
+		want   time.Duration
+	}{
+		"NoHeaders": {
+			header: header(),
+		},
+		"RetryAfterSeconds": {
+			header: header(headerRetryAfter, "12"),
+			want:   12 * time.Second,
+		},
+		"RetryAfterDate": {
+			header: header(headerRetryAfter, now.Add(time.Minute).Format(http.TimeFormat)),
+			want:   time.Minute,
+		},
+		"RateLimitReset": {
+			header: header(headerRateLimitReset, reset),
+			want:   20 * time.Second,
+		},
+		"RetryAfterTakesPrecedence": {
+			header: header(headerRetryAfter, "3", headerRateLimitReset, reset),
+			want:   3 * time.Second,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := retryAfter(tc.header, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("retryAfter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	type want struct {
+		maxRetries *int
+		minBackoff time.Duration
+		maxBackoff time.Duration
+	}
+	cases := map[string]struct {
+		r    *namespacedV1Beta1.Retry
+		want want
+	}{
+		"Unset": {},
+		"MaxRetriesOnly": {
+			r:    &namespacedV1Beta1.Retry{MaxRetries: ptr.To(0)},
+			want: want{maxRetries: ptr.To(0)},
+		},
+		"Backoff": {
+			r: &namespacedV1Beta1.Retry{
+				MinBackoff: &metav1.Duration{Duration: time.Second},
+				MaxBackoff: &metav1.Duration{Duration: time.Minute},
+			},
+			want: want{minBackoff: time.Second, maxBackoff: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			maxRetries, minBackoff, maxBackoff := retry(tc.r)
+			got := want{maxRetries: maxRetries, minBackoff: minBackoff, maxBackoff: maxBackoff}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("retry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	// AnnotationKeyAllowSelfMembershipChange allows a member resource to
+	// change or remove the membership of the user the provider authenticates
+	// as when set to "true". Such changes are refused by default, since they
+	// can lock the provider out of the project or group.
+	AnnotationKeyAllowSelfMembershipChange = "gitlab.crossplane.io/allow-self-membership-change"
+)
+
+// AllowSelfMembershipChange returns true if a member resource may change or
+// remove the membership of the user the provider authenticates as.
+func AllowSelfMembershipChange(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyAllowSelfMembershipChange] == "true"
+}
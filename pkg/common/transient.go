@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EnableTransientServerErrors treats 5xx responses of GitLab as
+	// transient, see WithTransientServerErrors.
+	EnableTransientServerErrors feature.Flag = "EnableTransientServerErrors"
+
+	// TypeGitLabUnavailable indicates that GitLab answered the last request
+	// of a managed resource with a 5xx response, e.g. during maintenance.
+	TypeGitLabUnavailable xpv1.ConditionType = "GitLabUnavailable"
+
+	// ReasonServerError is used when GitLab answered with a 5xx response.
+	ReasonServerError xpv1.ConditionReason = "ServerError"
+
+	// ReasonServerAvailable is used once GitLab answers again.
+	ReasonServerAvailable xpv1.ConditionReason = "ServerAvailable"
+)
+
+// IsServerError returns true if err is a 5xx response of GitLab.
+func IsServerError(err error) bool {
+	var res *gitlab.ErrorResponse
+	return errors.As(err, &res) && res.Response != nil && res.Response.StatusCode >= http.StatusInternalServerError
+}
+
+// SetGitLabUnavailable sets the GitLabUnavailable condition if err is a 5xx
+// response of GitLab and resets it once a request succeeds again.
+func SetGitLabUnavailable(mg resource.Managed, err error) {
+	if IsServerError(err) {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeGitLabUnavailable,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonServerError,
+			Message:            err.Error(),
+		})
+		return
+	}
+	if err == nil && mg.GetCondition(TypeGitLabUnavailable).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeGitLabUnavailable,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonServerAvailable,
+		})
+	}
+}
+
+// WithTransientServerErrors returns an ExternalConnecter whose external
+// clients treat 5xx responses of GitLab as transient if the
+// EnableTransientServerErrors flag is enabled. They set the GitLabUnavailable
+// condition instead of failing the reconcile, so that maintenance of GitLab
+// neither marks resources as failed nor writes anything to GitLab. The
+// supplied connecter is returned unchanged otherwise.
+func WithTransientServerErrors(f *feature.Flags, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if !f.Enabled(EnableTransientServerErrors) {
+		return c
+	}
+	return &transientConnecter{ExternalConnecter: c}
+}
+
+type transientConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *transientConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if IsServerError(err) {
+		SetGitLabUnavailable(mg, err)
+		return unavailableExternal{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &transientExternal{ExternalClient: e}, nil
+}
+
+type transientExternal struct {
+	managed.ExternalClient
+}
+
+// Observe reports the resource as existing and up to date if GitLab answered
+// with a 5xx response, so that the managed reconciler neither creates nor
+// updates it and observes it again after the poll interval. A resource that
+// is being deleted is deleted once GitLab answers again.
+func (e *transientExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if IsServerError(err) {
+		SetGitLabUnavailable(mg, err)
+		return unavailableObservation, nil
+	}
+	if err == nil {
+		SetGitLabUnavailable(mg, nil)
+	}
+	return o, err
+}
+
+// Create, Update and Delete ignore 5xx responses, the managed reconciler
+// observes the resource again and retries them once GitLab answers again.
+
+func (e *transientExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	return c, ignoreServerError(mg, err)
+}
+
+func (e *transientExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	return u, ignoreServerError(mg, err)
+}
+
+func (e *transientExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.ExternalClient.Delete(ctx, mg)
+	return d, ignoreServerError(mg, err)
+}
+
+// unavailableObservation does not publish connection details. They are
+// patched into the connection secret, so the details published last are kept.
+var unavailableObservation = managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+// unavailableExternal is used if connecting failed because of a 5xx response
+// of GitLab. It observes nothing and writes nothing.
+type unavailableExternal struct{}
+
+func (unavailableExternal) Observe(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+	return unavailableObservation, nil
+}
+
+func (unavailableExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (unavailableExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (unavailableExternal) Delete(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (unavailableExternal) Disconnect(_ context.Context) error {
+	return nil
+}
+
+// ignoreServerError sets the GitLabUnavailable condition and drops err if it
+// is a 5xx response of GitLab. Other errors are returned unchanged.
+func ignoreServerError(mg resource.Managed, err error) error {
+	if !IsServerError(err) {
+		return err
+	}
+	SetGitLabUnavailable(mg, err)
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func serverError(status int) error {
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1", nil)
+	return &gitlab.ErrorResponse{Response: &http.Response{StatusCode: status, Request: req}}
+}
+
+func TestIsServerError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":        {},
+		"Other":      {err: errors.New("boom")},
+		"NotFound":   {err: serverError(http.StatusNotFound)},
+		"BadGateway": {err: serverError(http.StatusBadGateway), want: true},
+		"Wrapped":    {err: errors.Wrap(serverError(http.StatusInternalServerError), "cannot get project"), want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsServerError(tc.err); got != tc.want {
+				t.Errorf("IsServerError(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWithTransientServerErrors(t *testing.T) {
+	var observeErr error
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, observeErr
+			},
+		}, nil
+	})
+
+	if _, ok := WithTransientServerErrors(&feature.Flags{}, c).(managed.ExternalConnectorFn); !ok {
+		t.Errorf("WithTransientServerErrors(...): want the connecter unchanged unless enabled")
+	}
+
+	f := &feature.Flags{}
+	f.Enable(EnableTransientServerErrors)
+	mg := &fake.Managed{}
+	e, err := WithTransientServerErrors(f, c).Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): unexpected error: %v", err)
+	}
+
+	observeErr = serverError(http.StatusBadGateway)
+	o, err := e.Observe(context.Background(), mg)
+	if err != nil {
+		t.Errorf("Observe(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, o); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	if got := mg.GetCondition(TypeGitLabUnavailable); got.Status != corev1.ConditionTrue || got.Reason != ReasonServerError {
+		t.Errorf("Observe(...): want %s condition, got %+v", ReasonServerError, got)
+	}
+
+	observeErr = nil
+	if _, err := e.Observe(context.Background(), mg); err != nil {
+		t.Errorf("Observe(...): unexpected error: %v", err)
+	}
+	if got := mg.GetCondition(TypeGitLabUnavailable); got.Status != corev1.ConditionFalse || got.Reason != ReasonServerAvailable {
+		t.Errorf("Observe(...): want %s condition, got %+v", ReasonServerAvailable, got)
+	}
+}
+
+func TestTransientServerErrorReconcile(t *testing.T) {
+	errBadGateway := serverError(http.StatusBadGateway)
+
+	type want struct {
+		result reconcile.Result
+		err    error
+		calls  []string
+	}
+	cases := map[string]struct {
+		deleted    bool
+		connectErr error
+		observe    managed.ExternalObservation
+		observeErr error
+		writeErr   error
+		want       want
+	}{
+		"ObserveFails": {
+			observeErr: errBadGateway,
+			want:       want{result: reconcile.Result{RequeueAfter: time.Minute}, calls: []string{"Observe"}},
+		},
+		"ConnectFails": {
+			connectErr: errBadGateway,
+			want:       want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"CreateFails": {
+			observe:  managed.ExternalObservation{},
+			writeErr: errBadGateway,
+			want:     want{result: reconcile.Result{Requeue: true}, calls: []string{"Observe", "Create"}},
+		},
+		"UpdateFails": {
+			observe:  managed.ExternalObservation{ResourceExists: true},
+			writeErr: errBadGateway,
+			want:     want{result: reconcile.Result{RequeueAfter: time.Minute}, calls: []string{"Observe", "Update"}},
+		},
+		"DeleteFails": {
+			deleted:  true,
+			observe:  managed.ExternalObservation{ResourceExists: true},
+			writeErr: errBadGateway,
+			want:     want{result: reconcile.Result{Requeue: true}, calls: []string{"Observe", "Delete"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := &feature.Flags{}
+			f.Enable(EnableTransientServerErrors)
+
+			var got *fake.ModernManaged
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					if tc.deleted {
+						obj.SetDeletionTimestamp(ptr.To(metav1.Now()))
+					}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got = obj.(*fake.ModernManaged)
+					return nil
+				}),
+			}
+			var calls []string
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				if tc.connectErr != nil {
+					return nil, tc.connectErr
+				}
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						calls = append(calls, "Observe")
+						return tc.observe, tc.observeErr
+					},
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						calls = append(calls, "Create")
+						return managed.ExternalCreation{}, tc.writeErr
+					},
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						calls = append(calls, "Update")
+						return managed.ExternalUpdate{}, tc.writeErr
+					},
+					DeleteFn: func(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+						calls = append(calls, "Delete")
+						return managed.ExternalDelete{}, tc.writeErr
+					},
+					DisconnectFn: func(_ context.Context) error { return nil },
+				}, nil
+			})
+
+			r := managed.NewReconciler(&fake.Manager{Client: kube, Scheme: fake.SchemeWith(&fake.ModernManaged{})},
+				resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				managed.WithExternalConnecter(WithTransientServerErrors(f, c)),
+				managed.WithInitializers(),
+				managed.WithPollInterval(time.Minute),
+				managed.WithPollJitterHook(0),
+				managed.WithReferenceResolver(managed.ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				managed.WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+
+			res, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, res); diff != "" {
+				t.Errorf("Reconcile(...): -want result, +got result:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Reconcile(...): -want calls, +got calls:\n%s", diff)
+			}
+			if got == nil {
+				t.Fatal("Reconcile(...): want the status to be updated")
+			}
+			if c := got.GetCondition(TypeGitLabUnavailable); c.Status != corev1.ConditionTrue {
+				t.Errorf("Reconcile(...): want %s condition, got %+v", TypeGitLabUnavailable, c)
+			}
+			if c := got.GetCondition(xpv1.TypeSynced); c.Status != corev1.ConditionTrue {
+				t.Errorf("Reconcile(...): want Synced to be True, got %+v", c)
+			}
+		})
+	}
+}
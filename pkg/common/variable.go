@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/apimachinery/pkg/types"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+const (
+	// variableExternalNameSeparator separates the key from the environment
+	// scope in the external name of a variable. Variable keys may only
+	// contain letters, digits and underscores, so it never occurs in a key.
+	variableExternalNameSeparator = "@"
+
+	// DefaultEnvironmentScope is the environment scope GitLab assigns to
+	// variables created without one.
+	DefaultEnvironmentScope = "*"
+)
+
+// VariableExternalName returns the external name of the variable with the
+// given key in the given environment scope, e.g. KEY@production.
+func VariableExternalName(key, scope string) string {
+	if scope == "" {
+		scope = DefaultEnvironmentScope
+	}
+	return key + variableExternalNameSeparator + scope
+}
+
+// ParseVariableExternalName returns the key and environment scope encoded in
+// the external name of a variable. External names of variables created before
+// the environment scope was part of them only hold the key, for them the
+// returned scope is nil.
+func ParseVariableExternalName(name string) (string, *string) {
+	key, scope, ok := strings.Cut(name, variableExternalNameSeparator)
+	if !ok {
+		return name, nil
+	}
+	return key, &scope
+}
+
+// HashVariableValue returns the hex encoded HMAC-SHA256 of a variable value
+// under key. Unlike a plain hash, the recorded hash does not allow guessing
+// the value without knowing the key.
+func HashVariableValue(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// variableValueHashSalt keys the hashes of variable values if the provider
+// config references no value hash key.
+const variableValueHashSalt = "provider-gitlab/variable-value-hash"
+
+// VariableValueHashKey returns the key hashing the values of the variable
+// with the given UID. It is derived from the value hash key of the provider
+// config, or a fixed salt without one, and the UID, so that equal values of
+// different variables do not have equal hashes. It does not depend on the
+// credentials, so rotating them does not apply every masked value again.
+func VariableValueHashKey(secret []byte, uid types.UID) []byte {
+	if len(secret) == 0 {
+		secret = []byte(variableValueHashSalt)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(uid))
+	return mac.Sum(nil)
+}
+
+// VariableType returns the type of a variable as reported by GitLab. Older
+// GitLab versions omit the type of env_var variables, it defaults to env_var.
+func VariableType(t gitlab.VariableTypeValue) commonv1alpha1.VariableType {
+	if t == "" {
+		return commonv1alpha1.VariableTypeEnvVar
+	}
+	return commonv1alpha1.VariableType(t)
+}
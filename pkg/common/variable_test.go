@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+func TestParseVariableExternalName(t *testing.T) {
+	type want struct {
+		key   string
+		scope *string
+	}
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"KeyOnly": {
+			name: "API_TOKEN",
+			want: want{key: "API_TOKEN"},
+		},
+		"DefaultScope": {
+			name: "API_TOKEN@*",
+			want: want{key: "API_TOKEN", scope: ptr.To("*")},
+		},
+		"NestedScope": {
+			name: "API_TOKEN@review/*",
+			want: want{key: "API_TOKEN", scope: ptr.To("review/*")},
+		},
+		"SeparatorInScope": {
+			name: "API_TOKEN@team@staging",
+			want: want{key: "API_TOKEN", scope: ptr.To("team@staging")},
+		},
+		"EmptyScope": {
+			name: "API_TOKEN@",
+			want: want{key: "API_TOKEN", scope: ptr.To("")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, scope := ParseVariableExternalName(tc.name)
+			if diff := cmp.Diff(tc.want, want{key: key, scope: scope}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ParseVariableExternalName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVariableExternalNameRoundTrip(t *testing.T) {
+	cases := map[string]struct {
+		key   string
+		scope string
+		name  string
+		want  string
+	}{
+		"DefaultScope": {
+			key:  "API_TOKEN",
+			name: "API_TOKEN@*",
+			want: "*",
+		},
+		"ExplicitScope": {
+			key:   "API_TOKEN",
+			scope: "production",
+			name:  "API_TOKEN@production",
+			want:  "production",
+		},
+		"WildcardScope": {
+			key:   "API_TOKEN",
+			scope: "review/*",
+			name:  "API_TOKEN@review/*",
+			want:  "review/*",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VariableExternalName(tc.key, tc.scope)
+			if diff := cmp.Diff(tc.name, got); diff != "" {
+				t.Errorf("VariableExternalName(...): -want, +got:\n%s", diff)
+			}
+			key, scope := ParseVariableExternalName(got)
+			if diff := cmp.Diff(tc.key, key); diff != "" {
+				t.Errorf("ParseVariableExternalName(...) key: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(&tc.want, scope); diff != "" {
+				t.Errorf("ParseVariableExternalName(...) scope: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVariableType(t *testing.T) {
+	cases := map[string]struct {
+		variableType gitlab.VariableTypeValue
+		want         commonv1alpha1.VariableType
+	}{
+		"Missing": {
+			want: commonv1alpha1.VariableTypeEnvVar,
+		},
+		"EnvVar": {
+			variableType: gitlab.EnvVariableType,
+			want:         commonv1alpha1.VariableTypeEnvVar,
+		},
+		"File": {
+			variableType: gitlab.FileVariableType,
+			want:         commonv1alpha1.VariableTypeFile,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, VariableType(tc.variableType)); diff != "" {
+				t.Errorf("VariableType(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHashVariableValue(t *testing.T) {
+	key := VariableValueHashKey([]byte("secret"), "uid")
+	plain := sha256.Sum256([]byte("value"))
+
+	cases := map[string]struct {
+		hash string
+		want bool
+	}{
+		"SameKeyAndValue": {
+			hash: HashVariableValue(VariableValueHashKey([]byte("secret"), "uid"), "value"),
+			want: true,
+		},
+		"OtherValue": {
+			hash: HashVariableValue(key, "other"),
+		},
+		"OtherValueHashKey": {
+			hash: HashVariableValue(VariableValueHashKey([]byte("other"), "uid"), "value"),
+		},
+		"NoValueHashKey": {
+			hash: HashVariableValue(VariableValueHashKey(nil, "uid"), "value"),
+		},
+		"OtherVariable": {
+			hash: HashVariableValue(VariableValueHashKey([]byte("secret"), "other"), "value"),
+		},
+		"PlainHash": {
+			hash: hex.EncodeToString(plain[:]),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := HashVariableValue(key, "value") == tc.hash; got != tc.want {
+				t.Errorf("HashVariableValue(...): want equal hashes %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
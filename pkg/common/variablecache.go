@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// variableCaches holds one project variable cache per GitLab instance and
+// credentials, so that all variables of a project managed with them share
+// the cached variable list.
+var variableCaches = &variableCacheRegistry{caches: map[string]*VariableCache[gitlab.ProjectVariable]{}}
+
+type variableCacheRegistry struct {
+	mu     sync.Mutex
+	caches map[string]*VariableCache[gitlab.ProjectVariable]
+}
+
+// ProjectVariableCache returns the project variable cache shared by all
+// clients of the given configuration, or nil if the cache is not enabled.
+// Changes of the configured TTL are applied to the existing cache.
+func ProjectVariableCache(c Config) *VariableCache[gitlab.ProjectVariable] {
+	return variableCaches.sharedCache(c)
+}
+
+func (r *variableCacheRegistry) sharedCache(c Config) *VariableCache[gitlab.ProjectVariable] {
+	if c.VariableCacheTTL <= 0 {
+		return nil
+	}
+	key := limiterKey(c)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	vc, ok := r.caches[key]
+	if !ok {
+		vc = NewVariableCache[gitlab.ProjectVariable](c.VariableCacheTTL)
+		r.caches[key] = vc
+	}
+	vc.setTTL(c.VariableCacheTTL)
+	return vc
+}
+
+// ListPageFn lists a page of variables with the supplied request options.
+type ListPageFn[T any] func(page int64, options ...gitlab.RequestOptionFunc) ([]*T, *gitlab.Response, error)
+
+// VariableCache caches the variable lists of GitLab projects or groups by
+// key. A list is served from the cache for its TTL. After that each page is
+// revalidated with the ETag GitLab returned for it, and a 304 Not Modified
+// response reuses the cached page, so that unchanged variables are not
+// transferred again. Writes must Invalidate the list they change. A nil
+// VariableCache lists all pages on every call.
+type VariableCache[T any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*variableCacheEntry[T]
+	now     func() time.Time
+
+	// invalidations counts the calls of Invalidate, so that a list started
+	// before a write is not cached after it.
+	invalidations uint64
+}
+
+type variableCacheEntry[T any] struct {
+	pages       []variablePage[T]
+	validatedAt time.Time
+}
+
+type variablePage[T any] struct {
+	etag      string
+	variables []*T
+	nextPage  int64
+}
+
+// NewVariableCache returns an empty VariableCache whose lists are served
+// without revalidation for ttl.
+func NewVariableCache[T any](ttl time.Duration) *VariableCache[T] {
+	return &VariableCache[T]{ttl: ttl, entries: map[string]*variableCacheEntry[T]{}, now: time.Now}
+}
+
+func (c *VariableCache[T]) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
+// List returns all variables of key, listing the pages with list unless
+// the cached list is still fresh or GitLab reports it unchanged.
+func (c *VariableCache[T]) List(key string, list ListPageFn[T]) ([]*T, error) {
+	if c == nil {
+		pages, err := listPages(nil, list)
+		return flatten(pages), err
+	}
+
+	c.mu.Lock()
+	cached, ok := c.entries[key]
+	fresh := ok && c.now().Sub(cached.validatedAt) < c.ttl
+	invalidations := c.invalidations
+	c.mu.Unlock()
+	if fresh {
+		return flatten(cached.pages), nil
+	}
+
+	var previous []variablePage[T]
+	if ok {
+		previous = cached.pages
+	}
+	pages, err := listPages(previous, list)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		delete(c.entries, key)
+		return nil, err
+	}
+	if invalidations == c.invalidations {
+		c.entries[key] = &variableCacheEntry[T]{pages: pages, validatedAt: c.now()}
+	}
+	return flatten(pages), nil
+}
+
+// Invalidate drops the cached list of key, so that the next List gets it
+// from GitLab unconditionally.
+func (c *VariableCache[T]) Invalidate(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidations++
+	delete(c.entries, key)
+}
+
+// listPages lists all pages, sending the ETag of the corresponding previous
+// page along and reusing that page if GitLab answers 304 Not Modified.
+func listPages[T any](previous []variablePage[T], list ListPageFn[T]) ([]variablePage[T], error) {
+	var pages []variablePage[T]
+	for page := int64(1); ; {
+		var cached *variablePage[T]
+		var opts []gitlab.RequestOptionFunc
+		if i := len(pages); i < len(previous) && previous[i].etag != "" {
+			cached = &previous[i]
+			opts = append(opts, gitlab.WithHeader("If-None-Match", cached.etag))
+		}
+
+		variables, res, err := list(page, opts...)
+		switch {
+		case cached != nil && res != nil && res.Response != nil && res.StatusCode == http.StatusNotModified:
+			pages = append(pages, *cached)
+		case err != nil:
+			return nil, err
+		default:
+			p := variablePage[T]{variables: variables}
+			if res != nil && res.Response != nil {
+				p.etag = res.Header.Get("ETag")
+				p.nextPage = res.NextPage
+			}
+			pages = append(pages, p)
+		}
+
+		next := pages[len(pages)-1].nextPage
+		if next == 0 {
+			return pages, nil
+		}
+		page = next
+	}
+}
+
+func flatten[T any](pages []variablePage[T]) []*T {
+	var all []*T
+	for _, p := range pages {
+		all = append(all, p.variables...)
+	}
+	return all
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// fakeVariablePages serves pages of variables and answers 304 Not Modified
+// if the If-None-Match header matches the ETag of the requested page.
+type fakeVariablePages struct {
+	t       *testing.T
+	pages   [][]*gitlab.ProjectVariable
+	etags   []string
+	headers []string
+}
+
+func (f *fakeVariablePages) list(page int64, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	header := ifNoneMatch(f.t, options)
+	f.headers = append(f.headers, header)
+
+	i := page - 1
+	res := etagResponse(http.StatusOK, f.etags[i])
+	if int(page) < len(f.pages) {
+		res.NextPage = page + 1
+	}
+	if header != "" && header == f.etags[i] {
+		res.StatusCode = http.StatusNotModified
+		return nil, res, io.EOF
+	}
+	return f.pages[i], res, nil
+}
+
+func TestVariableCache(t *testing.T) {
+	v1 := &gitlab.ProjectVariable{Key: "REGION", Value: "eu-west-1"}
+	v2 := &gitlab.ProjectVariable{Key: "TOKEN", Value: "secret"}
+	changed := &gitlab.ProjectVariable{Key: "TOKEN", Value: "rotated"}
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	f := &fakeVariablePages{t: t, pages: [][]*gitlab.ProjectVariable{{v1}, {v2}}, etags: []string{`W/"p1"`, `W/"p2"`}}
+	c := NewVariableCache[gitlab.ProjectVariable](time.Minute)
+	c.now = func() time.Time { return now }
+
+	list := func(want []*gitlab.ProjectVariable, wantHeaders ...string) {
+		t.Helper()
+		f.headers = nil
+		got, err := c.List("42", f.list)
+		if err != nil {
+			t.Fatalf("List(...): %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("List(...): -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(wantHeaders, f.headers); diff != "" {
+			t.Errorf("List(...): -want If-None-Match headers, +got:\n%s", diff)
+		}
+	}
+
+	// The first list gets all pages unconditionally.
+	list([]*gitlab.ProjectVariable{v1, v2}, "", "")
+
+	// A fresh list is served from the cache without asking GitLab.
+	list([]*gitlab.ProjectVariable{v1, v2})
+
+	// Once the TTL expired a 304 reuses the cached pages.
+	now = now.Add(time.Minute)
+	list([]*gitlab.ProjectVariable{v1, v2}, `W/"p1"`, `W/"p2"`)
+
+	// Changed pages are replaced.
+	now = now.Add(time.Minute)
+	f.pages[1], f.etags[1] = []*gitlab.ProjectVariable{changed}, `W/"p2-2"`
+	list([]*gitlab.ProjectVariable{v1, changed}, `W/"p1"`, `W/"p2"`)
+
+	// A write invalidates the list, which is then listed unconditionally
+	// although it is still fresh.
+	c.Invalidate("42")
+	list([]*gitlab.ProjectVariable{v1, changed}, "", "")
+}
+
+func TestVariableCacheDisabled(t *testing.T) {
+	v := &gitlab.ProjectVariable{Key: "REGION"}
+	f := &fakeVariablePages{t: t, pages: [][]*gitlab.ProjectVariable{{v}}, etags: []string{`W/"p1"`}}
+
+	var c *VariableCache[gitlab.ProjectVariable]
+	for range 2 {
+		got, err := c.List("42", f.list)
+		if err != nil {
+			t.Fatalf("List(...): %v", err)
+		}
+		if diff := cmp.Diff([]*gitlab.ProjectVariable{v}, got); diff != "" {
+			t.Errorf("List(...): -want, +got:\n%s", diff)
+		}
+	}
+	if diff := cmp.Diff([]string{"", ""}, f.headers); diff != "" {
+		t.Errorf("List(...): -want If-None-Match headers, +got:\n%s", diff)
+	}
+	if ProjectVariableCache(Config{}) != nil {
+		t.Errorf("ProjectVariableCache(...): want nil without a TTL")
+	}
+}
+
+func TestVariableCacheInvalidateWhileListing(t *testing.T) {
+	v := &gitlab.ProjectVariable{Key: "REGION"}
+	f := &fakeVariablePages{t: t, pages: [][]*gitlab.ProjectVariable{{v}}, etags: []string{`W/"p1"`}}
+	c := NewVariableCache[gitlab.ProjectVariable](time.Minute)
+
+	// A write during the list may not be part of it, so it is not cached.
+	_, err := c.List("42", func(page int64, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		c.Invalidate("42")
+		return f.list(page, options...)
+	})
+	if err != nil {
+		t.Fatalf("List(...): %v", err)
+	}
+	if _, err := c.List("42", f.list); err != nil {
+		t.Fatalf("List(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"", ""}, f.headers); diff != "" {
+		t.Errorf("List(...): -want If-None-Match headers, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"golang.org/x/sync/singleflight"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+const (
+	// TypeUnsupportedFeatures indicates that some of the requested
+	// parameters are not supported by the GitLab server and are not sent.
+	TypeUnsupportedFeatures xpv1.ConditionType = "UnsupportedFeatures"
+
+	// ReasonUnsupportedByVersion is used when the GitLab version is too old
+	// for some of the requested parameters.
+	ReasonUnsupportedByVersion xpv1.ConditionReason = "UnsupportedByGitLabVersion"
+
+	// ReasonAllFeaturesSupported is used once all requested parameters are
+	// supported again, e.g. after GitLab has been upgraded.
+	ReasonAllFeaturesSupported xpv1.ConditionReason = "AllFeaturesSupported"
+
+	// serverVersionTTL is how long a probed GitLab version is reused before
+	// it is probed again, so that upgrades are eventually picked up.
+	serverVersionTTL = 30 * time.Minute
+
+	// serverVersionFailureTTL is how long the result of a failed probe is
+	// reused, so that a GitLab that could not be reached is probed again
+	// soon instead of being treated as the newest version for long.
+	serverVersionFailureTTL = time.Minute
+
+	errParseVersion = "cannot parse GitLab version %q"
+)
+
+// ServerVersion is the major and minor version of a GitLab server. A nil
+// ServerVersion is unknown and considered to support every feature.
+type ServerVersion struct {
+	Major int
+	Minor int
+}
+
+// ParseServerVersion parses a GitLab version such as "16.2.1-ee".
+func ParseServerVersion(v string) (*ServerVersion, error) {
+	parts := strings.SplitN(v, ".", 3)
+	if len(parts) < 2 {
+		return nil, errors.Errorf(errParseVersion, v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, errors.Errorf(errParseVersion, v)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errors.Errorf(errParseVersion, v)
+	}
+	return &ServerVersion{Major: major, Minor: minor}, nil
+}
+
+// AtLeast returns true if the version is at least major.minor or unknown.
+func (v *ServerVersion) AtLeast(major, minor int) bool {
+	if v == nil {
+		return true
+	}
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+func (v *ServerVersion) String() string {
+	if v == nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+type serverVersionEntry struct {
+	version *ServerVersion
+	expires time.Time
+}
+
+var serverVersions = struct {
+	sync.Mutex
+	entries map[string]serverVersionEntry
+	// probes shares a running probe of a base URL among its callers.
+	probes singleflight.Group
+}{entries: map[string]serverVersionEntry{}}
+
+// GetServerVersion returns the version of the GitLab server configured by
+// cfg. The version is probed once per base URL and cached for a while.
+// Concurrent callers share a running probe, and probes of other base URLs
+// are not held up by it. Failed probes, e.g. because the token may not read
+// the version, yield the last known version, or an unknown one so that
+// requests are sent unmodified, and are retried after a short while.
+func GetServerVersion(ctx context.Context, cfg Config) *ServerVersion {
+	serverVersions.Lock()
+	e, ok := serverVersions.entries[cfg.BaseURL]
+	serverVersions.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.version
+	}
+
+	v, _, _ := serverVersions.probes.Do(cfg.BaseURL, func() (any, error) {
+		version, err := probeServerVersion(ctx, cfg)
+		if err != nil {
+			// A probe cancelled with its context says nothing about GitLab.
+			if ctx.Err() != nil {
+				return e.version, nil
+			}
+			version = e.version
+		}
+		ttl := serverVersionTTL
+		if err != nil {
+			ttl = serverVersionFailureTTL
+		}
+		serverVersions.Lock()
+		serverVersions.entries[cfg.BaseURL] = serverVersionEntry{version: version, expires: time.Now().Add(ttl)}
+		serverVersions.Unlock()
+		return version, nil
+	})
+	return v.(*ServerVersion)
+}
+
+func probeServerVersion(ctx context.Context, cfg Config) (*ServerVersion, error) {
+	v, _, err := NewClient(cfg).Version.GetVersion(gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return ParseServerVersion(v.Version)
+}
+
+// OmitUnsupportedVariableParameters clears the variable parameters the
+// supplied GitLab version does not support, since sending them fails the
+// request. maskedAndHidden points at the maskedAndHidden parameter of
+// variables that have one and may be nil. It returns the names of the
+// omitted parameters that were set to a non-default value.
+func OmitUnsupportedVariableParameters(p *commonv1alpha1.CommonVariableParameters, maskedAndHidden **bool, v *ServerVersion) []string {
+	var omitted []string
+	if p.Raw != nil && !v.AtLeast(15, 7) {
+		if *p.Raw {
+			omitted = append(omitted, "raw")
+		}
+		p.Raw = nil
+	}
+	if p.Description != nil && !v.AtLeast(16, 2) {
+		if *p.Description != "" {
+			omitted = append(omitted, "description")
+		}
+		p.Description = nil
+	}
+	if maskedAndHidden != nil && *maskedAndHidden != nil && !v.AtLeast(17, 4) {
+		if **maskedAndHidden {
+			omitted = append(omitted, "masked_and_hidden")
+		}
+		*maskedAndHidden = nil
+	}
+	return omitted
+}
+
+// SetUnsupportedFeatures sets the UnsupportedFeatures condition if any
+// parameters were omitted and resets it once they are supported again.
+func SetUnsupportedFeatures(mg resource.Managed, v *ServerVersion, omitted []string) {
+	if len(omitted) > 0 {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeUnsupportedFeatures,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonUnsupportedByVersion,
+			Message:            fmt.Sprintf("GitLab %s does not support %s, the parameters are not sent", v, strings.Join(omitted, ", ")),
+		})
+		return
+	}
+	if mg.GetCondition(TypeUnsupportedFeatures).Status == corev1.ConditionTrue {
+		mg.SetConditions(xpv1.Condition{
+			Type:               TypeUnsupportedFeatures,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonAllFeaturesSupported,
+		})
+	}
+}
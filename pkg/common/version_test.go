@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		want    *ServerVersion
+		err     error
+	}{
+		"Release": {
+			version: "16.2.1",
+			want:    &ServerVersion{Major: 16, Minor: 2},
+		},
+		"Enterprise": {
+			version: "17.11.0-ee",
+			want:    &ServerVersion{Major: 17, Minor: 11},
+		},
+		"Invalid": {
+			version: "main",
+			err:     errors.Errorf(errParseVersion, "main"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseServerVersion(tc.version)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseServerVersion(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseServerVersion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOmitUnsupportedVariableParameters(t *testing.T) {
+	cases := map[string]struct {
+		version         *ServerVersion
+		params          commonv1alpha1.CommonVariableParameters
+		maskedAndHidden *bool
+		want            commonv1alpha1.CommonVariableParameters
+		wantHidden      *bool
+		omitted         []string
+	}{
+		"UnknownVersion": {
+			params: commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			want:   commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+		},
+		"AllSupported": {
+			version: &ServerVersion{Major: 16, Minor: 2},
+			params:  commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			want:    commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+		},
+		"DescriptionUnsupported": {
+			version: &ServerVersion{Major: 16, Minor: 1},
+			params:  commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			want:    commonv1alpha1.CommonVariableParameters{Raw: ptr.To(true)},
+			omitted: []string{"description"},
+		},
+		"NothingSupported": {
+			version: &ServerVersion{Major: 15, Minor: 6},
+			params:  commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			want:    commonv1alpha1.CommonVariableParameters{},
+			omitted: []string{"raw", "description"},
+		},
+		"DefaultsOmittedSilently": {
+			version:         &ServerVersion{Major: 15, Minor: 6},
+			params:          commonv1alpha1.CommonVariableParameters{Description: ptr.To(""), Raw: ptr.To(false)},
+			maskedAndHidden: ptr.To(false),
+			want:            commonv1alpha1.CommonVariableParameters{},
+		},
+		"MaskedAndHiddenSupported": {
+			version:         &ServerVersion{Major: 17, Minor: 4},
+			maskedAndHidden: ptr.To(true),
+			wantHidden:      ptr.To(true),
+		},
+		"MaskedAndHiddenUnsupported": {
+			version:         &ServerVersion{Major: 17, Minor: 3},
+			params:          commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			maskedAndHidden: ptr.To(true),
+			want:            commonv1alpha1.CommonVariableParameters{Description: ptr.To("desc"), Raw: ptr.To(true)},
+			omitted:         []string{"masked_and_hidden"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			omitted := OmitUnsupportedVariableParameters(&tc.params, &tc.maskedAndHidden, tc.version)
+			if diff := cmp.Diff(tc.omitted, omitted); diff != "" {
+				t.Errorf("OmitUnsupportedVariableParameters(...): -want omitted, +got omitted:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("OmitUnsupportedVariableParameters(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantHidden, tc.maskedAndHidden); diff != "" {
+				t.Errorf("OmitUnsupportedVariableParameters(...): -want maskedAndHidden, +got maskedAndHidden:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetUnsupportedFeatures(t *testing.T) {
+	mg := &fake.Managed{}
+	v := &ServerVersion{Major: 15, Minor: 6}
+
+	SetUnsupportedFeatures(mg, v, nil)
+	if got := mg.GetCondition(TypeUnsupportedFeatures).Status; got != corev1.ConditionUnknown {
+		t.Errorf("SetUnsupportedFeatures(...): want no condition, got %s", got)
+	}
+
+	SetUnsupportedFeatures(mg, v, []string{"raw"})
+	c := mg.GetCondition(TypeUnsupportedFeatures)
+	if c.Status != corev1.ConditionTrue || c.Reason != ReasonUnsupportedByVersion {
+		t.Errorf("SetUnsupportedFeatures(...): want unsupported condition, got %+v", c)
+	}
+	if diff := cmp.Diff("GitLab 15.6 does not support raw, the parameters are not sent", c.Message); diff != "" {
+		t.Errorf("SetUnsupportedFeatures(...): -want message, +got message:\n%s", diff)
+	}
+
+	SetUnsupportedFeatures(mg, v, nil)
+	c = mg.GetCondition(TypeUnsupportedFeatures)
+	if c.Status != corev1.ConditionFalse || c.Reason != ReasonAllFeaturesSupported {
+		t.Errorf("SetUnsupportedFeatures(...): want supported condition, got %+v", c)
+	}
+}
+
+func TestGetServerVersion(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version":"16.1.3-ee","revision":"abc"}`)
+	}))
+	defer srv.Close()
+
+	cfg := Config{BaseURL: srv.URL, Token: "token"}
+	for i := 0; i < 2; i++ {
+		got := GetServerVersion(context.Background(), cfg)
+		if diff := cmp.Diff(&ServerVersion{Major: 16, Minor: 1}, got); diff != "" {
+			t.Errorf("GetServerVersion(...): -want, +got:\n%s", diff)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("GetServerVersion(...): want version to be probed once, got %d probes", calls)
+	}
+}
+
+func TestGetServerVersionFailure(t *testing.T) {
+	cases := map[string]struct {
+		known *ServerVersion
+		want  *ServerVersion
+	}{
+		"UnknownWithoutPreviousVersion": {},
+		"KeepsLastKnownVersion": {
+			known: &ServerVersion{Major: 16, Minor: 1},
+			want:  &ServerVersion{Major: 16, Minor: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			defer srv.Close()
+
+			cfg := Config{BaseURL: srv.URL, Token: "token"}
+			if tc.known != nil {
+				serverVersions.Lock()
+				serverVersions.entries[cfg.BaseURL] = serverVersionEntry{version: tc.known, expires: time.Now().Add(-time.Second)}
+				serverVersions.Unlock()
+			}
+
+			got := GetServerVersion(context.Background(), cfg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetServerVersion(...): -want, +got:\n%s", diff)
+			}
+
+			serverVersions.Lock()
+			e := serverVersions.entries[cfg.BaseURL]
+			serverVersions.Unlock()
+			if e.expires.After(time.Now().Add(serverVersionFailureTTL)) {
+				t.Errorf("GetServerVersion(...): want failed probe cached for at most %s, expires %s", serverVersionFailureTTL, e.expires)
+			}
+
+			// Once the failure expired the version is probed again.
+			serverVersions.Lock()
+			e.expires = time.Now().Add(-time.Second)
+			serverVersions.entries[cfg.BaseURL] = e
+			serverVersions.Unlock()
+			GetServerVersion(context.Background(), cfg)
+			if calls != 2 {
+				t.Errorf("GetServerVersion(...): want failed probe retried, got %d probes", calls)
+			}
+		})
+	}
+}
+
+func TestGetServerVersionConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version":"16.1.3-ee","revision":"abc"}`)
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version":"17.0.0","revision":"abc"}`)
+	}))
+	defer fast.Close()
+
+	var wg sync.WaitGroup
+	got := make([]*ServerVersion, 5)
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = GetServerVersion(context.Background(), Config{BaseURL: slow.URL, Token: "token"})
+		}()
+	}
+
+	// A slow probe of one GitLab does not hold up probes of another.
+	done := make(chan *ServerVersion)
+	go func() { done <- GetServerVersion(context.Background(), Config{BaseURL: fast.URL, Token: "token"}) }()
+	select {
+	case v := <-done:
+		if diff := cmp.Diff(&ServerVersion{Major: 17}, v); diff != "" {
+			t.Errorf("GetServerVersion(...): -want, +got:\n%s", diff)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("GetServerVersion(...): probe blocked by a probe of another base URL")
+	}
+
+	// Let every caller join the running probe before it completes.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, v := range got {
+		if diff := cmp.Diff(&ServerVersion{Major: 16, Minor: 1}, v); diff != "" {
+			t.Errorf("GetServerVersion(...): -want, +got:\n%s", diff)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("GetServerVersion(...): want concurrent callers to share one probe, got %d probes", n)
+	}
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer generates managed resource manifests for existing GitLab
+// resources so they can be adopted by the provider.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	clusterprojects "github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errGetProject    = "cannot get GitLab project"
+	errListVariables = "cannot list GitLab project variables"
+	errMarshal       = "cannot marshal manifest"
+
+	// defaultEnvironmentScope is the environment scope GitLab assigns to
+	// variables that apply to all environments.
+	defaultEnvironmentScope = "*"
+)
+
+// ProjectVariableClient defines the GitLab operations needed to import
+// project variables.
+type ProjectVariableClient interface {
+	GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ListVariables(pid any, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error)
+}
+
+// NewProjectVariableClient returns a new GitLab client for importing project variables
+func NewProjectVariableClient(cfg common.Config) ProjectVariableClient {
+	git := common.NewClient(cfg)
+	return &projectVariableClient{
+		ProjectsServiceInterface:         git.Projects,
+		ProjectVariablesServiceInterface: git.ProjectVariables,
+	}
+}
+
+// projectVariableClient composes the projects and project variables services.
+type projectVariableClient struct {
+	gitlab.ProjectsServiceInterface
+	gitlab.ProjectVariablesServiceInterface
+}
+
+// VariableImportOptions configures the generated Variable manifests.
+type VariableImportOptions struct {
+	// Namespace of the generated namespaced resources.
+	Namespace string
+
+	// ProviderConfigName is the name of the referenced provider config.
+	ProviderConfigName string
+
+	// ProviderConfigKind is the kind of the referenced provider config.
+	// Only used for namespaced resources.
+	ProviderConfigKind string
+
+	// ValuesSecretName is the name of a Secret holding the variable values.
+	// If empty, values are omitted and left to GitLab.
+	ValuesSecretName string
+
+	// ClusterScoped generates cluster scoped resources instead of
+	// namespaced ones.
+	ClusterScoped bool
+
+	// AllowDelete lets the provider delete the variables from GitLab when
+	// the generated resources are deleted. Variables are orphaned by default.
+	AllowDelete bool
+
+	// ObserveOnly generates resources that only observe the variables and
+	// never create, update or delete them. Takes precedence over AllowDelete.
+	ObserveOnly bool
+}
+
+// ListProjectVariables returns the numeric ID of a project together with
+// all of its variables.
+func ListProjectVariables(ctx context.Context, c ProjectVariableClient, pid string) (int64, []*gitlab.ProjectVariable, error) {
+	p, _, err := c.GetProject(pid, &gitlab.GetProjectOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, errGetProject)
+	}
+
+	var all []*gitlab.ProjectVariable
+	opt := &gitlab.ListProjectVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1}}
+	for {
+		vars, res, err := c.ListVariables(p.ID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, nil, errors.Wrap(err, errListVariables)
+		}
+		all = append(all, vars...)
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+	return p.ID, all, nil
+}
+
+// GenerateProjectVariableManifests returns the manifests adopting the given
+// variables of a project. A Secret holding the values is prepended if
+// ValuesSecretName is set.
+func GenerateProjectVariableManifests(projectID int64, vars []*gitlab.ProjectVariable, o VariableImportOptions) []any {
+	manifests := []any{}
+	names := map[string]int{}
+
+	var secret *corev1.Secret
+	if o.ValuesSecretName != "" {
+		secret = &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: o.ValuesSecretName, Namespace: o.Namespace},
+			StringData: map[string]string{},
+		}
+		manifests = append(manifests, secret)
+	}
+
+	for _, v := range vars {
+		name := uniqueName(names, resourceName(v))
+		params := generateVariableParameters(projectID, v)
+
+		var valueRef *xpv1.LocalSecretKeySelector
+		// Hidden variables never return their value.
+		if secret != nil && !v.Hidden {
+			secret.StringData[name] = v.Value
+			valueRef = &xpv1.LocalSecretKeySelector{
+				LocalSecretReference: xpv1.LocalSecretReference{Name: o.ValuesSecretName},
+				Key:                  name,
+			}
+		}
+
+		if o.ClusterScoped {
+			manifests = append(manifests, generateClusterVariable(name, params, valueRef, o))
+			continue
+		}
+		manifests = append(manifests, generateVariable(name, params, valueRef, o))
+	}
+
+	return manifests
+}
+
+// WriteManifests writes the manifests as a multi-document YAML stream.
+func WriteManifests(w io.Writer, manifests []any) error {
+	for _, m := range manifests {
+		b, err := yaml.Marshal(m)
+		if err != nil {
+			return errors.Wrap(err, errMarshal)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateVariableParameters(projectID int64, v *gitlab.ProjectVariable) v1alpha1.VariableParameters {
+	variableType := commonv1alpha1.VariableType(v.VariableType)
+	p := v1alpha1.VariableParameters{
+		CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+			Key:          v.Key,
+			Masked:       &v.Masked,
+			Protected:    &v.Protected,
+			Raw:          &v.Raw,
+			VariableType: &variableType,
+		},
+		ProjectID: &projectID,
+	}
+	if v.Description != "" {
+		p.Description = &v.Description
+	}
+	if v.EnvironmentScope != "" {
+		p.EnvironmentScope = &v.EnvironmentScope
+	}
+	return p
+}
+
+func generateVariable(name string, p v1alpha1.VariableParameters, valueRef *xpv1.LocalSecretKeySelector, o VariableImportOptions) *v1alpha1.Variable {
+	p.ValueSecretRef = valueRef
+
+	policies := xpv1.ManagementPolicies{xpv1.ManagementActionAll}
+	switch {
+	case o.ObserveOnly:
+		policies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+	case !o.AllowDelete:
+		policies = xpv1.ManagementPolicies{
+			xpv1.ManagementActionObserve,
+			xpv1.ManagementActionCreate,
+			xpv1.ManagementActionUpdate,
+			xpv1.ManagementActionLateInitialize,
+		}
+	}
+
+	cr := &v1alpha1.Variable{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.VariableKind},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: o.Namespace},
+		Spec: v1alpha1.VariableSpec{
+			ManagedResourceSpec: xpv2.ManagedResourceSpec{
+				ManagementPolicies: policies,
+			},
+			ForProvider: p,
+		},
+	}
+	if o.ProviderConfigName != "" {
+		cr.Spec.ProviderConfigReference = &xpv1.ProviderConfigReference{Name: o.ProviderConfigName, Kind: o.ProviderConfigKind}
+	}
+	meta.SetExternalName(cr, common.VariableExternalName(p.Key, ptr.Deref(p.EnvironmentScope, "")))
+	return cr
+}
+
+func generateClusterVariable(name string, p v1alpha1.VariableParameters, valueRef *xpv1.LocalSecretKeySelector, o VariableImportOptions) *clusterprojects.Variable {
+	policy := xpv1.DeletionOrphan
+	if o.AllowDelete && !o.ObserveOnly {
+		policy = xpv1.DeletionDelete
+	}
+
+	cp := clusterprojects.VariableParameters{
+		CommonVariableParameters: p.CommonVariableParameters,
+		ProjectID:                p.ProjectID,
+		EnvironmentScope:         p.EnvironmentScope,
+	}
+	if valueRef != nil {
+		cp.ValueSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: valueRef.Name, Namespace: o.Namespace},
+			Key:             valueRef.Key,
+		}
+	}
+
+	cr := &clusterprojects.Variable{
+		TypeMeta:   metav1.TypeMeta{APIVersion: clusterprojects.SchemeGroupVersion.String(), Kind: clusterprojects.VariableKind},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: clusterprojects.VariableSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				DeletionPolicy: policy,
+			},
+			ForProvider: cp,
+		},
+	}
+	if o.ObserveOnly {
+		cr.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+	}
+	if o.ProviderConfigName != "" {
+		cr.Spec.ProviderConfigReference = &xpv1.Reference{Name: o.ProviderConfigName}
+	}
+	meta.SetExternalName(cr, common.VariableExternalName(p.Key, ptr.Deref(p.EnvironmentScope, "")))
+	return cr
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// resourceName derives a valid Kubernetes name from the variable key and,
+// if not the default, its environment scope.
+func resourceName(v *gitlab.ProjectVariable) string {
+	name := v.Key
+	if v.EnvironmentScope != "" && v.EnvironmentScope != defaultEnvironmentScope {
+		name += "-" + v.EnvironmentScope
+	}
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 63 {
+		name = strings.Trim(name[:63], "-")
+	}
+	if name == "" {
+		name = "variable"
+	}
+	return name
+}
+
+func uniqueName(seen map[string]int, name string) string {
+	seen[name]++
+	if n := seen[name]; n > 1 {
+		return fmt.Sprintf("%s-%d", name, n)
+	}
+	return name
+}
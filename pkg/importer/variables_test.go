@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+
+	clusterprojects "github.com/crossplane-contrib/provider-gitlab/apis/cluster/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+type mockClient struct {
+	project *gitlab.Project
+	pages   [][]*gitlab.ProjectVariable
+	err     error
+}
+
+func (m *mockClient) GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return m.project, &gitlab.Response{}, m.err
+}
+
+func (m *mockClient) ListVariables(pid any, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	res := &gitlab.Response{}
+	if int(opt.Page) < len(m.pages) {
+		res.NextPage = opt.Page + 1
+	}
+	return m.pages[opt.Page-1], res, nil
+}
+
+func TestListProjectVariables(t *testing.T) {
+	type want struct {
+		id   int64
+		keys []string
+		err  error
+	}
+
+	cases := map[string]struct {
+		client *mockClient
+		want   want
+	}{
+		"Paginated": {
+			client: &mockClient{
+				project: &gitlab.Project{ID: 42},
+				pages: [][]*gitlab.ProjectVariable{
+					{{Key: "A"}, {Key: "B"}},
+					{{Key: "C"}},
+				},
+			},
+			want: want{id: 42, keys: []string{"A", "B", "C"}},
+		},
+		"GetProjectFailed": {
+			client: &mockClient{err: errBoom},
+			want:   want{err: errors.Wrap(errBoom, errGetProject)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, vars, err := ListProjectVariables(context.Background(), tc.client, "group/project")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ListProjectVariables(...): -want error, +got error:\n%s", diff)
+			}
+			var keys []string
+			for _, v := range vars {
+				keys = append(keys, v.Key)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("ListProjectVariables(...): -want id, +got id:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.keys, keys); diff != "" {
+				t.Errorf("ListProjectVariables(...): -want keys, +got keys:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProjectVariableManifests(t *testing.T) {
+	vars := []*gitlab.ProjectVariable{
+		{Key: "API_TOKEN", Value: "secret", EnvironmentScope: "*", VariableType: gitlab.EnvVariableType, Masked: true},
+		{Key: "API_TOKEN", Value: "other", EnvironmentScope: "production", VariableType: gitlab.EnvVariableType},
+		{Key: "HIDDEN", EnvironmentScope: "*", Hidden: true},
+	}
+
+	t.Run("NamespacedOrphanedByDefault", func(t *testing.T) {
+		m := GenerateProjectVariableManifests(42, vars, VariableImportOptions{
+			Namespace:          "team-a",
+			ProviderConfigName: "gitlab",
+			ProviderConfigKind: "ProviderConfig",
+			ValuesSecretName:   "imported-values",
+		})
+		if len(m) != 4 {
+			t.Fatalf("expected a Secret and 3 Variables, got %d manifests", len(m))
+		}
+
+		secret := m[0].(*corev1.Secret)
+		if diff := cmp.Diff(map[string]string{"api-token": "secret", "api-token-production": "other"}, secret.StringData); diff != "" {
+			t.Errorf("secret values: -want, +got:\n%s", diff)
+		}
+
+		v := m[1].(*v1alpha1.Variable)
+		if diff := cmp.Diff("API_TOKEN@*", meta.GetExternalName(v)); diff != "" {
+			t.Errorf("external name: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff("API_TOKEN@production", meta.GetExternalName(m[2].(*v1alpha1.Variable))); diff != "" {
+			t.Errorf("external name: -want, +got:\n%s", diff)
+		}
+		for _, a := range v.Spec.ManagementPolicies {
+			if a == xpv1.ManagementActionDelete || a == xpv1.ManagementActionAll {
+				t.Errorf("expected variables to be orphaned by default, got management policies %v", v.Spec.ManagementPolicies)
+			}
+		}
+		if diff := cmp.Diff(int64(42), *v.Spec.ForProvider.ProjectID); diff != "" {
+			t.Errorf("project id: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff("api-token", v.Spec.ForProvider.ValueSecretRef.Key); diff != "" {
+			t.Errorf("value secret key: -want, +got:\n%s", diff)
+		}
+
+		if diff := cmp.Diff("api-token-production", m[2].(*v1alpha1.Variable).Name); diff != "" {
+			t.Errorf("scoped name: -want, +got:\n%s", diff)
+		}
+		if m[3].(*v1alpha1.Variable).Spec.ForProvider.ValueSecretRef != nil {
+			t.Errorf("expected no value reference for hidden variables")
+		}
+	})
+
+	t.Run("ClusterScoped", func(t *testing.T) {
+		m := GenerateProjectVariableManifests(42, vars[:1], VariableImportOptions{ClusterScoped: true})
+		v := m[0].(*clusterprojects.Variable)
+		if diff := cmp.Diff(xpv1.DeletionOrphan, v.Spec.DeletionPolicy); diff != "" {
+			t.Errorf("deletion policy: -want, +got:\n%s", diff)
+		}
+		if v.Spec.ForProvider.Value != nil {
+			t.Errorf("expected values to be omitted without a values secret")
+		}
+	})
+}
+
+func TestGenerateProjectVariableManifestsObserveOnly(t *testing.T) {
+	vars := []*gitlab.ProjectVariable{{Key: "API_TOKEN", Value: "secret", EnvironmentScope: "production"}}
+	observe := xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+
+	t.Run("Namespaced", func(t *testing.T) {
+		m := GenerateProjectVariableManifests(42, vars, VariableImportOptions{Namespace: "team-a", ObserveOnly: true, AllowDelete: true})
+		v := m[0].(*v1alpha1.Variable)
+		if diff := cmp.Diff(observe, v.Spec.ManagementPolicies); diff != "" {
+			t.Errorf("management policies: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff("API_TOKEN@production", meta.GetExternalName(v)); diff != "" {
+			t.Errorf("external name: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("ClusterScoped", func(t *testing.T) {
+		m := GenerateProjectVariableManifests(42, vars, VariableImportOptions{ClusterScoped: true, ObserveOnly: true, AllowDelete: true})
+		v := m[0].(*clusterprojects.Variable)
+		if diff := cmp.Diff(observe, v.Spec.ManagementPolicies); diff != "" {
+			t.Errorf("management policies: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(xpv1.DeletionOrphan, v.Spec.DeletionPolicy); diff != "" {
+			t.Errorf("deletion policy: -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestWriteManifests(t *testing.T) {
+	m := GenerateProjectVariableManifests(42, []*gitlab.ProjectVariable{{Key: "A"}}, VariableImportOptions{Namespace: "default"})
+
+	var b bytes.Buffer
+	if err := WriteManifests(&b, m); err != nil {
+		t.Fatalf("WriteManifests(...): unexpected error: %v", err)
+	}
+	for _, s := range []string{"---\n", "apiVersion: projects.gitlab.m.crossplane.io/v1alpha1", "kind: Variable", "crossplane.io/external-name: A"} {
+		if !strings.Contains(b.String(), s) {
+			t.Errorf("WriteManifests(...): expected output to contain %q, got:\n%s", s, b.String())
+		}
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func TestIsSameSet(t *testing.T) {
+	cases := map[string]struct {
+		a    []string
+		b    []string
+		want bool
+	}{
+		"BothEmpty":  {a: nil, b: []string{}, want: true},
+		"Equal":      {a: []string{"api", "read_repository"}, b: []string{"api", "read_repository"}, want: true},
+		"Reordered":  {a: []string{"read_repository", "api"}, b: []string{"api", "read_repository"}, want: true},
+		"Missing":    {a: []string{"api", "read_repository"}, b: []string{"api"}},
+		"Different":  {a: []string{"api"}, b: []string{"read_api"}},
+		"Duplicates": {a: []string{"api", "api"}, b: []string{"api", "read_api"}},
+		"OneIsEmpty": {a: []string{"api"}},
+		"OtherIsNil": {b: []string{"api"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsSameSet(tc.a, tc.b); got != tc.want {
+				t.Errorf("IsSameSet(%v, %v): want %t, got %t", tc.a, tc.b, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsSameSetAsSlicePtr(t *testing.T) {
+	if !IsSameSetAsSlicePtr(nil, []int64{1}) {
+		t.Errorf("IsSameSetAsSlicePtr(nil, ...): want unset list to be up to date")
+	}
+	if !IsSameSetAsSlicePtr(&[]int64{30, 40}, []int64{40, 30}) {
+		t.Errorf("IsSameSetAsSlicePtr(...): want reordered list to be up to date")
+	}
+	if IsSameSetAsSlicePtr(&[]int64{30}, []int64{40}) {
+		t.Errorf("IsSameSetAsSlicePtr(...): want different list not to be up to date")
+	}
+}
+
+func TestIsEqual(t *testing.T) {
+	base := v1alpha1.ApprovalRuleParameters{
+		ProjectID: ptr.To[int64](1),
+		Name:      ptr.To("security"),
+		UserIDs:   &[]int64{},
+	}
+
+	cases := map[string]struct {
+		a    v1alpha1.ApprovalRuleParameters
+		b    v1alpha1.ApprovalRuleParameters
+		opts []cmp.Option
+		want bool
+	}{
+		"Equal": {
+			a:    base,
+			b:    base,
+			want: true,
+		},
+		"NilAndEmptyAreEqual": {
+			a:    base,
+			b:    v1alpha1.ApprovalRuleParameters{ProjectID: ptr.To[int64](1), Name: ptr.To("security"), UserIDs: new([]int64)},
+			want: true,
+		},
+		"ReferencesAreIgnored": {
+			a: base,
+			b: v1alpha1.ApprovalRuleParameters{
+				ProjectID:         ptr.To[int64](1),
+				Name:              ptr.To("security"),
+				UserIDs:           &[]int64{},
+				ProjectIDRef:      &xpv1.NamespacedReference{Name: "project"},
+				ProjectIDSelector: &xpv1.NamespacedSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+			want: true,
+		},
+		"DifferentValue": {
+			a: base,
+			b: v1alpha1.ApprovalRuleParameters{ProjectID: ptr.To[int64](1), Name: ptr.To("license"), UserIDs: &[]int64{}},
+		},
+		"ExtendedOptions": {
+			a:    base,
+			b:    v1alpha1.ApprovalRuleParameters{ProjectID: ptr.To[int64](1), Name: ptr.To("license"), UserIDs: &[]int64{}},
+			opts: []cmp.Option{cmpopts.IgnoreFields(v1alpha1.ApprovalRuleParameters{}, "Name")},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsEqual(&tc.a, &tc.b, tc.opts...); got != tc.want {
+				t.Errorf("IsEqual(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEquateOptionsEmpty(t *testing.T) {
+	if !IsEqual([]string(nil), []string{}) {
+		t.Errorf("IsEqual(nil, []string{}): want nil and empty slices to be equal")
+	}
+	if !IsEqual(map[string]string(nil), map[string]string{}) {
+		t.Errorf("IsEqual(nil, map[string]string{}): want nil and empty maps to be equal")
+	}
+}
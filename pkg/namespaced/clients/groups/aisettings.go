@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// AISettings are the GitLab Duo and AI feature settings of a group. The
+// groups API of the GitLab client does not cover them, so they are read and
+// written with requests of their own. Settings GitLab does not return, e.g.
+// because the license or the group does not include them, are nil.
+type AISettings struct {
+	DuoFeaturesEnabled        *bool `json:"duo_features_enabled,omitempty"`
+	LockDuoFeaturesEnabled    *bool `json:"lock_duo_features_enabled,omitempty"`
+	ExperimentFeaturesEnabled *bool `json:"experiment_features_enabled,omitempty"`
+}
+
+// AISettingsClient defines the GitLab operations on the AI settings of a
+// group.
+type AISettingsClient interface {
+	GetGroupAISettings(gid int64, options ...gitlab.RequestOptionFunc) (*AISettings, *gitlab.Response, error)
+	UpdateGroupAISettings(gid int64, opt *AISettings, options ...gitlab.RequestOptionFunc) (*AISettings, *gitlab.Response, error)
+}
+
+// NewAISettingsClient returns a new GitLab group AI settings client.
+func NewAISettingsClient(cfg common.Config) AISettingsClient {
+	return &aiSettingsClient{client: common.NewClient(cfg)}
+}
+
+type aiSettingsClient struct {
+	client *gitlab.Client
+}
+
+// GetGroupAISettings returns the AI settings of a group.
+func (c *aiSettingsClient) GetGroupAISettings(gid int64, options ...gitlab.RequestOptionFunc) (*AISettings, *gitlab.Response, error) {
+	req, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%d", gid), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := new(AISettings)
+	res, err := c.client.Do(req, s)
+	if err != nil {
+		return nil, res, err
+	}
+	return s, res, nil
+}
+
+// UpdateGroupAISettings updates the AI settings of a group. Settings that are
+// nil are left unchanged.
+func (c *aiSettingsClient) UpdateGroupAISettings(gid int64, opt *AISettings, options ...gitlab.RequestOptionFunc) (*AISettings, *gitlab.Response, error) {
+	req, err := c.client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%d", gid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := new(AISettings)
+	res, err := c.client.Do(req, s)
+	if err != nil {
+		return nil, res, err
+	}
+	return s, res, nil
+}
+
+// GenerateAISettings returns the AI settings of the group parameters. Nil
+// parameters are not managed and stay nil.
+func GenerateAISettings(p *v1alpha1.GroupParameters) *AISettings {
+	return &AISettings{
+		DuoFeaturesEnabled:        p.DuoFeaturesEnabled,
+		LockDuoFeaturesEnabled:    p.LockDuoFeaturesEnabled,
+		ExperimentFeaturesEnabled: p.ExperimentFeaturesEnabled,
+	}
+}
+
+// IsManaged returns true if any of the AI settings is set.
+func (s *AISettings) IsManaged() bool {
+	return s.DuoFeaturesEnabled != nil || s.LockDuoFeaturesEnabled != nil || s.ExperimentFeaturesEnabled != nil
+}
+
+// OmitUnsupportedAISettings clears the AI settings the supplied GitLab
+// version does not support, since sending them fails the request. It returns
+// the names of the omitted settings.
+func OmitUnsupportedAISettings(s *AISettings, v *common.ServerVersion) []string {
+	var omitted []string
+	if !v.AtLeast(16, 10) {
+		if s.DuoFeaturesEnabled != nil {
+			omitted = append(omitted, "duoFeaturesEnabled")
+		}
+		if s.LockDuoFeaturesEnabled != nil {
+			omitted = append(omitted, "lockDuoFeaturesEnabled")
+		}
+		s.DuoFeaturesEnabled = nil
+		s.LockDuoFeaturesEnabled = nil
+	}
+	return omitted
+}
+
+// OmitUnavailableAISettings clears the AI settings GitLab did not return for
+// the group, which it does when the license or the group does not include
+// them. It returns the names of the omitted settings.
+func OmitUnavailableAISettings(s, observed *AISettings) []string {
+	var omitted []string
+	if s.DuoFeaturesEnabled != nil && observed.DuoFeaturesEnabled == nil {
+		omitted = append(omitted, "duoFeaturesEnabled")
+		s.DuoFeaturesEnabled = nil
+	}
+	if s.LockDuoFeaturesEnabled != nil && observed.LockDuoFeaturesEnabled == nil {
+		omitted = append(omitted, "lockDuoFeaturesEnabled")
+		s.LockDuoFeaturesEnabled = nil
+	}
+	if s.ExperimentFeaturesEnabled != nil && observed.ExperimentFeaturesEnabled == nil {
+		omitted = append(omitted, "experimentFeaturesEnabled")
+		s.ExperimentFeaturesEnabled = nil
+	}
+	return omitted
+}
+
+// IsAISettingsUpToDate checks whether the observed AI settings match the
+// desired ones. Settings that are nil in desired are not compared.
+func IsAISettingsUpToDate(desired, observed *AISettings) bool {
+	return isBoolUpToDate(desired.DuoFeaturesEnabled, observed.DuoFeaturesEnabled) &&
+		isBoolUpToDate(desired.LockDuoFeaturesEnabled, observed.LockDuoFeaturesEnabled) &&
+		isBoolUpToDate(desired.ExperimentFeaturesEnabled, observed.ExperimentFeaturesEnabled)
+}
+
+func isBoolUpToDate(desired, observed *bool) bool {
+	return desired == nil || observed != nil && *desired == *observed
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+func TestAISettingsClientRoundTrip(t *testing.T) {
+	stored := map[string]any{"id": 1234, "name": "group", "duo_features_enabled": false, "lock_duo_features_enabled": false}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/groups/1234" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			update := map[string]any{}
+			if err := json.Unmarshal(body, &update); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for k, v := range update {
+				stored[k] = v
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(stored)
+		fmt.Fprint(w, string(b))
+	}))
+	defer srv.Close()
+
+	c := NewAISettingsClient(common.Config{BaseURL: srv.URL, Token: "token"})
+
+	got, _, err := c.GetGroupAISettings(1234)
+	if err != nil {
+		t.Fatalf("GetGroupAISettings(...): %v", err)
+	}
+	want := &AISettings{DuoFeaturesEnabled: ptr.To(false), LockDuoFeaturesEnabled: ptr.To(false)}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetGroupAISettings(...): -want, +got:\n%s", diff)
+	}
+
+	if _, _, err := c.UpdateGroupAISettings(1234, &AISettings{DuoFeaturesEnabled: ptr.To(true)}); err != nil {
+		t.Fatalf("UpdateGroupAISettings(...): %v", err)
+	}
+	if stored["name"] != "group" || stored["lock_duo_features_enabled"] != false {
+		t.Errorf("UpdateGroupAISettings(...): unset settings must not be sent, got %v", stored)
+	}
+
+	got, _, err = c.GetGroupAISettings(1234)
+	if err != nil {
+		t.Fatalf("GetGroupAISettings(...): %v", err)
+	}
+	want = &AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(false)}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetGroupAISettings(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAISettings(t *testing.T) {
+	cases := map[string]struct {
+		p       *v1alpha1.GroupParameters
+		want    *AISettings
+		managed bool
+	}{
+		"Unset": {
+			p:    &v1alpha1.GroupParameters{},
+			want: &AISettings{},
+		},
+		"Set": {
+			p: &v1alpha1.GroupParameters{
+				DuoFeaturesEnabled:        ptr.To(true),
+				ExperimentFeaturesEnabled: ptr.To(false),
+			},
+			want: &AISettings{
+				DuoFeaturesEnabled:        ptr.To(true),
+				ExperimentFeaturesEnabled: ptr.To(false),
+			},
+			managed: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAISettings(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateAISettings(...): -want, +got:\n%s", diff)
+			}
+			if got.IsManaged() != tc.managed {
+				t.Errorf("IsManaged(): want %t, got %t", tc.managed, got.IsManaged())
+			}
+		})
+	}
+}
+
+func TestOmitUnsupportedAISettings(t *testing.T) {
+	cases := map[string]struct {
+		s       *AISettings
+		v       *common.ServerVersion
+		want    *AISettings
+		omitted []string
+	}{
+		"UnknownVersion": {
+			s:    &AISettings{DuoFeaturesEnabled: ptr.To(true)},
+			want: &AISettings{DuoFeaturesEnabled: ptr.To(true)},
+		},
+		"Supported": {
+			s:    &AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(true)},
+			v:    &common.ServerVersion{Major: 16, Minor: 10},
+			want: &AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(true)},
+		},
+		"TooOld": {
+			s:       &AISettings{DuoFeaturesEnabled: ptr.To(false), ExperimentFeaturesEnabled: ptr.To(true)},
+			v:       &common.ServerVersion{Major: 16, Minor: 9},
+			want:    &AISettings{ExperimentFeaturesEnabled: ptr.To(true)},
+			omitted: []string{"duoFeaturesEnabled"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			omitted := OmitUnsupportedAISettings(tc.s, tc.v)
+			if diff := cmp.Diff(tc.want, tc.s); diff != "" {
+				t.Errorf("OmitUnsupportedAISettings(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.omitted, omitted); diff != "" {
+				t.Errorf("OmitUnsupportedAISettings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOmitUnavailableAISettings(t *testing.T) {
+	s := &AISettings{DuoFeaturesEnabled: ptr.To(true), ExperimentFeaturesEnabled: ptr.To(true)}
+	omitted := OmitUnavailableAISettings(s, &AISettings{DuoFeaturesEnabled: ptr.To(false)})
+
+	if diff := cmp.Diff(&AISettings{DuoFeaturesEnabled: ptr.To(true)}, s); diff != "" {
+		t.Errorf("OmitUnavailableAISettings(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"experimentFeaturesEnabled"}, omitted); diff != "" {
+		t.Errorf("OmitUnavailableAISettings(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAISettingsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *AISettings
+		observed *AISettings
+		want     bool
+	}{
+		"Unmanaged": {
+			desired:  &AISettings{},
+			observed: &AISettings{DuoFeaturesEnabled: ptr.To(true)},
+			want:     true,
+		},
+		"Equal": {
+			desired:  &AISettings{DuoFeaturesEnabled: ptr.To(true)},
+			observed: &AISettings{DuoFeaturesEnabled: ptr.To(true), LockDuoFeaturesEnabled: ptr.To(false)},
+			want:     true,
+		},
+		"Different": {
+			desired:  &AISettings{LockDuoFeaturesEnabled: ptr.To(true)},
+			observed: &AISettings{LockDuoFeaturesEnabled: ptr.To(false)},
+			want:     false,
+		},
+		"NotObserved": {
+			desired:  &AISettings{ExperimentFeaturesEnabled: ptr.To(false)},
+			observed: &AISettings{},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsAISettingsUpToDate(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("IsAISettingsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+)
+
+type fakeGraphQL struct {
+	response string
+	query    gitlab.GraphQLQuery
+}
+
+func (f *fakeGraphQL) Do(query gitlab.GraphQLQuery, response any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	f.query = query
+	return &gitlab.Response{}, json.Unmarshal([]byte(f.response), response)
+}
+
+type fakeGroups struct {
+	gitlab.GroupsServiceInterface
+	gid any
+}
+
+func (f *fakeGroups) GetGroup(gid any, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	f.gid = gid
+	return &gitlab.Group{FullPath: "acme"}, &gitlab.Response{}, nil
+}
+
+func TestGetGroupPath(t *testing.T) {
+	cases := map[string]struct {
+		group   string
+		want    string
+		wantGID any
+	}{
+		"Path": {
+			group: "acme",
+			want:  "acme",
+		},
+		"ID": {
+			group:   "1234",
+			want:    "acme",
+			wantGID: "1234",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGroups{}
+			c := &complianceFrameworkClient{groups: g}
+			got, err := c.GetGroupPath(context.Background(), tc.group)
+			if err != nil {
+				t.Fatalf("GetGroupPath(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetGroupPath(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantGID, g.gid); diff != "" {
+				t.Errorf("GetGroup(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetComplianceFramework(t *testing.T) {
+	type want struct {
+		framework *ComplianceFramework
+		err       error
+	}
+
+	cases := map[string]struct {
+		response string
+		want     want
+	}{
+		"Found": {
+			response: `{"data":{"namespace":{"complianceFrameworks":{"nodes":[{"id":"gid://gitlab/ComplianceManagement::Framework/7","name":"SOX","description":"Sarbanes-Oxley","color":"#1aaa55","pipelineConfigurationFullPath":null}]}}}}`,
+			want: want{
+				framework: &ComplianceFramework{ID: 7, Name: "SOX", Description: "Sarbanes-Oxley", Color: "#1aaa55"},
+			},
+		},
+		"NotFound": {
+			response: `{"data":{"namespace":{"complianceFrameworks":{"nodes":[]}}}}`,
+		},
+		"GroupNotFound": {
+			response: `{"data":{"namespace":null}}`,
+		},
+		"Errors": {
+			response: `{"errors":[{"message":"boom"}]}`,
+			want:     want{err: errors.New("boom")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{response: tc.response}
+			c := &complianceFrameworkClient{graphql: g}
+			got, err := c.GetComplianceFramework(context.Background(), "acme", 7)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetComplianceFramework(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.framework, got); diff != "" {
+				t.Errorf("GetComplianceFramework(...): -want, +got:\n%s", diff)
+			}
+			wantVars := map[string]any{"fullPath": "acme", "id": "gid://gitlab/ComplianceManagement::Framework/7"}
+			if diff := cmp.Diff(wantVars, g.query.Variables); diff != "" {
+				t.Errorf("GetComplianceFramework(...): -want variables, +got variables:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateComplianceFramework(t *testing.T) {
+	type want struct {
+		framework *ComplianceFramework
+		err       error
+	}
+
+	cases := map[string]struct {
+		response string
+		want     want
+	}{
+		"Created": {
+			response: `{"data":{"createComplianceFramework":{"framework":{"id":"gid://gitlab/ComplianceManagement::Framework/7","name":"SOX","description":"Sarbanes-Oxley","color":"#1aaa55"},"errors":[]}}}`,
+			want: want{
+				framework: &ComplianceFramework{ID: 7, Name: "SOX", Description: "Sarbanes-Oxley", Color: "#1aaa55"},
+			},
+		},
+		"MutationErrors": {
+			response: `{"data":{"createComplianceFramework":{"framework":null,"errors":["Name has already been taken"]}}}`,
+			want:     want{err: errors.New("Name has already been taken")},
+		},
+		"NotAvailable": {
+			response: `{"data":{"createComplianceFramework":null}}`,
+			want:     want{err: errors.New("group acme does not exist or you don't have permission to perform this action")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{response: tc.response}
+			c := &complianceFrameworkClient{graphql: g}
+			got, err := c.CreateComplianceFramework(context.Background(), "acme", &ComplianceFrameworkInput{Name: "SOX"})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CreateComplianceFramework(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.framework, got); diff != "" {
+				t.Errorf("CreateComplianceFramework(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeComplianceFrameworkColor(t *testing.T) {
+	cases := map[string]struct {
+		color string
+		want  string
+	}{
+		"Normalized": {color: "#1aaa55", want: "#1aaa55"},
+		"UpperCase":  {color: "#1AAA55", want: "#1aaa55"},
+		"NoHash":     {color: "1aaa55", want: "#1aaa55"},
+		"Short":      {color: "#ABC", want: "#aabbcc"},
+		"Whitespace": {color: " #1aaa55 ", want: "#1aaa55"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NormalizeComplianceFrameworkColor(tc.color)); diff != "" {
+				t.Errorf("NormalizeComplianceFrameworkColor(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComplianceFrameworkUpToDate(t *testing.T) {
+	observed := &ComplianceFramework{ID: 7, Name: "SOX", Description: "Sarbanes-Oxley", Color: "#1aaa55", PipelineConfigurationFullPath: "ci.yml@compliance/pipelines"}
+
+	cases := map[string]struct {
+		p    v1alpha1.ComplianceFrameworkParameters
+		f    *ComplianceFramework
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.ComplianceFrameworkParameters{Name: "SOX", Description: "Sarbanes-Oxley", Color: "1AAA55"},
+			f:    observed,
+			want: true,
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.ComplianceFrameworkParameters{Name: "SOX", Description: "SOX controls", Color: "#1aaa55"},
+			f: observed,
+		},
+		"ColorChanged": {
+			p: v1alpha1.ComplianceFrameworkParameters{Name: "SOX", Description: "Sarbanes-Oxley", Color: "#ff0000"},
+			f: observed,
+		},
+		"PipelineConfigurationRemoved": {
+			p: v1alpha1.ComplianceFrameworkParameters{Name: "SOX", Description: "Sarbanes-Oxley", Color: "#1aaa55", PipelineConfigurationFullPath: ptr.To("")},
+			f: observed,
+		},
+		"NotObserved": {
+			p: v1alpha1.ComplianceFrameworkParameters{Name: "SOX"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsComplianceFrameworkUpToDate(&tc.p, tc.f); got != tc.want {
+				t.Errorf("IsComplianceFrameworkUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
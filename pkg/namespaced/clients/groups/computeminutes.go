@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	keySharedRunnersMinutesLimit      = "shared_runners_minutes_limit"
+	keyExtraSharedRunnersMinutesLimit = "extra_shared_runners_minutes_limit"
+)
+
+// ComputeMinutes are the compute minutes quotas of a group. GitLab only
+// returns them to administrators of a self-managed instance with a Premium or
+// Ultimate license, and returns null for quotas that inherit the instance
+// default. The GitLab client reads both as 0, which means unlimited, so they
+// are read and written with requests of their own.
+type ComputeMinutes struct {
+	SharedRunnersMinutesLimit      *int64 `json:"shared_runners_minutes_limit,omitempty"`
+	ExtraSharedRunnersMinutesLimit *int64 `json:"extra_shared_runners_minutes_limit,omitempty"`
+
+	// Available is false if GitLab did not return the quotas.
+	Available bool `json:"-"`
+}
+
+// ComputeMinutesClient defines the GitLab operations on the compute minutes
+// quotas of a group.
+type ComputeMinutesClient interface {
+	GetGroupComputeMinutes(gid int64, options ...gitlab.RequestOptionFunc) (*ComputeMinutes, *gitlab.Response, error)
+	UpdateGroupComputeMinutes(gid int64, opt *ComputeMinutes, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewComputeMinutesClient returns a new GitLab group compute minutes client.
+func NewComputeMinutesClient(cfg common.Config) ComputeMinutesClient {
+	return &computeMinutesClient{client: common.NewClient(cfg)}
+}
+
+type computeMinutesClient struct {
+	client *gitlab.Client
+}
+
+// GetGroupComputeMinutes returns the compute minutes quotas of a group.
+func (c *computeMinutesClient) GetGroupComputeMinutes(gid int64, options ...gitlab.RequestOptionFunc) (*ComputeMinutes, *gitlab.Response, error) {
+	req, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%d", gid), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	var raw map[string]json.RawMessage
+	res, err := c.client.Do(req, &raw)
+	if err != nil {
+		return nil, res, err
+	}
+	m, err := ParseComputeMinutes(raw)
+	return m, res, err
+}
+
+// UpdateGroupComputeMinutes updates the compute minutes quotas of a group.
+// Quotas that are nil are left unchanged.
+func (c *computeMinutesClient) UpdateGroupComputeMinutes(gid int64, opt *ComputeMinutes, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	req, err := c.client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%d", gid), opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// ParseComputeMinutes reads the compute minutes quotas from the attributes of
+// a group as returned by GitLab.
+func ParseComputeMinutes(raw map[string]json.RawMessage) (*ComputeMinutes, error) {
+	m := &ComputeMinutes{}
+	limit, ok := raw[keySharedRunnersMinutesLimit]
+	if !ok {
+		return m, nil
+	}
+	m.Available = true
+	if err := json.Unmarshal(limit, &m.SharedRunnersMinutesLimit); err != nil {
+		return nil, err
+	}
+	if extra, ok := raw[keyExtraSharedRunnersMinutesLimit]; ok {
+		if err := json.Unmarshal(extra, &m.ExtraSharedRunnersMinutesLimit); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// GenerateComputeMinutes returns the compute minutes quotas of the group
+// parameters. Nil parameters are not managed and stay nil.
+func GenerateComputeMinutes(p *v1alpha1.GroupParameters) *ComputeMinutes {
+	return &ComputeMinutes{
+		SharedRunnersMinutesLimit:      p.SharedRunnersMinutesLimit,
+		ExtraSharedRunnersMinutesLimit: p.ExtraSharedRunnersMinutesLimit,
+	}
+}
+
+// IsManaged returns true if any of the quotas is set.
+func (m *ComputeMinutes) IsManaged() bool {
+	return m.SharedRunnersMinutesLimit != nil || m.ExtraSharedRunnersMinutesLimit != nil
+}
+
+// IsComputeMinutesUpToDate checks whether the observed quotas match the
+// desired ones. Quotas that are nil in desired are not compared, a quota that
+// inherits the instance default differs from every desired one.
+func IsComputeMinutesUpToDate(desired, observed *ComputeMinutes) bool {
+	return isInt64UpToDate(desired.SharedRunnersMinutesLimit, observed.SharedRunnersMinutesLimit) &&
+		isInt64UpToDate(desired.ExtraSharedRunnersMinutesLimit, observed.ExtraSharedRunnersMinutesLimit)
+}
+
+func isInt64UpToDate(desired, observed *int64) bool {
+	return desired == nil || observed != nil && *desired == *observed
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+func TestComputeMinutesClientRoundTrip(t *testing.T) {
+	stored := map[string]any{"id": 1234, "name": "group", "shared_runners_minutes_limit": nil, "extra_shared_runners_minutes_limit": 100}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/groups/1234" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			update := map[string]any{}
+			if err := json.Unmarshal(body, &update); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for k, v := range update {
+				stored[k] = v
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(stored)
+		fmt.Fprint(w, string(b))
+	}))
+	defer srv.Close()
+
+	c := NewComputeMinutesClient(common.Config{BaseURL: srv.URL, Token: "token"})
+
+	got, _, err := c.GetGroupComputeMinutes(1234)
+	if err != nil {
+		t.Fatalf("GetGroupComputeMinutes(...): %v", err)
+	}
+	want := &ComputeMinutes{ExtraSharedRunnersMinutesLimit: ptr.To[int64](100), Available: true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetGroupComputeMinutes(...): -want, +got:\n%s", diff)
+	}
+
+	if _, err := c.UpdateGroupComputeMinutes(1234, &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](0)}); err != nil {
+		t.Fatalf("UpdateGroupComputeMinutes(...): %v", err)
+	}
+	if stored["name"] != "group" || stored["extra_shared_runners_minutes_limit"] != 100 {
+		t.Errorf("UpdateGroupComputeMinutes(...): unset quotas must not be sent, got %v", stored)
+	}
+
+	got, _, err = c.GetGroupComputeMinutes(1234)
+	if err != nil {
+		t.Fatalf("GetGroupComputeMinutes(...): %v", err)
+	}
+	want = &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](0), ExtraSharedRunnersMinutesLimit: ptr.To[int64](100), Available: true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetGroupComputeMinutes(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestParseComputeMinutes(t *testing.T) {
+	cases := map[string]struct {
+		raw  string
+		want *ComputeMinutes
+	}{
+		"NotReturned": {
+			raw:  `{"id": 1}`,
+			want: &ComputeMinutes{},
+		},
+		"Inherited": {
+			raw:  `{"shared_runners_minutes_limit": null, "extra_shared_runners_minutes_limit": null}`,
+			want: &ComputeMinutes{Available: true},
+		},
+		"Unlimited": {
+			raw:  `{"shared_runners_minutes_limit": 0, "extra_shared_runners_minutes_limit": 0}`,
+			want: &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](0), ExtraSharedRunnersMinutesLimit: ptr.To[int64](0), Available: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]json.RawMessage{}
+			if err := json.Unmarshal([]byte(tc.raw), &raw); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ParseComputeMinutes(raw)
+			if err != nil {
+				t.Fatalf("ParseComputeMinutes(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseComputeMinutes(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComputeMinutesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *ComputeMinutes
+		observed *ComputeMinutes
+		want     bool
+	}{
+		"Unmanaged": {
+			desired:  &ComputeMinutes{},
+			observed: &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](100)},
+			want:     true,
+		},
+		"UpToDate": {
+			desired:  &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](100)},
+			observed: &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](100), ExtraSharedRunnersMinutesLimit: ptr.To[int64](5)},
+			want:     true,
+		},
+		"Changed": {
+			desired:  &ComputeMinutes{ExtraSharedRunnersMinutesLimit: ptr.To[int64](10)},
+			observed: &ComputeMinutes{ExtraSharedRunnersMinutesLimit: ptr.To[int64](5)},
+		},
+		"UnlimitedIsNotInherited": {
+			desired:  &ComputeMinutes{SharedRunnersMinutesLimit: ptr.To[int64](0)},
+			observed: &ComputeMinutes{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsComputeMinutesUpToDate(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("IsComputeMinutesUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
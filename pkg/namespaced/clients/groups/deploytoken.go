@@ -23,6 +23,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
 )
 
 // DeployTokenClient defines Gitlab Group service operations
@@ -63,3 +64,13 @@ func GenerateCreateGroupDeployTokenOptions(name string, p *v1alpha1.DeployTokenP
 
 	return deploytoken
 }
+
+// ImmutableDeployTokenFields returns the fields of a deploy token that GitLab
+// cannot update. Fields that are not set are not compared.
+func ImmutableDeployTokenFields(p *v1alpha1.DeployTokenParameters, dt *gitlab.DeployToken) []common.ImmutableField {
+	return []common.ImmutableField{
+		{Name: "forProvider.username", UpToDate: p.Username == nil || *p.Username == dt.Username},
+		{Name: "forProvider.expiresAt", UpToDate: p.ExpiresAt == nil || dt.ExpiresAt != nil && p.ExpiresAt.Time.Equal(*dt.ExpiresAt)},
+		{Name: "forProvider.scopes", UpToDate: p.Scopes == nil || clients.IsSameSet(p.Scopes, dt.Scopes)},
+	}
+}
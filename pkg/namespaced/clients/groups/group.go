@@ -17,6 +17,8 @@ limitations under the License.
 package groups
 
 import (
+	"bytes"
+	"io"
 	"strings"
 	"time"
 
@@ -36,6 +38,8 @@ type Client interface {
 	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	CreateGroup(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	UpdateGroup(gid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	UploadAvatar(gid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	DownloadAvatar(gid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error)
 	DeleteGroup(gid interface{}, opt *gitlab.DeleteGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	ShareGroupWithGroup(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	UnshareGroupFromGroup(gid interface{}, groupID int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
@@ -159,6 +163,7 @@ func GenerateCreateGroupOptions(name string, p *v1alpha1.GroupParameters) *gitla
 		SubGroupCreationLevel:          SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
 		MentionsDisabled:               p.MentionsDisabled,
 		EmailsEnabled:                  p.EmailsEnabled,
+		EmailsDisabled:                 common.EmailsDisabledSetting(p.EmailsEnabled), //nolint:staticcheck
 		LFSEnabled:                     p.LFSEnabled,
 		RequestAccessEnabled:           p.RequestAccessEnabled,
 		ParentID:                       p.ParentID,
@@ -177,23 +182,22 @@ func GenerateEditGroupOptions(name string, p *v1alpha1.GroupParameters) *gitlab.
 	}
 
 	group := &gitlab.UpdateGroupOptions{
-		Name:                           &name,
-		Path:                           &p.Path,
-		Description:                    p.Description,
-		MembershipLock:                 p.MembershipLock,
-		Visibility:                     VisibilityValueV1alpha1ToGitlab(p.Visibility),
-		ShareWithGroupLock:             p.ShareWithGroupLock,
-		RequireTwoFactorAuth:           p.RequireTwoFactorAuth,
-		TwoFactorGracePeriod:           p.TwoFactorGracePeriod,
-		ProjectCreationLevel:           ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
-		AutoDevopsEnabled:              p.AutoDevopsEnabled,
-		SubGroupCreationLevel:          SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
-		EmailsEnabled:                  p.EmailsEnabled,
-		MentionsDisabled:               p.MentionsDisabled,
-		LFSEnabled:                     p.LFSEnabled,
-		RequestAccessEnabled:           p.RequestAccessEnabled,
-		SharedRunnersMinutesLimit:      p.SharedRunnersMinutesLimit,
-		ExtraSharedRunnersMinutesLimit: p.ExtraSharedRunnersMinutesLimit,
+		Name:                  &name,
+		Path:                  &p.Path,
+		Description:           p.Description,
+		MembershipLock:        p.MembershipLock,
+		Visibility:            VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		ShareWithGroupLock:    p.ShareWithGroupLock,
+		RequireTwoFactorAuth:  p.RequireTwoFactorAuth,
+		TwoFactorGracePeriod:  p.TwoFactorGracePeriod,
+		ProjectCreationLevel:  ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
+		AutoDevopsEnabled:     p.AutoDevopsEnabled,
+		SubGroupCreationLevel: SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
+		EmailsEnabled:         p.EmailsEnabled,
+		EmailsDisabled:        common.EmailsDisabledSetting(p.EmailsEnabled), //nolint:staticcheck
+		MentionsDisabled:      p.MentionsDisabled,
+		LFSEnabled:            p.LFSEnabled,
+		RequestAccessEnabled:  p.RequestAccessEnabled,
 	}
 	return group
 }
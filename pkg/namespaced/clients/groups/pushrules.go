@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// PushRulesClient defines Gitlab group push rule service operations
+type PushRulesClient interface {
+	GetGroupPushRules(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	AddGroupPushRule(gid any, opt *gitlab.AddGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	EditGroupPushRule(gid any, opt *gitlab.EditGroupPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupPushRules, *gitlab.Response, error)
+	DeleteGroupPushRule(gid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewPushRulesClient returns a new Gitlab group push rule service
+func NewPushRulesClient(cfg common.Config) PushRulesClient {
+	git := common.NewClient(cfg)
+	return git.Groups
+}
+
+// GeneratePushRules converts the push rules of a group as returned by Gitlab.
+// It returns nil if the group has no push rules: GitLab answers with a null
+// body in that case, which the client decodes into a zero-valued struct.
+func GeneratePushRules(r *gitlab.GroupPushRules) *commonv1alpha1.PushRules {
+	if r == nil || r.ID == 0 {
+		return nil
+	}
+	return &commonv1alpha1.PushRules{
+		AuthorEmailRegex:           &r.AuthorEmailRegex,
+		BranchNameRegex:            &r.BranchNameRegex,
+		CommitCommitterCheck:       &r.CommitCommitterCheck,
+		CommitCommitterNameCheck:   &r.CommitCommitterNameCheck,
+		CommitMessageNegativeRegex: &r.CommitMessageNegativeRegex,
+		CommitMessageRegex:         &r.CommitMessageRegex,
+		DenyDeleteTag:              &r.DenyDeleteTag,
+		FileNameRegex:              &r.FileNameRegex,
+		MaxFileSize:                &r.MaxFileSize,
+		MemberCheck:                &r.MemberCheck,
+		PreventSecrets:             &r.PreventSecrets,
+		RejectUnsignedCommits:      &r.RejectUnsignedCommits,
+		RejectNonDCOCommits:        &r.RejectNonDCOCommits,
+	}
+}
+
+// GenerateGroupPushRulesObservation generates v1alpha1 observation from Gitlab GroupPushRules
+func GenerateGroupPushRulesObservation(r *gitlab.GroupPushRules) v1alpha1.GroupPushRulesObservation {
+	if r == nil {
+		return v1alpha1.GroupPushRulesObservation{}
+	}
+	return v1alpha1.GroupPushRulesObservation{
+		ID:        r.ID,
+		CreatedAt: common.TimeToMetaTime(r.CreatedAt),
+	}
+}
+
+// GenerateAddGroupPushRuleOptions generates group push rule creation options from v1alpha1 parameters
+func GenerateAddGroupPushRuleOptions(p *v1alpha1.GroupPushRulesParameters) *gitlab.AddGroupPushRuleOptions {
+	return &gitlab.AddGroupPushRuleOptions{
+		AuthorEmailRegex:           p.AuthorEmailRegex,
+		BranchNameRegex:            p.BranchNameRegex,
+		CommitCommitterCheck:       p.CommitCommitterCheck,
+		CommitCommitterNameCheck:   p.CommitCommitterNameCheck,
+		CommitMessageNegativeRegex: p.CommitMessageNegativeRegex,
+		CommitMessageRegex:         p.CommitMessageRegex,
+		DenyDeleteTag:              p.DenyDeleteTag,
+		FileNameRegex:              p.FileNameRegex,
+		MaxFileSize:                p.MaxFileSize,
+		MemberCheck:                p.MemberCheck,
+		PreventSecrets:             p.PreventSecrets,
+		RejectUnsignedCommits:      p.RejectUnsignedCommits,
+		RejectNonDCOCommits:        p.RejectNonDCOCommits,
+	}
+}
+
+// GenerateEditGroupPushRuleOptions generates group push rule edit options from v1alpha1 parameters
+func GenerateEditGroupPushRuleOptions(p *v1alpha1.GroupPushRulesParameters) *gitlab.EditGroupPushRuleOptions {
+	return &gitlab.EditGroupPushRuleOptions{
+		AuthorEmailRegex:           p.AuthorEmailRegex,
+		BranchNameRegex:            p.BranchNameRegex,
+		CommitCommitterCheck:       p.CommitCommitterCheck,
+		CommitCommitterNameCheck:   p.CommitCommitterNameCheck,
+		CommitMessageNegativeRegex: p.CommitMessageNegativeRegex,
+		CommitMessageRegex:         p.CommitMessageRegex,
+		DenyDeleteTag:              p.DenyDeleteTag,
+		FileNameRegex:              p.FileNameRegex,
+		MaxFileSize:                p.MaxFileSize,
+		MemberCheck:                p.MemberCheck,
+		PreventSecrets:             p.PreventSecrets,
+		RejectUnsignedCommits:      p.RejectUnsignedCommits,
+		RejectNonDCOCommits:        p.RejectNonDCOCommits,
+	}
+}
+
+// LateInitializeGroupPushRules fills the unset push rules of the spec with
+// the ones observed in Gitlab.
+func LateInitializeGroupPushRules(p *v1alpha1.GroupPushRulesParameters, r *gitlab.GroupPushRules) {
+	current := GeneratePushRules(r)
+	if current == nil {
+		return
+	}
+	p.PushRules = *common.LateInitializePushRules(&p.PushRules, current)
+}
+
+// IsGroupPushRulesUpToDate checks whether the observed Gitlab group push
+// rules match the desired v1alpha1 parameters.
+func IsGroupPushRulesUpToDate(p *v1alpha1.GroupPushRulesParameters, r *gitlab.GroupPushRules) bool {
+	return common.IsPushRulesUpToDate(&p.PushRules, GeneratePushRules(r))
+}
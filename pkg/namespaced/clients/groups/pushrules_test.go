@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+)
+
+func TestGeneratePushRules(t *testing.T) {
+	cases := map[string]struct {
+		in   *gitlab.GroupPushRules
+		want *commonv1alpha1.PushRules
+	}{
+		"Nil":      {in: nil, want: nil},
+		"NullBody": {in: &gitlab.GroupPushRules{}, want: nil},
+		"Configured": {
+			in: &gitlab.GroupPushRules{ID: 1, CommitMessageRegex: "^JIRA-", MaxFileSize: 20, RejectUnsignedCommits: true},
+			want: &commonv1alpha1.PushRules{
+				AuthorEmailRegex:           ptr.To(""),
+				BranchNameRegex:            ptr.To(""),
+				CommitCommitterCheck:       ptr.To(false),
+				CommitCommitterNameCheck:   ptr.To(false),
+				CommitMessageNegativeRegex: ptr.To(""),
+				CommitMessageRegex:         ptr.To("^JIRA-"),
+				DenyDeleteTag:              ptr.To(false),
+				FileNameRegex:              ptr.To(""),
+				MaxFileSize:                ptr.To(int64(20)),
+				MemberCheck:                ptr.To(false),
+				PreventSecrets:             ptr.To(false),
+				RejectUnsignedCommits:      ptr.To(true),
+				RejectNonDCOCommits:        ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GeneratePushRules(tc.in)); diff != "" {
+				t.Errorf("GeneratePushRules(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateGroupPushRulesObservation(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	got := GenerateGroupPushRulesObservation(&gitlab.GroupPushRules{ID: 3, CreatedAt: &created})
+	want := v1alpha1.GroupPushRulesObservation{ID: 3, CreatedAt: &metav1.Time{Time: created}}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateGroupPushRulesObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateGroupPushRuleOptions(t *testing.T) {
+	p := &v1alpha1.GroupPushRulesParameters{
+		PushRules: commonv1alpha1.PushRules{BranchNameRegex: ptr.To("^main$"), PreventSecrets: ptr.To(true)},
+		GroupID:   ptr.To(int64(1)),
+	}
+
+	wantAdd := &gitlab.AddGroupPushRuleOptions{BranchNameRegex: ptr.To("^main$"), PreventSecrets: ptr.To(true)}
+	if diff := cmp.Diff(wantAdd, GenerateAddGroupPushRuleOptions(p)); diff != "" {
+		t.Errorf("GenerateAddGroupPushRuleOptions(...): -want, +got:\n%s", diff)
+	}
+
+	wantEdit := &gitlab.EditGroupPushRuleOptions{BranchNameRegex: ptr.To("^main$"), PreventSecrets: ptr.To(true)}
+	if diff := cmp.Diff(wantEdit, GenerateEditGroupPushRuleOptions(p)); diff != "" {
+		t.Errorf("GenerateEditGroupPushRuleOptions(...): -want, +got:\n%s", diff)
+	}
+}
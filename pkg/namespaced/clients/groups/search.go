@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+const (
+	errSearchGroups   = "cannot search groups"
+	errNoGroupMatches = "no group matches %s"
+	errGroupAmbiguous = "%d groups match %s, the search must be unique"
+
+	searchPageSize = 100
+)
+
+// SearchClient defines the GitLab group operations needed to resolve a group
+// by searching for it.
+type SearchClient interface {
+	ListGroups(opt *gitlab.ListGroupsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error)
+}
+
+// NewSearchClient returns a new GitLab group service
+func NewSearchClient(cfg common.Config) SearchClient {
+	git := common.NewClient(cfg)
+	return git.Groups
+}
+
+// SearchGroupID returns the ID of the only group matching the search. The
+// name, path and full path of the parent group of a group must match
+// exactly; top-level groups have an empty namespace. It returns an error if
+// no or more than one group matches.
+func SearchGroupID(ctx context.Context, c SearchClient, s *v1alpha1.GitLabSearch) (int64, error) {
+	opt := &gitlab.ListGroupsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: searchPageSize, Page: 1},
+		Search:       ptr.To(clients.SearchTerm(s)),
+		AllAvailable: ptr.To(true),
+	}
+
+	var ids []int64
+	for {
+		grps, res, err := c.ListGroups(opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, errors.Wrap(err, errSearchGroups)
+		}
+		for _, g := range grps {
+			if clients.MatchesSearch(s, g.Name, g.Path, parentPath(g.FullPath)) {
+				ids = append(ids, g.ID)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, errors.Errorf(errNoGroupMatches, clients.DescribeSearch(s))
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, errors.Errorf(errGroupAmbiguous, len(ids), clients.DescribeSearch(s))
+	}
+}
+
+// parentPath returns the full path of the parent group of a group, or an
+// empty string for top-level groups.
+func parentPath(fullPath string) string {
+	if i := strings.LastIndex(fullPath, "/"); i >= 0 {
+		return fullPath[:i]
+	}
+	return ""
+}
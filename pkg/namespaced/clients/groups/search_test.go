@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+type fakeSearchClient struct {
+	groups []*gitlab.Group
+	err    error
+}
+
+func (c *fakeSearchClient) ListGroups(_ *gitlab.ListGroupsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+	return c.groups, &gitlab.Response{}, c.err
+}
+
+func TestSearchGroupID(t *testing.T) {
+	errBoom := errors.New("boom")
+	groups := []*gitlab.Group{
+		{ID: 1, Name: "Platform", Path: "platform", FullPath: "platform"},
+		{ID: 2, Name: "Platform", Path: "platform", FullPath: "engineering/platform"},
+		{ID: 3, Name: "Platform Tools", Path: "platform-tools", FullPath: "engineering/platform-tools"},
+	}
+
+	cases := map[string]struct {
+		search  *v1alpha1.GitLabSearch
+		err     error
+		want    int64
+		errWant error
+	}{
+		"UniqueMatch": {
+			search: &v1alpha1.GitLabSearch{Path: ptr.To("platform"), Namespace: ptr.To("engineering")},
+			want:   2,
+		},
+		"UniqueTopLevelMatch": {
+			search: &v1alpha1.GitLabSearch{Path: ptr.To("platform"), Namespace: ptr.To("")},
+			want:   1,
+		},
+		"AmbiguousMatch": {
+			search:  &v1alpha1.GitLabSearch{Name: ptr.To("Platform")},
+			errWant: errors.Errorf(errGroupAmbiguous, 2, "name=Platform"),
+		},
+		"NoMatch": {
+			search:  &v1alpha1.GitLabSearch{Name: ptr.To("Platform"), Namespace: ptr.To("sales")},
+			errWant: errors.Errorf(errNoGroupMatches, "name=Platform, namespace=sales"),
+		},
+		"ListFailed": {
+			search:  &v1alpha1.GitLabSearch{Path: ptr.To("platform")},
+			err:     errBoom,
+			errWant: errors.Wrap(errBoom, errSearchGroups),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SearchGroupID(context.Background(), &fakeSearchClient{groups: groups, err: tc.err}, tc.search)
+			if diff := cmp.Diff(tc.errWant, err, test.EquateErrors()); diff != "" {
+				t.Errorf("SearchGroupID(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SearchGroupID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// ServiceAccountAccessTokenClient defines Gitlab group service account access token operations
+type ServiceAccountAccessTokenClient interface {
+	ListServiceAccountPersonalAccessTokens(gid any, serviceAccount int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	CreateServiceAccountPersonalAccessToken(gid any, serviceAccount int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	RevokeServiceAccountPersonalAccessToken(gid any, serviceAccount, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewServiceAccountAccessTokenClient returns a new Gitlab group service account access token service
+func NewServiceAccountAccessTokenClient(cfg common.Config) ServiceAccountAccessTokenClient {
+	git := common.NewClient(cfg)
+	return git.Groups
+}
+
+// GenerateCreateServiceAccountAccessTokenOptions generates service account access token creation options
+func GenerateCreateServiceAccountAccessTokenOptions(p *v1alpha1.ServiceAccountAccessTokenParameters) *gitlab.CreateServiceAccountPersonalAccessTokenOptions {
+	opt := &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+		Name:        &p.Name,
+		Description: p.Description,
+		Scopes:      &p.Scopes,
+	}
+
+	if p.ExpiresAt != nil {
+		opt.ExpiresAt = (*gitlab.ISOTime)(&p.ExpiresAt.Time)
+	}
+
+	return opt
+}
+
+// GenerateServiceAccountAccessTokenObservation generates service account access token observation from gitlab.PersonalAccessToken
+func GenerateServiceAccountAccessTokenObservation(at *gitlab.PersonalAccessToken) v1alpha1.ServiceAccountAccessTokenObservation {
+	if at == nil {
+		return v1alpha1.ServiceAccountAccessTokenObservation{}
+	}
+
+	return v1alpha1.ServiceAccountAccessTokenObservation{
+		ID:          at.ID,
+		Name:        at.Name,
+		Description: at.Description,
+		UserID:      at.UserID,
+		Scopes:      at.Scopes,
+		ExpiresAt:   common.TimeToMetaTime((*time.Time)(at.ExpiresAt)),
+		Active:      at.Active,
+		CreatedAt:   common.TimeToMetaTime(at.CreatedAt),
+		Revoked:     at.Revoked,
+	}
+}
+
+// FindServiceAccountAccessToken returns the token with the given ID from a
+// list of service account access tokens, or nil if there is none.
+func FindServiceAccountAccessToken(tokens []*gitlab.PersonalAccessToken, id int64) *gitlab.PersonalAccessToken {
+	for _, t := range tokens {
+		if t != nil && t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
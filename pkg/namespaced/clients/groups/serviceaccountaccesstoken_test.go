@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+)
+
+func TestGenerateCreateServiceAccountAccessTokenOptions(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		p    *v1alpha1.ServiceAccountAccessTokenParameters
+		want *gitlab.CreateServiceAccountPersonalAccessTokenOptions
+	}{
+		"Minimal": {
+			p: &v1alpha1.ServiceAccountAccessTokenParameters{Name: "ci", Scopes: []string{"api"}},
+			want: &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+				Name:   ptr.To("ci"),
+				Scopes: &[]string{"api"},
+			},
+		},
+		"Full": {
+			p: &v1alpha1.ServiceAccountAccessTokenParameters{
+				Name:        "ci",
+				Description: ptr.To("ci token"),
+				Scopes:      []string{"api", "read_repository"},
+				ExpiresAt:   &metav1.Time{Time: expiresAt},
+			},
+			want: &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+				Name:        ptr.To("ci"),
+				Description: ptr.To("ci token"),
+				Scopes:      &[]string{"api", "read_repository"},
+				ExpiresAt:   (*gitlab.ISOTime)(&expiresAt),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateServiceAccountAccessTokenOptions(tc.p)
+			isoTimeEqual := cmp.Comparer(func(a, b gitlab.ISOTime) bool { return time.Time(a).Equal(time.Time(b)) })
+			if diff := cmp.Diff(tc.want, got, isoTimeEqual); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindServiceAccountAccessToken(t *testing.T) {
+	tokens := []*gitlab.PersonalAccessToken{{ID: 1}, nil, {ID: 2}}
+
+	cases := map[string]struct {
+		id   int64
+		want *gitlab.PersonalAccessToken
+	}{
+		"Found":    {id: 2, want: tokens[2]},
+		"NotFound": {id: 3, want: nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindServiceAccountAccessToken(tokens, tc.id)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package groups
 
 import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
@@ -46,7 +47,7 @@ func GenerateVariableObservation(variable *gitlab.GroupVariable) v1alpha1.Variab
 		CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
 			Key:          variable.Key,
 			Description:  variable.Description,
-			VariableType: commonv1alpha1.VariableType(variable.VariableType),
+			VariableType: common.VariableType(variable.VariableType),
 			Protected:    variable.Protected,
 			Masked:       variable.Masked,
 			Raw:          variable.Raw,
@@ -64,7 +65,7 @@ func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.Gr
 	}
 
 	if in.VariableType == nil {
-		in.VariableType = (*commonv1alpha1.VariableType)(&variable.VariableType)
+		in.VariableType = ptr.To(common.VariableType(variable.VariableType))
 	}
 
 	if in.Description == nil {
@@ -117,6 +118,31 @@ func GenerateUpdateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.Updat
 	return variable
 }
 
+// OtherScopeVariables returns the variables with the key of the variable
+// parameters in environment scopes other than theirs.
+func OtherScopeVariables(variables []*gitlab.GroupVariable, p *v1alpha1.VariableParameters) []*gitlab.GroupVariable {
+	scope := ptr.Deref(p.EnvironmentScope, common.DefaultEnvironmentScope)
+	var others []*gitlab.GroupVariable
+	for _, v := range variables {
+		if v != nil && v.Key == p.Key && v.EnvironmentScope != scope {
+			others = append(others, v)
+		}
+	}
+	return others
+}
+
+// ScopeVariable returns the variable with the key and environment scope of
+// the variable parameters, or nil if there is none.
+func ScopeVariable(variables []*gitlab.GroupVariable, p *v1alpha1.VariableParameters) *gitlab.GroupVariable {
+	scope := ptr.Deref(p.EnvironmentScope, common.DefaultEnvironmentScope)
+	for _, v := range variables {
+		if v != nil && v.Key == p.Key && v.EnvironmentScope == scope {
+			return v
+		}
+	}
+	return nil
+}
+
 // GenerateVariableFilter generates a variable filter that matches the variable parameters' environment scope.
 func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilter {
 	if p.EnvironmentScope == nil {
@@ -128,6 +154,17 @@ func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilt
 	}
 }
 
+// GenerateRemoveVariableOptions generates group remove options. The
+// environment scope is always filtered, defaulting to *, so that variables
+// sharing the key in other scopes are never removed.
+func GenerateRemoveVariableOptions(p *v1alpha1.VariableParameters) *gitlab.RemoveGroupVariableOptions {
+	return &gitlab.RemoveGroupVariableOptions{
+		Filter: &gitlab.VariableFilter{
+			EnvironmentScope: ptr.Deref(p.EnvironmentScope, common.DefaultEnvironmentScope),
+		},
+	}
+}
+
 // GenerateGetVariableOptions generates group get options
 func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *gitlab.GetGroupVariableOptions {
 	variable := &gitlab.GetGroupVariableOptions{
@@ -157,7 +194,7 @@ func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.GroupVariable)
 		return false
 	}
 
-	if !clients.IsComparableEqualToComparablePtr((*string)(p.VariableType), (string)(g.VariableType)) {
+	if !clients.IsComparableEqualToComparablePtr(p.VariableType, common.VariableType(g.VariableType)) {
 		return false
 	}
 
@@ -19,6 +19,7 @@ package groups
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
@@ -251,6 +252,68 @@ func TestIsVariableUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"FileVariableMultilineValueMatches": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          groupVariableKey,
+						Value:        strPtr("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+						VariableType: variableTypePtr(commonv1alpha1.VariableTypeFile),
+					},
+				},
+				variable: &gitlab.GroupVariable{
+					Key:          groupVariableKey,
+					Value:        "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+					VariableType: gitlab.FileVariableType,
+				},
+			},
+			want: true,
+		},
+		"FileVariableMultilineValueDiffers": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          groupVariableKey,
+						Value:        strPtr("-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n"),
+						VariableType: variableTypePtr(commonv1alpha1.VariableTypeFile),
+					},
+				},
+				variable: &gitlab.GroupVariable{
+					Key:          groupVariableKey,
+					Value:        "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+					VariableType: gitlab.FileVariableType,
+				},
+			},
+			want: false,
+		},
+		"MissingVariableTypeIsEnvVar": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          groupVariableKey,
+						VariableType: variableTypePtr(commonv1alpha1.VariableTypeEnvVar),
+					},
+				},
+				variable: &gitlab.GroupVariable{
+					Key: groupVariableKey,
+				},
+			},
+			want: true,
+		},
+		"FileVariableDiffersFromMissingVariableType": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          groupVariableKey,
+						VariableType: variableTypePtr(commonv1alpha1.VariableTypeFile),
+					},
+				},
+				variable: &gitlab.GroupVariable{
+					Key: groupVariableKey,
+				},
+			},
+			want: false,
+		},
 		"SpecNil": {
 			args: args{
 				p:        nil,
@@ -280,3 +343,34 @@ func TestIsVariableUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRemoveVariableOptions(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want *gitlab.RemoveGroupVariableOptions
+	}{
+		"Scope": {
+			p: &v1alpha1.VariableParameters{
+				EnvironmentScope: strPtr("production"),
+			},
+			want: &gitlab.RemoveGroupVariableOptions{
+				Filter: &gitlab.VariableFilter{EnvironmentScope: "production"},
+			},
+		},
+		"NoScope": {
+			p: &v1alpha1.VariableParameters{},
+			want: &gitlab.RemoveGroupVariableOptions{
+				Filter: &gitlab.VariableFilter{EnvironmentScope: "*"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRemoveVariableOptions(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRemoveVariableOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
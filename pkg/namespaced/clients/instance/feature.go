@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// Keys of the feature flag gates GitLab reports.
+const (
+	FeatureGateBoolean            = "boolean"
+	FeatureGatePercentageOfTime   = "percentage_of_time"
+	FeatureGatePercentageOfActors = "percentage_of_actors"
+	FeatureGateActors             = "actors"
+)
+
+// Types of the actors a feature flag can be enabled for.
+const (
+	FeatureActorGroup   = "Group"
+	FeatureActorProject = "Project"
+)
+
+// FeatureClient defines Gitlab Features service operations
+type FeatureClient interface {
+	ListFeatures(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error)
+	SetFeatureFlag(name string, opt *gitlab.SetFeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Feature, *gitlab.Response, error)
+	DeleteFeatureFlag(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewFeatureClient returns a new Gitlab Features service
+func NewFeatureClient(cfg common.Config) FeatureClient {
+	git := common.NewClient(cfg)
+	return git.Features
+}
+
+// FindFeature returns the feature flag with the given name, or nil if GitLab
+// does not know it.
+func FindFeature(features []*gitlab.Feature, name string) *gitlab.Feature {
+	for _, f := range features {
+		if f != nil && f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// FeatureActorID returns the ID GitLab reports in the actors gate for the
+// actor of the given type, e.g. Project:42.
+func FeatureActorID(actorType string, id int64) string {
+	return fmt.Sprintf("%s:%d", actorType, id)
+}
+
+// ParseFeatureActorID returns the type and ID of an actor reported in the
+// actors gate. ok is false if the actor is not of the form Type:ID.
+func ParseFeatureActorID(actor string) (actorType string, id int64, ok bool) {
+	actorType, s, found := strings.Cut(actor, ":")
+	if !found {
+		return "", 0, false
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return actorType, id, true
+}
+
+// GenerateFeatureObservation generates a FeatureObservation from the gates of
+// a Gitlab feature flag.
+func GenerateFeatureObservation(f *gitlab.Feature) v1alpha1.FeatureObservation {
+	if f == nil {
+		return v1alpha1.FeatureObservation{}
+	}
+	o := v1alpha1.FeatureObservation{State: f.State}
+	for _, g := range f.Gates {
+		switch g.Key {
+		case FeatureGateBoolean:
+			o.Enabled, _ = g.Value.(bool)
+		case FeatureGatePercentageOfTime:
+			o.PercentageOfTime = gateInt(g.Value)
+		case FeatureGatePercentageOfActors:
+			o.PercentageOfActors = gateInt(g.Value)
+		case FeatureGateActors:
+			actors, _ := g.Value.([]any)
+			for _, a := range actors {
+				if s, ok := a.(string); ok {
+					o.Actors = append(o.Actors, s)
+				}
+			}
+			slices.Sort(o.Actors)
+		}
+	}
+	return o
+}
+
+// gateInt returns the value of a percentage gate. GitLab reports it as a
+// number, which is decoded as float64, but older versions use a string.
+func gateInt(v any) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// IsFeatureUpToDate checks whether the boolean and percentage gates of the
+// observed feature flag match the parameters. Actor gates are compared with
+// DiffFeatureActors, since they require looking up the actors first.
+func IsFeatureUpToDate(p *v1alpha1.FeatureParameters, o *v1alpha1.FeatureObservation) bool {
+	if p.Enabled != nil && *p.Enabled != o.Enabled {
+		return false
+	}
+	if p.PercentageOfTime != nil && *p.PercentageOfTime != o.PercentageOfTime {
+		return false
+	}
+	if p.PercentageOfActors != nil && *p.PercentageOfActors != o.PercentageOfActors {
+		return false
+	}
+	return true
+}
+
+// DiffFeatureActors returns the desired actors of the given type the feature
+// flag is not enabled for yet, and the observed actors of that type it must
+// be disabled for.
+func DiffFeatureActors(actorType string, desired, observed []string) (add, remove []string) {
+	for _, a := range desired {
+		if !slices.Contains(observed, a) {
+			add = append(add, a)
+		}
+	}
+	for _, a := range observed {
+		if t, _, ok := ParseFeatureActorID(a); ok && t == actorType && !slices.Contains(desired, a) {
+			remove = append(remove, a)
+		}
+	}
+	return add, remove
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+)
+
+func TestGenerateFeatureObservation(t *testing.T) {
+	cases := map[string]struct {
+		f    *gitlab.Feature
+		want v1alpha1.FeatureObservation
+	}{
+		"Nil": {},
+		"Gates": {
+			f: &gitlab.Feature{
+				Name:  "my_feature",
+				State: "conditional",
+				Gates: []gitlab.Gate{
+					{Key: "boolean", Value: false},
+					{Key: "percentage_of_time", Value: float64(30)},
+					{Key: "percentage_of_actors", Value: "20"},
+					{Key: "actors", Value: []any{"Project:2", "Group:1"}},
+				},
+			},
+			want: v1alpha1.FeatureObservation{
+				State:              "conditional",
+				PercentageOfTime:   30,
+				PercentageOfActors: 20,
+				Actors:             []string{"Group:1", "Project:2"},
+			},
+		},
+		"Enabled": {
+			f:    &gitlab.Feature{Name: "my_feature", State: "on", Gates: []gitlab.Gate{{Key: "boolean", Value: true}}},
+			want: v1alpha1.FeatureObservation{State: "on", Enabled: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateFeatureObservation(tc.f)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateFeatureObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFeatureUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.FeatureParameters
+		o    v1alpha1.FeatureObservation
+		want bool
+	}{
+		"Unmanaged": {
+			o:    v1alpha1.FeatureObservation{Enabled: true, PercentageOfTime: 10},
+			want: true,
+		},
+		"Equal": {
+			p:    v1alpha1.FeatureParameters{Enabled: ptr.To(false), PercentageOfActors: ptr.To[int64](0)},
+			want: true,
+		},
+		"EnabledDiffers": {
+			p: v1alpha1.FeatureParameters{Enabled: ptr.To(true)},
+		},
+		"PercentageOfTimeDiffers": {
+			p: v1alpha1.FeatureParameters{PercentageOfTime: ptr.To[int64](50)},
+			o: v1alpha1.FeatureObservation{PercentageOfTime: 10},
+		},
+		"PercentageOfActorsDiffers": {
+			p: v1alpha1.FeatureParameters{PercentageOfActors: ptr.To[int64](50)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsFeatureUpToDate(&tc.p, &tc.o); got != tc.want {
+				t.Errorf("IsFeatureUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffFeatureActors(t *testing.T) {
+	observed := []string{"Group:1", "Project:2", "Project:3", "User:4", "flipper"}
+	add, remove := DiffFeatureActors(FeatureActorProject, []string{"Project:2", "Project:5"}, observed)
+	if diff := cmp.Diff([]string{"Project:5"}, add); diff != "" {
+		t.Errorf("DiffFeatureActors(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Project:3"}, remove); diff != "" {
+		t.Errorf("DiffFeatureActors(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFindFeature(t *testing.T) {
+	features := []*gitlab.Feature{{Name: "a"}, nil, {Name: "b"}}
+	if got := FindFeature(features, "b"); got != features[2] {
+		t.Errorf("FindFeature(...): want %v, got %v", features[2], got)
+	}
+	if got := FindFeature(features, "c"); got != nil {
+		t.Errorf("FindFeature(...): want nil, got %v", got)
+	}
+}
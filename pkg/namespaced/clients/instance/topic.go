@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// TopicClient defines Gitlab Topics service operations
+type TopicClient interface {
+	GetTopic(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	CreateTopic(opt *gitlab.CreateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	UpdateTopic(topic int64, opt *gitlab.UpdateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	DeleteTopic(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewTopicClient returns a new Gitlab Topics service
+func NewTopicClient(cfg common.Config) TopicClient {
+	git := common.NewClient(cfg)
+	return git.Topics
+}
+
+// GenerateTopicObservation generates a TopicObservation from a Gitlab topic.
+// The avatar hash is not known to GitLab and left empty.
+func GenerateTopicObservation(t *gitlab.Topic) v1alpha1.TopicObservation {
+	if t == nil {
+		return v1alpha1.TopicObservation{}
+	}
+	return v1alpha1.TopicObservation{
+		ID:                 t.ID,
+		Name:               t.Name,
+		Title:              t.Title,
+		Description:        t.Description,
+		TotalProjectsCount: t.TotalProjectsCount,
+		AvatarURL:          t.AvatarURL,
+	}
+}
+
+// GenerateCreateTopicOptions generates Gitlab CreateTopicOptions from
+// TopicParameters. The avatar is uploaded separately.
+func GenerateCreateTopicOptions(p *v1alpha1.TopicParameters) *gitlab.CreateTopicOptions {
+	return &gitlab.CreateTopicOptions{
+		Name:        &p.Name,
+		Title:       &p.Title,
+		Description: p.Description,
+	}
+}
+
+// GenerateUpdateTopicOptions generates Gitlab UpdateTopicOptions from
+// TopicParameters. The avatar is uploaded separately.
+func GenerateUpdateTopicOptions(p *v1alpha1.TopicParameters) *gitlab.UpdateTopicOptions {
+	return &gitlab.UpdateTopicOptions{
+		Name:        &p.Name,
+		Title:       &p.Title,
+		Description: p.Description,
+	}
+}
+
+// LateInitializeTopic fills the empty fields of the topic parameters with
+// the observed ones.
+func LateInitializeTopic(p *v1alpha1.TopicParameters, t *gitlab.Topic) {
+	if t == nil {
+		return
+	}
+	p.Description = clients.LateInitializeStringPtr(p.Description, t.Description)
+}
+
+// IsTopicUpToDate checks whether the text fields of the observed topic match
+// the parameters. The avatar is compared by its hash by the caller.
+func IsTopicUpToDate(p *v1alpha1.TopicParameters, t *gitlab.Topic) bool {
+	if t == nil {
+		return false
+	}
+	return p.Name == t.Name &&
+		p.Title == t.Title &&
+		clients.IsComparableEqualToComparablePtr(p.Description, t.Description)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+)
+
+func TestGenerateTopicOptions(t *testing.T) {
+	p := &v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Description: ptr.To("desc")}
+
+	wantCreate := &gitlab.CreateTopicOptions{Name: ptr.To("crossplane"), Title: ptr.To("Crossplane"), Description: ptr.To("desc")}
+	if diff := cmp.Diff(wantCreate, GenerateCreateTopicOptions(p)); diff != "" {
+		t.Errorf("GenerateCreateTopicOptions(...): -want, +got:\n%s", diff)
+	}
+	wantUpdate := &gitlab.UpdateTopicOptions{Name: ptr.To("crossplane"), Title: ptr.To("Crossplane"), Description: ptr.To("desc")}
+	if diff := cmp.Diff(wantUpdate, GenerateUpdateTopicOptions(p)); diff != "" {
+		t.Errorf("GenerateUpdateTopicOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTopic(t *testing.T) {
+	p := &v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane"}
+	LateInitializeTopic(p, &gitlab.Topic{Name: "crossplane", Title: "Other", Description: "desc"})
+
+	want := &v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Description: ptr.To("desc")}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeTopic(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTopicUpToDate(t *testing.T) {
+	observed := &gitlab.Topic{ID: 1, Name: "crossplane", Title: "Crossplane", Description: "desc", AvatarURL: "https://gitlab.example.com/avatar.png"}
+
+	cases := map[string]struct {
+		p    v1alpha1.TopicParameters
+		t    *gitlab.Topic
+		want bool
+	}{
+		"NotObserved": {
+			p: v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane"},
+		},
+		"UpToDate": {
+			p:    v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Description: ptr.To("desc")},
+			t:    observed,
+			want: true,
+		},
+		"DescriptionUnmanaged": {
+			p:    v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane"},
+			t:    observed,
+			want: true,
+		},
+		"NameChanged": {
+			p: v1alpha1.TopicParameters{Name: "crossplane-io", Title: "Crossplane"},
+			t: observed,
+		},
+		"TitleChanged": {
+			p: v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane.io"},
+			t: observed,
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Description: ptr.To("other")},
+			t: observed,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsTopicUpToDate(&tc.p, tc.t); got != tc.want {
+				t.Errorf("IsTopicUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// UserImpersonationTokenClient defines the Gitlab Users service operations
+// on impersonation tokens.
+type UserImpersonationTokenClient interface {
+	GetImpersonationToken(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error)
+	CreateImpersonationToken(user int64, opt *gitlab.CreateImpersonationTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error)
+	RevokeImpersonationToken(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewUserImpersonationTokenClient returns a new Gitlab Users service
+func NewUserImpersonationTokenClient(cfg common.Config) UserImpersonationTokenClient {
+	git := common.NewClient(cfg)
+	return git.Users
+}
+
+// GenerateCreateImpersonationTokenOptions generates Gitlab
+// CreateImpersonationTokenOptions from UserImpersonationTokenParameters.
+func GenerateCreateImpersonationTokenOptions(p *v1alpha1.UserImpersonationTokenParameters) *gitlab.CreateImpersonationTokenOptions {
+	opt := &gitlab.CreateImpersonationTokenOptions{
+		Name:   &p.Name,
+		Scopes: &p.Scopes,
+	}
+
+	if p.ExpiresAt != nil {
+		opt.ExpiresAt = &p.ExpiresAt.Time
+	} else if p.RenewalPeriodDays != nil {
+		opt.ExpiresAt = (*time.Time)(common.GenerateRenewalExpiration(*p.RenewalPeriodDays))
+	}
+
+	return opt
+}
+
+// GenerateUserImpersonationTokenObservation generates a
+// UserImpersonationTokenObservation from a Gitlab impersonation token.
+func GenerateUserImpersonationTokenObservation(t *gitlab.ImpersonationToken) v1alpha1.UserImpersonationTokenObservation {
+	if t == nil {
+		return v1alpha1.UserImpersonationTokenObservation{}
+	}
+
+	return v1alpha1.UserImpersonationTokenObservation{
+		ID:         t.ID,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		ExpiresAt:  common.TimeToMetaTime((*time.Time)(t.ExpiresAt)),
+		Active:     t.Active,
+		Revoked:    t.Revoked,
+		CreatedAt:  common.TimeToMetaTime(t.CreatedAt),
+		LastUsedAt: common.TimeToMetaTime(t.LastUsedAt),
+	}
+}
+
+// ShouldRotateImpersonationToken returns true when the token must be
+// replaced: it is inactive, or ExpiresAt is set and the actual expiry does
+// not match.
+func ShouldRotateImpersonationToken(p *v1alpha1.UserImpersonationTokenParameters, t *gitlab.ImpersonationToken) bool {
+	if t == nil {
+		return true
+	}
+
+	var desiredExpiresAt *time.Time
+	if p != nil && p.ExpiresAt != nil {
+		desiredExpiresAt = &p.ExpiresAt.Time
+	}
+
+	return common.ShouldRotateToken(t.Active, t.ExpiresAt, desiredExpiresAt)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+)
+
+func TestGenerateCreateImpersonationTokenOptions(t *testing.T) {
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	p := &v1alpha1.UserImpersonationTokenParameters{Name: "ci-bot", Scopes: []string{"api"}, ExpiresAt: &metav1.Time{Time: expiresAt}}
+	want := &gitlab.CreateImpersonationTokenOptions{Name: ptr.To("ci-bot"), Scopes: &[]string{"api"}, ExpiresAt: &expiresAt}
+	if diff := cmp.Diff(want, GenerateCreateImpersonationTokenOptions(p)); diff != "" {
+		t.Errorf("GenerateCreateImpersonationTokenOptions(...): -want, +got:\n%s", diff)
+	}
+
+	renewal := GenerateCreateImpersonationTokenOptions(&v1alpha1.UserImpersonationTokenParameters{Name: "ci-bot", Scopes: []string{"api"}, RenewalPeriodDays: ptr.To(30)})
+	if renewal.ExpiresAt == nil || renewal.ExpiresAt.Before(time.Now().AddDate(0, 0, 29)) {
+		t.Errorf("GenerateCreateImpersonationTokenOptions(...): want expiry in 30 days, got %v", renewal.ExpiresAt)
+	}
+}
+
+func TestShouldRotateImpersonationToken(t *testing.T) {
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		p    v1alpha1.UserImpersonationTokenParameters
+		t    *gitlab.ImpersonationToken
+		want bool
+	}{
+		"NotObserved": {
+			want: true,
+		},
+		"Active": {
+			p: v1alpha1.UserImpersonationTokenParameters{ExpiresAt: &metav1.Time{Time: expiresAt}},
+			t: &gitlab.ImpersonationToken{Active: true, ExpiresAt: (*gitlab.ISOTime)(&expiresAt)},
+		},
+		"Inactive": {
+			p:    v1alpha1.UserImpersonationTokenParameters{RenewalPeriodDays: ptr.To(30)},
+			t:    &gitlab.ImpersonationToken{Active: false, ExpiresAt: (*gitlab.ISOTime)(&expiresAt)},
+			want: true,
+		},
+		"ExpiryChanged": {
+			p:    v1alpha1.UserImpersonationTokenParameters{ExpiresAt: &metav1.Time{Time: expiresAt.AddDate(0, 0, 1)}},
+			t:    &gitlab.ImpersonationToken{Active: true, ExpiresAt: (*gitlab.ISOTime)(&expiresAt)},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ShouldRotateImpersonationToken(&tc.p, tc.t); got != tc.want {
+				t.Errorf("ShouldRotateImpersonationToken(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
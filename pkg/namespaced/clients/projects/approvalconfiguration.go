@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// ApprovalConfigurationClient defines GitLab project approval configuration
+// service operations
+type ApprovalConfigurationClient interface {
+	GetApprovalConfiguration(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	ChangeApprovalConfiguration(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+}
+
+// NewApprovalConfigurationClient returns a new GitLab project approval
+// configuration service
+func NewApprovalConfigurationClient(cfg common.Config) ApprovalConfigurationClient {
+	git := common.NewClient(cfg)
+	return git.Projects
+}
+
+// GenerateApprovalConfigurationObservation produces an
+// ApprovalConfigurationObservation from a gitlab.ProjectApprovals.
+func GenerateApprovalConfigurationObservation(a *gitlab.ProjectApprovals) v1alpha1.ApprovalConfigurationObservation {
+	if a == nil {
+		return v1alpha1.ApprovalConfigurationObservation{}
+	}
+
+	return v1alpha1.ApprovalConfigurationObservation{
+		ResetApprovalsOnPush:                      a.ResetApprovalsOnPush,
+		SelectiveCodeOwnerRemovals:                a.SelectiveCodeOwnerRemovals,
+		DisableOverridingApproversPerMergeRequest: a.DisableOverridingApproversPerMergeRequest,
+		MergeRequestsAuthorApproval:               a.MergeRequestsAuthorApproval,
+		MergeRequestsDisableCommittersApproval:    a.MergeRequestsDisableCommittersApproval,
+		RequirePasswordToApprove:                  a.RequirePasswordToApprove,
+	}
+}
+
+// GenerateChangeApprovalConfigurationOptions generates approval
+// configuration change options. Unset settings are omitted so that GitLab
+// keeps them, settings set to false are sent.
+func GenerateChangeApprovalConfigurationOptions(p *v1alpha1.ApprovalConfigurationParameters) *gitlab.ChangeApprovalConfigurationOptions {
+	return &gitlab.ChangeApprovalConfigurationOptions{
+		ResetApprovalsOnPush:                      p.ResetApprovalsOnPush,
+		SelectiveCodeOwnerRemovals:                p.SelectiveCodeOwnerRemovals,
+		DisableOverridingApproversPerMergeRequest: p.DisableOverridingApproversPerMergeRequest,
+		MergeRequestsAuthorApproval:               p.MergeRequestsAuthorApproval,
+		MergeRequestsDisableCommittersApproval:    p.MergeRequestsDisableCommittersApproval,
+		RequirePasswordToApprove:                  p.RequirePasswordToApprove,
+	}
+}
+
+// LateInitializeApprovalConfiguration fills the empty fields in the approval
+// configuration spec with the values seen in gitlab.ProjectApprovals.
+func LateInitializeApprovalConfiguration(in *v1alpha1.ApprovalConfigurationParameters, a *gitlab.ProjectApprovals) {
+	if a == nil {
+		return
+	}
+
+	in.ResetApprovalsOnPush = clients.LateInitializeFromValue(in.ResetApprovalsOnPush, a.ResetApprovalsOnPush)
+	// Selective code owner removals cannot be combined with resetting all
+	// approvals, late initializing them would fail the spec validation.
+	if !ptr.Deref(in.ResetApprovalsOnPush, false) {
+		in.SelectiveCodeOwnerRemovals = clients.LateInitializeFromValue(in.SelectiveCodeOwnerRemovals, a.SelectiveCodeOwnerRemovals)
+	}
+	in.DisableOverridingApproversPerMergeRequest = clients.LateInitializeFromValue(in.DisableOverridingApproversPerMergeRequest, a.DisableOverridingApproversPerMergeRequest)
+	in.MergeRequestsAuthorApproval = clients.LateInitializeFromValue(in.MergeRequestsAuthorApproval, a.MergeRequestsAuthorApproval)
+	in.MergeRequestsDisableCommittersApproval = clients.LateInitializeFromValue(in.MergeRequestsDisableCommittersApproval, a.MergeRequestsDisableCommittersApproval)
+	in.RequirePasswordToApprove = clients.LateInitializeFromValue(in.RequirePasswordToApprove, a.RequirePasswordToApprove)
+}
+
+// IsApprovalConfigurationUpToDate checks whether the observed approval
+// configuration matches the desired one. Unset settings are not compared.
+func IsApprovalConfigurationUpToDate(p *v1alpha1.ApprovalConfigurationParameters, a *gitlab.ProjectApprovals) bool {
+	if a == nil {
+		return false
+	}
+
+	return clients.IsComparableEqualToComparablePtr(p.ResetApprovalsOnPush, a.ResetApprovalsOnPush) &&
+		clients.IsComparableEqualToComparablePtr(p.SelectiveCodeOwnerRemovals, a.SelectiveCodeOwnerRemovals) &&
+		clients.IsComparableEqualToComparablePtr(p.DisableOverridingApproversPerMergeRequest, a.DisableOverridingApproversPerMergeRequest) &&
+		clients.IsComparableEqualToComparablePtr(p.MergeRequestsAuthorApproval, a.MergeRequestsAuthorApproval) &&
+		clients.IsComparableEqualToComparablePtr(p.MergeRequestsDisableCommittersApproval, a.MergeRequestsDisableCommittersApproval) &&
+		clients.IsComparableEqualToComparablePtr(p.RequirePasswordToApprove, a.RequirePasswordToApprove)
+}
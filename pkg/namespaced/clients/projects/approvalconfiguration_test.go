@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func TestGenerateChangeApprovalConfigurationOptions(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ApprovalConfigurationParameters
+		want *gitlab.ChangeApprovalConfigurationOptions
+	}{
+		"Unset": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{},
+			want: &gitlab.ChangeApprovalConfigurationOptions{},
+		},
+		"ResetOnPushDisabled": {
+			p: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush: ptr.To(false),
+			},
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush: ptr.To(false),
+			},
+		},
+		"SelectiveCodeOwnerRemovals": {
+			p: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:       ptr.To(false),
+				SelectiveCodeOwnerRemovals: ptr.To(true),
+			},
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush:       ptr.To(false),
+				SelectiveCodeOwnerRemovals: ptr.To(true),
+			},
+		},
+		"AllSettings": {
+			p: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:                      ptr.To(true),
+				SelectiveCodeOwnerRemovals:                ptr.To(false),
+				DisableOverridingApproversPerMergeRequest: ptr.To(true),
+				MergeRequestsAuthorApproval:               ptr.To(false),
+				MergeRequestsDisableCommittersApproval:    ptr.To(true),
+				RequirePasswordToApprove:                  ptr.To(true),
+			},
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush:                      ptr.To(true),
+				SelectiveCodeOwnerRemovals:                ptr.To(false),
+				DisableOverridingApproversPerMergeRequest: ptr.To(true),
+				MergeRequestsAuthorApproval:               ptr.To(false),
+				MergeRequestsDisableCommittersApproval:    ptr.To(true),
+				RequirePasswordToApprove:                  ptr.To(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateChangeApprovalConfigurationOptions(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateChangeApprovalConfigurationOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApprovalConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ApprovalConfigurationParameters
+		a    *gitlab.ProjectApprovals
+		want bool
+	}{
+		"Unset": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{},
+			a:    &gitlab.ProjectApprovals{ResetApprovalsOnPush: true, SelectiveCodeOwnerRemovals: true},
+			want: true,
+		},
+		"ResetOnPushEnabled": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{ResetApprovalsOnPush: ptr.To(true)},
+			a:    &gitlab.ProjectApprovals{ResetApprovalsOnPush: false},
+			want: false,
+		},
+		"ResetOnPushDisabled": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{ResetApprovalsOnPush: ptr.To(false)},
+			a:    &gitlab.ProjectApprovals{ResetApprovalsOnPush: true},
+			want: false,
+		},
+		"SelectiveCodeOwnerRemovalsEnabled": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{SelectiveCodeOwnerRemovals: ptr.To(true)},
+			a:    &gitlab.ProjectApprovals{SelectiveCodeOwnerRemovals: false},
+			want: false,
+		},
+		"SelectiveCodeOwnerRemovalsDisabled": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{SelectiveCodeOwnerRemovals: ptr.To(false)},
+			a:    &gitlab.ProjectApprovals{SelectiveCodeOwnerRemovals: true},
+			want: false,
+		},
+		"UpToDate": {
+			p: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:       ptr.To(false),
+				SelectiveCodeOwnerRemovals: ptr.To(true),
+				RequirePasswordToApprove:   ptr.To(false),
+			},
+			a:    &gitlab.ProjectApprovals{SelectiveCodeOwnerRemovals: true},
+			want: true,
+		},
+		"ExternalNil": {
+			p:    &v1alpha1.ApprovalConfigurationParameters{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsApprovalConfigurationUpToDate(tc.p, tc.a); got != tc.want {
+				t.Errorf("IsApprovalConfigurationUpToDate(...) = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeApprovalConfiguration(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.ApprovalConfigurationParameters
+		a    *gitlab.ProjectApprovals
+		want *v1alpha1.ApprovalConfigurationParameters
+	}{
+		"Unset": {
+			in: &v1alpha1.ApprovalConfigurationParameters{},
+			a: &gitlab.ProjectApprovals{
+				SelectiveCodeOwnerRemovals:  true,
+				MergeRequestsAuthorApproval: true,
+			},
+			want: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:                      ptr.To(false),
+				SelectiveCodeOwnerRemovals:                ptr.To(true),
+				DisableOverridingApproversPerMergeRequest: ptr.To(false),
+				MergeRequestsAuthorApproval:               ptr.To(true),
+				MergeRequestsDisableCommittersApproval:    ptr.To(false),
+				RequirePasswordToApprove:                  ptr.To(false),
+			},
+		},
+		"FalseIsKept": {
+			in: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:       ptr.To(false),
+				SelectiveCodeOwnerRemovals: ptr.To(false),
+			},
+			a: &gitlab.ProjectApprovals{ResetApprovalsOnPush: true},
+			want: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:                      ptr.To(false),
+				SelectiveCodeOwnerRemovals:                ptr.To(false),
+				DisableOverridingApproversPerMergeRequest: ptr.To(false),
+				MergeRequestsAuthorApproval:               ptr.To(false),
+				MergeRequestsDisableCommittersApproval:    ptr.To(false),
+				RequirePasswordToApprove:                  ptr.To(false),
+			},
+		},
+		"ResetOnPushSkipsSelectiveCodeOwnerRemovals": {
+			in: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush: ptr.To(true),
+			},
+			a: &gitlab.ProjectApprovals{SelectiveCodeOwnerRemovals: true},
+			want: &v1alpha1.ApprovalConfigurationParameters{
+				ResetApprovalsOnPush:                      ptr.To(true),
+				DisableOverridingApproversPerMergeRequest: ptr.To(false),
+				MergeRequestsAuthorApproval:               ptr.To(false),
+				MergeRequestsDisableCommittersApproval:    ptr.To(false),
+				RequirePasswordToApprove:                  ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeApprovalConfiguration(tc.in, tc.a)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("LateInitializeApprovalConfiguration(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -143,6 +143,18 @@ func TestIsUserIDsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"ReorderedUserIDs": {
+			cr: &v1alpha1.ApprovalRuleParameters{
+				UserIDs: &[]int64{2, 1},
+			},
+			in: &gitlab.ProjectApprovalRule{
+				Users: []*gitlab.BasicUser{
+					{ID: 1},
+					{ID: 2},
+				},
+			},
+			want: true,
+		},
 		"DifferentUserIDs": {
 			cr: &v1alpha1.ApprovalRuleParameters{
 				UserIDs: &[]int64{1, 2},
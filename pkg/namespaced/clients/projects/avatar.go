@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MaxAvatarSize is the largest avatar image GitLab accepts, in bytes.
+const MaxAvatarSize = 200 * 1024
+
+const (
+	errAvatarEmpty           = "avatar image is empty"
+	errAvatarTooLarge        = "avatar image is %d bytes, at most %d bytes are allowed"
+	errAvatarUnsupportedType = "avatar image type %s is not supported"
+)
+
+// avatarExtensions maps the supported avatar content types to the file
+// extension GitLab expects for them.
+var avatarExtensions = map[string]string{
+	"image/png":    ".png",
+	"image/jpeg":   ".jpg",
+	"image/gif":    ".gif",
+	"image/bmp":    ".bmp",
+	"image/x-icon": ".ico",
+	"image/webp":   ".webp",
+}
+
+// ValidateAvatar checks that an avatar image is small enough and of a type
+// GitLab accepts. It returns a filename with an extension matching the
+// detected type, as GitLab validates uploads by their extension.
+func ValidateAvatar(image []byte) (string, error) {
+	if len(image) == 0 {
+		return "", errors.New(errAvatarEmpty)
+	}
+	if len(image) > MaxAvatarSize {
+		return "", errors.Errorf(errAvatarTooLarge, len(image), MaxAvatarSize)
+	}
+
+	contentType := http.DetectContentType(image)
+	ext, ok := avatarExtensions[contentType]
+	if !ok {
+		return "", errors.Errorf(errAvatarUnsupportedType, contentType)
+	}
+	return "avatar" + ext, nil
+}
+
+// HashAvatar returns the hex encoded SHA-256 hash of an avatar image.
+func HashAvatar(image []byte) string {
+	sum := sha256.Sum256(image)
+	return hex.EncodeToString(sum[:])
+}
+
+// IsCustomAvatarURL returns true if an avatar URL reported by GitLab points
+// to an uploaded avatar. Empty URLs and URLs derived from Gravatar, which
+// GitLab may report for entities without an uploaded avatar, are not.
+func IsCustomAvatarURL(avatarURL string) bool {
+	if avatarURL == "" {
+		return false
+	}
+	u, err := neturl.Parse(avatarURL)
+	if err != nil {
+		return true
+	}
+	host := strings.ToLower(u.Hostname())
+	return host != "gravatar.com" && !strings.HasSuffix(host, ".gravatar.com")
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestValidateAvatar(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	type want struct {
+		filename string
+		err      error
+	}
+
+	cases := map[string]struct {
+		image []byte
+		want  want
+	}{
+		"PNG": {
+			image: png,
+			want:  want{filename: "avatar.png"},
+		},
+		"JPEG": {
+			image: []byte("\xff\xd8\xff\xe0\x00\x10JFIF"),
+			want:  want{filename: "avatar.jpg"},
+		},
+		"GIF": {
+			image: []byte("GIF89a\x01\x00\x01\x00"),
+			want:  want{filename: "avatar.gif"},
+		},
+		"Empty": {
+			want: want{err: errors.New(errAvatarEmpty)},
+		},
+		"TooLarge": {
+			image: append(png, bytes.Repeat([]byte{0}, MaxAvatarSize)...),
+			want:  want{err: errors.Errorf(errAvatarTooLarge, len(png)+MaxAvatarSize, MaxAvatarSize)},
+		},
+		"UnsupportedType": {
+			image: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+			want:  want{err: errors.Errorf(errAvatarUnsupportedType, "text/plain; charset=utf-8")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			filename, err := ValidateAvatar(tc.image)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateAvatar(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.filename, filename); diff != "" {
+				t.Errorf("ValidateAvatar(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCustomAvatarURL(t *testing.T) {
+	cases := map[string]struct {
+		url  string
+		want bool
+	}{
+		"Empty": {},
+		"Uploaded": {
+			url:  "https://gitlab.example.com/uploads/-/system/project/avatar/1234/avatar.png",
+			want: true,
+		},
+		"Gravatar": {
+			url: "https://www.gravatar.com/avatar/205e460b479e2e5b48aec07710c08d50?s=80&d=identicon",
+		},
+		"SecureGravatar": {
+			url: "https://secure.gravatar.com/avatar/205e460b479e2e5b48aec07710c08d50?s=80&d=identicon",
+		},
+		"GravatarLookalike": {
+			url:  "https://notgravatar.com/avatar.png",
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsCustomAvatarURL(tc.url); got != tc.want {
+				t.Errorf("IsCustomAvatarURL(%q): want %t, got %t", tc.url, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// BranchClient defines GitLab Branch service operations
+type BranchClient interface {
+	GetBranch(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	CreateBranch(pid any, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	DeleteBranch(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewBranchClient returns a new GitLab Branch service
+func NewBranchClient(cfg common.Config) BranchClient {
+	git := common.NewClient(cfg)
+	return git.Branches
+}
+
+// GenerateBranchObservation produces a BranchObservation from a gitlab.Branch.
+// The ref is not returned by GitLab and has to be carried over by the caller.
+func GenerateBranchObservation(b *gitlab.Branch) v1alpha1.BranchObservation {
+	if b == nil {
+		return v1alpha1.BranchObservation{}
+	}
+
+	o := v1alpha1.BranchObservation{
+		Name:               b.Name,
+		Protected:          b.Protected,
+		Merged:             b.Merged,
+		Default:            b.Default,
+		CanPush:            b.CanPush,
+		DevelopersCanPush:  b.DevelopersCanPush,
+		DevelopersCanMerge: b.DevelopersCanMerge,
+		WebURL:             b.WebURL,
+	}
+
+	if b.Commit != nil {
+		o.CommitID = b.Commit.ID
+	}
+
+	return o
+}
+
+// GenerateCreateBranchOptions generates branch creation options
+func GenerateCreateBranchOptions(p *v1alpha1.BranchParameters) *gitlab.CreateBranchOptions {
+	return &gitlab.CreateBranchOptions{
+		Branch: &p.Branch,
+		Ref:    &p.Ref,
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	getCatalogResourceQuery = `query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    isCatalogResource
+  }
+}`
+
+	createCatalogResourceMutation = `mutation($projectPath: ID!) {
+  catalogResourcesCreate(input: {projectPath: $projectPath}) {
+    errors
+  }
+}`
+
+	getLatestCatalogResourceVersionQuery = `query($fullPath: ID!) {
+  ciCatalogResource(fullPath: $fullPath) {
+    versions(first: 1, sort: RELEASED_AT_DESC) {
+      nodes {
+        name
+        releasedAt
+      }
+    }
+  }
+}`
+
+	destroyCatalogResourceMutation = `mutation($projectPath: ID!) {
+  catalogResourcesDestroy(input: {projectPath: $projectPath}) {
+    errors
+  }
+}`
+)
+
+// CatalogResourceClient defines the GitLab operations that publish a project
+// in the CI/CD catalog. They are only available through the GraphQL API.
+type CatalogResourceClient interface {
+	IsCatalogResource(ctx context.Context, project string) (bool, error)
+	CreateCatalogResource(ctx context.Context, project string) error
+	DestroyCatalogResource(ctx context.Context, project string) error
+	GetLatestCatalogResourceVersion(ctx context.Context, project string) (*CatalogResourceVersion, error)
+}
+
+// CatalogResourceVersion is a version of a CI/CD catalog resource. GitLab
+// publishes one for each release of the project once it is a catalog
+// resource.
+type CatalogResourceVersion struct {
+	Name       string
+	ReleasedAt *time.Time
+}
+
+// NewCatalogResourceClient returns a new GitLab CI/CD catalog resource client.
+func NewCatalogResourceClient(cfg common.Config) CatalogResourceClient {
+	git := common.NewClient(cfg)
+	return &catalogResourceClient{graphql: git.GraphQL}
+}
+
+type catalogResourceClient struct {
+	graphql gitlab.GraphQLInterface
+}
+
+// IsCatalogResource returns true if the project with the given full path is
+// a CI/CD catalog resource.
+func (c *catalogResourceClient) IsCatalogResource(ctx context.Context, project string) (bool, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			Project *struct {
+				IsCatalogResource bool `json:"isCatalogResource"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     getCatalogResourceQuery,
+		Variables: map[string]any{"fullPath": project},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return false, err
+	}
+	if err := res.Err(); err != nil {
+		return false, err
+	}
+	if res.Data.Project == nil {
+		return false, common.ErrGraphQLResourceNotAvailable("project " + project)
+	}
+	return res.Data.Project.IsCatalogResource, nil
+}
+
+// CreateCatalogResource makes the project with the given full path a CI/CD
+// catalog resource.
+func (c *catalogResourceClient) CreateCatalogResource(ctx context.Context, project string) error {
+	return c.mutate(ctx, createCatalogResourceMutation, "catalogResourcesCreate", project)
+}
+
+// DestroyCatalogResource removes the project with the given full path from
+// the CI/CD catalog. Its components stay in the repository.
+func (c *catalogResourceClient) DestroyCatalogResource(ctx context.Context, project string) error {
+	return c.mutate(ctx, destroyCatalogResourceMutation, "catalogResourcesDestroy", project)
+}
+
+// GetLatestCatalogResourceVersion returns the most recently released version
+// of the CI/CD catalog resource with the given full path, or nil if none was
+// published yet.
+func (c *catalogResourceClient) GetLatestCatalogResourceVersion(ctx context.Context, project string) (*CatalogResourceVersion, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			CICatalogResource *struct {
+				Versions struct {
+					Nodes []struct {
+						Name       string     `json:"name"`
+						ReleasedAt *time.Time `json:"releasedAt"`
+					} `json:"nodes"`
+				} `json:"versions"`
+			} `json:"ciCatalogResource"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     getLatestCatalogResourceVersionQuery,
+		Variables: map[string]any{"fullPath": project},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	if res.Data.CICatalogResource == nil {
+		return nil, common.ErrGraphQLResourceNotAvailable("catalog resource " + project)
+	}
+	nodes := res.Data.CICatalogResource.Versions.Nodes
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return &CatalogResourceVersion{Name: nodes[0].Name, ReleasedAt: nodes[0].ReleasedAt}, nil
+}
+
+// GenerateCatalogResourceVersionObservation produces the observation of a
+// published CI/CD catalog version.
+func GenerateCatalogResourceVersionObservation(v *CatalogResourceVersion) *v1alpha1.CICatalogVersionObservation {
+	if v == nil {
+		return nil
+	}
+	return &v1alpha1.CICatalogVersionObservation{Name: v.Name, ReleasedAt: common.TimeToMetaTime(v.ReleasedAt)}
+}
+
+func (c *catalogResourceClient) mutate(ctx context.Context, mutation, name, project string) error {
+	var res struct {
+		common.GraphQLErrors
+		Data map[string]*struct {
+			Errors []string `json:"errors"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     mutation,
+		Variables: map[string]any{"projectPath": project},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	if m := res.Data[name]; m != nil {
+		return common.GraphQLMutationErrors(m.Errors)
+	}
+	return nil
+}
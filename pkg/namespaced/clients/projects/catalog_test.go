@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestIsCatalogResource(t *testing.T) {
+	type want struct {
+		published bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		response string
+		want     want
+	}{
+		"Published": {
+			response: `{"data":{"project":{"isCatalogResource":true}}}`,
+			want:     want{published: true},
+		},
+		"NotPublished": {
+			response: `{"data":{"project":{"isCatalogResource":false}}}`,
+		},
+		"ProjectNotAvailable": {
+			response: `{"data":{"project":null}}`,
+			want: want{
+				err: errors.New("project acme/components does not exist or you don't have permission to perform this action"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &catalogResourceClient{graphql: &fakeGraphQL{responses: []string{tc.response}}}
+
+			got, err := c.IsCatalogResource(context.Background(), "acme/components")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("IsCatalogResource(...): -want error, +got error:\n%s", diff)
+			}
+			if got != tc.want.published {
+				t.Errorf("IsCatalogResource(...): want %t, got %t", tc.want.published, got)
+			}
+		})
+	}
+}
+
+func TestUpdateCatalogResource(t *testing.T) {
+	cases := map[string]struct {
+		publish  bool
+		response string
+		want     error
+		query    string
+	}{
+		"Create": {
+			publish:  true,
+			response: `{"data":{"catalogResourcesCreate":{"errors":[]}}}`,
+			query:    createCatalogResourceMutation,
+		},
+		"Destroy": {
+			response: `{"data":{"catalogResourcesDestroy":{"errors":[]}}}`,
+			query:    destroyCatalogResourceMutation,
+		},
+		"MutationErrors": {
+			publish:  true,
+			response: `{"data":{"catalogResourcesCreate":{"errors":["Project must have a description"]}}}`,
+			query:    createCatalogResourceMutation,
+			want:     errors.New("Project must have a description"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: []string{tc.response}}
+			c := &catalogResourceClient{graphql: g}
+
+			var err error
+			if tc.publish {
+				err = c.CreateCatalogResource(context.Background(), "acme/components")
+			} else {
+				err = c.DestroyCatalogResource(context.Background(), "acme/components")
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("-want error, +got error:\n%s", diff)
+			}
+			want := []gitlab.GraphQLQuery{{Query: tc.query, Variables: map[string]any{"projectPath": "acme/components"}}}
+			if diff := cmp.Diff(want, g.queries); diff != "" {
+				t.Errorf("-want queries, +got queries:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetLatestCatalogResourceVersion(t *testing.T) {
+	releasedAt := time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+
+	type want struct {
+		version *CatalogResourceVersion
+		err     error
+	}
+
+	cases := map[string]struct {
+		response string
+		want     want
+	}{
+		"Published": {
+			response: `{"data":{"ciCatalogResource":{"versions":{"nodes":[{"name":"1.2.0","releasedAt":"2026-03-02T10:00:00Z"}]}}}}`,
+			want:     want{version: &CatalogResourceVersion{Name: "1.2.0", ReleasedAt: &releasedAt}},
+		},
+		"NoVersion": {
+			response: `{"data":{"ciCatalogResource":{"versions":{"nodes":[]}}}}`,
+		},
+		"NotCatalogResource": {
+			response: `{"data":{"ciCatalogResource":null}}`,
+			want: want{
+				err: errors.New("catalog resource acme/components does not exist or you don't have permission to perform this action"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: []string{tc.response}}
+			c := &catalogResourceClient{graphql: g}
+
+			got, err := c.GetLatestCatalogResourceVersion(context.Background(), "acme/components")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetLatestCatalogResourceVersion(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, got); diff != "" {
+				t.Errorf("GetLatestCatalogResourceVersion(...): -want, +got:\n%s", diff)
+			}
+			want := []gitlab.GraphQLQuery{{Query: getLatestCatalogResourceVersionQuery, Variables: map[string]any{"fullPath": "acme/components"}}}
+			if diff := cmp.Diff(want, g.queries); diff != "" {
+				t.Errorf("-want queries, +got queries:\n%s", diff)
+			}
+		})
+	}
+}
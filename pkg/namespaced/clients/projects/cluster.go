@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// ClusterClient defines GitLab project cluster service operations.
+// Certificate-based clusters are deprecated by GitLab but still supported
+// on self-managed instances.
+type ClusterClient interface {
+	GetCluster(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	AddCluster(pid any, opt *gitlab.AddClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	EditCluster(pid any, cluster int64, opt *gitlab.EditClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	DeleteCluster(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewClusterClient returns a new GitLab project cluster service
+func NewClusterClient(cfg common.Config) ClusterClient {
+	git := common.NewClient(cfg)
+	return git.ProjectCluster //nolint:staticcheck // certificate-based clusters are deprecated but still in use
+}
+
+// GenerateClusterObservation produces a ProjectClusterObservation from a gitlab.ProjectCluster.
+func GenerateClusterObservation(c *gitlab.ProjectCluster) v1alpha1.ProjectClusterObservation {
+	if c == nil {
+		return v1alpha1.ProjectClusterObservation{}
+	}
+
+	o := v1alpha1.ProjectClusterObservation{
+		ID:               c.ID,
+		Name:             c.Name,
+		Domain:           c.Domain,
+		EnvironmentScope: c.EnvironmentScope,
+		ProviderType:     c.ProviderType,
+		PlatformType:     c.PlatformType,
+		ClusterType:      c.ClusterType,
+		CreatedAt:        common.TimeToMetaTime(c.CreatedAt),
+	}
+
+	if c.ManagementProject != nil {
+		o.ManagementProjectID = &c.ManagementProject.ID
+	}
+
+	if c.PlatformKubernetes != nil {
+		o.PlatformKubernetes = &v1alpha1.ProjectClusterPlatformKubernetesObservation{
+			APIURL:            c.PlatformKubernetes.APIURL,
+			Namespace:         c.PlatformKubernetes.Namespace,
+			AuthorizationType: c.PlatformKubernetes.AuthorizationType,
+		}
+	}
+
+	return o
+}
+
+// GenerateAddClusterOptions generates project cluster creation options
+func GenerateAddClusterOptions(p *v1alpha1.ProjectClusterParameters, token string, caCert *string) *gitlab.AddClusterOptions {
+	return &gitlab.AddClusterOptions{
+		Name:                &p.Name,
+		Domain:              p.Domain,
+		Enabled:             p.Enabled,
+		Managed:             p.Managed,
+		EnvironmentScope:    p.EnvironmentScope,
+		ManagementProjectID: p.ManagementProjectID,
+		PlatformKubernetes: &gitlab.AddPlatformKubernetesOptions{
+			APIURL:            &p.PlatformKubernetes.APIURL,
+			Token:             &token,
+			CaCert:            caCert,
+			Namespace:         p.PlatformKubernetes.Namespace,
+			AuthorizationType: p.PlatformKubernetes.AuthorizationType,
+		},
+	}
+}
+
+// GenerateEditClusterOptions generates project cluster update options
+func GenerateEditClusterOptions(p *v1alpha1.ProjectClusterParameters, token string, caCert *string) *gitlab.EditClusterOptions {
+	return &gitlab.EditClusterOptions{
+		Name:                &p.Name,
+		Domain:              p.Domain,
+		EnvironmentScope:    p.EnvironmentScope,
+		ManagementProjectID: p.ManagementProjectID,
+		PlatformKubernetes: &gitlab.EditPlatformKubernetesOptions{
+			APIURL:    &p.PlatformKubernetes.APIURL,
+			Token:     &token,
+			CaCert:    caCert,
+			Namespace: p.PlatformKubernetes.Namespace,
+		},
+	}
+}
+
+// HashClusterCredentials returns the hex encoded SHA-256 hash of the token
+// and CA certificate of a project cluster.
+func HashClusterCredentials(token string, caCert *string) string {
+	h := sha256.New()
+	h.Write([]byte(token))
+	if caCert != nil {
+		h.Write([]byte{0})
+		h.Write([]byte(*caCert))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LateInitializeCluster fills the empty fields in the project cluster spec
+// with the values seen in gitlab.ProjectCluster.
+func LateInitializeCluster(p *v1alpha1.ProjectClusterParameters, c *gitlab.ProjectCluster) {
+	if c == nil {
+		return
+	}
+
+	p.Domain = clients.LateInitializeStringPtr(p.Domain, c.Domain)
+	p.EnvironmentScope = clients.LateInitializeStringPtr(p.EnvironmentScope, c.EnvironmentScope)
+
+	if c.PlatformKubernetes != nil {
+		p.PlatformKubernetes.Namespace = clients.LateInitializeStringPtr(p.PlatformKubernetes.Namespace, c.PlatformKubernetes.Namespace)
+		p.PlatformKubernetes.AuthorizationType = clients.LateInitializeStringPtr(p.PlatformKubernetes.AuthorizationType, c.PlatformKubernetes.AuthorizationType)
+	}
+}
+
+// IsClusterUpToDate checks whether the observed project cluster matches the
+// desired one. The token and CA certificate are sensitive and have to be
+// compared by the caller.
+func IsClusterUpToDate(p *v1alpha1.ProjectClusterParameters, c *gitlab.ProjectCluster) bool {
+	if c == nil {
+		return false
+	}
+
+	if p.Name != c.Name {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.Domain, c.Domain) {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.EnvironmentScope, c.EnvironmentScope) {
+		return false
+	}
+	if p.ManagementProjectID != nil {
+		if c.ManagementProject == nil || *p.ManagementProjectID != strconv.FormatInt(c.ManagementProject.ID, 10) {
+			return false
+		}
+	}
+
+	if c.PlatformKubernetes == nil {
+		return false
+	}
+	if p.PlatformKubernetes.APIURL != c.PlatformKubernetes.APIURL {
+		return false
+	}
+	if !clients.IsComparableEqualToComparablePtr(p.PlatformKubernetes.Namespace, c.PlatformKubernetes.Namespace) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+const (
+	complianceFrameworkGIDType = "ComplianceManagement::Framework"
+	projectGIDType             = "Project"
+
+	errComplianceFrameworkNotFound = "compliance framework %s does not exist in group %s"
+
+	listComplianceFrameworksQuery = `query($fullPath: ID!, $after: String) {
+  namespace(fullPath: $fullPath) {
+    complianceFrameworks(after: $after) {
+      nodes { id name }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+	updateProjectComplianceFrameworksMutation = `mutation($projectId: ProjectID!, $ids: [ComplianceManagementFrameworkID!]!) {
+  projectUpdateComplianceFrameworks(input: {projectId: $projectId, complianceFrameworkIds: $ids}) {
+    errors
+  }
+}`
+)
+
+// ComplianceFramework is a compliance framework of a top-level group.
+type ComplianceFramework struct {
+	ID   int64
+	Name string
+}
+
+// ComplianceFrameworkClient defines the GitLab operations on compliance
+// frameworks. They are only available through the GraphQL API.
+type ComplianceFrameworkClient interface {
+	ListComplianceFrameworks(ctx context.Context, group string) ([]ComplianceFramework, error)
+	UpdateProjectComplianceFrameworks(ctx context.Context, projectID int64, frameworkIDs []int64) error
+}
+
+// NewComplianceFrameworkClient returns a new GitLab compliance framework
+// client.
+func NewComplianceFrameworkClient(cfg common.Config) ComplianceFrameworkClient {
+	git := common.NewClient(cfg)
+	return &complianceFrameworkClient{graphql: git.GraphQL}
+}
+
+type complianceFrameworkClient struct {
+	graphql gitlab.GraphQLInterface
+}
+
+// ListComplianceFrameworks returns the compliance frameworks of a top-level
+// group.
+func (c *complianceFrameworkClient) ListComplianceFrameworks(ctx context.Context, group string) ([]ComplianceFramework, error) {
+	var frameworks []ComplianceFramework
+	var after *string
+	for {
+		var res struct {
+			common.GraphQLErrors
+			Data struct {
+				Namespace *struct {
+					ComplianceFrameworks struct {
+						Nodes []struct {
+							ID   string `json:"id"`
+							Name string `json:"name"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"complianceFrameworks"`
+				} `json:"namespace"`
+			} `json:"data"`
+		}
+		q := gitlab.GraphQLQuery{
+			Query:     listComplianceFrameworksQuery,
+			Variables: map[string]any{"fullPath": group, "after": after},
+		}
+		if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+			return nil, err
+		}
+		if err := res.Err(); err != nil {
+			return nil, err
+		}
+		if res.Data.Namespace == nil {
+			return nil, common.ErrGraphQLResourceNotAvailable("group " + group)
+		}
+
+		page := res.Data.Namespace.ComplianceFrameworks
+		for _, n := range page.Nodes {
+			id, err := common.ParseGraphQLID(complianceFrameworkGIDType, n.ID)
+			if err != nil {
+				return nil, err
+			}
+			frameworks = append(frameworks, ComplianceFramework{ID: id, Name: n.Name})
+		}
+		if !page.PageInfo.HasNextPage {
+			return frameworks, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// UpdateProjectComplianceFrameworks replaces the compliance frameworks
+// assigned to a project.
+func (c *complianceFrameworkClient) UpdateProjectComplianceFrameworks(ctx context.Context, projectID int64, frameworkIDs []int64) error {
+	ids := make([]string, 0, len(frameworkIDs))
+	for _, id := range frameworkIDs {
+		ids = append(ids, common.GraphQLID(complianceFrameworkGIDType, id))
+	}
+
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			ProjectUpdateComplianceFrameworks *struct {
+				Errors []string `json:"errors"`
+			} `json:"projectUpdateComplianceFrameworks"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     updateProjectComplianceFrameworksMutation,
+		Variables: map[string]any{"projectId": common.GraphQLID(projectGIDType, projectID), "ids": ids},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	if p := res.Data.ProjectUpdateComplianceFrameworks; p != nil {
+		return common.GraphQLMutationErrors(p.Errors)
+	}
+	return nil
+}
+
+// IsComplianceFrameworksUnavailable returns true if the error indicates that
+// compliance frameworks are not available, usually because the license of
+// the GitLab server does not include them.
+func IsComplianceFrameworksUnavailable(err error) bool {
+	return common.IsGraphQLResourceNotAvailable(err)
+}
+
+// ComplianceFrameworkGroup returns the path of the top-level group holding
+// the compliance frameworks available to a project.
+func ComplianceFrameworkGroup(prj *gitlab.Project) string {
+	group, _, _ := strings.Cut(prj.PathWithNamespace, "/")
+	return group
+}
+
+// ResolveComplianceFrameworkIDs returns the IDs of the referenced compliance
+// frameworks, each ID once.
+func ResolveComplianceFrameworkIDs(refs []v1alpha1.ComplianceFrameworkReference, available []ComplianceFramework, group string) ([]int64, error) {
+	ids := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		id, ok := findComplianceFramework(ref, available)
+		if !ok {
+			return nil, errors.Errorf(errComplianceFrameworkNotFound, complianceFrameworkReferenceString(ref), group)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// ComplianceFrameworkIDsByName returns the IDs of the compliance frameworks
+// with the given names, as GitLab reports them for projects. Names of
+// unknown frameworks are ignored.
+func ComplianceFrameworkIDsByName(names []string, available []ComplianceFramework) []int64 {
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		for _, f := range available {
+			if f.Name == name {
+				ids = append(ids, f.ID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// AreComplianceFrameworksUpToDate checks whether the assigned compliance
+// frameworks are the desired ones, regardless of their order.
+func AreComplianceFrameworksUpToDate(desired, observed []int64) bool {
+	return clients.IsSameSet(desired, observed)
+}
+
+func findComplianceFramework(ref v1alpha1.ComplianceFrameworkReference, available []ComplianceFramework) (int64, bool) {
+	for _, f := range available {
+		if ref.ID != nil && f.ID == *ref.ID || ref.ID == nil && ref.Name != nil && f.Name == *ref.Name {
+			return f.ID, true
+		}
+	}
+	return 0, false
+}
+
+func complianceFrameworkReferenceString(ref v1alpha1.ComplianceFrameworkReference) string {
+	if ref.ID != nil {
+		return fmt.Sprintf("with ID %d", *ref.ID)
+	}
+	if ref.Name != nil {
+		return fmt.Sprintf("%q", *ref.Name)
+	}
+	return "without ID or name"
+}
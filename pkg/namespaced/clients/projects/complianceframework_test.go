@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+type fakeGraphQL struct {
+	responses []string
+	queries   []gitlab.GraphQLQuery
+}
+
+func (f *fakeGraphQL) Do(query gitlab.GraphQLQuery, response any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	f.queries = append(f.queries, query)
+	res := f.responses[0]
+	f.responses = f.responses[1:]
+	return &gitlab.Response{}, json.Unmarshal([]byte(res), response)
+}
+
+var testComplianceFrameworks = []ComplianceFramework{
+	{ID: 1, Name: "SOX"},
+	{ID: 2, Name: "HIPAA"},
+	{ID: 3, Name: "PCI DSS"},
+}
+
+func TestListComplianceFrameworks(t *testing.T) {
+	type want struct {
+		frameworks []ComplianceFramework
+		after      []any
+		err        error
+	}
+
+	cases := map[string]struct {
+		responses []string
+		want      want
+	}{
+		"Paginated": {
+			responses: []string{
+				`{"data":{"namespace":{"complianceFrameworks":{"nodes":[{"id":"gid://gitlab/ComplianceManagement::Framework/1","name":"SOX"}],"pageInfo":{"hasNextPage":true,"endCursor":"abc"}}}}}`,
+				`{"data":{"namespace":{"complianceFrameworks":{"nodes":[{"id":"gid://gitlab/ComplianceManagement::Framework/2","name":"HIPAA"}],"pageInfo":{"hasNextPage":false}}}}}`,
+			},
+			want: want{
+				frameworks: []ComplianceFramework{{ID: 1, Name: "SOX"}, {ID: 2, Name: "HIPAA"}},
+				after:      []any{(*string)(nil), ptr.To("abc")},
+			},
+		},
+		"NamespaceNotAvailable": {
+			responses: []string{`{"data":{"namespace":null}}`},
+			want: want{
+				after: []any{(*string)(nil)},
+				err:   errors.New("group acme does not exist or you don't have permission to perform this action"),
+			},
+		},
+		"GraphQLErrors": {
+			responses: []string{`{"errors":[{"message":"boom"},{"message":"bang"}]}`},
+			want: want{
+				after: []any{(*string)(nil)},
+				err:   errors.New("boom, bang"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: tc.responses}
+			c := &complianceFrameworkClient{graphql: g}
+
+			got, err := c.ListComplianceFrameworks(context.Background(), "acme")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ListComplianceFrameworks(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.frameworks, got); diff != "" {
+				t.Errorf("ListComplianceFrameworks(...): -want, +got:\n%s", diff)
+			}
+			after := make([]any, 0, len(g.queries))
+			for _, q := range g.queries {
+				after = append(after, q.Variables["after"])
+			}
+			if diff := cmp.Diff(tc.want.after, after); diff != "" {
+				t.Errorf("ListComplianceFrameworks(...): -want cursors, +got cursors:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectComplianceFrameworks(t *testing.T) {
+	type want struct {
+		variables map[string]any
+		err       error
+	}
+
+	cases := map[string]struct {
+		ids      []int64
+		response string
+		want     want
+	}{
+		"Success": {
+			ids:      []int64{1, 3},
+			response: `{"data":{"projectUpdateComplianceFrameworks":{"errors":[]}}}`,
+			want: want{
+				variables: map[string]any{
+					"projectId": "gid://gitlab/Project/1234",
+					"ids":       []string{"gid://gitlab/ComplianceManagement::Framework/1", "gid://gitlab/ComplianceManagement::Framework/3"},
+				},
+			},
+		},
+		"RemoveAll": {
+			ids:      []int64{},
+			response: `{"data":{"projectUpdateComplianceFrameworks":{"errors":[]}}}`,
+			want: want{
+				variables: map[string]any{
+					"projectId": "gid://gitlab/Project/1234",
+					"ids":       []string{},
+				},
+			},
+		},
+		"MutationErrors": {
+			ids:      []int64{1},
+			response: `{"data":{"projectUpdateComplianceFrameworks":{"errors":["framework is invalid"]}}}`,
+			want: want{
+				variables: map[string]any{
+					"projectId": "gid://gitlab/Project/1234",
+					"ids":       []string{"gid://gitlab/ComplianceManagement::Framework/1"},
+				},
+				err: errors.New("framework is invalid"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: []string{tc.response}}
+			c := &complianceFrameworkClient{graphql: g}
+
+			err := c.UpdateProjectComplianceFrameworks(context.Background(), 1234, tc.ids)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateProjectComplianceFrameworks(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.variables, g.queries[0].Variables); diff != "" {
+				t.Errorf("UpdateProjectComplianceFrameworks(...): -want variables, +got variables:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveComplianceFrameworkIDs(t *testing.T) {
+	type want struct {
+		ids []int64
+		err error
+	}
+
+	cases := map[string]struct {
+		refs []v1alpha1.ComplianceFrameworkReference
+		want want
+	}{
+		"ByIDAndName": {
+			refs: []v1alpha1.ComplianceFrameworkReference{{ID: ptr.To[int64](3)}, {Name: ptr.To("SOX")}},
+			want: want{ids: []int64{3, 1}},
+		},
+		"Duplicate": {
+			refs: []v1alpha1.ComplianceFrameworkReference{{ID: ptr.To[int64](1)}, {Name: ptr.To("SOX")}},
+			want: want{ids: []int64{1}},
+		},
+		"Empty": {
+			refs: []v1alpha1.ComplianceFrameworkReference{},
+			want: want{ids: []int64{}},
+		},
+		"UnknownName": {
+			refs: []v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("ISO 27001")}},
+			want: want{err: errors.New(`compliance framework "ISO 27001" does not exist in group acme`)},
+		},
+		"UnknownID": {
+			refs: []v1alpha1.ComplianceFrameworkReference{{ID: ptr.To[int64](42)}},
+			want: want{err: errors.New("compliance framework with ID 42 does not exist in group acme")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveComplianceFrameworkIDs(tc.refs, testComplianceFrameworks, "acme")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveComplianceFrameworkIDs(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ids, got); diff != "" {
+				t.Errorf("ResolveComplianceFrameworkIDs(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreComplianceFrameworksUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired []int64
+		names   []string
+		want    bool
+	}{
+		"SameOrder": {
+			desired: []int64{1, 2},
+			names:   []string{"SOX", "HIPAA"},
+			want:    true,
+		},
+		"DifferentOrder": {
+			desired: []int64{2, 1},
+			names:   []string{"SOX", "HIPAA"},
+			want:    true,
+		},
+		"Missing": {
+			desired: []int64{1, 2},
+			names:   []string{"SOX"},
+			want:    false,
+		},
+		"Additional": {
+			desired: []int64{1},
+			names:   []string{"SOX", "PCI DSS"},
+			want:    false,
+		},
+		"NoneDesired": {
+			desired: []int64{},
+			names:   nil,
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := ComplianceFrameworkIDsByName(tc.names, testComplianceFrameworks)
+			if got := AreComplianceFrameworksUpToDate(tc.desired, observed); got != tc.want {
+				t.Errorf("AreComplianceFrameworksUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestComplianceFrameworkGroup(t *testing.T) {
+	got := ComplianceFrameworkGroup(&gitlab.Project{PathWithNamespace: "acme/platform/service"})
+	if diff := cmp.Diff("acme", got); diff != "" {
+		t.Errorf("ComplianceFrameworkGroup(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	testPublicKey            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINISkfduuvGxQE+4GKJv7ecQOCukAcnTiukS0FrAL4ui deploy@example.com"
+	testPublicKeyFingerprint = "SHA256:tNk8B5iVe2acM6/ZVCzrrP+xNCTb2aBQbcuYtNRXHAc"
+)
+
+func TestDeployKeyFingerprintSHA256(t *testing.T) {
+	cases := map[string]struct {
+		key     string
+		want    string
+		wantErr bool
+	}{
+		"Key": {
+			key:  testPublicKey,
+			want: testPublicKeyFingerprint,
+		},
+		"KeyWithoutComment": {
+			key:  "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINISkfduuvGxQE+4GKJv7ecQOCukAcnTiukS0FrAL4ui",
+			want: testPublicKeyFingerprint,
+		},
+		"KeyWithSurroundingWhitespace": {
+			key:  "\n  " + testPublicKey + "\r\n",
+			want: testPublicKeyFingerprint,
+		},
+		"NoKeyData": {
+			key:     "ssh-ed25519",
+			wantErr: true,
+		},
+		"InvalidKeyData": {
+			key:     "ssh-ed25519 not-base64!",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DeployKeyFingerprintSHA256(tc.key)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("DeployKeyFingerprintSHA256(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DeployKeyFingerprintSHA256(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindDeployKey(t *testing.T) {
+	keys := []*gitlab.ProjectDeployKey{
+		{ID: 1, FingerprintSHA256: "SHA256:other"},
+		{ID: 2, FingerprintSHA256: testPublicKeyFingerprint},
+	}
+
+	cases := map[string]struct {
+		id          int64
+		fingerprint string
+		want        *gitlab.ProjectDeployKey
+	}{
+		"ByID": {
+			id:   1,
+			want: keys[0],
+		},
+		"ByIDIgnoresFingerprint": {
+			id:          1,
+			fingerprint: testPublicKeyFingerprint,
+			want:        keys[0],
+		},
+		"ByFingerprint": {
+			fingerprint: testPublicKeyFingerprint,
+			want:        keys[1],
+		},
+		"UnknownID": {
+			id: 3,
+		},
+		"UnknownFingerprint": {
+			fingerprint: "SHA256:unknown",
+		},
+		"Nothing": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindDeployKey(keys, tc.id, tc.fingerprint)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindDeployKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// DeploymentClient defines GitLab deployment service operations
+type DeploymentClient interface {
+	ListProjectDeployments(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error)
+	GetProjectDeployment(pid any, deployment int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error)
+}
+
+// NewDeploymentClient returns a new GitLab deployment service
+func NewDeploymentClient(cfg common.Config) DeploymentClient {
+	git := common.NewClient(cfg)
+	return git.Deployments
+}
+
+// GenerateLatestDeploymentOptions generates the options listing only the
+// latest deployment of the environment, optionally with the given status.
+func GenerateLatestDeploymentOptions(p *v1alpha1.DeploymentParameters) *gitlab.ListProjectDeploymentsOptions {
+	return &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+		OrderBy:     gitlab.Ptr("id"),
+		Sort:        gitlab.Ptr("desc"),
+		Environment: p.Environment,
+		Status:      p.Status,
+	}
+}
+
+// GenerateDeploymentObservation produces a DeploymentObservation from a
+// gitlab.Deployment.
+func GenerateDeploymentObservation(d *gitlab.Deployment) v1alpha1.DeploymentObservation {
+	if d == nil {
+		return v1alpha1.DeploymentObservation{}
+	}
+
+	o := v1alpha1.DeploymentObservation{
+		ID:         d.ID,
+		IID:        d.IID,
+		Status:     d.Status,
+		Ref:        d.Ref,
+		SHA:        d.SHA,
+		PipelineID: d.Deployable.Pipeline.ID,
+		CreatedAt:  common.TimeToMetaTime(d.CreatedAt),
+		UpdatedAt:  common.TimeToMetaTime(d.UpdatedAt),
+		FinishedAt: common.TimeToMetaTime(d.Deployable.FinishedAt),
+	}
+	if d.Environment != nil {
+		o.Environment = d.Environment.Name
+	}
+	if d.User != nil {
+		o.Username = d.User.Username
+	}
+	return o
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"slices"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// ExternalStatusCheckClient defines GitLab external status check service
+// operations.
+type ExternalStatusCheckClient interface {
+	ListProjectExternalStatusChecks(pid any, opt *gitlab.ListProjectExternalStatusChecksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	CreateProjectExternalStatusCheck(pid any, opt *gitlab.CreateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	UpdateProjectExternalStatusCheck(pid any, check int64, opt *gitlab.UpdateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	DeleteProjectExternalStatusCheck(pid any, check int64, opt *gitlab.DeleteProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewExternalStatusCheckClient returns a new GitLab external status check
+// service.
+func NewExternalStatusCheckClient(cfg common.Config) ExternalStatusCheckClient {
+	git := common.NewClient(cfg)
+	return git.ExternalStatusChecks
+}
+
+// FindExternalStatusCheck returns the status check with the given ID or, if
+// the ID is zero, the given name. It returns nil if there is no such check.
+func FindExternalStatusCheck(checks []*gitlab.ProjectStatusCheck, id int64, name string) *gitlab.ProjectStatusCheck {
+	for _, c := range checks {
+		if c != nil && (id != 0 && c.ID == id || id == 0 && c.Name == name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// GenerateExternalStatusCheckObservation produces the observation of an
+// external status check.
+func GenerateExternalStatusCheckObservation(c *gitlab.ProjectStatusCheck) v1alpha1.ProjectExternalStatusCheckObservation {
+	if c == nil {
+		return v1alpha1.ProjectExternalStatusCheckObservation{}
+	}
+	o := v1alpha1.ProjectExternalStatusCheckObservation{ID: c.ID, Name: c.Name, HMAC: c.HMAC}
+	for _, b := range c.ProtectedBranches {
+		o.ProtectedBranches = append(o.ProtectedBranches, v1alpha1.ExternalStatusCheckProtectedBranch{ID: b.ID, Name: b.Name})
+	}
+	return o
+}
+
+// GenerateCreateExternalStatusCheckOptions generates the options to create
+// an external status check calling the given URL.
+func GenerateCreateExternalStatusCheckOptions(p *v1alpha1.ProjectExternalStatusCheckParameters, url string) *gitlab.CreateProjectExternalStatusCheckOptions {
+	return &gitlab.CreateProjectExternalStatusCheckOptions{
+		Name:               &p.Name,
+		ExternalURL:        &url,
+		ProtectedBranchIDs: protectedBranchIDs(p),
+	}
+}
+
+// GenerateUpdateExternalStatusCheckOptions generates the options to update
+// an external status check to call the given URL.
+func GenerateUpdateExternalStatusCheckOptions(p *v1alpha1.ProjectExternalStatusCheckParameters, url string) *gitlab.UpdateProjectExternalStatusCheckOptions {
+	return &gitlab.UpdateProjectExternalStatusCheckOptions{
+		Name:               &p.Name,
+		ExternalURL:        &url,
+		ProtectedBranchIDs: protectedBranchIDs(p),
+	}
+}
+
+// protectedBranchIDs returns the protected branch IDs to send. An empty list
+// is sent as well, so that a status check stops being limited to branches.
+func protectedBranchIDs(p *v1alpha1.ProjectExternalStatusCheckParameters) *[]int64 {
+	ids := append([]int64{}, p.ProtectedBranchIDs...)
+	return &ids
+}
+
+// IsExternalStatusCheckUpToDate checks whether the observed status check
+// calls the given URL with the desired name for the desired protected
+// branches, in any order.
+func IsExternalStatusCheckUpToDate(p *v1alpha1.ProjectExternalStatusCheckParameters, url string, c *gitlab.ProjectStatusCheck) bool {
+	if c == nil {
+		return false
+	}
+	if p.Name != c.Name || url != c.ExternalURL {
+		return false
+	}
+	observed := make([]int64, 0, len(c.ProtectedBranches))
+	for _, b := range c.ProtectedBranches {
+		observed = append(observed, b.ID)
+	}
+	desired := slices.Clone(p.ProtectedBranchIDs)
+	slices.Sort(desired)
+	slices.Sort(observed)
+	return slices.Equal(desired, observed)
+}
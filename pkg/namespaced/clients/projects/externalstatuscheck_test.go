@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func TestFindExternalStatusCheck(t *testing.T) {
+	checks := []*gitlab.ProjectStatusCheck{
+		{ID: 1, Name: "compliance"},
+		{ID: 2, Name: "security"},
+	}
+
+	cases := map[string]struct {
+		id   int64
+		name string
+		want *gitlab.ProjectStatusCheck
+	}{
+		"ByID": {
+			id:   2,
+			name: "compliance",
+			want: checks[1],
+		},
+		"ByName": {
+			name: "security",
+			want: checks[1],
+		},
+		"IDNotFound": {
+			id:   3,
+			name: "compliance",
+		},
+		"NameNotFound": {
+			name: "licenses",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindExternalStatusCheck(checks, tc.id, tc.name)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindExternalStatusCheck(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsExternalStatusCheckUpToDate(t *testing.T) {
+	url := "https://checks.example.org/gitlab"
+	observed := &gitlab.ProjectStatusCheck{
+		Name:              "compliance",
+		ExternalURL:       url,
+		ProtectedBranches: []gitlab.StatusCheckProtectedBranch{{ID: 2}, {ID: 1}},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectExternalStatusCheckParameters
+		url  string
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.ProjectExternalStatusCheckParameters{Name: "compliance", ProtectedBranchIDs: []int64{1, 2}},
+			url:  url,
+			want: true,
+		},
+		"NameChanged": {
+			p:   &v1alpha1.ProjectExternalStatusCheckParameters{Name: "security", ProtectedBranchIDs: []int64{1, 2}},
+			url: url,
+		},
+		"URLChanged": {
+			p:   &v1alpha1.ProjectExternalStatusCheckParameters{Name: "compliance", ProtectedBranchIDs: []int64{1, 2}},
+			url: url + "?token=s3cr3t",
+		},
+		"ProtectedBranchRemoved": {
+			p:   &v1alpha1.ProjectExternalStatusCheckParameters{Name: "compliance", ProtectedBranchIDs: []int64{1}},
+			url: url,
+		},
+		"AllBranches": {
+			p:   &v1alpha1.ProjectExternalStatusCheckParameters{Name: "compliance"},
+			url: url,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsExternalStatusCheckUpToDate(tc.p, tc.url, observed)
+			if got != tc.want {
+				t.Errorf("IsExternalStatusCheckUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -17,31 +17,44 @@ limitations under the License.
 package fake
 
 import (
+	"bytes"
+	"context"
+	"io"
+
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
 )
 
 var _ projects.Client = &MockClient{}
+var _ projects.ExternalStatusCheckClient = &MockClient{}
 
 // MockClient is a fake implementation of projects.Client.
 type MockClient struct {
 	projects.Client
 
-	MockGetProject    func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockCreateProject func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockEditProject   func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockDeleteProject func(pid any, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetProject      func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockCreateProject   func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockEditProject     func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockDeleteProject   func(pid any, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockRestoreProject  func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockTransferProject func(pid any, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockUploadAvatar    func(pid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockDownloadAvatar  func(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error)
 
+	MockListHooks  func(pid any, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error)
 	MockGetHook    func(pid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockAddHook    func(pid any, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockEditHook   func(pid any, hook int64, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockDeleteHook func(pid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
-	MockGetMember    func(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockAddMember    func(pid any, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockEditMember   func(pid any, user int64, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockDeleteMember func(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteProjectCustomHeader func(pid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetMember      func(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockListAllMembers func(pid any, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)
+	MockAddMember      func(pid any, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockEditMember     func(pid any, user int64, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockDeleteMember   func(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
 	MockCreateDeployToken     func(pid any, opt *gitlab.CreateProjectDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 	MockDeleteDeployToken     func(pid any, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
@@ -59,10 +72,12 @@ type MockClient struct {
 	MockRotateProjectAccessToken func(pid any, id int64, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	MockRotateSelf               func(opt *gitlab.RotatePersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
 
-	MockAddDeployKey    func(pid any, opt *gitlab.AddDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
-	MockDeleteDeployKey func(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
-	MockUpdateDeployKey func(pid any, deployKey int64, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
-	MockGetDeployKey    func(pid any, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockAddDeployKey          func(pid any, opt *gitlab.AddDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockDeleteDeployKey       func(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockUpdateDeployKey       func(pid any, deployKey int64, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockGetDeployKey          func(pid any, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockListProjectDeployKeys func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockEnableDeployKey       func(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 
 	MockGetPipelineSchedule            func(pid any, schedule int64, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
 	MockCreatePipelineSchedule         func(pid any, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
@@ -72,7 +87,8 @@ type MockClient struct {
 	MockEditPipelineScheduleVariable   func(pid any, schedule int64, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	MockDeletePipelineScheduleVariable func(pid any, schedule int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 
-	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockListUsers   func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockCurrentUser func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 
 	MockGetProjectPushRules func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
 	MockAddProjectPushRule  func(pid any, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
@@ -93,6 +109,57 @@ type MockClient struct {
 
 	MockShareProjectWithGroup        func(pid any, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockDeleteSharedProjectFromGroup func(pid any, groupID int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetBranch    func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockCreateBranch func(pid any, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockDeleteBranch func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetTag        func(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	MockCreateTag     func(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	MockDeleteTag     func(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockCreateRelease func(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+
+	MockGetPagesDomain    func(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	MockCreatePagesDomain func(pid any, opt *gitlab.CreatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	MockUpdatePagesDomain func(pid any, domain string, opt *gitlab.UpdatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	MockDeletePagesDomain func(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetCluster    func(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	MockAddCluster    func(pid any, opt *gitlab.AddClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	MockEditCluster   func(pid any, cluster int64, opt *gitlab.EditClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error)
+	MockDeleteCluster func(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockForkProject               func(pid any, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockCreateProjectForkRelation func(pid any, fork int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error)
+	MockDeleteProjectForkRelation func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetIssue    func(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockCreateIssue func(pid any, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockUpdateIssue func(pid any, issue int64, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockDeleteIssue func(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetWikiPage    func(pid any, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockCreateWikiPage func(pid any, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockEditWikiPage   func(pid any, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockDeleteWikiPage func(pid any, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetMergeRequest                 func(pid any, mergeRequest int64, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	MockCreateMergeRequest              func(pid any, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	MockUpdateMergeRequest              func(pid any, mergeRequest int64, opt *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	MockDeleteMergeRequest              func(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockAcceptMergeRequest              func(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	MockCancelMergeWhenPipelineSucceeds func(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+
+	MockGetApprovalConfiguration    func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	MockChangeApprovalConfiguration func(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+
+	MockListProjectDeployments func(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error)
+	MockGetProjectDeployment   func(pid any, deployment int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error)
+
+	MockListProjectExternalStatusChecks  func(pid any, opt *gitlab.ListProjectExternalStatusChecksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	MockCreateProjectExternalStatusCheck func(pid any, opt *gitlab.CreateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	MockUpdateProjectExternalStatusCheck func(pid any, check int64, opt *gitlab.UpdateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error)
+	MockDeleteProjectExternalStatusCheck func(pid any, check int64, opt *gitlab.DeleteProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
 // GetPipelineSchedule calls the underlying MockGetPipelineSchedule method.
@@ -150,6 +217,31 @@ func (c *MockClient) DeleteProject(pid any, opt *gitlab.DeleteProjectOptions, op
 	return c.MockDeleteProject(pid, opt)
 }
 
+// RestoreProject calls the underlying MockRestoreProject method
+func (c *MockClient) RestoreProject(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockRestoreProject(pid, options...)
+}
+
+// TransferProject calls the underlying MockTransferProject method
+func (c *MockClient) TransferProject(pid any, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockTransferProject(pid, opt, options...)
+}
+
+// UploadAvatar calls the underlying MockUploadAvatar method
+func (c *MockClient) UploadAvatar(pid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockUploadAvatar(pid, avatar, filename, options...)
+}
+
+// DownloadAvatar calls the underlying MockDownloadAvatar method
+func (c *MockClient) DownloadAvatar(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return c.MockDownloadAvatar(pid, options...)
+}
+
+// ListProjectHooks calls the underlying MockListHooks method.
+func (c *MockClient) ListProjectHooks(pid any, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+	return c.MockListHooks(pid, opt)
+}
+
 // GetProjectHook calls the underlying MockGetProjectHook method.
 func (c *MockClient) GetProjectHook(pid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
 	return c.MockGetHook(pid, hook)
@@ -171,12 +263,22 @@ func (c *MockClient) DeleteProjectHook(pid any, hook int64, options ...gitlab.Re
 	return c.MockDeleteHook(pid, hook)
 }
 
+// DeleteProjectCustomHeader calls the underlying MockDeleteProjectCustomHeader method.
+func (c *MockClient) DeleteProjectCustomHeader(pid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectCustomHeader(pid, hook, key, options...)
+}
+
 // GetProjectMember calls the underlying MockGetMember method.
 // GetProjectMember calls the underlying MockGetMember method.
 func (c *MockClient) GetProjectMember(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
 	return c.MockGetMember(pid, user)
 }
 
+// ListAllProjectMembers calls the underlying MockListAllMembers method.
+func (c *MockClient) ListAllProjectMembers(pid any, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+	return c.MockListAllMembers(pid, opt)
+}
+
 // AddProjectMember calls the underlying MockAddMember method.
 // AddProjectMember calls the underlying MockAddMember method.
 func (c *MockClient) AddProjectMember(pid any, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
@@ -212,7 +314,7 @@ func (c *MockClient) GetProjectDeployToken(pid any, deployToken int64, options .
 
 // GetVariable calls the underlying MockGetProjectVariable
 func (c *MockClient) GetVariable(pid any, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
-	return c.MockGetVariable(pid, key, opt)
+	return c.MockGetVariable(pid, key, opt, options...)
 }
 
 // CreateVariable calls the underlying MockCreateProjectVariable
@@ -232,7 +334,7 @@ func (c *MockClient) RemoveVariable(pid any, key string, opt *gitlab.RemoveProje
 
 // ListVariables calls the underlying MockListVariables
 func (c *MockClient) ListVariables(pid any, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
-	return c.MockListVariables(pid, opt)
+	return c.MockListVariables(pid, opt, options...)
 }
 
 // GetDeployKey calls the underlying MockGetDeployKey
@@ -250,6 +352,16 @@ func (c *MockClient) DeleteDeployKey(pid any, deployKey int64, options ...gitlab
 	return c.MockDeleteDeployKey(pid, deployKey)
 }
 
+// ListProjectDeployKeys calls the underlying MockListProjectDeployKeys
+func (c *MockClient) ListProjectDeployKeys(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+	return c.MockListProjectDeployKeys(pid, opt)
+}
+
+// EnableDeployKey calls the underlying MockEnableDeployKey
+func (c *MockClient) EnableDeployKey(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+	return c.MockEnableDeployKey(pid, deployKey)
+}
+
 // UpdateDeployKey cals the underlying MockUpdateDeployKey
 func (c *MockClient) UpdateDeployKey(pid any, deployKey int64, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
 	return c.MockUpdateDeployKey(pid, deployKey, opt)
@@ -285,6 +397,11 @@ func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.R
 	return c.MockListUsers(opt)
 }
 
+// CurrentUser calls the underlying MockCurrentUser method.
+func (c *MockClient) CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockCurrentUser(options...)
+}
+
 // GetProjectPushRules calls the underlying MockGetProjectPushRules method.
 func (c *MockClient) GetProjectPushRules(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
 	return c.MockGetProjectPushRules(pid, options...)
@@ -355,3 +472,357 @@ func (c *MockClient) ShareProjectWithGroup(pid any, opt *gitlab.ShareWithGroupOp
 func (c *MockClient) DeleteSharedProjectFromGroup(pid any, groupID int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockDeleteSharedProjectFromGroup(pid, groupID, options...)
 }
+
+// GetBranch calls the underlying MockGetBranch method.
+func (c *MockClient) GetBranch(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockGetBranch(pid, branch, options...)
+}
+
+// CreateBranch calls the underlying MockCreateBranch method.
+func (c *MockClient) CreateBranch(pid any, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockCreateBranch(pid, opt, options...)
+}
+
+// DeleteBranch calls the underlying MockDeleteBranch method.
+func (c *MockClient) DeleteBranch(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteBranch(pid, branch, options...)
+}
+
+// GetTag calls the underlying MockGetTag method.
+func (c *MockClient) GetTag(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return c.MockGetTag(pid, tag, options...)
+}
+
+// CreateTag calls the underlying MockCreateTag method.
+func (c *MockClient) CreateTag(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return c.MockCreateTag(pid, opt, options...)
+}
+
+// DeleteTag calls the underlying MockDeleteTag method.
+func (c *MockClient) DeleteTag(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteTag(pid, tag, options...)
+}
+
+// CreateRelease calls the underlying MockCreateRelease method.
+func (c *MockClient) CreateRelease(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockCreateRelease(pid, opts, options...)
+}
+
+// GetPagesDomain calls the underlying MockGetPagesDomain method.
+func (c *MockClient) GetPagesDomain(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error) {
+	return c.MockGetPagesDomain(pid, domain, options...)
+}
+
+// CreatePagesDomain calls the underlying MockCreatePagesDomain method.
+func (c *MockClient) CreatePagesDomain(pid any, opt *gitlab.CreatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error) {
+	return c.MockCreatePagesDomain(pid, opt, options...)
+}
+
+// UpdatePagesDomain calls the underlying MockUpdatePagesDomain method.
+func (c *MockClient) UpdatePagesDomain(pid any, domain string, opt *gitlab.UpdatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error) {
+	return c.MockUpdatePagesDomain(pid, domain, opt, options...)
+}
+
+// DeletePagesDomain calls the underlying MockDeletePagesDomain method.
+func (c *MockClient) DeletePagesDomain(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeletePagesDomain(pid, domain, options...)
+}
+
+// GetCluster calls the underlying MockGetCluster method.
+func (c *MockClient) GetCluster(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+	return c.MockGetCluster(pid, cluster, options...)
+}
+
+// AddCluster calls the underlying MockAddCluster method.
+func (c *MockClient) AddCluster(pid any, opt *gitlab.AddClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+	return c.MockAddCluster(pid, opt, options...)
+}
+
+// EditCluster calls the underlying MockEditCluster method.
+func (c *MockClient) EditCluster(pid any, cluster int64, opt *gitlab.EditClusterOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectCluster, *gitlab.Response, error) {
+	return c.MockEditCluster(pid, cluster, opt, options...)
+}
+
+// DeleteCluster calls the underlying MockDeleteCluster method.
+func (c *MockClient) DeleteCluster(pid any, cluster int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteCluster(pid, cluster, options...)
+}
+
+// ForkProject calls the underlying MockForkProject method.
+func (c *MockClient) ForkProject(pid any, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockForkProject(pid, opt, options...)
+}
+
+// CreateProjectForkRelation calls the underlying MockCreateProjectForkRelation method.
+func (c *MockClient) CreateProjectForkRelation(pid any, fork int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error) {
+	return c.MockCreateProjectForkRelation(pid, fork, options...)
+}
+
+// DeleteProjectForkRelation calls the underlying MockDeleteProjectForkRelation method.
+func (c *MockClient) DeleteProjectForkRelation(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectForkRelation(pid, options...)
+}
+
+// GetIssue calls the underlying MockGetIssue method.
+func (c *MockClient) GetIssue(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockGetIssue(pid, issue, options...)
+}
+
+// CreateIssue calls the underlying MockCreateIssue method.
+func (c *MockClient) CreateIssue(pid any, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockCreateIssue(pid, opt, options...)
+}
+
+// UpdateIssue calls the underlying MockUpdateIssue method.
+func (c *MockClient) UpdateIssue(pid any, issue int64, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockUpdateIssue(pid, issue, opt, options...)
+}
+
+// DeleteIssue calls the underlying MockDeleteIssue method.
+func (c *MockClient) DeleteIssue(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteIssue(pid, issue, options...)
+}
+
+// GetWikiPage calls the underlying MockGetWikiPage method.
+func (c *MockClient) GetWikiPage(pid any, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockGetWikiPage(pid, slug, opt, options...)
+}
+
+// CreateWikiPage calls the underlying MockCreateWikiPage method.
+func (c *MockClient) CreateWikiPage(pid any, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockCreateWikiPage(pid, opt, options...)
+}
+
+// EditWikiPage calls the underlying MockEditWikiPage method.
+func (c *MockClient) EditWikiPage(pid any, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockEditWikiPage(pid, slug, opt, options...)
+}
+
+// DeleteWikiPage calls the underlying MockDeleteWikiPage method.
+func (c *MockClient) DeleteWikiPage(pid any, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteWikiPage(pid, slug, options...)
+}
+
+// ListProjectExternalStatusChecks calls the underlying
+// MockListProjectExternalStatusChecks method.
+func (c *MockClient) ListProjectExternalStatusChecks(pid any, opt *gitlab.ListProjectExternalStatusChecksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+	return c.MockListProjectExternalStatusChecks(pid, opt, options...)
+}
+
+// CreateProjectExternalStatusCheck calls the underlying
+// MockCreateProjectExternalStatusCheck method.
+func (c *MockClient) CreateProjectExternalStatusCheck(pid any, opt *gitlab.CreateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+	return c.MockCreateProjectExternalStatusCheck(pid, opt, options...)
+}
+
+// UpdateProjectExternalStatusCheck calls the underlying
+// MockUpdateProjectExternalStatusCheck method.
+func (c *MockClient) UpdateProjectExternalStatusCheck(pid any, check int64, opt *gitlab.UpdateProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+	return c.MockUpdateProjectExternalStatusCheck(pid, check, opt, options...)
+}
+
+// DeleteProjectExternalStatusCheck calls the underlying
+// MockDeleteProjectExternalStatusCheck method.
+func (c *MockClient) DeleteProjectExternalStatusCheck(pid any, check int64, opt *gitlab.DeleteProjectExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectExternalStatusCheck(pid, check, opt, options...)
+}
+
+// GetMergeRequest calls the underlying MockGetMergeRequest method.
+func (c *MockClient) GetMergeRequest(pid any, mergeRequest int64, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockGetMergeRequest(pid, mergeRequest, opt, options...)
+}
+
+// CreateMergeRequest calls the underlying MockCreateMergeRequest method.
+func (c *MockClient) CreateMergeRequest(pid any, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockCreateMergeRequest(pid, opt, options...)
+}
+
+// UpdateMergeRequest calls the underlying MockUpdateMergeRequest method.
+func (c *MockClient) UpdateMergeRequest(pid any, mergeRequest int64, opt *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockUpdateMergeRequest(pid, mergeRequest, opt, options...)
+}
+
+// DeleteMergeRequest calls the underlying MockDeleteMergeRequest method.
+func (c *MockClient) DeleteMergeRequest(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteMergeRequest(pid, mergeRequest, options...)
+}
+
+// AcceptMergeRequest calls the underlying MockAcceptMergeRequest method.
+func (c *MockClient) AcceptMergeRequest(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockAcceptMergeRequest(pid, mergeRequest, opt, options...)
+}
+
+// CancelMergeWhenPipelineSucceeds calls the underlying MockCancelMergeWhenPipelineSucceeds method.
+func (c *MockClient) CancelMergeWhenPipelineSucceeds(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockCancelMergeWhenPipelineSucceeds(pid, mergeRequest, options...)
+}
+
+// GetApprovalConfiguration calls the underlying MockGetApprovalConfiguration method.
+func (c *MockClient) GetApprovalConfiguration(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockGetApprovalConfiguration(pid, options...)
+}
+
+// ChangeApprovalConfiguration calls the underlying MockChangeApprovalConfiguration method.
+func (c *MockClient) ChangeApprovalConfiguration(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockChangeApprovalConfiguration(pid, opt, options...)
+}
+
+// ListProjectDeployments calls the underlying MockListProjectDeployments method.
+func (c *MockClient) ListProjectDeployments(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+	return c.MockListProjectDeployments(pid, opts, options...)
+}
+
+// GetProjectDeployment calls the underlying MockGetProjectDeployment method.
+func (c *MockClient) GetProjectDeployment(pid any, deployment int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+	return c.MockGetProjectDeployment(pid, deployment, options...)
+}
+
+var _ projects.ComplianceFrameworkClient = &MockComplianceFrameworkClient{}
+
+// MockComplianceFrameworkClient is a fake implementation of
+// projects.ComplianceFrameworkClient.
+type MockComplianceFrameworkClient struct {
+	MockListComplianceFrameworks          func(ctx context.Context, group string) ([]projects.ComplianceFramework, error)
+	MockUpdateProjectComplianceFrameworks func(ctx context.Context, projectID int64, frameworkIDs []int64) error
+}
+
+// ListComplianceFrameworks calls the underlying MockListComplianceFrameworks method.
+func (c *MockComplianceFrameworkClient) ListComplianceFrameworks(ctx context.Context, group string) ([]projects.ComplianceFramework, error) {
+	return c.MockListComplianceFrameworks(ctx, group)
+}
+
+// UpdateProjectComplianceFrameworks calls the underlying MockUpdateProjectComplianceFrameworks method.
+func (c *MockComplianceFrameworkClient) UpdateProjectComplianceFrameworks(ctx context.Context, projectID int64, frameworkIDs []int64) error {
+	return c.MockUpdateProjectComplianceFrameworks(ctx, projectID, frameworkIDs)
+}
+
+var _ projects.NamespaceClient = &MockNamespaceClient{}
+
+// MockNamespaceClient is a fake implementation of projects.NamespaceClient.
+type MockNamespaceClient struct {
+	MockGetNamespace func(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error)
+}
+
+// GetNamespace calls the underlying MockGetNamespace method.
+func (c *MockNamespaceClient) GetNamespace(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+	return c.MockGetNamespace(id, options...)
+}
+
+var _ projects.JiraClient = &MockJiraClient{}
+
+// MockJiraClient is a fake implementation of projects.JiraClient.
+type MockJiraClient struct {
+	MockGetJiraService func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error)
+}
+
+// GetJiraService calls the underlying MockGetJiraService method.
+func (c *MockJiraClient) GetJiraService(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+	return c.MockGetJiraService(pid, options...)
+}
+
+var _ projects.PackagesCleanupPolicyClient = &MockPackagesCleanupPolicyClient{}
+
+// MockPackagesCleanupPolicyClient is a fake implementation of
+// projects.PackagesCleanupPolicyClient.
+type MockPackagesCleanupPolicyClient struct {
+	MockGetPackagesCleanupPolicy    func(ctx context.Context, project string) (*projects.PackagesCleanupPolicy, error)
+	MockUpdatePackagesCleanupPolicy func(ctx context.Context, project string, keepN string) error
+}
+
+// GetPackagesCleanupPolicy calls the underlying MockGetPackagesCleanupPolicy method.
+func (c *MockPackagesCleanupPolicyClient) GetPackagesCleanupPolicy(ctx context.Context, project string) (*projects.PackagesCleanupPolicy, error) {
+	return c.MockGetPackagesCleanupPolicy(ctx, project)
+}
+
+// UpdatePackagesCleanupPolicy calls the underlying MockUpdatePackagesCleanupPolicy method.
+func (c *MockPackagesCleanupPolicyClient) UpdatePackagesCleanupPolicy(ctx context.Context, project string, keepN string) error {
+	return c.MockUpdatePackagesCleanupPolicy(ctx, project, keepN)
+}
+
+var _ projects.CatalogResourceClient = &MockCatalogResourceClient{}
+
+// MockCatalogResourceClient is a fake implementation of
+// projects.CatalogResourceClient.
+type MockCatalogResourceClient struct {
+	MockIsCatalogResource      func(ctx context.Context, project string) (bool, error)
+	MockCreateCatalogResource  func(ctx context.Context, project string) error
+	MockDestroyCatalogResource func(ctx context.Context, project string) error
+
+	MockGetLatestCatalogResourceVersion func(ctx context.Context, project string) (*projects.CatalogResourceVersion, error)
+}
+
+// IsCatalogResource calls the underlying MockIsCatalogResource method.
+func (c *MockCatalogResourceClient) IsCatalogResource(ctx context.Context, project string) (bool, error) {
+	return c.MockIsCatalogResource(ctx, project)
+}
+
+// CreateCatalogResource calls the underlying MockCreateCatalogResource method.
+func (c *MockCatalogResourceClient) CreateCatalogResource(ctx context.Context, project string) error {
+	return c.MockCreateCatalogResource(ctx, project)
+}
+
+// DestroyCatalogResource calls the underlying MockDestroyCatalogResource method.
+func (c *MockCatalogResourceClient) DestroyCatalogResource(ctx context.Context, project string) error {
+	return c.MockDestroyCatalogResource(ctx, project)
+}
+
+// GetLatestCatalogResourceVersion calls the underlying
+// MockGetLatestCatalogResourceVersion method.
+func (c *MockCatalogResourceClient) GetLatestCatalogResourceVersion(ctx context.Context, project string) (*projects.CatalogResourceVersion, error) {
+	return c.MockGetLatestCatalogResourceVersion(ctx, project)
+}
+
+var _ projects.TerraformStateClient = &MockTerraformStateClient{}
+
+// MockTerraformStateClient is a fake implementation of
+// projects.TerraformStateClient.
+type MockTerraformStateClient struct {
+	MockGetProjectPath       func(ctx context.Context, project string) (string, error)
+	MockListTerraformStates  func(ctx context.Context, project string) ([]gitlab.TerraformState, error)
+	MockDeleteTerraformState func(ctx context.Context, project, name string) (*gitlab.Response, error)
+	MockLockTerraformState   func(ctx context.Context, project, name string) error
+	MockUnlockTerraformState func(ctx context.Context, project, name string) error
+}
+
+// GetProjectPath calls the underlying MockGetProjectPath method.
+func (c *MockTerraformStateClient) GetProjectPath(ctx context.Context, project string) (string, error) {
+	return c.MockGetProjectPath(ctx, project)
+}
+
+// ListTerraformStates calls the underlying MockListTerraformStates method.
+func (c *MockTerraformStateClient) ListTerraformStates(ctx context.Context, project string) ([]gitlab.TerraformState, error) {
+	return c.MockListTerraformStates(ctx, project)
+}
+
+// DeleteTerraformState calls the underlying MockDeleteTerraformState method.
+func (c *MockTerraformStateClient) DeleteTerraformState(ctx context.Context, project, name string) (*gitlab.Response, error) {
+	return c.MockDeleteTerraformState(ctx, project, name)
+}
+
+// LockTerraformState calls the underlying MockLockTerraformState method.
+func (c *MockTerraformStateClient) LockTerraformState(ctx context.Context, project, name string) error {
+	return c.MockLockTerraformState(ctx, project, name)
+}
+
+// UnlockTerraformState calls the underlying MockUnlockTerraformState method.
+func (c *MockTerraformStateClient) UnlockTerraformState(ctx context.Context, project, name string) error {
+	return c.MockUnlockTerraformState(ctx, project, name)
+}
+
+var _ projects.SearchClient = &MockSearchClient{}
+
+// MockSearchClient is a fake implementation of projects.SearchClient.
+type MockSearchClient struct {
+	MockGetProject   func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockListProjects func(opt *gitlab.ListProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+}
+
+// GetProject calls the underlying MockGetProject method.
+func (c *MockSearchClient) GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockGetProject(pid, opt, options...)
+}
+
+// ListProjects calls the underlying MockListProjects method.
+func (c *MockSearchClient) ListProjects(opt *gitlab.ListProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.MockListProjects(opt, options...)
+}
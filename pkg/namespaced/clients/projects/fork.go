@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	errNamespaceIDNotInt = "namespaceId is not a valid GitLab namespace ID"
+)
+
+// ForkClient defines GitLab project fork service operations
+type ForkClient interface {
+	GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ForkProject(pid any, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	DeleteProject(pid any, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	CreateProjectForkRelation(pid any, fork int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error)
+	DeleteProjectForkRelation(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewForkClient returns a new GitLab project fork service
+func NewForkClient(cfg common.Config) ForkClient {
+	git := common.NewClient(cfg)
+	return git.Projects
+}
+
+// GenerateForkObservation produces a ProjectForkObservation from a gitlab.Project.
+func GenerateForkObservation(prj *gitlab.Project) v1alpha1.ProjectForkObservation {
+	if prj == nil {
+		return v1alpha1.ProjectForkObservation{}
+	}
+
+	o := v1alpha1.ProjectForkObservation{
+		ID:                prj.ID,
+		Name:              prj.Name,
+		PathWithNamespace: prj.PathWithNamespace,
+		WebURL:            prj.WebURL,
+	}
+
+	if prj.ForkedFromProject != nil {
+		o.ForkedFromProject = &v1alpha1.ForkParent{
+			HTTPURLToRepo:     prj.ForkedFromProject.HTTPURLToRepo,
+			ID:                prj.ForkedFromProject.ID,
+			Name:              prj.ForkedFromProject.Name,
+			NameWithNamespace: prj.ForkedFromProject.NameWithNamespace,
+			Path:              prj.ForkedFromProject.Path,
+			PathWithNamespace: prj.ForkedFromProject.PathWithNamespace,
+			WebURL:            prj.ForkedFromProject.WebURL,
+		}
+	}
+
+	return o
+}
+
+// GenerateForkProjectOptions generates project fork options
+func GenerateForkProjectOptions(p *v1alpha1.ProjectForkParameters) (*gitlab.ForkProjectOptions, error) {
+	opt := &gitlab.ForkProjectOptions{
+		Branches:                      p.Branches,
+		Description:                   p.Description,
+		MergeRequestDefaultTargetSelf: p.MergeRequestDefaultTargetSelf,
+		Name:                          p.Name,
+		NamespacePath:                 p.NamespacePath,
+		Path:                          p.Path,
+	}
+
+	if p.NamespaceID != nil {
+		id, err := strconv.ParseInt(*p.NamespaceID, 10, 64)
+		if err != nil {
+			return nil, errors.New(errNamespaceIDNotInt)
+		}
+		opt.NamespaceID = &id
+	}
+
+	if p.Visibility != nil {
+		opt.Visibility = gitlab.Ptr(gitlab.VisibilityValue(*p.Visibility))
+	}
+
+	return opt, nil
+}
+
+// IsForkedFrom checks whether the project is forked from the desired source
+// project, which may be referenced by ID or by path.
+func IsForkedFrom(p *v1alpha1.ProjectForkParameters, prj *gitlab.Project) bool {
+	if prj == nil || prj.ForkedFromProject == nil || p.ForkedFromProjectID == nil {
+		return false
+	}
+
+	source := *p.ForkedFromProjectID
+	return source == strconv.FormatInt(prj.ForkedFromProject.ID, 10) || source == prj.ForkedFromProject.PathWithNamespace
+}
+
+// ForkDeletePolicy returns the effective delete policy of a project fork.
+func ForkDeletePolicy(p *v1alpha1.ProjectForkParameters) v1alpha1.ProjectForkDeletePolicy {
+	if p.DeletePolicy != nil {
+		return *p.DeletePolicy
+	}
+	if p.ProjectID != nil {
+		return v1alpha1.ProjectForkRemoveForkRelation
+	}
+	return v1alpha1.ProjectForkDeleteProject
+}
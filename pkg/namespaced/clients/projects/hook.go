@@ -34,10 +34,12 @@ const (
 
 // HookClient defines Gitlab Hook service operations
 type HookClient interface {
+	ListProjectHooks(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error)
 	GetProjectHook(pid interface{}, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	AddProjectHook(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	EditProjectHook(pid interface{}, hook int64, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	DeleteProjectHook(pid interface{}, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteProjectCustomHeader(pid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
 // NewHookClient returns a new Gitlab Project service
@@ -54,6 +56,19 @@ func IsErrorHookNotFound(err error) bool {
 	return strings.Contains(err.Error(), errHookNotFound)
 }
 
+// FindHookByURL returns the hook with the supplied URL, or nil if there is
+// none. GitLab allows several hooks with the same URL, the one with the lowest
+// ID is returned so that the same hook is found every time.
+func FindHookByURL(hooks []*gitlab.ProjectHook, url string) *gitlab.ProjectHook {
+	var found *gitlab.ProjectHook
+	for _, h := range hooks {
+		if h.URL == url && (found == nil || h.ID < found.ID) {
+			found = h
+		}
+	}
+	return found
+}
+
 // LateInitializeHook fills the empty fields in the hook spec with the
 // values seen in gitlab.Hook.
 func LateInitializeHook(in *v1alpha1.HookParameters, hook *gitlab.ProjectHook) { //nolint:gocyclo
@@ -95,6 +110,7 @@ func LateInitializeHook(in *v1alpha1.HookParameters, hook *gitlab.ProjectHook) {
 	if in.EnableSSLVerification == nil {
 		in.EnableSSLVerification = &hook.EnableSSLVerification
 	}
+	in.CustomWebhookTemplate = clients.LateInitializeStringPtr(in.CustomWebhookTemplate, hook.CustomWebhookTemplate)
 }
 
 // GenerateHookObservation is used to produce v1alpha1.HookObservation from
@@ -108,6 +124,10 @@ func GenerateHookObservation(hook *gitlab.ProjectHook) v1alpha1.HookObservation
 		ID: hook.ID,
 	}
 
+	for _, h := range hook.CustomHeaders {
+		o.CustomHeaderKeys = append(o.CustomHeaderKeys, h.Key)
+	}
+
 	if hook.CreatedAt != nil {
 		o.CreatedAt = &metav1.Time{Time: *hook.CreatedAt}
 	}
@@ -115,8 +135,8 @@ func GenerateHookObservation(hook *gitlab.ProjectHook) v1alpha1.HookObservation
 }
 
 // GenerateCreateHookOptions generates project creation options
-func GenerateCreateHookOptions(p *v1alpha1.HookParameters, token *string) *gitlab.AddProjectHookOptions {
-	return &gitlab.AddProjectHookOptions{
+func GenerateCreateHookOptions(p *v1alpha1.HookParameters, token *string, headers []*gitlab.HookCustomHeader) *gitlab.AddProjectHookOptions {
+	o := &gitlab.AddProjectHookOptions{
 		URL:                      p.URL,
 		ConfidentialNoteEvents:   p.ConfidentialNoteEvents,
 		PushEvents:               p.PushEvents,
@@ -131,12 +151,17 @@ func GenerateCreateHookOptions(p *v1alpha1.HookParameters, token *string) *gitla
 		WikiPageEvents:           p.WikiPageEvents,
 		EnableSSLVerification:    p.EnableSSLVerification,
 		Token:                    token,
+		CustomWebhookTemplate:    p.CustomWebhookTemplate,
+	}
+	if headers != nil {
+		o.CustomHeaders = &headers
 	}
+	return o
 }
 
 // GenerateEditHookOptions generates project edit options
-func GenerateEditHookOptions(p *v1alpha1.HookParameters, token *string) *gitlab.EditProjectHookOptions {
-	return &gitlab.EditProjectHookOptions{
+func GenerateEditHookOptions(p *v1alpha1.HookParameters, token *string, headers []*gitlab.HookCustomHeader) *gitlab.EditProjectHookOptions {
+	o := &gitlab.EditProjectHookOptions{
 		URL:                      p.URL,
 		ConfidentialNoteEvents:   p.ConfidentialNoteEvents,
 		PushEvents:               p.PushEvents,
@@ -151,50 +176,81 @@ func GenerateEditHookOptions(p *v1alpha1.HookParameters, token *string) *gitlab.
 		WikiPageEvents:           p.WikiPageEvents,
 		EnableSSLVerification:    p.EnableSSLVerification,
 		Token:                    token,
+		CustomWebhookTemplate:    p.CustomWebhookTemplate,
 	}
+	if headers != nil {
+		o.CustomHeaders = &headers
+	}
+	return o
 }
 
 // IsHookUpToDate checks whether there is a change in any of the modifiable fields.
-func IsHookUpToDate(p *v1alpha1.HookParameters, g *gitlab.ProjectHook) bool { //nolint:gocyclo
+func IsHookUpToDate(p *v1alpha1.HookParameters, g *gitlab.ProjectHook) bool {
 	if !cmp.Equal(p.URL, clients.StringToPtr(g.URL)) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.ConfidentialNoteEvents, g.ConfidentialNoteEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.PushEvents, g.PushEvents) {
-		return false
-	}
-	if !cmp.Equal(p.PushEventsBranchFilter, clients.StringToPtr(g.PushEventsBranchFilter)) {
+	if !common.AreHookTriggersUpToDate(hookTriggers(p), observedHookTriggers(g)) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.IssuesEvents, g.IssuesEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.ConfidentialIssuesEvents, g.ConfidentialIssuesEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.MergeRequestsEvents, g.MergeRequestsEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.TagPushEvents, g.TagPushEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.NoteEvents, g.NoteEvents) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.JobEvents, g.JobEvents) {
+	if !clients.IsBoolEqualToBoolPtr(p.EnableSSLVerification, g.EnableSSLVerification) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.PipelineEvents, g.PipelineEvents) {
+	if !clients.IsComparableEqualToComparablePtr(p.CustomWebhookTemplate, g.CustomWebhookTemplate) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.WikiPageEvents, g.WikiPageEvents) {
-		return false
+
+	return AreHookCustomHeaderKeysUpToDate(p, common.HookCustomHeaderKeys(g.CustomHeaders))
+}
+
+// AreHookCustomHeaderKeysUpToDate checks whether the custom headers of a hook
+// have the desired keys. Their values are not returned by GitLab and have to
+// be compared by the caller.
+func AreHookCustomHeaderKeysUpToDate(p *v1alpha1.HookParameters, keys []string) bool {
+	return common.AreHookCustomHeaderKeysUpToDate(hookCustomHeaderKeys(p), keys)
+}
+
+// RemovedHookCustomHeaderKeys returns the observed custom header keys which
+// are no longer desired.
+func RemovedHookCustomHeaderKeys(p *v1alpha1.HookParameters, keys []string) []string {
+	return common.RemovedHookCustomHeaderKeys(hookCustomHeaderKeys(p), keys)
+}
+
+func hookCustomHeaderKeys(p *v1alpha1.HookParameters) []string {
+	keys := make([]string, 0, len(p.CustomHeaders))
+	for _, h := range p.CustomHeaders {
+		keys = append(keys, h.Key)
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.EnableSSLVerification, g.EnableSSLVerification) {
-		return false
+	return keys
+}
+
+func hookTriggers(p *v1alpha1.HookParameters) common.HookTriggers {
+	return common.HookTriggers{
+		PushEvents:               p.PushEvents,
+		PushEventsBranchFilter:   p.PushEventsBranchFilter,
+		IssuesEvents:             p.IssuesEvents,
+		ConfidentialIssuesEvents: p.ConfidentialIssuesEvents,
+		MergeRequestsEvents:      p.MergeRequestsEvents,
+		TagPushEvents:            p.TagPushEvents,
+		NoteEvents:               p.NoteEvents,
+		ConfidentialNoteEvents:   p.ConfidentialNoteEvents,
+		JobEvents:                p.JobEvents,
+		PipelineEvents:           p.PipelineEvents,
+		WikiPageEvents:           p.WikiPageEvents,
 	}
+}
 
-	return true
+func observedHookTriggers(g *gitlab.ProjectHook) common.HookTriggers {
+	return common.HookTriggers{
+		PushEvents:               &g.PushEvents,
+		PushEventsBranchFilter:   &g.PushEventsBranchFilter,
+		IssuesEvents:             &g.IssuesEvents,
+		ConfidentialIssuesEvents: &g.ConfidentialIssuesEvents,
+		MergeRequestsEvents:      &g.MergeRequestsEvents,
+		TagPushEvents:            &g.TagPushEvents,
+		NoteEvents:               &g.NoteEvents,
+		ConfidentialNoteEvents:   &g.ConfidentialNoteEvents,
+		JobEvents:                &g.JobEvents,
+		PipelineEvents:           &g.PipelineEvents,
+		WikiPageEvents:           &g.WikiPageEvents,
+	}
 }
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"slices"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+const (
+	issueStateEventClose  = "close"
+	issueStateEventReopen = "reopen"
+)
+
+// IssueClient defines GitLab issue service operations
+type IssueClient interface {
+	GetIssue(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	CreateIssue(pid any, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	UpdateIssue(pid any, issue int64, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	DeleteIssue(pid any, issue int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewIssueClient returns a new GitLab issue service
+func NewIssueClient(cfg common.Config) IssueClient {
+	git := common.NewClient(cfg)
+	return git.Issues
+}
+
+// GenerateIssueObservation produces an IssueObservation from a gitlab.Issue.
+func GenerateIssueObservation(i *gitlab.Issue) v1alpha1.IssueObservation {
+	if i == nil {
+		return v1alpha1.IssueObservation{}
+	}
+
+	o := v1alpha1.IssueObservation{
+		ID:        i.ID,
+		IID:       i.IID,
+		ProjectID: i.ProjectID,
+		State:     i.State,
+		WebURL:    i.WebURL,
+		CreatedAt: common.TimeToMetaTime(i.CreatedAt),
+		UpdatedAt: common.TimeToMetaTime(i.UpdatedAt),
+		ClosedAt:  common.TimeToMetaTime(i.ClosedAt),
+	}
+
+	if i.Author != nil {
+		o.AuthorID = i.Author.ID
+	}
+
+	return o
+}
+
+// GenerateCreateIssueOptions generates issue creation options. New issues are
+// always opened, a desired closed state is applied by a subsequent update.
+func GenerateCreateIssueOptions(p *v1alpha1.IssueParameters) *gitlab.CreateIssueOptions {
+	opt := &gitlab.CreateIssueOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		MilestoneID: p.MilestoneID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	return opt
+}
+
+// GenerateUpdateIssueOptions generates issue update options. The state is
+// changed by a close or reopen event if it differs from the observed state.
+func GenerateUpdateIssueOptions(p *v1alpha1.IssueParameters, state string) *gitlab.UpdateIssueOptions {
+	opt := &gitlab.UpdateIssueOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		MilestoneID: p.MilestoneID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	if p.State != nil && string(*p.State) != state {
+		switch *p.State {
+		case v1alpha1.IssueStateClosed:
+			opt.StateEvent = gitlab.Ptr(issueStateEventClose)
+		case v1alpha1.IssueStateOpened:
+			opt.StateEvent = gitlab.Ptr(issueStateEventReopen)
+		}
+	}
+
+	return opt
+}
+
+// LateInitializeIssue fills the empty fields in the issue spec with the
+// values seen in gitlab.Issue.
+func LateInitializeIssue(in *v1alpha1.IssueParameters, i *gitlab.Issue) {
+	if i == nil {
+		return
+	}
+
+	in.Description = clients.LateInitializeStringPtr(in.Description, i.Description)
+
+	if in.Labels == nil && len(i.Labels) > 0 {
+		in.Labels = slices.Clone(i.Labels)
+	}
+	if in.AssigneeIDs == nil && len(i.Assignees) > 0 {
+		in.AssigneeIDs = issueAssigneeIDs(i)
+	}
+	if in.MilestoneID == nil && i.Milestone != nil {
+		in.MilestoneID = &i.Milestone.ID
+	}
+	if in.State == nil && i.State != "" {
+		in.State = (*v1alpha1.IssueState)(&i.State)
+	}
+}
+
+// IsIssueUpToDate checks whether the observed issue matches the desired one.
+// Labels and assignees are compared regardless of their order.
+func IsIssueUpToDate(p *v1alpha1.IssueParameters, i *gitlab.Issue) bool {
+	if i == nil {
+		return false
+	}
+
+	if p.Title != i.Title {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.Description, i.Description) {
+		return false
+	}
+
+	if p.Labels != nil && !clients.IsSameSet(p.Labels, i.Labels) {
+		return false
+	}
+
+	if p.AssigneeIDs != nil && !clients.IsSameSet(p.AssigneeIDs, issueAssigneeIDs(i)) {
+		return false
+	}
+
+	if p.MilestoneID != nil {
+		var milestoneID int64
+		if i.Milestone != nil {
+			milestoneID = i.Milestone.ID
+		}
+		if *p.MilestoneID != milestoneID {
+			return false
+		}
+	}
+
+	if p.State != nil && string(*p.State) != i.State {
+		return false
+	}
+
+	return true
+}
+
+func issueAssigneeIDs(i *gitlab.Issue) []int64 {
+	ids := make([]int64, 0, len(i.Assignees))
+	for _, a := range i.Assignees {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
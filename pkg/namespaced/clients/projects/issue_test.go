@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func TestIsIssueUpToDate(t *testing.T) {
+	closed := v1alpha1.IssueStateClosed
+	milestoneID := int64(9)
+	issue := &gitlab.Issue{
+		Title:     "Onboarding",
+		State:     "opened",
+		Labels:    gitlab.Labels{"a", "b"},
+		Assignees: []*gitlab.IssueAssignee{{ID: 1}, {ID: 2}},
+		Milestone: &gitlab.Milestone{ID: 9},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.IssueParameters
+		i    *gitlab.Issue
+		want bool
+	}{
+		"NoIssue": {
+			p:    &v1alpha1.IssueParameters{Title: "Onboarding"},
+			want: false,
+		},
+		"UpToDate": {
+			p: &v1alpha1.IssueParameters{
+				Title:       "Onboarding",
+				Labels:      []string{"b", "a"},
+				AssigneeIDs: []int64{2, 1},
+				MilestoneID: &milestoneID,
+			},
+			i:    issue,
+			want: true,
+		},
+		"TitleChanged": {
+			p:    &v1alpha1.IssueParameters{Title: "Offboarding"},
+			i:    issue,
+			want: false,
+		},
+		"LabelsChanged": {
+			p:    &v1alpha1.IssueParameters{Title: "Onboarding", Labels: []string{"a"}},
+			i:    issue,
+			want: false,
+		},
+		"AssigneesChanged": {
+			p:    &v1alpha1.IssueParameters{Title: "Onboarding", AssigneeIDs: []int64{1, 3}},
+			i:    issue,
+			want: false,
+		},
+		"MilestoneRemoved": {
+			p:    &v1alpha1.IssueParameters{Title: "Onboarding", MilestoneID: new(int64)},
+			i:    issue,
+			want: false,
+		},
+		"StateChanged": {
+			p:    &v1alpha1.IssueParameters{Title: "Onboarding", State: &closed},
+			i:    issue,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsIssueUpToDate(tc.p, tc.i)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsIssueUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateIssueOptionsStateEvent(t *testing.T) {
+	opened := v1alpha1.IssueStateOpened
+	closed := v1alpha1.IssueStateClosed
+
+	cases := map[string]struct {
+		state    *v1alpha1.IssueState
+		observed string
+		want     *string
+	}{
+		"StateUnset": {
+			observed: "opened",
+		},
+		"StateUnchanged": {
+			state:    &closed,
+			observed: "closed",
+		},
+		"Close": {
+			state:    &closed,
+			observed: "opened",
+			want:     gitlab.Ptr("close"),
+		},
+		"Reopen": {
+			state:    &opened,
+			observed: "closed",
+			want:     gitlab.Ptr("reopen"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateIssueOptions(&v1alpha1.IssueParameters{Title: "Onboarding", State: tc.state}, tc.observed)
+			if diff := cmp.Diff(tc.want, got.StateEvent); diff != "" {
+				t.Errorf("GenerateUpdateIssueOptions(...).StateEvent: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeIssue(t *testing.T) {
+	description := "desc"
+	set := []string{"set"}
+	opened := v1alpha1.IssueStateOpened
+	milestoneID := int64(9)
+
+	cases := map[string]struct {
+		p    *v1alpha1.IssueParameters
+		i    *gitlab.Issue
+		want *v1alpha1.IssueParameters
+	}{
+		"NoIssue": {
+			p:    &v1alpha1.IssueParameters{Title: "t"},
+			want: &v1alpha1.IssueParameters{Title: "t"},
+		},
+		"FillsUnsetFields": {
+			p: &v1alpha1.IssueParameters{Title: "t"},
+			i: &gitlab.Issue{
+				Description: description,
+				Labels:      gitlab.Labels{"gitlab"},
+				Assignees:   []*gitlab.IssueAssignee{{ID: 1}},
+				Milestone:   &gitlab.Milestone{ID: 9},
+				State:       "opened",
+			},
+			want: &v1alpha1.IssueParameters{
+				Title:       "t",
+				Description: &description,
+				Labels:      []string{"gitlab"},
+				AssigneeIDs: []int64{1},
+				MilestoneID: &milestoneID,
+				State:       &opened,
+			},
+		},
+		"KeepsSetFields": {
+			p: &v1alpha1.IssueParameters{Title: "t", Labels: set},
+			i: &gitlab.Issue{Labels: gitlab.Labels{"gitlab"}, State: "opened"},
+			want: &v1alpha1.IssueParameters{
+				Title:  "t",
+				Labels: set,
+				State:  &opened,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeIssue(tc.p, tc.i)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeIssue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
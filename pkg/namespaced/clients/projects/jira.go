@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// JiraClient defines the GitLab operations on the Jira integration of a
+// project.
+type JiraClient interface {
+	GetJiraService(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error)
+}
+
+// NewJiraClient returns a new GitLab Services client.
+func NewJiraClient(cfg common.Config) JiraClient {
+	git := common.NewClient(cfg)
+	return git.Services
+}
+
+// IsJiraIntegrationActive returns true if the Jira integration returned by
+// GetJiraService is active. GitLab answers with 404 for projects that never
+// configured it, which is not an error.
+func IsJiraIntegrationActive(jira *gitlab.JiraService, res *gitlab.Response, err error) (bool, error) {
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return false, nil
+		}
+		return false, err
+	}
+	return jira != nil && jira.Active, nil
+}
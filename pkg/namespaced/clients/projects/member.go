@@ -17,6 +17,7 @@ limitations under the License.
 package projects
 
 import (
+	"context"
 	"strings"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -28,11 +29,14 @@ import (
 
 const (
 	errMemberNotFound = "404 Project Member Not Found"
+
+	memberPageSize = 100
 )
 
 // MemberClient defines Gitlab Member service operations
 type MemberClient interface {
 	GetProjectMember(pid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	ListAllProjectMembers(pid interface{}, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)
 	AddProjectMember(pid interface{}, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
 	EditProjectMember(pid interface{}, user int64, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
 	DeleteProjectMember(pid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
@@ -52,6 +56,32 @@ func IsErrorMemberNotFound(err error) bool {
 	return strings.Contains(err.Error(), errMemberNotFound)
 }
 
+// GetInheritedMember returns the membership of the user in the project
+// including the ones inherited from ancestor groups, or nil if the user has
+// no access to the project. GitLab returns the highest access level of the
+// user, which is why the direct membership is read with GetProjectMember.
+func GetInheritedMember(ctx context.Context, c MemberClient, pid interface{}, user int64) (*gitlab.ProjectMember, error) {
+	opt := &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: memberPageSize, Page: 1},
+		UserIDs:     &[]int64{user},
+	}
+	for {
+		members, res, err := c.ListAllProjectMembers(pid, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			if m.ID == user {
+				return m, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
 // GenerateMemberObservation is used to produce v1alpha1.MemberObservation from
 // gitlab.Member.
 func GenerateMemberObservation(projectMember *gitlab.ProjectMember) v1alpha1.MemberObservation {
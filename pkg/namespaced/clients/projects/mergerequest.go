@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"slices"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// MergeRequestStateOpened is the state of merge requests that are neither
+// merged nor closed.
+const MergeRequestStateOpened = "opened"
+
+// MergeRequestClient defines GitLab merge request service operations
+type MergeRequestClient interface {
+	GetMergeRequest(pid any, mergeRequest int64, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	CreateMergeRequest(pid any, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	UpdateMergeRequest(pid any, mergeRequest int64, opt *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	DeleteMergeRequest(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	AcceptMergeRequest(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	CancelMergeWhenPipelineSucceeds(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+}
+
+// NewMergeRequestClient returns a new GitLab merge request service
+func NewMergeRequestClient(cfg common.Config) MergeRequestClient {
+	git := common.NewClient(cfg)
+	return git.MergeRequests
+}
+
+// GenerateMergeRequestObservation produces a MergeRequestObservation from a
+// gitlab.MergeRequest.
+func GenerateMergeRequestObservation(mr *gitlab.MergeRequest) v1alpha1.MergeRequestObservation {
+	if mr == nil {
+		return v1alpha1.MergeRequestObservation{}
+	}
+
+	return v1alpha1.MergeRequestObservation{
+		ID:                  mr.ID,
+		IID:                 mr.IID,
+		State:               mr.State,
+		DetailedMergeStatus: mr.DetailedMergeStatus,
+		AutoMergeEnabled:    mr.MergeWhenPipelineSucceeds,
+		SHA:                 mr.SHA,
+		MergeCommitSHA:      mr.MergeCommitSHA,
+		WebURL:              mr.WebURL,
+		CreatedAt:           common.TimeToMetaTime(mr.CreatedAt),
+		UpdatedAt:           common.TimeToMetaTime(mr.UpdatedAt),
+		MergedAt:            common.TimeToMetaTime(mr.MergedAt),
+		ClosedAt:            common.TimeToMetaTime(mr.ClosedAt),
+	}
+}
+
+// GenerateCreateMergeRequestOptions generates merge request creation options.
+func GenerateCreateMergeRequestOptions(p *v1alpha1.MergeRequestParameters) *gitlab.CreateMergeRequestOptions {
+	opt := &gitlab.CreateMergeRequestOptions{
+		SourceBranch:       &p.SourceBranch,
+		TargetBranch:       &p.TargetBranch,
+		Title:              &p.Title,
+		Description:        p.Description,
+		RemoveSourceBranch: p.RemoveSourceBranch,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	return opt
+}
+
+// GenerateUpdateMergeRequestOptions generates merge request update options.
+func GenerateUpdateMergeRequestOptions(p *v1alpha1.MergeRequestParameters) *gitlab.UpdateMergeRequestOptions {
+	opt := &gitlab.UpdateMergeRequestOptions{
+		TargetBranch:       &p.TargetBranch,
+		Title:              &p.Title,
+		Description:        p.Description,
+		RemoveSourceBranch: p.RemoveSourceBranch,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	return opt
+}
+
+// LateInitializeMergeRequest fills the empty fields in the merge request spec
+// with the values seen in gitlab.MergeRequest.
+func LateInitializeMergeRequest(in *v1alpha1.MergeRequestParameters, mr *gitlab.MergeRequest) {
+	if mr == nil {
+		return
+	}
+
+	in.Description = clients.LateInitializeStringPtr(in.Description, mr.Description)
+
+	if in.Labels == nil && len(mr.Labels) > 0 {
+		in.Labels = slices.Clone(mr.Labels)
+	}
+	if in.AssigneeIDs == nil && len(mr.Assignees) > 0 {
+		in.AssigneeIDs = mergeRequestAssigneeIDs(mr)
+	}
+	if in.RemoveSourceBranch == nil {
+		in.RemoveSourceBranch = &mr.ForceRemoveSourceBranch
+	}
+}
+
+// IsMergeRequestUpToDate checks whether the metadata of the observed merge
+// request matches the desired one. Labels and assignees are compared
+// regardless of their order.
+func IsMergeRequestUpToDate(p *v1alpha1.MergeRequestParameters, mr *gitlab.MergeRequest) bool {
+	if mr == nil {
+		return false
+	}
+
+	if p.Title != mr.Title || p.TargetBranch != mr.TargetBranch {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.Description, mr.Description) {
+		return false
+	}
+
+	if p.Labels != nil && !clients.IsSameSet(p.Labels, mr.Labels) {
+		return false
+	}
+
+	if p.AssigneeIDs != nil && !clients.IsSameSet(p.AssigneeIDs, mergeRequestAssigneeIDs(mr)) {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.RemoveSourceBranch, mr.ForceRemoveSourceBranch) {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.AutoMerge, mr.MergeWhenPipelineSucceeds) {
+		return false
+	}
+
+	return true
+}
+
+func mergeRequestAssigneeIDs(mr *gitlab.MergeRequest) []int64 {
+	ids := make([]int64, 0, len(mr.Assignees))
+	for _, a := range mr.Assignees {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
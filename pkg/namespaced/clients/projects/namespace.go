@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// NamespaceClient defines the GitLab namespace operations needed to resolve
+// the namespace of a project.
+type NamespaceClient interface {
+	GetNamespace(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error)
+}
+
+// NewNamespaceClient returns a new GitLab namespace service
+func NewNamespaceClient(cfg common.Config) NamespaceClient {
+	git := common.NewClient(cfg)
+	return git.Namespaces
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+const (
+	errUnknownKeepNDuplicatedPackageFiles = "unknown keepNDuplicatedPackageFiles %s"
+
+	getPackagesCleanupPolicyQuery = `query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    packagesCleanupPolicy { keepNDuplicatedPackageFiles nextRunAt }
+  }
+}`
+
+	updatePackagesCleanupPolicyMutation = `mutation($projectPath: ID!, $keepN: PackagesCleanupKeepDuplicatedPackageFilesEnum) {
+  updatePackagesCleanupPolicy(input: {projectPath: $projectPath, keepNDuplicatedPackageFiles: $keepN}) {
+    errors
+  }
+}`
+)
+
+// keepNDuplicatedPackageFiles maps the values of keepNDuplicatedPackageFiles
+// to the GraphQL enum values GitLab uses for them.
+var keepNDuplicatedPackageFiles = map[string]string{
+	"all": "ALL_PACKAGE_FILES",
+	"1":   "ONE_PACKAGE_FILE",
+	"10":  "TEN_PACKAGE_FILES",
+	"20":  "TWENTY_PACKAGE_FILES",
+	"30":  "THIRTY_PACKAGE_FILES",
+	"40":  "FORTY_PACKAGE_FILES",
+	"50":  "FIFTY_PACKAGE_FILES",
+}
+
+// PackagesCleanupPolicy is the cleanup policy of the package registry of a
+// project.
+type PackagesCleanupPolicy struct {
+	KeepNDuplicatedPackageFiles string
+	NextRunAt                   *time.Time
+}
+
+// PackagesCleanupPolicyClient defines the GitLab operations on the package
+// cleanup policy of a project. It is only available through the GraphQL API.
+type PackagesCleanupPolicyClient interface {
+	GetPackagesCleanupPolicy(ctx context.Context, project string) (*PackagesCleanupPolicy, error)
+	UpdatePackagesCleanupPolicy(ctx context.Context, project string, keepN string) error
+}
+
+// NewPackagesCleanupPolicyClient returns a new GitLab package cleanup policy
+// client.
+func NewPackagesCleanupPolicyClient(cfg common.Config) PackagesCleanupPolicyClient {
+	git := common.NewClient(cfg)
+	return &packagesCleanupPolicyClient{graphql: git.GraphQL}
+}
+
+type packagesCleanupPolicyClient struct {
+	graphql gitlab.GraphQLInterface
+}
+
+// GetPackagesCleanupPolicy returns the package cleanup policy of the project
+// with the given full path.
+func (c *packagesCleanupPolicyClient) GetPackagesCleanupPolicy(ctx context.Context, project string) (*PackagesCleanupPolicy, error) {
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			Project *struct {
+				PackagesCleanupPolicy *struct {
+					KeepNDuplicatedPackageFiles string     `json:"keepNDuplicatedPackageFiles"`
+					NextRunAt                   *time.Time `json:"nextRunAt"`
+				} `json:"packagesCleanupPolicy"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     getPackagesCleanupPolicyQuery,
+		Variables: map[string]any{"fullPath": project},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	if res.Data.Project == nil || res.Data.Project.PackagesCleanupPolicy == nil {
+		return nil, common.ErrGraphQLResourceNotAvailable("package cleanup policy of project " + project)
+	}
+
+	p := res.Data.Project.PackagesCleanupPolicy
+	policy := &PackagesCleanupPolicy{NextRunAt: p.NextRunAt}
+	for k, v := range keepNDuplicatedPackageFiles {
+		if v == p.KeepNDuplicatedPackageFiles {
+			policy.KeepNDuplicatedPackageFiles = k
+		}
+	}
+	return policy, nil
+}
+
+// UpdatePackagesCleanupPolicy sets how many duplicated files of a package the
+// project with the given full path keeps.
+func (c *packagesCleanupPolicyClient) UpdatePackagesCleanupPolicy(ctx context.Context, project string, keepN string) error {
+	value, ok := keepNDuplicatedPackageFiles[keepN]
+	if !ok {
+		return errors.Errorf(errUnknownKeepNDuplicatedPackageFiles, keepN)
+	}
+
+	var res struct {
+		common.GraphQLErrors
+		Data struct {
+			UpdatePackagesCleanupPolicy *struct {
+				Errors []string `json:"errors"`
+			} `json:"updatePackagesCleanupPolicy"`
+		} `json:"data"`
+	}
+	q := gitlab.GraphQLQuery{
+		Query:     updatePackagesCleanupPolicyMutation,
+		Variables: map[string]any{"projectPath": project, "keepN": value},
+	}
+	if _, err := c.graphql.Do(q, &res, gitlab.WithContext(ctx)); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	if p := res.Data.UpdatePackagesCleanupPolicy; p != nil {
+		return common.GraphQLMutationErrors(p.Errors)
+	}
+	return nil
+}
+
+// GeneratePackagesCleanupPolicyObservation produces the observation of a
+// package cleanup policy. Only the time of the next run is computed by
+// GitLab, it is never compared with the spec.
+func GeneratePackagesCleanupPolicyObservation(p *PackagesCleanupPolicy) *v1alpha1.PackagesCleanupPolicyObservation {
+	if p == nil {
+		return nil
+	}
+	o := &v1alpha1.PackagesCleanupPolicyObservation{KeepNDuplicatedPackageFiles: p.KeepNDuplicatedPackageFiles}
+	if p.NextRunAt != nil {
+		o.NextRunAt = &metav1.Time{Time: *p.NextRunAt}
+	}
+	return o
+}
+
+// IsPackagesCleanupPolicyUpToDate checks whether the observed package
+// cleanup policy matches the desired one. A nil desired policy is not
+// managed and always up to date.
+func IsPackagesCleanupPolicyUpToDate(desired *v1alpha1.PackagesCleanupPolicy, observed *PackagesCleanupPolicy) bool {
+	if desired == nil {
+		return true
+	}
+	return observed != nil && desired.KeepNDuplicatedPackageFiles == observed.KeepNDuplicatedPackageFiles
+}
+
+// IsContainerExpirationPolicyUpToDate checks whether the observed container
+// expiration policy matches the desired attributes. Attributes that are nil
+// are not compared, neither is the time of the next run GitLab computes.
+func IsContainerExpirationPolicyUpToDate(desired *v1alpha1.ContainerExpirationPolicyAttributes, observed *gitlab.ContainerExpirationPolicy) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	//nolint:staticcheck // SA1019 NameRegex is deprecated but still accepted and returned by GitLab
+	return clients.IsComparableEqualToComparablePtr(desired.Cadence, observed.Cadence) &&
+		clients.IsComparableEqualToComparablePtr(desired.KeepN, observed.KeepN) &&
+		clients.IsComparableEqualToComparablePtr(desired.OlderThan, observed.OlderThan) &&
+		clients.IsComparableEqualToComparablePtr(desired.NameRegexDelete, observed.NameRegexDelete) &&
+		clients.IsComparableEqualToComparablePtr(desired.NameRegexKeep, observed.NameRegexKeep) &&
+		clients.IsComparableEqualToComparablePtr(desired.Enabled, observed.Enabled) &&
+		clients.IsComparableEqualToComparablePtr(desired.NameRegex, observed.NameRegex)
+}
+
+func isEqualOrUnset[T comparable](desired *T, observed T) bool {
+	return desired == nil || *desired == observed
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func TestGetPackagesCleanupPolicy(t *testing.T) {
+	nextRunAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type want struct {
+		policy *PackagesCleanupPolicy
+		err    error
+	}
+
+	cases := map[string]struct {
+		response string
+		want     want
+	}{
+		"Success": {
+			response: `{"data":{"project":{"packagesCleanupPolicy":{"keepNDuplicatedPackageFiles":"TEN_PACKAGE_FILES","nextRunAt":"2026-01-02T03:04:05Z"}}}}`,
+			want: want{
+				policy: &PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "10", NextRunAt: &nextRunAt},
+			},
+		},
+		"ProjectNotAvailable": {
+			response: `{"data":{"project":null}}`,
+			want: want{
+				err: errors.New("package cleanup policy of project acme/app does not exist or you don't have permission to perform this action"),
+			},
+		},
+		"GraphQLErrors": {
+			response: `{"errors":[{"message":"boom"}]}`,
+			want: want{
+				err: errors.New("boom"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: []string{tc.response}}
+			c := &packagesCleanupPolicyClient{graphql: g}
+
+			got, err := c.GetPackagesCleanupPolicy(context.Background(), "acme/app")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetPackagesCleanupPolicy(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, got); diff != "" {
+				t.Errorf("GetPackagesCleanupPolicy(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(map[string]any{"fullPath": "acme/app"}, g.queries[0].Variables); diff != "" {
+				t.Errorf("GetPackagesCleanupPolicy(...): -want variables, +got variables:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdatePackagesCleanupPolicy(t *testing.T) {
+	type want struct {
+		queries []gitlab.GraphQLQuery
+		err     error
+	}
+
+	cases := map[string]struct {
+		keepN     string
+		responses []string
+		want      want
+	}{
+		"Success": {
+			keepN:     "all",
+			responses: []string{`{"data":{"updatePackagesCleanupPolicy":{"errors":[]}}}`},
+			want: want{
+				queries: []gitlab.GraphQLQuery{{
+					Query:     updatePackagesCleanupPolicyMutation,
+					Variables: map[string]any{"projectPath": "acme/app", "keepN": "ALL_PACKAGE_FILES"},
+				}},
+			},
+		},
+		"MutationErrors": {
+			keepN:     "1",
+			responses: []string{`{"data":{"updatePackagesCleanupPolicy":{"errors":["not allowed"]}}}`},
+			want: want{
+				queries: []gitlab.GraphQLQuery{{
+					Query:     updatePackagesCleanupPolicyMutation,
+					Variables: map[string]any{"projectPath": "acme/app", "keepN": "ONE_PACKAGE_FILE"},
+				}},
+				err: errors.New("not allowed"),
+			},
+		},
+		"UnknownValue": {
+			keepN: "5",
+			want: want{
+				err: errors.Errorf(errUnknownKeepNDuplicatedPackageFiles, "5"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &fakeGraphQL{responses: tc.responses}
+			c := &packagesCleanupPolicyClient{graphql: g}
+
+			err := c.UpdatePackagesCleanupPolicy(context.Background(), "acme/app", tc.keepN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdatePackagesCleanupPolicy(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.queries, g.queries); diff != "" {
+				t.Errorf("UpdatePackagesCleanupPolicy(...): -want queries, +got queries:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPackagesCleanupPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *v1alpha1.PackagesCleanupPolicy
+		observed *PackagesCleanupPolicy
+		want     bool
+	}{
+		"Unmanaged": {
+			observed: &PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "all"},
+			want:     true,
+		},
+		"Equal": {
+			desired:  &v1alpha1.PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "10"},
+			observed: &PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "10", NextRunAt: &time.Time{}},
+			want:     true,
+		},
+		"Different": {
+			desired:  &v1alpha1.PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "1"},
+			observed: &PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "all"},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPackagesCleanupPolicyUpToDate(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("IsPackagesCleanupPolicyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsContainerExpirationPolicyUpToDate(t *testing.T) {
+	nextRunAt := time.Now()
+	observed := &gitlab.ContainerExpirationPolicy{
+		Cadence:   "7d",
+		KeepN:     10,
+		OlderThan: "90d",
+		Enabled:   true,
+		NextRunAt: &nextRunAt,
+	}
+
+	cases := map[string]struct {
+		desired  *v1alpha1.ContainerExpirationPolicyAttributes
+		observed *gitlab.ContainerExpirationPolicy
+		want     bool
+	}{
+		"Unmanaged": {
+			observed: observed,
+			want:     true,
+		},
+		"Equal": {
+			desired:  &v1alpha1.ContainerExpirationPolicyAttributes{Cadence: ptr.To("7d"), Enabled: ptr.To(true)},
+			observed: observed,
+			want:     true,
+		},
+		"CadenceChanged": {
+			desired:  &v1alpha1.ContainerExpirationPolicyAttributes{Cadence: ptr.To("1month"), Enabled: ptr.To(true)},
+			observed: observed,
+			want:     false,
+		},
+		"NotObserved": {
+			desired: &v1alpha1.ContainerExpirationPolicyAttributes{Cadence: ptr.To("7d")},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsContainerExpirationPolicyUpToDate(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("IsContainerExpirationPolicyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
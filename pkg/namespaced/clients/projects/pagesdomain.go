@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// PagesDomainClient defines GitLab Pages domain service operations
+type PagesDomainClient interface {
+	GetPagesDomain(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	CreatePagesDomain(pid any, opt *gitlab.CreatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	UpdatePagesDomain(pid any, domain string, opt *gitlab.UpdatePagesDomainOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PagesDomain, *gitlab.Response, error)
+	DeletePagesDomain(pid any, domain string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewPagesDomainClient returns a new GitLab Pages domain service
+func NewPagesDomainClient(cfg common.Config) PagesDomainClient {
+	git := common.NewClient(cfg)
+	return git.PagesDomains
+}
+
+// GeneratePagesDomainObservation produces a PagesDomainObservation from a gitlab.PagesDomain.
+func GeneratePagesDomainObservation(d *gitlab.PagesDomain) v1alpha1.PagesDomainObservation {
+	if d == nil {
+		return v1alpha1.PagesDomainObservation{}
+	}
+
+	o := v1alpha1.PagesDomainObservation{
+		Domain:           d.Domain,
+		URL:              d.URL,
+		AutoSslEnabled:   d.AutoSslEnabled,
+		Verified:         d.Verified,
+		VerificationCode: d.VerificationCode,
+		EnabledUntil:     common.TimeToMetaTime(d.EnabledUntil),
+	}
+
+	if d.Certificate.Subject != "" || d.Certificate.Certificate != "" {
+		o.Certificate = &v1alpha1.PagesDomainCertificate{
+			Subject:    d.Certificate.Subject,
+			Expired:    d.Certificate.Expired,
+			Expiration: common.TimeToMetaTime(d.Certificate.Expiration),
+		}
+	}
+
+	return o
+}
+
+// GenerateCreatePagesDomainOptions generates Pages domain creation options
+func GenerateCreatePagesDomainOptions(p *v1alpha1.PagesDomainParameters, key *string) *gitlab.CreatePagesDomainOptions {
+	return &gitlab.CreatePagesDomainOptions{
+		Domain:         &p.Domain,
+		AutoSslEnabled: p.AutoSslEnabled,
+		Certificate:    p.Certificate,
+		Key:            key,
+	}
+}
+
+// GenerateUpdatePagesDomainOptions generates Pages domain update options
+func GenerateUpdatePagesDomainOptions(p *v1alpha1.PagesDomainParameters, key *string) *gitlab.UpdatePagesDomainOptions {
+	return &gitlab.UpdatePagesDomainOptions{
+		AutoSslEnabled: p.AutoSslEnabled,
+		Certificate:    p.Certificate,
+		Key:            key,
+	}
+}
+
+// HashPagesDomainKey returns the hex encoded SHA-256 hash of a Pages domain key.
+func HashPagesDomainKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsPagesDomainUpToDate checks whether the observed Pages domain matches the
+// desired one. The key is not returned by GitLab and has to be compared by
+// the caller.
+func IsPagesDomainUpToDate(p *v1alpha1.PagesDomainParameters, d *gitlab.PagesDomain) bool {
+	if d == nil {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.AutoSslEnabled, d.AutoSslEnabled) {
+		return false
+	}
+
+	// GitLab normalizes surrounding whitespace of the certificate.
+	if p.Certificate != nil && strings.TrimSpace(*p.Certificate) != strings.TrimSpace(d.Certificate.Certificate) {
+		return false
+	}
+
+	return true
+}
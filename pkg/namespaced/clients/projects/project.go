@@ -17,11 +17,14 @@ limitations under the License.
 package projects
 
 import (
+	"bytes"
+	"io"
 	"strings"
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
@@ -38,6 +41,10 @@ type Client interface {
 	CreateProject(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	DeleteProject(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	RestoreProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	TransferProject(pid any, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	UploadAvatar(pid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	DownloadAvatar(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error)
 
 	GetProjectPushRules(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
 	AddProjectPushRule(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
@@ -53,6 +60,87 @@ func NewProjectClient(cfg common.Config) Client {
 	return git.Projects
 }
 
+// CIConfigProject returns the path of the project holding the CI
+// configuration if the CI configuration path references a file in another
+// project, e.g. path/to/ci.yml@group/shared-repo or
+// path/to/ci.yml@group/shared-repo:main. Remote URLs are not considered.
+func CIConfigProject(ciConfigPath string) (string, bool) {
+	if strings.Contains(ciConfigPath, "://") {
+		return "", false
+	}
+	i := strings.LastIndex(ciConfigPath, "@")
+	if i < 0 {
+		return "", false
+	}
+	project, _, _ := strings.Cut(ciConfigPath[i+1:], ":")
+	return project, project != ""
+}
+
+// TransferNamespaceID returns the ID of the namespace the project has to be
+// transferred to. It is nil unless transfers are allowed and namespaceId
+// differs from the namespace of the project.
+func TransferNamespaceID(p *v1alpha1.ProjectParameters, prj *gitlab.Project) *int64 {
+	if !ptr.Deref(p.AllowTransfer, false) || p.NamespaceID == nil || prj.Namespace == nil {
+		return nil
+	}
+	if *p.NamespaceID == prj.Namespace.ID {
+		return nil
+	}
+	return p.NamespaceID
+}
+
+// OmitUnsupportedProjectParameters clears the project parameters the supplied
+// GitLab version does not support, since GitLab ignores them and they would
+// never be up to date. It returns the names of the omitted parameters.
+func OmitUnsupportedProjectParameters(p *v1alpha1.ProjectParameters, v *common.ServerVersion) []string {
+	var omitted []string
+	if p.CIPipelineVariablesMinimumOverrideRole != nil && !v.AtLeast(17, 1) {
+		omitted = append(omitted, "ciPipelineVariablesMinimumOverrideRole")
+		p.CIPipelineVariablesMinimumOverrideRole = nil
+	}
+	if p.CICatalogResource != nil && !v.AtLeast(16, 6) {
+		omitted = append(omitted, "ciCatalogResource")
+		p.CICatalogResource = nil
+	}
+	return omitted
+}
+
+// IsAtExpectedPath returns true if the observed project is at the path the
+// parameters expect. Only path, namespacePath and namespaceId are compared,
+// and only if they are set.
+func IsAtExpectedPath(p *v1alpha1.ProjectParameters, o *v1alpha1.ProjectObservation) bool {
+	namespacePath, path := "", o.PathWithNamespace
+	if i := strings.LastIndex(o.PathWithNamespace, "/"); i >= 0 {
+		namespacePath, path = o.PathWithNamespace[:i], o.PathWithNamespace[i+1:]
+	}
+	if p.Path != nil && *p.Path != path {
+		return false
+	}
+	if p.NamespacePath != nil && *p.NamespacePath != namespacePath {
+		return false
+	}
+	if p.NamespaceID != nil && (o.Namespace == nil || *p.NamespaceID != o.Namespace.ID) {
+		return false
+	}
+	return true
+}
+
+// IsVerifiedProject returns true if the observed project is the one whose ID
+// was recorded when it was first observed at the expected path. Projects
+// observed before IDs were recorded are compared by path instead.
+func IsVerifiedProject(p *v1alpha1.ProjectParameters, o *v1alpha1.ProjectObservation) bool {
+	if o.VerifiedID != 0 {
+		return o.ID == o.VerifiedID
+	}
+	return IsAtExpectedPath(p, o)
+}
+
+// IsMarkedForDeletion returns true if the project is pending deletion, which
+// is how GitLab Premium and Ultimate delete projects by default.
+func IsMarkedForDeletion(prj *gitlab.Project) bool {
+	return prj.MarkedForDeletionOn != nil || prj.MarkedForDeletionAt != nil //nolint:staticcheck
+}
+
 // IsErrorProjectNotFound helper function to test for errProjectNotFound error.
 func IsErrorProjectNotFound(err error) bool {
 	if err == nil {
@@ -108,7 +196,9 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { //no
 			NameRegexDelete: prj.ContainerExpirationPolicy.NameRegexDelete,
 			NameRegexKeep:   prj.ContainerExpirationPolicy.NameRegexKeep,
 			Enabled:         prj.ContainerExpirationPolicy.Enabled,
-			NextRunAt:       &metav1.Time{Time: *prj.ContainerExpirationPolicy.NextRunAt},
+		}
+		if prj.ContainerExpirationPolicy.NextRunAt != nil {
+			o.ContainerExpirationPolicy.NextRunAt = &metav1.Time{Time: *prj.ContainerExpirationPolicy.NextRunAt}
 		}
 	}
 
@@ -155,6 +245,7 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { //no
 				LfsObjectsSize:   prj.Statistics.LFSObjectsSize,
 				JobArtifactsSize: prj.Statistics.JobArtifactsSize,
 			},
+			PipelineArtifactsSize: prj.Statistics.PipelineArtifactsSize,
 		}
 	}
 
@@ -292,6 +383,13 @@ func resolvePublicJobsValue(p *v1alpha1.ProjectParameters) *bool {
 	return value
 }
 
+// resolveEmailsEnabledValue returns the effective value for emailsEnabled,
+// prioritizing EmailsEnabled over the deprecated EmailsDisabled field.
+func resolveEmailsEnabledValue(p *v1alpha1.ProjectParameters) *bool {
+	//nolint:staticcheck // We intentionally use the deprecated field for backward compatibility
+	return common.ResolveEmailsEnabledSetting(p.EmailsDisabled, p.EmailsEnabled)
+}
+
 // GenerateCreateProjectOptions generates project creation options
 func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *gitlab.CreateProjectOptions {
 	// Name field overrides resource name
@@ -312,7 +410,18 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		SnippetsAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.SnippetsAccessLevel),
 		PagesAccessLevel:                    clients.AccessControlValueV1alpha1ToGitlab(p.PagesAccessLevel),
 		OperationsAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.OperationsAccessLevel),
-		EmailsDisabled:                      p.EmailsDisabled,
+		AnalyticsAccessLevel:                clients.AccessControlValueV1alpha1ToGitlab(p.AnalyticsAccessLevel),
+		EnvironmentsAccessLevel:             clients.AccessControlValueV1alpha1ToGitlab(p.EnvironmentsAccessLevel),
+		FeatureFlagsAccessLevel:             clients.AccessControlValueV1alpha1ToGitlab(p.FeatureFlagsAccessLevel),
+		InfrastructureAccessLevel:           clients.AccessControlValueV1alpha1ToGitlab(p.InfrastructureAccessLevel),
+		ModelExperimentsAccessLevel:         clients.AccessControlValueV1alpha1ToGitlab(p.ModelExperimentsAccessLevel),
+		ModelRegistryAccessLevel:            clients.AccessControlValueV1alpha1ToGitlab(p.ModelRegistryAccessLevel),
+		MonitorAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.MonitorAccessLevel),
+		ReleasesAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.ReleasesAccessLevel),
+		RequirementsAccessLevel:             clients.AccessControlValueV1alpha1ToGitlab(p.RequirementsAccessLevel),
+		SecurityAndComplianceAccessLevel:    clients.AccessControlValueV1alpha1ToGitlab(p.SecurityAndComplianceAccessLevel),
+		EmailsEnabled:                       resolveEmailsEnabledValue(p),
+		EmailsDisabled:                      common.EmailsDisabledSetting(resolveEmailsEnabledValue(p)), //nolint:staticcheck
 		ResolveOutdatedDiffDiscussions:      p.ResolveOutdatedDiffDiscussions,
 		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
 		ContainerRegistryEnabled:            p.ContainerRegistryEnabled, //nolint:staticcheck
@@ -350,6 +459,8 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		ServiceDeskEnabled:                        p.ServiceDeskEnabled,
 		AutocloseReferencedIssues:                 p.AutocloseReferencedIssues,
 		SuggestionCommitMessage:                   p.SuggestionCommitMessage,
+		MergeCommitTemplate:                       p.MergeCommitTemplate,
+		SquashCommitTemplate:                      p.SquashCommitTemplate,
 		IssuesTemplate:                            p.IssuesTemplate,
 		MergeRequestsTemplate:                     p.MergeRequestsTemplate,
 	}
@@ -371,55 +482,76 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		name = *p.Name
 	}
 	o := &gitlab.EditProjectOptions{
-		Name:                                &name,
-		Path:                                p.Path,
-		DefaultBranch:                       p.DefaultBranch,
-		Description:                         p.Description,
-		IssuesAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.IssuesAccessLevel),
-		RepositoryAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.RepositoryAccessLevel),
-		MergeRequestsAccessLevel:            clients.AccessControlValueV1alpha1ToGitlab(p.MergeRequestsAccessLevel),
-		ForkingAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.ForkingAccessLevel),
-		BuildsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.BuildsAccessLevel),
-		WikiAccessLevel:                     clients.AccessControlValueV1alpha1ToGitlab(p.WikiAccessLevel),
-		SnippetsAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.SnippetsAccessLevel),
-		PagesAccessLevel:                    clients.AccessControlValueV1alpha1ToGitlab(p.PagesAccessLevel),
-		OperationsAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.OperationsAccessLevel),
-		EmailsDisabled:                      p.EmailsDisabled,
-		ResolveOutdatedDiffDiscussions:      p.ResolveOutdatedDiffDiscussions,
-		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
-		ContainerRegistryEnabled:            p.ContainerRegistryEnabled, //nolint:staticcheck
-		ContainerRegistryAccessLevel:        clients.AccessControlValueV1alpha1ToGitlab(p.ContainerRegistryAccessLevel),
-		SharedRunnersEnabled:                p.SharedRunnersEnabled,
-		Visibility:                          clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
-		ImportURL:                           p.ImportURL,
-		PublicJobs:                          resolvePublicJobsValue(p),
-		AllowMergeOnSkippedPipeline:         p.AllowMergeOnSkippedPipeline,
-		OnlyAllowMergeIfPipelineSucceeds:    p.OnlyAllowMergeIfPipelineSucceeds,
+		Name:                                      &name,
+		Path:                                      p.Path,
+		DefaultBranch:                             p.DefaultBranch,
+		Description:                               p.Description,
+		IssuesAccessLevel:                         clients.AccessControlValueV1alpha1ToGitlab(p.IssuesAccessLevel),
+		RepositoryAccessLevel:                     clients.AccessControlValueV1alpha1ToGitlab(p.RepositoryAccessLevel),
+		MergeRequestsAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.MergeRequestsAccessLevel),
+		ForkingAccessLevel:                        clients.AccessControlValueV1alpha1ToGitlab(p.ForkingAccessLevel),
+		BuildsAccessLevel:                         clients.AccessControlValueV1alpha1ToGitlab(p.BuildsAccessLevel),
+		WikiAccessLevel:                           clients.AccessControlValueV1alpha1ToGitlab(p.WikiAccessLevel),
+		SnippetsAccessLevel:                       clients.AccessControlValueV1alpha1ToGitlab(p.SnippetsAccessLevel),
+		PagesAccessLevel:                          clients.AccessControlValueV1alpha1ToGitlab(p.PagesAccessLevel),
+		OperationsAccessLevel:                     clients.AccessControlValueV1alpha1ToGitlab(p.OperationsAccessLevel),
+		AnalyticsAccessLevel:                      clients.AccessControlValueV1alpha1ToGitlab(p.AnalyticsAccessLevel),
+		EnvironmentsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.EnvironmentsAccessLevel),
+		FeatureFlagsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.FeatureFlagsAccessLevel),
+		InfrastructureAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.InfrastructureAccessLevel),
+		ModelExperimentsAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.ModelExperimentsAccessLevel),
+		ModelRegistryAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.ModelRegistryAccessLevel),
+		MonitorAccessLevel:                        clients.AccessControlValueV1alpha1ToGitlab(p.MonitorAccessLevel),
+		ReleasesAccessLevel:                       clients.AccessControlValueV1alpha1ToGitlab(p.ReleasesAccessLevel),
+		RequirementsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.RequirementsAccessLevel),
+		SecurityAndComplianceAccessLevel:          clients.AccessControlValueV1alpha1ToGitlab(p.SecurityAndComplianceAccessLevel),
+		EmailsEnabled:                             resolveEmailsEnabledValue(p),
+		EmailsDisabled:                            common.EmailsDisabledSetting(resolveEmailsEnabledValue(p)), //nolint:staticcheck
+		ResolveOutdatedDiffDiscussions:            p.ResolveOutdatedDiffDiscussions,
+		ContainerExpirationPolicyAttributes:       clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
+		ContainerRegistryEnabled:                  p.ContainerRegistryEnabled, //nolint:staticcheck
+		ContainerRegistryAccessLevel:              clients.AccessControlValueV1alpha1ToGitlab(p.ContainerRegistryAccessLevel),
+		SharedRunnersEnabled:                      p.SharedRunnersEnabled,
+		Visibility:                                clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		ImportURL:                                 p.ImportURL,
+		PublicJobs:                                resolvePublicJobsValue(p),
+		AllowMergeOnSkippedPipeline:               p.AllowMergeOnSkippedPipeline,
+		AllowPipelineTriggerApproveDeployment:     p.AllowPipelineTriggerApproveDeployment,
+		OnlyAllowMergeIfPipelineSucceeds:          p.OnlyAllowMergeIfPipelineSucceeds,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: p.OnlyAllowMergeIfAllDiscussionsAreResolved,
-		MergeMethod:                              clients.MergeMethodV1alpha1ToGitlab(p.MergeMethod),
-		RemoveSourceBranchAfterMerge:             p.RemoveSourceBranchAfterMerge,
-		LFSEnabled:                               p.LFSEnabled,
-		RequestAccessEnabled:                     p.RequestAccessEnabled,
-		TagList:                                  &p.TagList, //nolint:staticcheck
-		Topics:                                   &p.Topics,
-		BuildGitStrategy:                         p.BuildGitStrategy,
-		AutoCancelPendingPipelines:               p.AutoCancelPendingPipelines,
-		BuildCoverageRegex:                       p.BuildCoverageRegex,
-		CIConfigPath:                             p.CIConfigPath,
-		CIForwardDeploymentEnabled:               p.CIForwardDeploymentEnabled,
-		AutoDevopsEnabled:                        p.AutoDevopsEnabled,
-		AutoDevopsDeployStrategy:                 p.AutoDevopsDeployStrategy,
-		ExternalAuthorizationClassificationLabel: p.ExternalAuthorizationClassificationLabel,
-		Mirror:                                   p.Mirror,
-		MirrorTriggerBuilds:                      p.MirrorTriggerBuilds,
-		OnlyMirrorProtectedBranches:              p.OnlyMirrorProtectedBranches,
-		MirrorOverwritesDivergedBranches:         p.MirrorOverwritesDivergedBranches,
-		PackagesEnabled:                          p.PackagesEnabled,
-		ServiceDeskEnabled:                       p.ServiceDeskEnabled,
-		AutocloseReferencedIssues:                p.AutocloseReferencedIssues,
-		SuggestionCommitMessage:                  p.SuggestionCommitMessage,
-		IssuesTemplate:                           p.IssuesTemplate,
-		MergeRequestsTemplate:                    p.MergeRequestsTemplate,
+		MergeMethod:                               clients.MergeMethodV1alpha1ToGitlab(p.MergeMethod),
+		RemoveSourceBranchAfterMerge:              p.RemoveSourceBranchAfterMerge,
+		LFSEnabled:                                p.LFSEnabled,
+		RequestAccessEnabled:                      p.RequestAccessEnabled,
+		TagList:                                   &p.TagList, //nolint:staticcheck
+		Topics:                                    &p.Topics,
+		BuildGitStrategy:                          p.BuildGitStrategy,
+		AutoCancelPendingPipelines:                p.AutoCancelPendingPipelines,
+		BuildCoverageRegex:                        p.BuildCoverageRegex,
+		CIConfigPath:                              p.CIConfigPath,
+		CIDeletePipelinesInSeconds:                p.CIDeletePipelinesInSeconds,
+		CIForwardDeploymentEnabled:                p.CIForwardDeploymentEnabled,
+		CIPipelineVariablesMinimumOverrideRole:    p.CIPipelineVariablesMinimumOverrideRole,
+		RestrictUserDefinedVariables:              p.RestrictUserDefinedVariables, //nolint:staticcheck
+		AutoDevopsEnabled:                         p.AutoDevopsEnabled,
+		AutoDevopsDeployStrategy:                  p.AutoDevopsDeployStrategy,
+		ExternalAuthorizationClassificationLabel:  p.ExternalAuthorizationClassificationLabel,
+		Mirror:                                    p.Mirror,
+		MirrorTriggerBuilds:                       p.MirrorTriggerBuilds,
+		OnlyMirrorProtectedBranches:               p.OnlyMirrorProtectedBranches,
+		MirrorOverwritesDivergedBranches:          p.MirrorOverwritesDivergedBranches,
+		PackagesEnabled:                           p.PackagesEnabled,
+		ServiceDeskEnabled:                        p.ServiceDeskEnabled,
+		AutocloseReferencedIssues:                 p.AutocloseReferencedIssues,
+		SuggestionCommitMessage:                   p.SuggestionCommitMessage,
+		MergeCommitTemplate:                       p.MergeCommitTemplate,
+		SquashCommitTemplate:                      p.SquashCommitTemplate,
+		IssuesTemplate:                            p.IssuesTemplate,
+		KeepLatestArtifact:                        p.KeepLatestArtifact,
+		MergeRequestsTemplate:                     p.MergeRequestsTemplate,
+		PreventMergeWithoutJiraIssue:              p.PreventMergeWithoutJiraIssue,
+		PrintingMergeRequestLinkEnabled:           p.PrintingMergeRequestLinkEnabled,
+		MergeRequestDefaultTargetSelf:             p.MergeRequestDefaultTargetSelf,
 	}
 	o.BuildTimeout = p.BuildTimeout
 	o.CIDefaultGitDepth = p.CIDefaultGitDepth
@@ -1,8 +1,10 @@
 package projects
 
 import (
+	"context"
 	"strings"
 
+	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"k8s.io/utils/ptr"
 
@@ -11,7 +13,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
 )
 
-const errProtectedEnvironmentNotFound = "404 Not found"
+const (
+	errProtectedEnvironmentNotFound = "404 Not found"
+	errGetGroupPathFailed           = "cannot resolve group path %s"
+	errNotAGroup                    = "%s is a %s namespace, not a group"
+)
 
 // ProtectedEnvironmentClient defines GitLab Protected Environments service operations.
 type ProtectedEnvironmentClient interface {
@@ -43,6 +49,96 @@ func LateInitializeProtectedEnvironment(in *v1alpha1.ProtectedEnvironmentParamet
 	if in.RequiredApprovalCount == nil && pe.RequiredApprovalCount != 0 {
 		in.RequiredApprovalCount = ptr.To(pe.RequiredApprovalCount)
 	}
+	if in.DeployAccessLevels != nil {
+		lateInitializeDeployAccessLevels(*in.DeployAccessLevels, pe.DeployAccessLevels)
+	}
+	if in.ApprovalRules != nil {
+		lateInitializeApprovalRules(*in.ApprovalRules, pe.ApprovalRules)
+	}
+}
+
+// lateInitializeDeployAccessLevels adopts the group inheritance type GitLab
+// defaulted for deploy access levels of groups that do not set one.
+func lateInitializeDeployAccessLevels(spec []v1alpha1.EnvironmentAccessLevelParameters, got []*gitlab.EnvironmentAccessDescription) {
+	used := make([]bool, len(got))
+	for i := range spec {
+		s := &spec[i]
+		for j, g := range got {
+			if used[j] || !deployAccessIdentityMatchesSpec(*s, g) {
+				continue
+			}
+			used[j] = true
+			if s.GroupID != nil && s.GroupInheritanceType == nil {
+				s.GroupInheritanceType = ptr.To(g.GroupInheritanceType)
+			}
+			break
+		}
+	}
+}
+
+// lateInitializeApprovalRules adopts the required approvals and the group
+// inheritance type GitLab defaulted for approval rules that do not set them.
+func lateInitializeApprovalRules(spec []v1alpha1.EnvironmentApprovalRuleParameters, got []*gitlab.EnvironmentApprovalRule) {
+	used := make([]bool, len(got))
+	for i := range spec {
+		s := &spec[i]
+		for j, g := range got {
+			if used[j] || !approvalRuleIdentityMatchesSpec(*s, g) {
+				continue
+			}
+			used[j] = true
+			if s.RequiredApprovals == nil {
+				s.RequiredApprovals = ptr.To(g.RequiredApprovalCount)
+			}
+			if s.GroupID != nil && s.GroupInheritanceType == nil {
+				s.GroupInheritanceType = ptr.To(g.GroupInheritanceType)
+			}
+			break
+		}
+	}
+}
+
+// ResolveGroupPaths sets the GroupID of every deploy access level and
+// approval rule that names its group by GroupPath to the ID GitLab returns
+// for that path. The path takes precedence over the GroupID, so that a
+// renamed group or changed path is picked up.
+func ResolveGroupPaths(ctx context.Context, c NamespaceClient, p *v1alpha1.ProtectedEnvironmentParameters) error {
+	if p == nil {
+		return nil
+	}
+	if p.DeployAccessLevels != nil {
+		for i := range *p.DeployAccessLevels {
+			l := &(*p.DeployAccessLevels)[i]
+			if err := resolveGroupPath(ctx, c, l.GroupPath, &l.GroupID); err != nil {
+				return err
+			}
+		}
+	}
+	if p.ApprovalRules != nil {
+		for i := range *p.ApprovalRules {
+			a := &(*p.ApprovalRules)[i]
+			if err := resolveGroupPath(ctx, c, a.GroupPath, &a.GroupID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resolveGroupPath(ctx context.Context, c NamespaceClient, path *string, id **int64) error {
+	if path == nil {
+		return nil
+	}
+	ns, _, err := c.GetNamespace(*path, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, errGetGroupPathFailed, *path)
+	}
+	// The namespace of a group has the ID of the group.
+	if ns.Kind != "group" {
+		return errors.Errorf(errNotAGroup, *path, ns.Kind)
+	}
+	*id = ptr.To(ns.ID)
+	return nil
 }
 
 // GenerateProtectedEnvironmentObservation builds status.atProvider from GitLab object.
@@ -242,19 +338,26 @@ func sameAccessSubject(
 }
 
 func deployAccessMatchesSpec(s v1alpha1.EnvironmentAccessLevelParameters, g *gitlab.EnvironmentAccessDescription) bool {
-	return sameAccessSubject(s.AccessLevel, s.UserID, s.GroupID, g.AccessLevel, g.UserID, g.GroupID) &&
+	return deployAccessIdentityMatchesSpec(s, g) &&
 		clients.IsInt64EqualToInt64Ptr(s.GroupInheritanceType, g.GroupInheritanceType)
 }
 
+// deployAccessIdentityMatchesSpec matches by subject only, so that changes of
+// the remaining attributes become updates of the existing entry.
+func deployAccessIdentityMatchesSpec(s v1alpha1.EnvironmentAccessLevelParameters, g *gitlab.EnvironmentAccessDescription) bool {
+	return sameAccessSubject(s.AccessLevel, s.UserID, s.GroupID, g.AccessLevel, g.UserID, g.GroupID)
+}
+
 func approvalRuleEqualsSpec(s v1alpha1.EnvironmentApprovalRuleParameters, g *gitlab.EnvironmentApprovalRule) bool {
-	return sameAccessSubject(s.AccessLevel, s.UserID, s.GroupID, g.AccessLevel, g.UserID, g.GroupID) &&
+	return approvalRuleIdentityMatchesSpec(s, g) &&
 		clients.IsInt64EqualToInt64Ptr(s.GroupInheritanceType, g.GroupInheritanceType) &&
 		clients.IsInt64EqualToInt64Ptr(s.RequiredApprovals, g.RequiredApprovalCount)
 }
 
+// approvalRuleIdentityMatchesSpec matches by subject only, so that changes of
+// the remaining attributes become updates of the existing rule.
 func approvalRuleIdentityMatchesSpec(s v1alpha1.EnvironmentApprovalRuleParameters, g *gitlab.EnvironmentApprovalRule) bool {
-	return sameAccessSubject(s.AccessLevel, s.UserID, s.GroupID, g.AccessLevel, g.UserID, g.GroupID) &&
-		clients.IsInt64EqualToInt64Ptr(s.GroupInheritanceType, g.GroupInheritanceType)
+	return sameAccessSubject(s.AccessLevel, s.UserID, s.GroupID, g.AccessLevel, g.UserID, g.GroupID)
 }
 
 func matchDeployAccessLevels(spec *[]v1alpha1.EnvironmentAccessLevelParameters, got []*gitlab.EnvironmentAccessDescription) bool {
@@ -311,6 +414,30 @@ func matchApprovalRules(spec *[]v1alpha1.EnvironmentApprovalRuleParameters, got
 	return true
 }
 
+// IsSelfApprovalUpToDate checks whether the self-approval setting of the
+// project matches the desired one. An unset setting is always up to date.
+func IsSelfApprovalUpToDate(p *v1alpha1.ProtectedEnvironmentParameters, prj *gitlab.Project) bool {
+	if p == nil || p.PreventSelfApproval == nil {
+		return true
+	}
+	if prj == nil {
+		return false
+	}
+	return *p.PreventSelfApproval == !prj.AllowPipelineTriggerApproveDeployment
+}
+
+// GenerateSelfApprovalOptions produces the project options that apply the
+// desired self-approval setting. GitLab models it inversely as allowing the
+// pipeline triggerer to approve the deployment.
+func GenerateSelfApprovalOptions(p *v1alpha1.ProtectedEnvironmentParameters) *gitlab.EditProjectOptions {
+	if p == nil || p.PreventSelfApproval == nil {
+		return nil
+	}
+	return &gitlab.EditProjectOptions{
+		AllowPipelineTriggerApproveDeployment: ptr.To(!*p.PreventSelfApproval),
+	}
+}
+
 // GenerateUpdateProtectedEnvironmentsOptions builds the delta; returns nil if there are no changes.
 func GenerateUpdateProtectedEnvironmentsOptions(
 	p *v1alpha1.ProtectedEnvironmentParameters,
@@ -368,14 +495,27 @@ func buildDeployAccessLevelsDelta(
 			if used[i] {
 				continue
 			}
-			if deployAccessMatchesSpec(s, g) {
+			if deployAccessIdentityMatchesSpec(s, g) {
 				matched = i
 				break
 			}
 		}
 
 		if matched >= 0 {
+			cur := got[matched]
 			used[matched] = true
+
+			if deployAccessMatchesSpec(s, cur) {
+				continue
+			}
+
+			out = append(out, &gitlab.UpdateEnvironmentAccessOptions{
+				ID:                   ptr.To(cur.ID),
+				AccessLevel:          accessLevelPtr(s.AccessLevel),
+				UserID:               s.UserID,
+				GroupID:              s.GroupID,
+				GroupInheritanceType: s.GroupInheritanceType,
+			})
 			continue
 		}
 
@@ -420,7 +560,7 @@ func buildApprovalRulesDelta(
 			if used[i] {
 				continue
 			}
-			// Match by identity (subject + inheritance), so RequiredApprovals changes become updates.
+			// Match by subject, so RequiredApprovals and inheritance changes become updates.
 			if approvalRuleIdentityMatchesSpec(s, g) {
 				matched = i
 				break
@@ -430,8 +570,8 @@ func buildApprovalRulesDelta(
 		if matched >= 0 {
 			cur := got[matched]
 
-			// If required approvals are equal, this is a no-op.
-			if clients.IsInt64EqualToInt64Ptr(s.RequiredApprovals, cur.RequiredApprovalCount) {
+			// If required approvals and inheritance are equal, this is a no-op.
+			if approvalRuleEqualsSpec(s, cur) {
 				used[matched] = true
 				continue
 			}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+const (
+	errGetProjectByPath = "cannot get project %s"
+	errSearchProjects   = "cannot search projects"
+	errNoProjectMatches = "no project matches %s"
+	errProjectAmbiguous = "%d projects match %s, the search must be unique"
+
+	searchPageSize = 100
+)
+
+// SearchClient defines the GitLab project operations needed to resolve a
+// project by searching for it.
+type SearchClient interface {
+	GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ListProjects(opt *gitlab.ListProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+}
+
+// NewSearchClient returns a new GitLab project service
+func NewSearchClient(cfg common.Config) SearchClient {
+	git := common.NewClient(cfg)
+	return git.Projects
+}
+
+// SearchProjectID returns the ID of the only project matching the search.
+// The name, path and namespace full path of a project must match exactly.
+// It returns an error if no or more than one project matches.
+func SearchProjectID(ctx context.Context, c SearchClient, s *v1alpha1.GitLabSearch) (int64, error) {
+	opt := &gitlab.ListProjectsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: searchPageSize, Page: 1},
+		Search:      ptr.To(clients.SearchTerm(s)),
+		Simple:      ptr.To(true),
+	}
+
+	var ids []int64
+	for {
+		prjs, res, err := c.ListProjects(opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, errors.Wrap(err, errSearchProjects)
+		}
+		for _, p := range prjs {
+			namespace := ""
+			if p.Namespace != nil {
+				namespace = p.Namespace.FullPath
+			}
+			if clients.MatchesSearch(s, p.Name, p.Path, namespace) {
+				ids = append(ids, p.ID)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, errors.Errorf(errNoProjectMatches, clients.DescribeSearch(s))
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, errors.Errorf(errProjectAmbiguous, len(ids), clients.DescribeSearch(s))
+	}
+}
+
+// ProjectIDByPath returns the ID of the project with the given full path,
+// e.g. my-group/my-project.
+func ProjectIDByPath(ctx context.Context, c SearchClient, path string) (int64, error) {
+	prj, _, err := c.GetProject(path, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrapf(err, errGetProjectByPath, path)
+	}
+	return prj.ID, nil
+}
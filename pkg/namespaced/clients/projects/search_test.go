@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+type fakeSearchClient struct {
+	pages [][]*gitlab.Project
+	err   error
+	pid   any
+}
+
+func (c *fakeSearchClient) GetProject(pid any, _ *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	c.pid = pid
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+	return searchProject(1234, "API", "api", "team-a"), &gitlab.Response{}, nil
+}
+
+func (c *fakeSearchClient) ListProjects(opt *gitlab.ListProjectsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+	res := &gitlab.Response{}
+	if opt.Page < int64(len(c.pages)) {
+		res.NextPage = opt.Page + 1
+	}
+	return c.pages[opt.Page-1], res, nil
+}
+
+func searchProject(id int64, name, path, namespace string) *gitlab.Project {
+	return &gitlab.Project{ID: id, Name: name, Path: path, Namespace: &gitlab.ProjectNamespace{FullPath: namespace}}
+}
+
+func TestSearchProjectID(t *testing.T) {
+	errBoom := errors.New("boom")
+	projects := [][]*gitlab.Project{
+		{
+			searchProject(1, "API", "api", "team-a"),
+			searchProject(2, "API", "api", "team-b"),
+		},
+		{
+			searchProject(3, "API Gateway", "api-gateway", "team-a"),
+		},
+	}
+
+	cases := map[string]struct {
+		client SearchClient
+		search *v1alpha1.GitLabSearch
+		want   int64
+		err    error
+	}{
+		"UniqueMatch": {
+			client: &fakeSearchClient{pages: projects},
+			search: &v1alpha1.GitLabSearch{Path: ptr.To("api"), Namespace: ptr.To("team-b")},
+			want:   2,
+		},
+		"UniqueMatchOnLaterPage": {
+			client: &fakeSearchClient{pages: projects},
+			search: &v1alpha1.GitLabSearch{Name: ptr.To("API Gateway")},
+			want:   3,
+		},
+		"AmbiguousMatch": {
+			client: &fakeSearchClient{pages: projects},
+			search: &v1alpha1.GitLabSearch{Name: ptr.To("API")},
+			err:    errors.Errorf(errProjectAmbiguous, 2, "name=API"),
+		},
+		"NoMatch": {
+			client: &fakeSearchClient{pages: projects},
+			search: &v1alpha1.GitLabSearch{Path: ptr.To("api"), Namespace: ptr.To("team-c")},
+			err:    errors.Errorf(errNoProjectMatches, "path=api, namespace=team-c"),
+		},
+		"ListFailed": {
+			client: &fakeSearchClient{err: errBoom},
+			search: &v1alpha1.GitLabSearch{Path: ptr.To("api")},
+			err:    errors.Wrap(errBoom, errSearchProjects),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SearchProjectID(context.Background(), tc.client, tc.search)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("SearchProjectID(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SearchProjectID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectIDByPath(t *testing.T) {
+	c := &fakeSearchClient{}
+	id, err := ProjectIDByPath(context.Background(), c, "team-a/api")
+	if err != nil {
+		t.Fatalf("ProjectIDByPath(...): unexpected error: %v", err)
+	}
+	if id != 1234 {
+		t.Errorf("ProjectIDByPath(...): want 1234, got %d", id)
+	}
+	if diff := cmp.Diff(any("team-a/api"), c.pid); diff != "" {
+		t.Errorf("GetProject(...): -want pid, +got pid:\n%s", diff)
+	}
+
+	if _, err := ProjectIDByPath(context.Background(), &fakeSearchClient{err: errors.New("boom")}, "team-a/api"); err == nil {
+		t.Errorf("ProjectIDByPath(...): want error, got nil")
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// TagClient defines GitLab Tag service operations
+type TagClient interface {
+	GetTag(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	CreateTag(pid any, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	DeleteTag(pid any, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	CreateRelease(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+}
+
+// NewTagClient returns a new GitLab Tag service
+func NewTagClient(cfg common.Config) TagClient {
+	git := common.NewClient(cfg)
+	return &tagClient{
+		TagsServiceInterface:     git.Tags,
+		ReleasesServiceInterface: git.Releases,
+	}
+}
+
+// tagClient composes the tags and releases services.
+type tagClient struct {
+	gitlab.TagsServiceInterface
+	gitlab.ReleasesServiceInterface
+}
+
+// GenerateTagObservation produces a TagObservation from a gitlab.Tag.
+// The ref is not returned by GitLab and has to be carried over by the caller.
+func GenerateTagObservation(t *gitlab.Tag) v1alpha1.TagObservation {
+	if t == nil {
+		return v1alpha1.TagObservation{}
+	}
+
+	o := v1alpha1.TagObservation{
+		Name:      t.Name,
+		Message:   t.Message,
+		Target:    t.Target,
+		Protected: t.Protected,
+		CreatedAt: common.TimeToMetaTime(t.CreatedAt),
+	}
+
+	if t.Commit != nil {
+		o.CommitID = t.Commit.ID
+	}
+
+	if t.Release != nil {
+		o.ReleaseDescription = t.Release.Description
+	}
+
+	return o
+}
+
+// GenerateCreateTagOptions generates tag creation options
+func GenerateCreateTagOptions(p *v1alpha1.TagParameters) *gitlab.CreateTagOptions {
+	return &gitlab.CreateTagOptions{
+		TagName: &p.TagName,
+		Ref:     &p.Ref,
+		Message: p.Message,
+	}
+}
+
+// GenerateCreateTagReleaseOptions generates the options for the release
+// attached to a tag.
+func GenerateCreateTagReleaseOptions(p *v1alpha1.TagParameters) *gitlab.CreateReleaseOptions {
+	return &gitlab.CreateReleaseOptions{
+		TagName:     &p.TagName,
+		Description: p.ReleaseDescription,
+	}
+}
+
+// IsTagUpToDate checks whether the observed tag matches the desired one.
+// The ref is not compared, it is immutable and GitLab only reports the
+// resolved commit.
+func IsTagUpToDate(p *v1alpha1.TagParameters, t *gitlab.Tag) bool {
+	if t == nil {
+		return false
+	}
+
+	if !clients.IsComparableEqualToComparablePtr(p.Message, t.Message) {
+		return false
+	}
+
+	if p.ReleaseDescription != nil {
+		if t.Release == nil || t.Release.Description != *p.ReleaseDescription {
+			return false
+		}
+	}
+
+	return true
+}
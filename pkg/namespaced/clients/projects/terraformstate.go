@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const errGetProject = "cannot get Gitlab project"
+
+// TerraformStateClient defines the GitLab operations on the GitLab-managed
+// Terraform states of a project. States are only listed by the GraphQL API,
+// which needs the full path of the project.
+type TerraformStateClient interface {
+	GetProjectPath(ctx context.Context, project string) (string, error)
+	ListTerraformStates(ctx context.Context, project string) ([]gitlab.TerraformState, error)
+	DeleteTerraformState(ctx context.Context, project, name string) (*gitlab.Response, error)
+	LockTerraformState(ctx context.Context, project, name string) error
+	UnlockTerraformState(ctx context.Context, project, name string) error
+}
+
+// NewTerraformStateClient returns a new GitLab Terraform state client.
+func NewTerraformStateClient(cfg common.Config) TerraformStateClient {
+	git := common.NewClient(cfg)
+	return &terraformStateClient{states: git.TerraformStates, projects: git.Projects}
+}
+
+type terraformStateClient struct {
+	states   gitlab.TerraformStatesServiceInterface
+	projects gitlab.ProjectsServiceInterface
+}
+
+// GetProjectPath returns the full path of a project given by ID or full
+// path.
+func (c *terraformStateClient) GetProjectPath(ctx context.Context, project string) (string, error) {
+	if _, err := strconv.ParseInt(project, 10, 64); err != nil {
+		return project, nil
+	}
+	prj, _, err := c.projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, errGetProject)
+	}
+	return prj.PathWithNamespace, nil
+}
+
+// ListTerraformStates returns the Terraform states of the project with the
+// given full path.
+func (c *terraformStateClient) ListTerraformStates(ctx context.Context, project string) ([]gitlab.TerraformState, error) {
+	states, _, err := c.states.List(project, gitlab.WithContext(ctx))
+	return states, err
+}
+
+// DeleteTerraformState deletes the Terraform state with all its versions.
+func (c *terraformStateClient) DeleteTerraformState(ctx context.Context, project, name string) (*gitlab.Response, error) {
+	return c.states.Delete(project, name, gitlab.WithContext(ctx))
+}
+
+// LockTerraformState locks the Terraform state.
+func (c *terraformStateClient) LockTerraformState(ctx context.Context, project, name string) error {
+	_, err := c.states.Lock(project, name, gitlab.WithContext(ctx))
+	return err
+}
+
+// UnlockTerraformState unlocks the Terraform state.
+func (c *terraformStateClient) UnlockTerraformState(ctx context.Context, project, name string) error {
+	_, err := c.states.Unlock(project, name, gitlab.WithContext(ctx))
+	return err
+}
+
+// FindTerraformState returns the state with the given name, or nil if there
+// is none.
+func FindTerraformState(states []gitlab.TerraformState, name string) *gitlab.TerraformState {
+	for i := range states {
+		if states[i].Name == name {
+			return &states[i]
+		}
+	}
+	return nil
+}
+
+// GenerateTerraformStateObservation produces the observation of a Terraform
+// state of the project with the given full path.
+func GenerateTerraformStateObservation(project string, s *gitlab.TerraformState) v1alpha1.TerraformStateObservation {
+	o := v1alpha1.TerraformStateObservation{ProjectPath: project}
+	if s == nil {
+		return o
+	}
+	o.Name = s.Name
+	o.Serial = s.LatestVersion.Serial
+	o.CreatedAt = timeToMetaTime(s.CreatedAt)
+	o.UpdatedAt = timeToMetaTime(s.LatestVersion.CreatedAt)
+	o.LockedAt = timeToMetaTime(s.LockedAt)
+	return o
+}
+
+// IsTerraformStateUpToDate checks whether the state is locked as desired.
+func IsTerraformStateUpToDate(p *v1alpha1.TerraformStateParameters, s *gitlab.TerraformState) bool {
+	if s == nil {
+		return false
+	}
+	return p.Locked == nil || *p.Locked == !s.LockedAt.IsZero()
+}
+
+// timeToMetaTime converts a time GitLab reports as zero if it is unset.
+func timeToMetaTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return common.TimeToMetaTime(&t)
+}
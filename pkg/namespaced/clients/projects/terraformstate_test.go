@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+type fakeProjects struct {
+	gitlab.ProjectsServiceInterface
+	pid any
+}
+
+func (f *fakeProjects) GetProject(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	f.pid = pid
+	return &gitlab.Project{PathWithNamespace: "acme/infra"}, &gitlab.Response{}, nil
+}
+
+func TestGetProjectPath(t *testing.T) {
+	cases := map[string]struct {
+		project string
+		want    string
+		wantPID any
+	}{
+		"Path": {
+			project: "acme/infra",
+			want:    "acme/infra",
+		},
+		"ID": {
+			project: "1234",
+			want:    "acme/infra",
+			wantPID: "1234",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &fakeProjects{}
+			c := &terraformStateClient{projects: p}
+
+			got, err := c.GetProjectPath(context.Background(), tc.project)
+			if err != nil {
+				t.Fatalf("GetProjectPath(...): unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("GetProjectPath(...): want %q, got %q", tc.want, got)
+			}
+			if diff := cmp.Diff(tc.wantPID, p.pid); diff != "" {
+				t.Errorf("GetProject(...): -want pid, +got pid:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTerraformState(t *testing.T) {
+	states := []gitlab.TerraformState{{Name: "staging"}, {Name: "production"}}
+
+	if got := FindTerraformState(states, "production"); got != &states[1] {
+		t.Errorf("FindTerraformState(...): want %v, got %v", &states[1], got)
+	}
+	if got := FindTerraformState(states, "review"); got != nil {
+		t.Errorf("FindTerraformState(...): want nil, got %v", got)
+	}
+}
+
+func TestIsTerraformStateUpToDate(t *testing.T) {
+	unlocked := &gitlab.TerraformState{Name: "production"}
+	locked := &gitlab.TerraformState{Name: "production", LockedAt: time.Date(2026, time.May, 6, 9, 30, 0, 0, time.UTC)}
+
+	cases := map[string]struct {
+		locked *bool
+		state  *gitlab.TerraformState
+		want   bool
+	}{
+		"LockUnmanaged": {
+			state: locked,
+			want:  true,
+		},
+		"Locked": {
+			locked: ptr.To(true),
+			state:  locked,
+			want:   true,
+		},
+		"LockRequested": {
+			locked: ptr.To(true),
+			state:  unlocked,
+		},
+		"UnlockRequested": {
+			locked: ptr.To(false),
+			state:  locked,
+		},
+		"NotPushed": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTerraformStateUpToDate(&v1alpha1.TerraformStateParameters{Locked: tc.locked}, tc.state)
+			if got != tc.want {
+				t.Errorf("IsTerraformStateUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
@@ -39,6 +40,8 @@ var (
 
 var (
 	variableTypeLocal = commonv1alpha1.VariableType(variableType)
+	fileVariableType  = commonv1alpha1.VariableTypeFile
+	certificate       = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n-----END CERTIFICATE-----\n"
 )
 
 func TestLateInitializeVariable(t *testing.T) {
@@ -68,6 +71,65 @@ func TestLateInitializeVariable(t *testing.T) {
 				EnvironmentScope: &variableEnvScope,
 			},
 		},
+		"HiddenVariable": {
+			parameters: &v1alpha1.VariableParameters{},
+			variable: &gitlab.ProjectVariable{
+				VariableType:     variableType,
+				Masked:           true,
+				Hidden:           true,
+				EnvironmentScope: variableEnvScope,
+			},
+			want: &v1alpha1.VariableParameters{
+				CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+					VariableType: &variableTypeLocal,
+					Description:  ptr.To(""),
+					Protected:    ptr.To(false),
+					Masked:       ptr.To(true),
+					Raw:          ptr.To(false),
+				},
+				MaskedAndHidden:  ptr.To(true),
+				EnvironmentScope: &variableEnvScope,
+			},
+		},
+		"FileVariable": {
+			parameters: &v1alpha1.VariableParameters{
+				CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+					Value: &certificate,
+				},
+			},
+			variable: &gitlab.ProjectVariable{
+				Value:            certificate,
+				VariableType:     gitlab.FileVariableType,
+				EnvironmentScope: "*",
+			},
+			want: &v1alpha1.VariableParameters{
+				CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+					Value:        &certificate,
+					VariableType: &fileVariableType,
+					Description:  ptr.To(""),
+					Protected:    ptr.To(false),
+					Masked:       ptr.To(false),
+					Raw:          ptr.To(false),
+				},
+				EnvironmentScope: ptr.To("*"),
+			},
+		},
+		"MissingVariableType": {
+			parameters: &v1alpha1.VariableParameters{},
+			variable: &gitlab.ProjectVariable{
+				EnvironmentScope: "*",
+			},
+			want: &v1alpha1.VariableParameters{
+				CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+					VariableType: ptr.To(commonv1alpha1.VariableTypeEnvVar),
+					Description:  ptr.To(""),
+					Protected:    ptr.To(false),
+					Masked:       ptr.To(false),
+					Raw:          ptr.To(false),
+				},
+				EnvironmentScope: ptr.To("*"),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -111,6 +173,24 @@ func TestGenerateCreateVariableOptions(t *testing.T) {
 				Raw:              &variableRaw,
 			},
 		},
+		"MaskedAndHidden": {
+			args: args{
+				parameters: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:    variableKey,
+						Value:  &variableValue,
+						Masked: ptr.To(true),
+					},
+					MaskedAndHidden: ptr.To(true),
+				},
+			},
+			want: &gitlab.CreateProjectVariableOptions{
+				Key:             &variableKey,
+				Value:           &variableValue,
+				Masked:          ptr.To(true),
+				MaskedAndHidden: ptr.To(true),
+			},
+		},
 		"SomeFields": {
 			args: args{
 				parameters: &v1alpha1.VariableParameters{
@@ -206,6 +286,118 @@ func TestIsVariableUpToDate(t *testing.T) {
 		args args
 		want bool
 	}{
+		"RawDisabled": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key: projectVariableKey,
+						Raw: boolPtr(false),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key: projectVariableKey,
+					Raw: true,
+				},
+			},
+			want: false,
+		},
+		"RawEnabled": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key: projectVariableKey,
+						Raw: boolPtr(true),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key: projectVariableKey,
+					Raw: false,
+				},
+			},
+			want: false,
+		},
+		"HiddenValueNotReturned": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:    projectVariableKey,
+						Value:  &projectVariableValue,
+						Masked: boolPtr(true),
+					},
+					MaskedAndHidden: boolPtr(true),
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:    projectVariableKey,
+					Masked: true,
+					Hidden: true,
+				},
+			},
+			want: true,
+		},
+		"UnhideRequested": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key: projectVariableKey,
+					},
+					MaskedAndHidden: boolPtr(false),
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:    projectVariableKey,
+					Masked: true,
+					Hidden: true,
+				},
+			},
+			want: false,
+		},
+		"MaskedValueHidden": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:    projectVariableKey,
+						Value:  &projectVariableValue,
+						Masked: boolPtr(true),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:    projectVariableKey,
+					Masked: true,
+				},
+			},
+			want: true,
+		},
+		"MaskedValueChanged": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:    projectVariableKey,
+						Value:  &projectVariableValue,
+						Masked: boolPtr(true),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:    projectVariableKey,
+					Value:  "OTHER_VALUE",
+					Masked: true,
+				},
+			},
+			want: false,
+		},
+		"NotMaskedValueEmpty": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:    projectVariableKey,
+						Value:  &projectVariableValue,
+						Masked: boolPtr(false),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key: projectVariableKey,
+				},
+			},
+			want: false,
+		},
 		"AllFieldsMatch": {
 			args: args{
 				p: &v1alpha1.VariableParameters{
@@ -468,6 +660,72 @@ func TestIsVariableUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"FileVariableMultilineValueMatches": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          projectVariableKey,
+						Value:        &certificate,
+						VariableType: &fileVariableType,
+						Masked:       boolPtr(false),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:          projectVariableKey,
+					Value:        certificate,
+					VariableType: gitlab.FileVariableType,
+				},
+			},
+			want: true,
+		},
+		"FileVariableMultilineValueDiffers": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          projectVariableKey,
+						Value:        strPtr(certificate + "\n"),
+						VariableType: &fileVariableType,
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:          projectVariableKey,
+					Value:        certificate,
+					VariableType: gitlab.FileVariableType,
+				},
+			},
+			want: false,
+		},
+		"FileVariableDiffersFromEnvVar": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          projectVariableKey,
+						Value:        &certificate,
+						VariableType: &fileVariableType,
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key:          projectVariableKey,
+					Value:        certificate,
+					VariableType: gitlab.EnvVariableType,
+				},
+			},
+			want: false,
+		},
+		"MissingVariableTypeIsEnvVar": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+						Key:          projectVariableKey,
+						VariableType: variableTypePtr(commonv1alpha1.VariableTypeEnvVar),
+					},
+				},
+				variable: &gitlab.ProjectVariable{
+					Key: projectVariableKey,
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -480,6 +738,52 @@ func TestIsVariableUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsProjectVariable(t *testing.T) {
+	production := "production"
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		v    *gitlab.ProjectVariable
+		want bool
+	}{
+		"Matching": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}, EnvironmentScope: &variableEnvScope},
+			v:    &gitlab.ProjectVariable{Key: variableKey, EnvironmentScope: variableEnvScope},
+			want: true,
+		},
+		"NoScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}},
+			v:    &gitlab.ProjectVariable{Key: variableKey, EnvironmentScope: "*"},
+			want: true,
+		},
+		"NoScopeOtherScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}},
+			v:    &gitlab.ProjectVariable{Key: variableKey, EnvironmentScope: production},
+			want: false,
+		},
+		"OtherScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}, EnvironmentScope: &variableEnvScope},
+			v:    &gitlab.ProjectVariable{Key: variableKey, EnvironmentScope: production},
+			want: false,
+		},
+		"OtherKey": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}},
+			v:    &gitlab.ProjectVariable{Key: "OTHER"},
+			want: false,
+		},
+		"Missing": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: variableKey}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsProjectVariable(tc.p, tc.v); got != tc.want {
+				t.Errorf("IsProjectVariable(...) = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestGenerateGetVariableOptions(t *testing.T) {
 	type args struct {
 		p *v1alpha1.VariableParameters
@@ -504,7 +808,11 @@ func TestGenerateGetVariableOptions(t *testing.T) {
 			args: args{
 				p: &v1alpha1.VariableParameters{},
 			},
-			want: nil,
+			want: &gitlab.GetProjectVariableOptions{
+				Filter: &gitlab.VariableFilter{
+					EnvironmentScope: "*",
+				},
+			},
 		},
 	}
 	for name, tc := range tests {
@@ -541,7 +849,11 @@ func TestGenerateRemoveVariableOptions(t *testing.T) {
 			args: args{
 				p: &v1alpha1.VariableParameters{},
 			},
-			want: nil,
+			want: &gitlab.RemoveProjectVariableOptions{
+				Filter: &gitlab.VariableFilter{
+					EnvironmentScope: "*",
+				},
+			},
 		},
 	}
 	for name, tc := range tests {
@@ -553,3 +865,108 @@ func TestGenerateRemoveVariableOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestOtherScopeVariables(t *testing.T) {
+	variables := []*gitlab.ProjectVariable{
+		{Key: "KEY", EnvironmentScope: "*"},
+		{Key: "KEY", EnvironmentScope: "production"},
+		{Key: "OTHER", EnvironmentScope: "staging"},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want []*gitlab.ProjectVariable
+	}{
+		"DefaultScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}},
+			want: []*gitlab.ProjectVariable{variables[1]},
+		},
+		"ExplicitScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}, EnvironmentScope: ptr.To("production")},
+			want: []*gitlab.ProjectVariable{variables[0]},
+		},
+		"NoOtherScopes": {
+			p: &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "OTHER"}, EnvironmentScope: ptr.To("staging")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := OtherScopeVariables(variables, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("OtherScopeVariables(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScopeVariable(t *testing.T) {
+	variables := []*gitlab.ProjectVariable{
+		{Key: "KEY", EnvironmentScope: "*"},
+		{Key: "KEY", EnvironmentScope: "production"},
+		{Key: "OTHER", EnvironmentScope: "staging"},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want *gitlab.ProjectVariable
+	}{
+		"DefaultScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}},
+			want: variables[0],
+		},
+		"ExplicitScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}, EnvironmentScope: ptr.To("production")},
+			want: variables[1],
+		},
+		"KeyOnlyInOtherScope": {
+			p: &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "OTHER"}, EnvironmentScope: ptr.To("production")},
+		},
+		"KeyMissing": {
+			p: &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "MISSING"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ScopeVariable(variables, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ScopeVariable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindVariable(t *testing.T) {
+	variables := []*gitlab.ProjectVariable{
+		{Key: "KEY", EnvironmentScope: "production"},
+		{Key: "KEY", EnvironmentScope: "staging"},
+		{Key: "KEY", EnvironmentScope: "*"},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want *gitlab.ProjectVariable
+	}{
+		"NoScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}},
+			want: variables[2],
+		},
+		"ExplicitScope": {
+			p:    &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}, EnvironmentScope: ptr.To("staging")},
+			want: variables[1],
+		},
+		"ScopeMissing": {
+			p: &v1alpha1.VariableParameters{CommonVariableParameters: commonv1alpha1.CommonVariableParameters{Key: "KEY"}, EnvironmentScope: ptr.To("review/*")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindVariable(variables, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindVariable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+// Ranks of the environment scopes matching an environment. GitLab prefers the
+// scope naming the environment over wildcard scopes, and wildcard scopes over
+// the default scope.
+const (
+	scopeRankDefault = iota
+	scopeRankWildcard
+	scopeRankExact
+)
+
+// EnvironmentScopeMatches reports whether a variable with the given
+// environment scope is available to jobs deploying to the environment. An
+// empty scope is the default scope *. The only wildcard GitLab supports in
+// scopes is *, which matches any sequence of characters including /. Jobs
+// without an environment only get variables of the default scope.
+func EnvironmentScopeMatches(scope, environment string) bool {
+	if scope == "" {
+		scope = common.DefaultEnvironmentScope
+	}
+	if scope == common.DefaultEnvironmentScope {
+		return true
+	}
+	if environment == "" {
+		return false
+	}
+	return matchScope(scope, environment)
+}
+
+// EffectiveVariables returns the variables that are available to jobs
+// deploying to the environment, indexed by key. When several variables
+// sharing a key match the environment, the one GitLab gives precedence to is
+// returned: a scope naming the environment wins over wildcard scopes such as
+// review/*, which win over the default scope *. GitLab does not define an
+// order between several matching wildcard scopes, the one with the most
+// characters besides * is returned so the result does not depend on the order
+// of the variables. Whether a variable is protected is not taken into
+// account.
+func EffectiveVariables(variables []v1alpha1.VariableParameters, environment string) map[string]v1alpha1.VariableParameters {
+	effective := make(map[string]v1alpha1.VariableParameters)
+	for _, v := range variables {
+		scope := ptr.Deref(v.EnvironmentScope, common.DefaultEnvironmentScope)
+		if !EnvironmentScopeMatches(scope, environment) {
+			continue
+		}
+		if cur, ok := effective[v.Key]; ok && !takesPrecedence(scope, ptr.Deref(cur.EnvironmentScope, common.DefaultEnvironmentScope), environment) {
+			continue
+		}
+		effective[v.Key] = v
+	}
+	return effective
+}
+
+// EffectiveVariable returns the variable with the key that is available to
+// jobs deploying to the environment, following the precedence of
+// EffectiveVariables. It returns false if no variable with the key matches.
+func EffectiveVariable(variables []v1alpha1.VariableParameters, key, environment string) (v1alpha1.VariableParameters, bool) {
+	v, ok := EffectiveVariables(variables, environment)[key]
+	return v, ok
+}
+
+// takesPrecedence reports whether a variable in scope a takes precedence over
+// one in scope b for the environment. Both scopes must match the environment.
+func takesPrecedence(a, b, environment string) bool {
+	ra, rb := scopeRank(a, environment), scopeRank(b, environment)
+	if ra != rb {
+		return ra > rb
+	}
+	la, lb := len(a)-strings.Count(a, "*"), len(b)-strings.Count(b, "*")
+	if la != lb {
+		return la > lb
+	}
+	return a < b
+}
+
+func scopeRank(scope, environment string) int {
+	switch scope {
+	case common.DefaultEnvironmentScope:
+		return scopeRankDefault
+	case environment:
+		return scopeRankExact
+	default:
+		return scopeRankWildcard
+	}
+}
+
+// matchScope matches the environment against a scope in which * matches any
+// sequence of characters.
+func matchScope(scope, environment string) bool {
+	parts := strings.Split(scope, "*")
+	if len(parts) == 1 {
+		return scope == environment
+	}
+
+	if !strings.HasPrefix(environment, parts[0]) {
+		return false
+	}
+	environment = environment[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, p := range parts[1 : len(parts)-1] {
+		i := strings.Index(environment, p)
+		if i < 0 {
+			return false
+		}
+		environment = environment[i+len(p):]
+	}
+	return strings.HasSuffix(environment, last)
+}
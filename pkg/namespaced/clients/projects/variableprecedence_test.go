@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+)
+
+func scopedVariable(key, value string, scope *string) v1alpha1.VariableParameters {
+	return v1alpha1.VariableParameters{
+		CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+			Key:   key,
+			Value: &value,
+		},
+		EnvironmentScope: scope,
+	}
+}
+
+func TestEnvironmentScopeMatches(t *testing.T) {
+	cases := map[string]struct {
+		scope       string
+		environment string
+		want        bool
+	}{
+		"DefaultScope":              {scope: "*", environment: "production", want: true},
+		"EmptyScope":                {scope: "", environment: "production", want: true},
+		"DefaultScopeNoEnvironment": {scope: "*", environment: "", want: true},
+		"ExactScope":                {scope: "production", environment: "production", want: true},
+		"OtherEnvironment":          {scope: "production", environment: "staging", want: false},
+		"ScopeIsCaseSensitive":      {scope: "Production", environment: "production", want: false},
+		"ScopeNoEnvironment":        {scope: "production", environment: "", want: false},
+		"WildcardSuffix":            {scope: "review/*", environment: "review/feature-1", want: true},
+		"WildcardSpansSlashes":      {scope: "review/*", environment: "review/team/feature-1", want: true},
+		"WildcardMatchesEmpty":      {scope: "review/*", environment: "review/", want: true},
+		"WildcardPrefixMismatch":    {scope: "review/*", environment: "staging/review/feature-1", want: false},
+		"WildcardPrefix":            {scope: "*/production", environment: "eu/production", want: true},
+		"WildcardInfix":             {scope: "review/*/app", environment: "review/feature-1/app", want: true},
+		"WildcardInfixMismatch":     {scope: "review/*/app", environment: "review/feature-1/api", want: false},
+		"SeveralWildcards":          {scope: "*-*-prod", environment: "eu-west-prod", want: true},
+		"AffixesDoNotOverlap":       {scope: "ab*b", environment: "ab", want: false},
+		"PercentIsLiteral":          {scope: "review/%", environment: "review/feature-1", want: false},
+		"UnderscoreIsLiteral":       {scope: "review_app", environment: "review-app", want: false},
+		"QuestionMarkIsLiteral":     {scope: "review/?", environment: "review/a", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := EnvironmentScopeMatches(tc.scope, tc.environment)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("EnvironmentScopeMatches(%q, %q): -want, +got:\n%s", tc.scope, tc.environment, diff)
+			}
+		})
+	}
+}
+
+func TestEffectiveVariables(t *testing.T) {
+	variables := []v1alpha1.VariableParameters{
+		scopedVariable("URL", "default", ptr.To("*")),
+		scopedVariable("URL", "review", ptr.To("review/*")),
+		scopedVariable("URL", "review-team", ptr.To("review/team/*")),
+		scopedVariable("URL", "production", ptr.To("production")),
+		scopedVariable("TOKEN", "default", nil),
+		scopedVariable("TOKEN", "staging", ptr.To("staging")),
+		scopedVariable("DEBUG", "review", ptr.To("review/*")),
+	}
+
+	cases := map[string]struct {
+		variables   []v1alpha1.VariableParameters
+		environment string
+		want        map[string]string
+	}{
+		"NoEnvironment": {
+			variables: variables,
+			want:      map[string]string{"URL": "default", "TOKEN": "default"},
+		},
+		"ExactScopeWins": {
+			variables:   variables,
+			environment: "production",
+			want:        map[string]string{"URL": "production", "TOKEN": "default"},
+		},
+		"WildcardScopeWinsOverDefault": {
+			variables:   variables,
+			environment: "review/feature-1",
+			want:        map[string]string{"URL": "review", "TOKEN": "default", "DEBUG": "review"},
+		},
+		"MoreSpecificWildcardWins": {
+			variables:   variables,
+			environment: "review/team/feature-1",
+			want:        map[string]string{"URL": "review-team", "TOKEN": "default", "DEBUG": "review"},
+		},
+		"DefaultScopeFallback": {
+			variables:   variables,
+			environment: "canary",
+			want:        map[string]string{"URL": "default", "TOKEN": "default"},
+		},
+		"OnlyScopedVariables": {
+			variables:   []v1alpha1.VariableParameters{scopedVariable("TOKEN", "staging", ptr.To("staging"))},
+			environment: "production",
+			want:        map[string]string{},
+		},
+		"ExactScopeWinsOverMatchingWildcard": {
+			variables: []v1alpha1.VariableParameters{
+				scopedVariable("URL", "exact", ptr.To("review/feature-1")),
+				scopedVariable("URL", "review", ptr.To("review/*")),
+				scopedVariable("URL", "any", ptr.To("*")),
+			},
+			environment: "review/feature-1",
+			want:        map[string]string{"URL": "exact"},
+		},
+		"OrderDoesNotMatter": {
+			variables: []v1alpha1.VariableParameters{
+				scopedVariable("URL", "review", ptr.To("review/*")),
+				scopedVariable("URL", "feature", ptr.To("*/feature-1")),
+				scopedVariable("URL", "any", ptr.To("*")),
+			},
+			environment: "review/feature-1",
+			want:        map[string]string{"URL": "feature"},
+		},
+		"OrderDoesNotMatterReversed": {
+			variables: []v1alpha1.VariableParameters{
+				scopedVariable("URL", "any", ptr.To("*")),
+				scopedVariable("URL", "feature", ptr.To("*/feature-1")),
+				scopedVariable("URL", "review", ptr.To("review/*")),
+			},
+			environment: "review/feature-1",
+			want:        map[string]string{"URL": "feature"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := map[string]string{}
+			for k, v := range EffectiveVariables(tc.variables, tc.environment) {
+				got[k] = ptr.Deref(v.Value, "")
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("EffectiveVariables(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEffectiveVariable(t *testing.T) {
+	variables := []v1alpha1.VariableParameters{
+		scopedVariable("URL", "default", nil),
+		scopedVariable("URL", "review", ptr.To("review/*")),
+	}
+
+	v, ok := EffectiveVariable(variables, "URL", "review/feature-1")
+	if !ok {
+		t.Fatal("EffectiveVariable(...): want variable URL, got none")
+	}
+	if diff := cmp.Diff(variables[1], v); diff != "" {
+		t.Errorf("EffectiveVariable(...): -want, +got:\n%s", diff)
+	}
+
+	if _, ok := EffectiveVariable(variables, "TOKEN", "review/feature-1"); ok {
+		t.Error("EffectiveVariable(...): want no variable TOKEN, got one")
+	}
+}
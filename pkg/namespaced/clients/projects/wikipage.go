@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+)
+
+// WikiPageClient defines GitLab wiki service operations
+type WikiPageClient interface {
+	GetWikiPage(pid any, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	CreateWikiPage(pid any, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	EditWikiPage(pid any, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	DeleteWikiPage(pid any, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewWikiPageClient returns a new GitLab wiki service
+func NewWikiPageClient(cfg common.Config) WikiPageClient {
+	git := common.NewClient(cfg)
+	return git.Wikis
+}
+
+// GenerateWikiPageObservation produces a WikiPageObservation from a gitlab.Wiki.
+func GenerateWikiPageObservation(w *gitlab.Wiki) v1alpha1.WikiPageObservation {
+	if w == nil {
+		return v1alpha1.WikiPageObservation{}
+	}
+
+	return v1alpha1.WikiPageObservation{
+		Slug:     w.Slug,
+		Title:    w.Title,
+		Format:   string(w.Format),
+		Encoding: w.Encoding,
+	}
+}
+
+// GenerateCreateWikiPageOptions generates wiki page creation options with the
+// resolved content of the page.
+func GenerateCreateWikiPageOptions(p *v1alpha1.WikiPageParameters, content string) *gitlab.CreateWikiPageOptions {
+	return &gitlab.CreateWikiPageOptions{
+		Title:   &p.Title,
+		Content: &content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
+
+// GenerateEditWikiPageOptions generates wiki page edit options with the
+// resolved content of the page.
+func GenerateEditWikiPageOptions(p *v1alpha1.WikiPageParameters, content string) *gitlab.EditWikiPageOptions {
+	return &gitlab.EditWikiPageOptions{
+		Title:   &p.Title,
+		Content: &content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
+
+// LateInitializeWikiPage fills the empty fields in the wiki page spec with the
+// values seen in gitlab.Wiki.
+func LateInitializeWikiPage(in *v1alpha1.WikiPageParameters, w *gitlab.Wiki) {
+	if w == nil {
+		return
+	}
+
+	in.Format = clients.LateInitializeStringPtr(in.Format, string(w.Format))
+}
+
+// IsWikiPageUpToDate checks whether the observed wiki page matches the desired
+// one. The content is the resolved content of the spec, since the page body
+// has to be compared whether it is set inline or read from a secret.
+func IsWikiPageUpToDate(p *v1alpha1.WikiPageParameters, content string, w *gitlab.Wiki) bool {
+	if w == nil {
+		return false
+	}
+
+	if p.Title != w.Title {
+		return false
+	}
+
+	if content != w.Content {
+		return false
+	}
+
+	if p.Format != nil && *p.Format != string(w.Format) {
+		return false
+	}
+
+	return true
+}
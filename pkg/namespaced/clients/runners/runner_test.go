@@ -288,6 +288,13 @@ func TestIsRunnerUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"ReorderedTagList": {
+			spec: &commonv1alpha1.CommonRunnerParameters{
+				TagList: &[]string{"b", "a"},
+			},
+			observed: &gitlab.RunnerDetails{TagList: []string{"a", "b"}},
+			want:     true,
+		},
 		"NotEqualTagList": {
 			spec: &commonv1alpha1.CommonRunnerParameters{
 				TagList: &[]string{"a", "b"},
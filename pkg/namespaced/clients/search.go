@@ -0,0 +1,59 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+)
+
+// SearchTerm returns the term to send to the GitLab search of a project or
+// group search. The path is preferred over the name since it is more
+// specific.
+func SearchTerm(s *v1alpha1.GitLabSearch) string {
+	if s.Path != nil {
+		return *s.Path
+	}
+	return ptr.Deref(s.Name, "")
+}
+
+// MatchesSearch returns true if the name, path and namespace of a project or
+// group match every attribute set in the search.
+func MatchesSearch(s *v1alpha1.GitLabSearch, name, path, namespace string) bool {
+	return (s.Name == nil || *s.Name == name) &&
+		(s.Path == nil || *s.Path == path) &&
+		(s.Namespace == nil || *s.Namespace == namespace)
+}
+
+// DescribeSearch returns the attributes set in the search for error
+// messages, e.g. name=api, namespace=my-group.
+func DescribeSearch(s *v1alpha1.GitLabSearch) string {
+	var attrs []string
+	if s.Name != nil {
+		attrs = append(attrs, "name="+*s.Name)
+	}
+	if s.Path != nil {
+		attrs = append(attrs, "path="+*s.Path)
+	}
+	if s.Namespace != nil {
+		attrs = append(attrs, "namespace="+*s.Namespace)
+	}
+	return strings.Join(attrs, ", ")
+}
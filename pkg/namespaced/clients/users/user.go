@@ -17,6 +17,9 @@ limitations under the License.
 package users
 
 import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
@@ -26,11 +29,15 @@ import (
 const (
 	errFetchFailed = "can not fetch userID by userName"
 	errPullUserID  = "cant determine user by userName. Amount of users received: %v"
+
+	errCurrentUserFailed = "cannot get the user the provider authenticates as"
+	errSelfMembership    = "refusing to change the membership of user %d, the provider authenticates as this user and could lock itself out; annotate the resource with %s: \"true\" to allow it"
 )
 
 // UserClient defines Gitlab User service operations
 type UserClient interface {
 	ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 }
 
 // NewUserClient returns a new Gitlab User service
@@ -54,3 +61,21 @@ func GetUserID(git UserClient, username string) (*int64, error) {
 
 	return &pulledUserID, nil
 }
+
+// CheckSelfMembership returns an error if userID is the user the provider
+// authenticates as, so that a member resource cannot demote or remove the
+// provider itself, unless the resource allows it with the
+// AnnotationKeyAllowSelfMembershipChange annotation.
+func CheckSelfMembership(ctx context.Context, git UserClient, mg resource.Managed, userID int64) error {
+	if common.AllowSelfMembershipChange(mg) {
+		return nil
+	}
+	self, _, err := git.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errCurrentUserFailed)
+	}
+	if self.ID == userID {
+		return errors.Errorf(errSelfMembership, userID, common.AnnotationKeyAllowSelfMembershipChange)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +cluster-scope:skip-file
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+const (
+	// TypeConnected indicates whether the credentials of a ProviderConfig
+	// authenticate against Gitlab.
+	TypeConnected xpv1.ConditionType = "Connected"
+
+	// TypeMissingScopes indicates that the access token of a ProviderConfig
+	// lacks scopes required to manage resources.
+	TypeMissingScopes xpv1.ConditionType = "MissingScopes"
+
+	// TypeRateLimitLow indicates that little of the rate limit budget Gitlab
+	// reported for the credentials of a ProviderConfig remains.
+	TypeRateLimitLow xpv1.ConditionType = "RateLimitLow"
+
+	// ReasonAuthenticated is used when the credentials authenticate.
+	ReasonAuthenticated xpv1.ConditionReason = "Authenticated"
+
+	// ReasonConnectionFailed is used when the credentials cannot be read or
+	// do not authenticate.
+	ReasonConnectionFailed xpv1.ConditionReason = "ConnectionFailed"
+
+	// ReasonInsufficientScopes is used when the access token lacks scopes.
+	ReasonInsufficientScopes xpv1.ConditionReason = "InsufficientScopes"
+
+	// ReasonAllScopesGranted is used once the access token has all required
+	// scopes again.
+	ReasonAllScopesGranted xpv1.ConditionReason = "AllScopesGranted"
+
+	// ReasonRateLimitBudgetLow is used when the remaining rate limit budget
+	// is low.
+	ReasonRateLimitBudgetLow xpv1.ConditionReason = "BudgetLow"
+
+	// ReasonRateLimitBudgetRecovered is used once enough of the rate limit
+	// budget remains again.
+	ReasonRateLimitBudgetRecovered xpv1.ConditionReason = "BudgetRecovered"
+
+	reasonConnectionCheck event.Reason = "ConnectionCheck"
+
+	// connectionCheckInterval is how often the connection is checked.
+	connectionCheckInterval = 10 * time.Minute
+	connectionCheckTimeout  = 2 * time.Minute
+
+	errGetPC             = "cannot get ProviderConfig"
+	errGetCredentials    = "cannot get credentials"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+	errUnknownConfigKind = "unknown ProviderConfig kind %T"
+)
+
+// connectionReconciler periodically checks that the credentials of a
+// ProviderConfig authenticate against Gitlab and records the authenticated
+// user, the token scopes, the instance version and the rate limit budget in
+// its status.
+type connectionReconciler struct {
+	client    client.Client
+	newConfig func() resource.ProviderConfig
+	check     func(ctx context.Context, cfg common.Config) (*common.Connection, error)
+	log       logging.Logger
+	record    event.Recorder
+}
+
+// SetupConnectionCheck adds controllers that check the connection of
+// ProviderConfigs and ClusterProviderConfigs.
+func SetupConnectionCheck(mgr ctrl.Manager, o controller.Options) error {
+	for kind, newConfig := range map[string]func() resource.ProviderConfig{
+		v1beta1.ProviderConfigGroupKind:        func() resource.ProviderConfig { return &v1beta1.ProviderConfig{} },
+		v1beta1.ClusterProviderConfigGroupKind: func() resource.ProviderConfig { return &v1beta1.ClusterProviderConfig{} },
+	} {
+		name := providerconfig.ControllerName(kind) + "/connection"
+		r := &connectionReconciler{
+			client:    mgr.GetClient(),
+			newConfig: newConfig,
+			check:     common.CheckConnection,
+			log:       o.Logger.WithValues("controller", name),
+			record:    event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		}
+
+		// Status updates do not change the generation, so that the
+		// connection is only checked on spec changes and periodically.
+		err := ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(newConfig(), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			Complete(r)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupConnectionCheckGated adds the connection check controllers once the
+// ProviderConfig CRDs are available.
+func SetupConnectionCheckGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupConnectionCheck(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconcilers", "gvk", v1beta1.ProviderConfigGroupVersionKind.String())
+		}
+	}, v1beta1.ProviderConfigGroupVersionKind, v1beta1.ClusterProviderConfigGroupVersionKind)
+	return nil
+}
+
+// Reconcile checks the connection of a ProviderConfig.
+func (r *connectionReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Checking connection")
+
+	ctx, cancel := context.WithTimeout(ctx, connectionCheckTimeout)
+	defer cancel()
+
+	pc := r.newConfig()
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	spec, status, err := specAndStatus(pc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	conn, err := r.connect(ctx, spec)
+	if err != nil {
+		log.Debug("Connection check failed", "error", err)
+		r.record.Event(pc, event.Warning(reasonConnectionCheck, err))
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeConnected,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonConnectionFailed,
+			Message:            err.Error(),
+		})
+	} else {
+		now := metav1.Now()
+		status.Connection = &v1beta1.ConnectionStatus{
+			Username:      conn.Username,
+			UserID:        conn.UserID,
+			Scopes:        conn.Scopes,
+			Version:       conn.Version,
+			LastCheckTime: &now,
+			RateLimit:     rateLimitStatus(conn.RateLimit),
+		}
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeConnected,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             ReasonAuthenticated,
+			Message:            fmt.Sprintf("authenticated as %s", conn.Username),
+		})
+		r.setMissingScopes(pc, conn.MissingScopes())
+		r.setRateLimitLow(pc, conn.RateLimit)
+	}
+
+	if err := r.client.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+	return reconcile.Result{RequeueAfter: connectionCheckInterval}, nil
+}
+
+func (r *connectionReconciler) connect(ctx context.Context, spec v1beta1.ProviderConfigSpec) (*common.Connection, error) {
+	cfg, err := common.ConfigFromSpec(ctx, r.client, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCredentials)
+	}
+	// The check only sends GET requests, which would otherwise be
+	// authenticated by the read credentials.
+	cfg.ReadToken = ""
+	return r.check(ctx, *cfg)
+}
+
+// setMissingScopes sets the MissingScopes condition if the access token
+// lacks required scopes and resets it once they are granted.
+func (r *connectionReconciler) setMissingScopes(pc resource.ProviderConfig, missing []string) {
+	if len(missing) > 0 {
+		msg := fmt.Sprintf("access token lacks the %s scope required to manage resources", strings.Join(missing, ", "))
+		r.record.Event(pc, event.Warning(reasonConnectionCheck, errors.New(msg)))
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeMissingScopes,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonInsufficientScopes,
+			Message:            msg,
+		})
+		return
+	}
+	if pc.GetCondition(TypeMissingScopes).Status == corev1.ConditionTrue {
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeMissingScopes,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonAllScopesGranted,
+		})
+	}
+}
+
+// setRateLimitLow sets the RateLimitLow condition if little of the rate limit
+// budget remains and resets it once the budget recovered.
+func (r *connectionReconciler) setRateLimitLow(pc resource.ProviderConfig, b *common.RateLimitBudget) {
+	if b != nil && b.IsLow() {
+		msg := fmt.Sprintf("%d of %d requests remain until %s", b.Remaining, b.Limit, b.ResetTime.UTC().Format(time.RFC3339))
+		r.record.Event(pc, event.Warning(reasonConnectionCheck, errors.New("rate limit budget is low: "+msg)))
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeRateLimitLow,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonRateLimitBudgetLow,
+			Message:            msg,
+		})
+		return
+	}
+	if pc.GetCondition(TypeRateLimitLow).Status == corev1.ConditionTrue {
+		pc.SetConditions(xpv1.Condition{
+			Type:               TypeRateLimitLow,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonRateLimitBudgetRecovered,
+		})
+	}
+}
+
+func rateLimitStatus(b *common.RateLimitBudget) *v1beta1.RateLimitStatus {
+	if b == nil {
+		return nil
+	}
+	s := &v1beta1.RateLimitStatus{
+		Limit:        b.Limit,
+		Remaining:    b.Remaining,
+		ObservedTime: &metav1.Time{Time: b.ObservedTime},
+	}
+	if !b.ResetTime.IsZero() {
+		s.ResetTime = &metav1.Time{Time: b.ResetTime}
+	}
+	return s
+}
+
+func specAndStatus(pc resource.ProviderConfig) (v1beta1.ProviderConfigSpec, *v1beta1.ProviderConfigStatus, error) {
+	switch p := pc.(type) {
+	case *v1beta1.ProviderConfig:
+		return p.Spec, &p.Status, nil
+	case *v1beta1.ClusterProviderConfig:
+		return p.Spec, &p.Status, nil
+	default:
+		return v1beta1.ProviderConfigSpec{}, nil, errors.Errorf(errUnknownConfigKind, pc)
+	}
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +cluster-scope:skip-file
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+)
+
+var errBoom = errors.New("boom")
+
+func connectionKube(secret bool, conditions []xpv1.Condition, got *v1beta1.ProviderConfig) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				o.Spec = v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "gitlab", Namespace: "crossplane-system"},
+								Key:             "token",
+							},
+						},
+					},
+				}
+				o.SetConditions(conditions...)
+			case *corev1.Secret:
+				if !secret {
+					return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				o.Data = map[string][]byte{"token": []byte("glpat-abc")}
+			}
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			*got = *obj.(*v1beta1.ProviderConfig)
+			return nil
+		},
+	}
+}
+
+func TestConnectionReconcile(t *testing.T) {
+	resetTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type want struct {
+		connection *v1beta1.ConnectionStatus
+		conditions []xpv1.Condition
+		result     reconcile.Result
+		err        error
+	}
+
+	cases := map[string]struct {
+		secret     bool
+		conditions []xpv1.Condition
+		check      func(ctx context.Context, cfg common.Config) (*common.Connection, error)
+		want       want
+	}{
+		"Connected": {
+			secret: true,
+			check: func(_ context.Context, cfg common.Config) (*common.Connection, error) {
+				if cfg.Token != "glpat-abc" {
+					return nil, errBoom
+				}
+				return &common.Connection{Username: "provider-bot", UserID: 42, Scopes: []string{"api"}, Version: "17.2.1"}, nil
+			},
+			want: want{
+				connection: &v1beta1.ConnectionStatus{Username: "provider-bot", UserID: 42, Scopes: []string{"api"}, Version: "17.2.1"},
+				conditions: []xpv1.Condition{{
+					Type:    TypeConnected,
+					Status:  corev1.ConditionTrue,
+					Reason:  ReasonAuthenticated,
+					Message: "authenticated as provider-bot",
+				}},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"MissingScopes": {
+			secret: true,
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				return &common.Connection{Username: "provider-bot", UserID: 42, Scopes: []string{"read_api"}}, nil
+			},
+			want: want{
+				connection: &v1beta1.ConnectionStatus{Username: "provider-bot", UserID: 42, Scopes: []string{"read_api"}},
+				conditions: []xpv1.Condition{
+					{
+						Type:    TypeConnected,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonAuthenticated,
+						Message: "authenticated as provider-bot",
+					},
+					{
+						Type:    TypeMissingScopes,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonInsufficientScopes,
+						Message: "access token lacks the api scope required to manage resources",
+					},
+				},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"ScopesGranted": {
+			secret: true,
+			conditions: []xpv1.Condition{{
+				Type:   TypeMissingScopes,
+				Status: corev1.ConditionTrue,
+				Reason: ReasonInsufficientScopes,
+			}},
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				return &common.Connection{Username: "provider-bot", UserID: 42, Scopes: []string{"api"}}, nil
+			},
+			want: want{
+				connection: &v1beta1.ConnectionStatus{Username: "provider-bot", UserID: 42, Scopes: []string{"api"}},
+				conditions: []xpv1.Condition{
+					{
+						Type:   TypeMissingScopes,
+						Status: corev1.ConditionFalse,
+						Reason: ReasonAllScopesGranted,
+					},
+					{
+						Type:    TypeConnected,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonAuthenticated,
+						Message: "authenticated as provider-bot",
+					},
+				},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"RateLimitLow": {
+			secret: true,
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				return &common.Connection{
+					Username:  "provider-bot",
+					UserID:    42,
+					RateLimit: &common.RateLimitBudget{Limit: 2000, Remaining: 20, ResetTime: resetTime, ObservedTime: resetTime.Add(-time.Minute)},
+				}, nil
+			},
+			want: want{
+				connection: &v1beta1.ConnectionStatus{
+					Username: "provider-bot",
+					UserID:   42,
+					RateLimit: &v1beta1.RateLimitStatus{
+						Limit:        2000,
+						Remaining:    20,
+						ResetTime:    &metav1.Time{Time: resetTime},
+						ObservedTime: &metav1.Time{Time: resetTime.Add(-time.Minute)},
+					},
+				},
+				conditions: []xpv1.Condition{
+					{
+						Type:    TypeConnected,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonAuthenticated,
+						Message: "authenticated as provider-bot",
+					},
+					{
+						Type:    TypeRateLimitLow,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonRateLimitBudgetLow,
+						Message: "20 of 2000 requests remain until 2026-01-02T03:04:05Z",
+					},
+				},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"RateLimitRecovered": {
+			secret: true,
+			conditions: []xpv1.Condition{{
+				Type:   TypeRateLimitLow,
+				Status: corev1.ConditionTrue,
+				Reason: ReasonRateLimitBudgetLow,
+			}},
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				return &common.Connection{
+					Username:  "provider-bot",
+					UserID:    42,
+					RateLimit: &common.RateLimitBudget{Limit: 2000, Remaining: 2000, ObservedTime: resetTime},
+				}, nil
+			},
+			want: want{
+				connection: &v1beta1.ConnectionStatus{
+					Username:  "provider-bot",
+					UserID:    42,
+					RateLimit: &v1beta1.RateLimitStatus{Limit: 2000, Remaining: 2000, ObservedTime: &metav1.Time{Time: resetTime}},
+				},
+				conditions: []xpv1.Condition{
+					{
+						Type:   TypeRateLimitLow,
+						Status: corev1.ConditionFalse,
+						Reason: ReasonRateLimitBudgetRecovered,
+					},
+					{
+						Type:    TypeConnected,
+						Status:  corev1.ConditionTrue,
+						Reason:  ReasonAuthenticated,
+						Message: "authenticated as provider-bot",
+					},
+				},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"ConnectionFailed": {
+			secret: true,
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				return nil, errBoom
+			},
+			want: want{
+				conditions: []xpv1.Condition{{
+					Type:    TypeConnected,
+					Status:  corev1.ConditionFalse,
+					Reason:  ReasonConnectionFailed,
+					Message: errBoom.Error(),
+				}},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+		"CredentialsMissing": {
+			check: func(_ context.Context, _ common.Config) (*common.Connection, error) {
+				t.Fatal("check must not be called without credentials")
+				return nil, nil
+			},
+			want: want{
+				conditions: []xpv1.Condition{{
+					Type:    TypeConnected,
+					Status:  corev1.ConditionFalse,
+					Reason:  ReasonConnectionFailed,
+					Message: errGetCredentials + ": " + common.ErrSecretNotFound + ":  \"gitlab\" not found",
+				}},
+				result: reconcile.Result{RequeueAfter: connectionCheckInterval},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &v1beta1.ProviderConfig{}
+			r := &connectionReconciler{
+				client:    connectionKube(tc.secret, tc.conditions, got),
+				newConfig: func() resource.ProviderConfig { return &v1beta1.ProviderConfig{} },
+				check:     tc.check,
+				log:       logging.NewNopLogger(),
+				record:    event.NewNopRecorder(),
+			}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "gitlab"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("Reconcile(...): -want result, +got result:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.connection, got.Status.Connection, cmpopts.IgnoreFields(v1beta1.ConnectionStatus{}, "LastCheckTime")); diff != "" {
+				t.Errorf("Reconcile(...): -want connection, +got connection:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, got.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile(...): -want conditions, +got conditions:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,8 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		SetupNamespaced,
 		// +cluster-scope:delete=1
 		SetupCluster,
+		// +cluster-scope:delete=1
+		SetupConnectionCheck,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -43,6 +45,8 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		SetupNamespacedGated,
 		// +cluster-scope:delete=1
 		SetupClusterGated,
+		// +cluster-scope:delete=1
+		SetupConnectionCheckGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
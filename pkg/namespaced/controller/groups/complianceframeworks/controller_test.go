@@ -0,0 +1,491 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package complianceframeworks
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	errUnavailable = errors.New("The resource that you are attempting to access does not exist or you don't have permission to perform this action")
+	groupID        = "1234"
+	groupPath      = "acme"
+	frameworkID    = int64(7)
+
+	unsupported = xpv1.Condition{
+		Type:    common.TypeUnsupportedFeatures,
+		Status:  corev1.ConditionTrue,
+		Reason:  common.ReasonUnsupportedByLicense,
+		Message: "Compliance frameworks require a GitLab Premium or Ultimate license, or the provider credentials lack the permission to manage them",
+	}
+)
+
+type args struct {
+	client groups.ComplianceFrameworkClient
+	cr     resource.Managed
+}
+
+type frameworkModifier func(*v1alpha1.ComplianceFramework)
+
+func withGroupID() frameworkModifier {
+	return func(r *v1alpha1.ComplianceFramework) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withExternalName(n string) frameworkModifier {
+	return func(r *v1alpha1.ComplianceFramework) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(fn func(p *v1alpha1.ComplianceFrameworkParameters)) frameworkModifier {
+	return func(r *v1alpha1.ComplianceFramework) { fn(&r.Spec.ForProvider) }
+}
+
+func withConditions(c ...xpv1.Condition) frameworkModifier {
+	return func(r *v1alpha1.ComplianceFramework) { r.Status.SetConditions(c...) }
+}
+
+func withStatus(s v1alpha1.ComplianceFrameworkObservation) frameworkModifier {
+	return func(r *v1alpha1.ComplianceFramework) { r.Status.AtProvider = s }
+}
+
+func complianceFramework(m ...frameworkModifier) *v1alpha1.ComplianceFramework {
+	cr := &v1alpha1.ComplianceFramework{}
+	cr.Spec.ForProvider.Name = "SOX"
+	cr.Spec.ForProvider.Description = "Sarbanes-Oxley"
+	cr.Spec.ForProvider.Color = "#1AAA55"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabFramework() *groups.ComplianceFramework {
+	return &groups.ComplianceFramework{
+		ID:                            frameworkID,
+		Name:                          "SOX",
+		Description:                   "Sarbanes-Oxley",
+		Color:                         "#1aaa55",
+		PipelineConfigurationFullPath: ".compliance-ci.yml@compliance/pipelines",
+	}
+}
+
+func withPipelineConfiguration(p *v1alpha1.ComplianceFrameworkParameters) {
+	p.PipelineConfigurationFullPath = ptr.To(".compliance-ci.yml@compliance/pipelines")
+}
+
+func groupPathClient(t *testing.T) func(ctx context.Context, group string) (string, error) {
+	return func(ctx context.Context, group string) (string, error) {
+		if group != groupID {
+			t.Errorf("GetGroupPath(%s): unexpected group", group)
+		}
+		return groupPath, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.ComplianceFrameworkObservation{ID: frameworkID, GroupPath: groupPath}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotComplianceFramework)},
+		},
+		"NoExternalName": {
+			args: args{cr: complianceFramework(withGroupID())},
+			want: want{cr: complianceFramework(withGroupID())},
+		},
+		"NotIDExternalName": {
+			args: args{cr: complianceFramework(withGroupID(), withExternalName("sox"))},
+			want: want{cr: complianceFramework(withGroupID(), withExternalName("sox")), err: errors.New(errIDNotInt)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: complianceFramework(withExternalName("7"))},
+			want: want{cr: complianceFramework(withExternalName("7")), err: errors.New(errGroupIDMissing)},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						return nil, nil
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7")),
+			},
+			want: want{cr: complianceFramework(withGroupID(), withExternalName("7"))},
+		},
+		"ErrGet": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						return nil, errBoom
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7")),
+			},
+			want: want{cr: complianceFramework(withGroupID(), withExternalName("7")), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"Unlicensed": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						return nil, errUnavailable
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7")),
+			},
+			want: want{
+				cr:  complianceFramework(withGroupID(), withExternalName("7"), withConditions(unsupported)),
+				err: errors.Wrap(errUnavailable, errGetFailed),
+			},
+		},
+		"LateInitializedUpToDate": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						if group != groupPath || id != frameworkID {
+							t.Errorf("GetComplianceFramework(%s, %d): unexpected framework", group, id)
+						}
+						return gitlabFramework(), nil
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7")),
+			},
+			want: want{
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withSpec(withPipelineConfiguration),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ShortColorUpToDate": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						f := gitlabFramework()
+						f.Color = "#aabbcc"
+						return f, nil
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withSpec(withPipelineConfiguration),
+					withSpec(func(p *v1alpha1.ComplianceFrameworkParameters) { p.Color = "ABC" }), withStatus(observation)),
+			},
+			want: want{
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withSpec(withPipelineConfiguration),
+					withSpec(func(p *v1alpha1.ComplianceFrameworkParameters) { p.Color = "ABC" }),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ColorChanged": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetComplianceFramework: func(ctx context.Context, group string, id int64) (*groups.ComplianceFramework, error) {
+						return gitlabFramework(), nil
+					},
+				},
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withSpec(withPipelineConfiguration),
+					withSpec(func(p *v1alpha1.ComplianceFrameworkParameters) { p.Color = "#ff0000" }), withStatus(observation)),
+			},
+			want: want{
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withSpec(withPipelineConfiguration),
+					withSpec(func(p *v1alpha1.ComplianceFrameworkParameters) { p.Color = "#ff0000" }),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotComplianceFramework)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: complianceFramework()},
+			want: want{cr: complianceFramework(), err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockCreateComplianceFramework: func(ctx context.Context, group string, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+						want := &groups.ComplianceFrameworkInput{Name: "SOX", Description: "Sarbanes-Oxley", Color: "#1aaa55"}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("CreateComplianceFramework(...): -want, +got:\n%s", diff)
+						}
+						if group != groupPath {
+							t.Errorf("CreateComplianceFramework(%s, ...): unexpected group", group)
+						}
+						return gitlabFramework(), nil
+					},
+				},
+				cr: complianceFramework(withGroupID()),
+			},
+			want: want{
+				cr: complianceFramework(withGroupID(), withExternalName("7"), withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.ComplianceFrameworkObservation{ID: frameworkID, GroupPath: groupPath})),
+			},
+		},
+		"Unlicensed": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: groupPathClient(t),
+					MockCreateComplianceFramework: func(ctx context.Context, group string, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+						return nil, errUnavailable
+					},
+				},
+				cr: complianceFramework(withGroupID()),
+			},
+			want: want{
+				cr:  complianceFramework(withGroupID(), withConditions(xpv1.Creating(), unsupported)),
+				err: errors.Wrap(errUnavailable, errCreateFailed),
+			},
+		},
+		"ErrGroupPath": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockGetGroupPath: func(ctx context.Context, group string) (string, error) {
+						return "", errBoom
+					},
+				},
+				cr: complianceFramework(withGroupID()),
+			},
+			want: want{cr: complianceFramework(withGroupID()), err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotComplianceFramework)},
+		},
+		"NotIDExternalName": {
+			args: args{cr: complianceFramework(withExternalName("sox"))},
+			want: want{cr: complianceFramework(withExternalName("sox")), err: errors.New(errIDNotInt)},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockUpdateComplianceFramework: func(ctx context.Context, id int64, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+						want := &groups.ComplianceFrameworkInput{
+							Name:                          "SOX",
+							Description:                   "Sarbanes-Oxley",
+							Color:                         "#1aaa55",
+							PipelineConfigurationFullPath: ptr.To(".compliance-ci.yml@compliance/pipelines"),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("UpdateComplianceFramework(...): -want, +got:\n%s", diff)
+						}
+						if id != frameworkID {
+							t.Errorf("UpdateComplianceFramework(%d, ...): unexpected framework", id)
+						}
+						return gitlabFramework(), nil
+					},
+				},
+				cr: complianceFramework(withExternalName("7"), withSpec(withPipelineConfiguration)),
+			},
+			want: want{cr: complianceFramework(withExternalName("7"), withSpec(withPipelineConfiguration))},
+		},
+		"ErrUpdate": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockUpdateComplianceFramework: func(ctx context.Context, id int64, in *groups.ComplianceFrameworkInput) (*groups.ComplianceFramework, error) {
+						return nil, errBoom
+					},
+				},
+				cr: complianceFramework(withExternalName("7")),
+			},
+			want: want{cr: complianceFramework(withExternalName("7")), err: errors.Wrap(errBoom, errUpdateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalDelete
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotComplianceFramework)},
+		},
+		"NotIDExternalName": {
+			args: args{cr: complianceFramework(withExternalName("sox"))},
+			want: want{cr: complianceFramework(withExternalName("sox")), err: errors.New(errIDNotInt)},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockDeleteComplianceFramework: func(ctx context.Context, id int64) error {
+						if id != frameworkID {
+							t.Errorf("DeleteComplianceFramework(%d): unexpected framework", id)
+						}
+						return nil
+					},
+				},
+				cr: complianceFramework(withExternalName("7")),
+			},
+			want: want{cr: complianceFramework(withExternalName("7"), withConditions(xpv1.Deleting()))},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockDeleteComplianceFramework: func(ctx context.Context, id int64) error {
+						return errUnavailable
+					},
+				},
+				cr: complianceFramework(withExternalName("7")),
+			},
+			want: want{cr: complianceFramework(withExternalName("7"), withConditions(xpv1.Deleting()))},
+		},
+		"ErrDelete": {
+			args: args{
+				client: &fake.MockComplianceFrameworkClient{
+					MockDeleteComplianceFramework: func(ctx context.Context, id int64) error {
+						return errBoom
+					},
+				},
+				cr: complianceFramework(withExternalName("7")),
+			},
+			want: want{
+				cr:  complianceFramework(withExternalName("7"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
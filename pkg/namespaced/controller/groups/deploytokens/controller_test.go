@@ -32,10 +32,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups/fake"
 )
@@ -46,7 +48,7 @@ var (
 	deployTokenID  = int64(1234)
 	sDeployTokenID = strconv.FormatInt(deployTokenID, 10)
 	unexpecedItem  resource.Managed
-	expiresAt      = time.Now()
+	expiresAt      = time.Now().AddDate(0, 6, 0)
 	token          = "Token"
 	username       = "Username"
 	deployTokenObj = gitlab.DeployToken{
@@ -70,6 +72,13 @@ var (
 	}
 
 	extNameAnnotation = map[string]string{meta.AnnotationKeyExternalName: fmt.Sprint(deployTokenID)}
+
+	scopesChanged = xpv1.Condition{
+		Type:    common.TypeImmutableFieldsChanged,
+		Status:  corev1.ConditionTrue,
+		Reason:  common.ReasonRecreateRequired,
+		Message: "field forProvider.scopes is immutable, recreate required",
+	}
 )
 
 type args struct {
@@ -321,6 +330,7 @@ func TestCreate(t *testing.T) {
 					withAnnotations(extNameAnnotation),
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry"},
 					}),
 				),
 			},
@@ -329,6 +339,7 @@ func TestCreate(t *testing.T) {
 					withExternalName(sDeployTokenID),
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry"},
 					}),
 				),
 				result: managed.ExternalCreation{
@@ -336,6 +347,31 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"InvalidScopes": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockCreateGroupDeployToken: func(pid interface{}, opt *gitlab.CreateGroupDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+						t.Errorf("Create(...): a deploy token with invalid scopes must not be created")
+						return &deployTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry", "api"},
+					}),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{
+						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry", "api"},
+					}),
+				),
+				err: errors.Wrap(common.ValidateDeployTokenScopes([]string{"read_registry", "api"}), errCreateFailed),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				deployToken: &fake.MockClient{
@@ -346,6 +382,7 @@ func TestCreate(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry"},
 					}),
 					withExternalName("0"),
 				),
@@ -354,6 +391,7 @@ func TestCreate(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID: &deployTokenID,
+						Scopes:  []string{"read_registry"},
 					}),
 					withExternalName("0"),
 				),
@@ -398,6 +436,38 @@ func TestUpdate(t *testing.T) {
 				cr: deployToken(),
 			},
 		},
+		"ImmutableFieldChanged": {
+			args: args{
+				cr: deployToken(withConditions(scopesChanged)),
+			},
+			want: want{
+				cr:  deployToken(withConditions(scopesChanged)),
+				err: errors.New("field forProvider.scopes is immutable, recreate required, set the " + common.AnnotationKeyRecreateOnImmutableChange + " annotation to \"true\" to delete and create it again"),
+			},
+		},
+		"ImmutableFieldChangedRecreate": {
+			args: args{
+				deployToken: &fake.MockClient{
+					MockDeleteGroupDeployToken: func(pid interface{}, deployToken int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{GroupID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withSpec(v1alpha1.DeployTokenParameters{GroupID: &deployTokenID}),
+					withExternalName(sDeployTokenID),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(scopesChanged),
+				),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
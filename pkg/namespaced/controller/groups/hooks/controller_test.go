@@ -0,0 +1,657 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	groupPath      = "platform/services"
+	hookID         = int64(42)
+	hookURL        = "https://hooks.example.com/gitlab"
+	secretValue    = "s3cr3t"
+	secretURL      = "https://hooks.internal.example.com/gitlab"
+	secret         = corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hook"},
+		Data:       map[string][]byte{"token": []byte(secretValue), "url": []byte(secretURL)},
+	}
+	headersHash = common.HashHookCustomHeaders([]*gitlab.HookCustomHeader{{Key: "X-Auth", Value: secretValue}})
+
+	notFound = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type args struct {
+	kube   client.Client
+	client groups.HookClient
+	cr     resource.Managed
+}
+
+type hookModifier func(*v1alpha1.GroupHook)
+
+func withGroupID() hookModifier {
+	return func(r *v1alpha1.GroupHook) { r.Spec.ForProvider.GroupID = &groupPath }
+}
+
+func withExternalName(n string) hookModifier {
+	return func(r *v1alpha1.GroupHook) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(fn func(p *v1alpha1.GroupHookParameters)) hookModifier {
+	return func(r *v1alpha1.GroupHook) { fn(&r.Spec.ForProvider) }
+}
+
+func withConditions(c ...xpv1.Condition) hookModifier {
+	return func(r *v1alpha1.GroupHook) { r.Status.SetConditions(c...) }
+}
+
+func withStatus(s v1alpha1.GroupHookObservation) hookModifier {
+	return func(r *v1alpha1.GroupHook) { r.Status.AtProvider = s }
+}
+
+func groupHook(m ...hookModifier) *v1alpha1.GroupHook {
+	cr := &v1alpha1.GroupHook{}
+	cr.Namespace = "default"
+	cr.Spec.ForProvider.URL = &hookURL
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// observedSpec returns the parameters of a hook late-initialized from
+// gitlabHook.
+func observedSpec(p *v1alpha1.GroupHookParameters) {
+	f := false
+	p.PushEvents = ptr.To(true)
+	p.PushEventsBranchFilter = ptr.To("main")
+	p.BranchFilterStrategy = ptr.To("wildcard")
+	p.SubGroupEvents = ptr.To(true)
+	p.EnableSSLVerification = ptr.To(true)
+	for _, b := range []**bool{
+		&p.IssuesEvents, &p.ConfidentialIssuesEvents, &p.MergeRequestsEvents, &p.TagPushEvents,
+		&p.NoteEvents, &p.ConfidentialNoteEvents, &p.JobEvents, &p.PipelineEvents, &p.WikiPageEvents,
+		&p.DeploymentEvents, &p.FeatureFlagEvents, &p.ReleasesEvents, &p.MilestoneEvents, &p.ProjectEvents,
+		&p.MemberEvents, &p.EmojiEvents, &p.VulnerabilityEvents, &p.ResourceAccessTokenEvents,
+	} {
+		*b = &f
+	}
+}
+
+func gitlabHook() *gitlab.GroupHook {
+	return &gitlab.GroupHook{
+		ID:                     hookID,
+		GroupID:                1234,
+		URL:                    hookURL,
+		PushEvents:             true,
+		PushEventsBranchFilter: "main",
+		BranchFilterStrategy:   "wildcard",
+		SubGroupEvents:         true,
+		EnableSSLVerification:  true,
+		AlertStatus:            "executable",
+		CustomHeaders:          []*gitlab.HookCustomHeader{{Key: "X-Auth"}},
+	}
+}
+
+func withCustomHeader(p *v1alpha1.GroupHookParameters) {
+	p.CustomHeaders = []v1alpha1.HookCustomHeader{{
+		Key:            "X-Auth",
+		ValueSecretRef: xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "hook"}, Key: "token"},
+	}}
+}
+
+func withToken(p *v1alpha1.GroupHookParameters) {
+	p.TokenSecretRef = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "hook"}, Key: "token"}
+}
+
+func withURLSecretRef(p *v1alpha1.GroupHookParameters) {
+	p.URL = nil
+	p.URLSecretRef = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "hook"}, Key: "url"}
+}
+
+func secretClient() *test.MockClient {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = secret
+			return nil
+		}),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.GroupHookObservation{
+		ID:                hookID,
+		GroupID:           1234,
+		AlertStatus:       "executable",
+		CustomHeaderKeys:  []string{"X-Auth"},
+		CustomHeadersHash: headersHash,
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupHook)},
+		},
+		"NoExternalName": {
+			args: args{cr: groupHook(withGroupID())},
+			want: want{cr: groupHook(withGroupID())},
+		},
+		"NotIDExternalName": {
+			args: args{cr: groupHook(withGroupID(), withExternalName("hook"))},
+			want: want{cr: groupHook(withGroupID(), withExternalName("hook")), err: errors.New(errIDNotInt)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupHook(withExternalName("42"))},
+			want: want{cr: groupHook(withExternalName("42")), err: errors.New(errGroupIDMissing)},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{cr: groupHook(withGroupID(), withExternalName("42"))},
+		},
+		"ErrGet": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, errBoom
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{cr: groupHook(withGroupID(), withExternalName("42")), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"LateInitializedUpToDate": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						if gid != groupPath || hook != hookID {
+							t.Errorf("GetGroupHook(%v, %d): unexpected hook", gid, hook)
+						}
+						return gitlabHook(), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withToken), withSpec(withCustomHeader),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeadersHash: headersHash})),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withToken), withSpec(withCustomHeader), withSpec(observedSpec),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"TriggerChanged": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return gitlabHook(), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader),
+					withSpec(func(p *v1alpha1.GroupHookParameters) { p.MemberEvents = ptr.To(true) }),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeadersHash: headersHash})),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader),
+					withSpec(func(p *v1alpha1.GroupHookParameters) { p.MemberEvents = ptr.To(true) }),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"URLSecretChanged": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return gitlabHook(), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader), withSpec(withURLSecretRef),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeadersHash: headersHash})),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader), withSpec(withURLSecretRef),
+					withConditions(xpv1.Available()), withStatus(observation)),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"CustomHeaderValueChanged": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return gitlabHook(), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeadersHash: "outdated"})),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec), withSpec(withCustomHeader),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupHookObservation{ID: hookID, GroupID: 1234, AlertStatus: "executable", CustomHeaderKeys: []string{"X-Auth"}, CustomHeadersHash: "outdated"})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupHook)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupHook()},
+			want: want{cr: groupHook(), err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockAddGroupHook: func(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						if diff := cmp.Diff(&secretValue, opt.Token); diff != "" {
+							t.Errorf("AddGroupHook(...): -want token, +got token:\n%s", diff)
+						}
+						if diff := cmp.Diff([]*gitlab.HookCustomHeader{{Key: "X-Auth", Value: secretValue}}, *opt.CustomHeaders); diff != "" {
+							t.Errorf("AddGroupHook(...): -want headers, +got headers:\n%s", diff)
+						}
+						return &gitlab.GroupHook{ID: hookID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withSpec(withToken), withSpec(withCustomHeader)),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withSpec(withToken), withSpec(withCustomHeader), withExternalName("42"),
+					withConditions(xpv1.Creating()), withStatus(v1alpha1.GroupHookObservation{CustomHeadersHash: headersHash})),
+			},
+		},
+		"WithoutToken": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupHook: func(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						if opt.Token != nil {
+							t.Errorf("AddGroupHook(...): unexpected token")
+						}
+						return &gitlab.GroupHook{ID: hookID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID()),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withConditions(xpv1.Creating())),
+			},
+		},
+		"ErrTokenSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   groupHook(withGroupID(), withSpec(withToken)),
+			},
+			want: want{
+				cr:  groupHook(withGroupID(), withSpec(withToken), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrap(errBoom, "Cannot find referenced secret"), errSecretRefInvalid),
+			},
+		},
+		"ErrCreate": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddGroupHook: func(gid any, opt *gitlab.AddGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: groupHook(withGroupID()),
+			},
+			want: want{
+				cr:  groupHook(withGroupID(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			got, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupHook)},
+		},
+		"GroupIDMissing": {
+			args: args{cr: groupHook(withExternalName("42"))},
+			want: want{cr: groupHook(withExternalName("42")), err: errors.New(errGroupIDMissing)},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockEditGroupHook: func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						if hook != hookID || !ptr.Deref(opt.SubGroupEvents, false) {
+							t.Errorf("EditGroupHook(...): unexpected options")
+						}
+						return &gitlab.GroupHook{}, &gitlab.Response{}, nil
+					},
+					MockDeleteGroupCustomHeader: func(gid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withCustomHeader),
+					withSpec(func(p *v1alpha1.GroupHookParameters) { p.SubGroupEvents = ptr.To(true) }),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeaderKeys: []string{"X-Auth", "X-Removed"}})),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withCustomHeader),
+					withSpec(func(p *v1alpha1.GroupHookParameters) { p.SubGroupEvents = ptr.To(true) }),
+					withStatus(v1alpha1.GroupHookObservation{CustomHeaderKeys: []string{"X-Auth", "X-Removed"}, CustomHeadersHash: headersHash})),
+				deleted: []string{"X-Removed"},
+			},
+		},
+		"URLFromSecret": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockEditGroupHook: func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						if ptr.Deref(opt.URL, "") != secretURL {
+							t.Errorf("EditGroupHook(...): want URL of the secret, got %v", opt.URL)
+						}
+						return &gitlab.GroupHook{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withURLSecretRef)),
+			},
+			want: want{
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withURLSecretRef)),
+			},
+		},
+		"ErrUpdateRedacted": {
+			args: args{
+				kube: secretClient(),
+				client: &fake.MockClient{
+					MockEditGroupHook: func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errors.Errorf("url %s is blocked", *opt.URL)
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42"), withSpec(withURLSecretRef)),
+			},
+			want: want{
+				cr:  groupHook(withGroupID(), withExternalName("42"), withSpec(withURLSecretRef)),
+				err: errors.Errorf("%s: url %s is blocked", errUpdateFailed, common.Redacted),
+			},
+		},
+		"ErrUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditGroupHook: func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{
+				cr:  groupHook(withGroupID(), withExternalName("42")),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			if c, ok := tc.client.(*fake.MockClient); ok && c.MockDeleteGroupCustomHeader != nil {
+				del := c.MockDeleteGroupCustomHeader
+				c.MockDeleteGroupCustomHeader = func(gid any, hook int64, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					deleted = append(deleted, key)
+					return del(gid, hook, key, options...)
+				}
+			}
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("DeleteGroupCustomHeader(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTokenRotation(t *testing.T) {
+	previous := "previous-token"
+	var sent *string
+	e := &external{
+		kube: &test.MockClient{
+			MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				if key.Name != "hook-connection" {
+					return errBoom
+				}
+				obj.(*corev1.Secret).Data = map[string][]byte{common.ConnectionKeyHookToken: []byte(previous)}
+				return nil
+			},
+		},
+		client: &fake.MockClient{
+			MockGetGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+				h := gitlabHook()
+				h.CustomHeaders = nil
+				return h, &gitlab.Response{}, nil
+			},
+			MockEditGroupHook: func(gid any, hook int64, opt *gitlab.EditGroupHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupHook, *gitlab.Response, error) {
+				sent = opt.Token
+				return &gitlab.GroupHook{}, &gitlab.Response{}, nil
+			},
+		},
+	}
+	cr := groupHook(withGroupID(), withExternalName("42"), withSpec(observedSpec),
+		withSpec(func(p *v1alpha1.GroupHookParameters) { p.RotateEvery = &metav1.Duration{Duration: time.Hour} }),
+		withStatus(v1alpha1.GroupHookObservation{TokenRotatedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}}))
+	cr.SetWriteConnectionSecretToReference(&xpv1.LocalSecretReference{Name: "hook-connection"})
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceUpToDate {
+		t.Errorf("Observe(...): want a hook whose token is due for rotation to be out of date")
+	}
+
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	token := string(u.ConnectionDetails[common.ConnectionKeyHookToken])
+	if sent == nil || *sent != token || token == previous {
+		t.Errorf("Update(...): want a new token sent and published, got sent %v and published %q", sent, token)
+	}
+	if diff := cmp.Diff(previous, string(u.ConnectionDetails[common.ConnectionKeyPreviousHookToken])); diff != "" {
+		t.Errorf("Update(...): -want previous token, +got:\n%s", diff)
+	}
+
+	o, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): want a hook rotated just now to be up to date")
+	}
+
+	sent = nil
+	if u, err := e.Update(context.Background(), cr); err != nil || sent != nil || u.ConnectionDetails != nil {
+		t.Errorf("Update(...): want the token kept until the next rotation, got sent %v, details %v, error %v", sent, u.ConnectionDetails, err)
+	}
+
+	withToken(&cr.Spec.ForProvider)
+	if _, err := e.Update(context.Background(), cr); !cmp.Equal(errors.New(common.ErrHookTokenRotationConflict), err, test.EquateErrors()) {
+		t.Errorf("Update(...): want %q, got %v", common.ErrHookTokenRotationConflict, err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotGroupHook)},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{cr: groupHook(withGroupID(), withExternalName("42"), withConditions(xpv1.Deleting()))},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound, errBoom
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{cr: groupHook(withGroupID(), withExternalName("42"), withConditions(xpv1.Deleting()))},
+		},
+		"ErrDelete": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupHook: func(gid any, hook int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, errBoom
+					},
+				},
+				cr: groupHook(withGroupID(), withExternalName("42")),
+			},
+			want: want{
+				cr:  groupHook(withGroupID(), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
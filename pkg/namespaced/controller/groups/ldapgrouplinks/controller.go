@@ -53,7 +53,7 @@ func SetupLdapGroupLink(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.LdapGroupLinkGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewLdapGroupLinkClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewLdapGroupLinkClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
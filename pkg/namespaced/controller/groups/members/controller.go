@@ -53,11 +53,11 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.MemberKubernetesGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: groups.NewMemberClient,
 			newUserClientFn:   users.NewUserClient,
-		}),
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -196,6 +196,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalUpdate{}, errors.New(errMissingUserInfo)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
 
 	_, _, err := e.client.EditGroupMember(
 		*cr.Spec.ForProvider.GroupID,
@@ -219,6 +222,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.UserID == nil {
 		return managed.ExternalDelete{}, errors.New(errMissingUserInfo)
 	}
+	if err := users.CheckSelfMembership(ctx, e.userClient, cr, *cr.Spec.ForProvider.UserID); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
 
 	_, err := e.client.RemoveGroupMember(
 		*cr.Spec.ForProvider.GroupID,
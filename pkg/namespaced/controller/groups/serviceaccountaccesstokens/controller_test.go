@@ -0,0 +1,388 @@
+// /*
+// Copyright 2021 The Crossplane Authors.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// */
+
+package serviceaccountaccesstokens
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups/fake"
+)
+
+var (
+	errBoom          = errors.New("boom")
+	groupID          = int64(42)
+	serviceAccountID = int64(7)
+	tokenID          = int64(1234)
+	sTokenID         = strconv.FormatInt(tokenID, 10)
+	invalidInput     resource.Managed
+	token            = "Token"
+
+	params = v1alpha1.ServiceAccountAccessTokenParameters{
+		GroupID:          &groupID,
+		ServiceAccountID: &serviceAccountID,
+		Name:             "ci",
+		Scopes:           []string{"api"},
+	}
+)
+
+type args struct {
+	client groups.ServiceAccountAccessTokenClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type tokenModifier func(*v1alpha1.ServiceAccountAccessToken)
+
+func withConditions(c ...xpv1.Condition) tokenModifier {
+	return func(r *v1alpha1.ServiceAccountAccessToken) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUnsupportedByLicense() tokenModifier {
+	return func(r *v1alpha1.ServiceAccountAccessToken) {
+		common.SetUnsupportedByLicense(r, featureServiceAccountAccessTokens)
+	}
+}
+
+func withSpec(fp v1alpha1.ServiceAccountAccessTokenParameters) tokenModifier {
+	return func(r *v1alpha1.ServiceAccountAccessToken) { r.Spec.ForProvider = fp }
+}
+
+func withExternalName(n string) tokenModifier {
+	return func(r *v1alpha1.ServiceAccountAccessToken) { meta.SetExternalName(r, n) }
+}
+
+func serviceAccountAccessToken(m ...tokenModifier) *v1alpha1.ServiceAccountAccessToken {
+	cr := &v1alpha1.ServiceAccountAccessToken{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func response(code int, nextPage int64) *gitlab.Response {
+	return &gitlab.Response{Response: &http.Response{StatusCode: code}, NextPage: nextPage}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: invalidInput},
+			want: want{cr: invalidInput, err: errors.New(errNotServiceAccountAccessToken)},
+		},
+		"NoExternalName": {
+			args: args{cr: serviceAccountAccessToken(withSpec(params))},
+			want: want{cr: serviceAccountAccessToken(withSpec(params))},
+		},
+		"NoServiceAccountID": {
+			args: args{cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(v1alpha1.ServiceAccountAccessTokenParameters{GroupID: &groupID}))},
+			want: want{
+				cr:  serviceAccountAccessToken(withExternalName(sTokenID), withSpec(v1alpha1.ServiceAccountAccessTokenParameters{GroupID: &groupID})),
+				err: errors.New(errMissingServiceAccountID),
+			},
+		},
+		"ErrList": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr:  serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Unlicensed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, response(http.StatusForbidden, 0), errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr:  serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params), withUnsupportedByLicense()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ServiceAccountNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, response(http.StatusNotFound, 0), errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+		},
+		"TokenNotListed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return []*gitlab.PersonalAccessToken{{ID: tokenID + 1, Active: true}}, response(http.StatusOK, 0), nil
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+		},
+		"TokenInactive": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return []*gitlab.PersonalAccessToken{{ID: tokenID, Active: false}}, response(http.StatusOK, 0), nil
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+		},
+		"TokenOnSecondPage": {
+			args: args{
+				client: &fake.MockClient{
+					MockListServiceAccountPersonalAccessTokens: func(gid any, sa int64, opt *gitlab.ListServiceAccountPersonalAccessTokensOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						if opt.Page == 1 {
+							return []*gitlab.PersonalAccessToken{{ID: tokenID + 1, Active: true}}, response(http.StatusOK, 2), nil
+						}
+						return []*gitlab.PersonalAccessToken{{ID: tokenID, Active: true}}, response(http.StatusOK, 0), nil
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params), withUnsupportedByLicense()),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params), withConditions(
+					xpv1.Available(),
+					xpv1.Condition{Type: common.TypeUnsupportedFeatures, Status: "False", Reason: common.ReasonAllFeaturesSupported},
+				)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.ServiceAccountAccessTokenStatus{}, "AtProvider")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: invalidInput},
+			want: want{cr: invalidInput, err: errors.New(errNotServiceAccountAccessToken)},
+		},
+		"NoGroupID": {
+			args: args{cr: serviceAccountAccessToken()},
+			want: want{cr: serviceAccountAccessToken(), err: errors.New(errMissingGroupID)},
+		},
+		"ErrCreate": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateServiceAccountPersonalAccessToken: func(gid any, sa int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withSpec(params)),
+			},
+			want: want{
+				cr:  serviceAccountAccessToken(withSpec(params)),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Unlicensed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateServiceAccountPersonalAccessToken: func(gid any, sa int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, response(http.StatusForbidden, 0), errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withSpec(params)),
+			},
+			want: want{
+				cr:  serviceAccountAccessToken(withSpec(params), withUnsupportedByLicense()),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateServiceAccountPersonalAccessToken: func(gid any, sa int64, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						if gid != groupID || sa != serviceAccountID {
+							t.Errorf("got group %v and service account %d", gid, sa)
+						}
+						return &gitlab.PersonalAccessToken{ID: tokenID, Token: token}, response(http.StatusCreated, 0), nil
+					},
+				},
+				cr: serviceAccountAccessToken(withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withSpec(params), withExternalName(sTokenID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: invalidInput},
+			want: want{cr: invalidInput, err: errors.New(errNotServiceAccountAccessToken)},
+		},
+		"ErrRevoke": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeServiceAccountPersonalAccessToken: func(gid any, sa, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr:  serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+		"AlreadyRevoked": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeServiceAccountPersonalAccessToken: func(gid any, sa, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return response(http.StatusNotFound, 0), errBoom
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeServiceAccountPersonalAccessToken: func(gid any, sa, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if id != tokenID {
+							t.Errorf("got token %d, want %d", id, tokenID)
+						}
+						return response(http.StatusNoContent, 0), nil
+					},
+				},
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+			want: want{
+				cr: serviceAccountAccessToken(withExternalName(sTokenID), withSpec(params)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -48,6 +48,8 @@ const (
 	errDeleteFailed      = "cannot delete Gitlab service account"
 	errIDNotInt          = "specified ID is not an integer"
 	errMissingGroupID    = "missing Spec.ForProvider.GroupID"
+
+	featureServiceAccounts = "group service accounts"
 )
 
 // SetupServiceAccount adds a controller that reconciles GitLab Service Accounts.
@@ -55,7 +57,7 @@ func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.ServiceAccountGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewServiceAccountClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewServiceAccountClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -152,6 +154,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = groups.GenerateServiceAccountObservationFromUser(serviceAccount)
+	// resets a license restriction reported earlier, e.g. before an upgrade
+	common.SetUnsupportedFeatures(cr, nil, nil)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
@@ -175,11 +179,16 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	// Call GitLab Service Accounts API
-	serviceAccount, _, err := e.client.CreateServiceAccount(
+	serviceAccount, res, err := e.client.CreateServiceAccount(
 		*cr.Spec.ForProvider.GroupID,
 		groups.GenerateServiceAccountCreateOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx))
 	if err != nil {
+		// Without a license including service accounts GitLab denies the
+		// request, the Community Edition does not know the endpoint at all.
+		if clients.IsResponseForbidden(res) || clients.IsResponseNotFound(res) {
+			common.SetUnsupportedByLicense(cr, featureServiceAccounts)
+		}
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
@@ -22,12 +22,16 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/accesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/badges"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/complianceframeworks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/deploytokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/hooks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/ldapgrouplinks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/pushrules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/runners"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/samlgrouplinks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/serviceaccountaccesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/serviceaccounts"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/groups/variables"
 )
@@ -45,6 +49,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		runners.SetupRunner,
 		badges.SetupBadge,
 		serviceaccounts.SetupServiceAccount,
+		serviceaccountaccesstokens.SetupServiceAccountAccessToken,
+		pushrules.SetupGroupPushRules,
+		hooks.SetupGroupHook,
+		complianceframeworks.SetupComplianceFramework,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -67,6 +75,10 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		runners.SetupRunnerGated,
 		badges.SetupBadgeGated,
 		serviceaccounts.SetupServiceAccountGated,
+		serviceaccountaccesstokens.SetupServiceAccountAccessTokenGated,
+		pushrules.SetupGroupPushRulesGated,
+		hooks.SetupGroupHookGated,
+		complianceframeworks.SetupComplianceFrameworkGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
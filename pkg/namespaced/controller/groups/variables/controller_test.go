@@ -19,16 +19,19 @@ package variables
 import (
 	"context"
 	"net/http"
+	"slices"
 	"testing"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
@@ -42,7 +45,7 @@ var (
 	errBoom             = errors.New("boom")
 	groupID             = int64(5678)
 	variableKey         = "VARIABLE_KEY"
-	variableValue       = "1234"
+	variableValue       = "12345678"
 	variableType        = commonv1alpha1.VariableTypeEnvVar
 	variableEnvScope    = "*"
 	f                   = false
@@ -146,6 +149,12 @@ func withEnvironmentScope(scope string) variableModifier {
 	}
 }
 
+func withExternalName(name string) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		meta.SetExternalName(r, name)
+	}
+}
+
 var deletionTime = metav1.Now()
 
 func withDeletionTimestamp() variableModifier {
@@ -193,6 +202,7 @@ func TestObserve(t *testing.T) {
 					withDefaultValues(),
 					withDescription(variableDescription),
 					withConditions(xpv1.Available()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 					withObservation(v1alpha1.VariableObservation{
 						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
 							Key:          variableKey,
@@ -206,8 +216,9 @@ func TestObserve(t *testing.T) {
 					}),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
 				},
 			},
 		},
@@ -231,6 +242,7 @@ func TestObserve(t *testing.T) {
 					withValue("blah"),
 					withDescription(variableDescription),
 					withConditions(xpv1.Available()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 					withObservation(v1alpha1.VariableObservation{
 						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
 							Key:          variableKey,
@@ -244,8 +256,9 @@ func TestObserve(t *testing.T) {
 					}),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: false,
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
 				},
 			},
 		},
@@ -278,6 +291,7 @@ func TestObserve(t *testing.T) {
 					withVariableType(commonv1alpha1.VariableTypeEnvVar),
 					withDescription(variableDescription),
 					withConditions(xpv1.Available()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 					withObservation(v1alpha1.VariableObservation{
 						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
 							Key:          variableKey,
@@ -299,6 +313,132 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"MigrateKeyOnlyExternalName": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return &pv, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@"+variableEnvScope),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.VariableObservation{
+						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
+							Key:          variableKey,
+							Description:  variableDescription,
+							VariableType: variableType,
+							Protected:    f,
+							Masked:       f,
+							Raw:          f,
+						},
+						EnvironmentScope: "*",
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"EnvironmentScopeFromExternalName": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						if opt == nil || opt.Filter == nil || opt.Filter.EnvironmentScope != "staging" {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						rv := pv
+						rv.EnvironmentScope = "staging"
+						return &rv, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@staging"),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@staging"),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.VariableObservation{
+						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
+							Key:          variableKey,
+							Description:  variableDescription,
+							VariableType: variableType,
+							Protected:    f,
+							Masked:       f,
+							Raw:          f,
+						},
+						EnvironmentScope: "staging",
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EnvironmentScopeMovedByUpdate": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						if opt == nil || opt.Filter == nil || opt.Filter.EnvironmentScope != "production" {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						rv := pv
+						rv.EnvironmentScope = "production"
+						return &rv, &gitlab.Response{}, nil
+					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						rv := pv
+						rv.EnvironmentScope = "production"
+						return []*gitlab.GroupVariable{&rv}, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@staging"),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@production"),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.VariableObservation{
+						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
+							Key:          variableKey,
+							Description:  variableDescription,
+							VariableType: variableType,
+							Protected:    f,
+							Masked:       f,
+							Raw:          f,
+						},
+						EnvironmentScope: "production",
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
 		"GetError": {
 			args: args{
 				variable: &fake.MockClient{
@@ -320,12 +460,101 @@ func TestObserve(t *testing.T) {
 				err:    errors.Wrap(errBoom, errGetFailed),
 			},
 		},
+		"ErrGet404KeyOnlyInOtherScope": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						rv := pv
+						rv.EnvironmentScope = "production"
+						return []*gitlab.GroupVariable{&rv}, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ErrGet404FilterMissedScope": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						other := pv
+						other.EnvironmentScope = "production"
+						return []*gitlab.GroupVariable{&other, &pv}, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withDescription(variableDescription),
+					withConditions(xpv1.Available()),
+					withExternalName(variableKey+"@"+variableEnvScope),
+					withObservation(v1alpha1.VariableObservation{
+						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
+							Key:          variableKey,
+							Description:  variableDescription,
+							VariableType: variableType,
+							Protected:    f,
+							Masked:       f,
+							Raw:          f,
+						},
+						EnvironmentScope: variableEnvScope,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ErrGet404ListFailed": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+				),
+				result: managed.ExternalObservation{},
+				err:    errors.Wrap(errors.Wrap(errBoom, errListFailed), errGetFailed),
+			},
+		},
 		"ErrGet404": {
 			args: args{
 				variable: &fake.MockClient{
 					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
 						return &gitlab.GroupVariable{}, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
 				},
 				cr: variable(
 					withDefaultValues(),
@@ -380,6 +609,7 @@ func TestObserve(t *testing.T) {
 					withRaw(true),
 					withDescription(variableDescription),
 					withConditions(xpv1.Available()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 					withVariableType(commonv1alpha1.VariableTypeEnvVar),
 					withObservation(v1alpha1.VariableObservation{
 						CommonVariableObservation: commonv1alpha1.CommonVariableObservation{
@@ -514,10 +744,82 @@ func TestCreate(t *testing.T) {
 				cr: variable(
 					withDefaultValues(),
 					withConditions(xpv1.Creating()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 				),
 				result: managed.ExternalCreation{},
 			},
 		},
+		"AdoptExistingByExternalName": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						if opt.Filter.EnvironmentScope != "production" {
+							return nil, nil, errors.New("unexpected environment scope")
+						}
+						return &gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "production"}, &gitlab.Response{}, nil
+					},
+					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, nil, errors.New("variable must be adopted, not created")
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@production"),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@production"),
+				),
+			},
+		},
+		"CreateIfExternalNameNotFound": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return &gitlab.GroupVariable{Key: variableKey}, &gitlab.Response{}, nil
+					},
+					MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@"+variableEnvScope),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withExternalName(variableKey+"@"+variableEnvScope),
+				),
+			},
+		},
+		"FailedGetExternalName": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@"+variableEnvScope),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey+"@"+variableEnvScope),
+				),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				variable: &fake.MockClient{
@@ -565,6 +867,7 @@ func TestCreate(t *testing.T) {
 					withGroupID(groupID),
 					withKey(variableKey),
 					withConditions(xpv1.Creating()),
+					withExternalName(variableKey+"@"+variableEnvScope),
 					withValueSecretRef(common.TestCreateLocalSecretKeySelector("something", "blah")),
 					withValue(variableValue),
 					withMasked(true),
@@ -653,6 +956,32 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"MovesEnvironmentScope": {
+			args: args{
+				variable: &fake.MockClient{
+					MockUpdateGroupVariable: func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						if opt.Filter == nil || opt.Filter.EnvironmentScope != "staging" || *opt.EnvironmentScope != "production" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.GroupVariable{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withKey(variableKey),
+					withGroupID(groupID),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@staging"),
+				),
+			},
+			want: want{
+				cr: variable(
+					withKey(variableKey),
+					withGroupID(groupID),
+					withEnvironmentScope("production"),
+					withExternalName(variableKey+"@staging"),
+				),
+			},
+		},
 		"FailedEdit": {
 			args: args{
 				variable: &fake.MockClient{
@@ -779,7 +1108,7 @@ func TestDelete(t *testing.T) {
 		"SuccessfulDeletion": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
 					},
 				},
@@ -798,7 +1127,7 @@ func TestDelete(t *testing.T) {
 		"FailedDeletion": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, errBoom
 					},
 				},
@@ -815,10 +1144,29 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDeleteFailed),
 			},
 		},
+		"AlreadyDeleted": {
+			args: args{
+				variable: &fake.MockClient{
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: variable(
+					withGroupID(groupID),
+					withConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: variable(
+					withGroupID(groupID),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
 		"InvalidVariableID": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
 					},
 				},
@@ -850,3 +1198,131 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteOnlyManagedScope(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.Variable
+		want []string
+	}{
+		"DefaultScope": {
+			cr:   variable(withGroupID(groupID), withKey(variableKey)),
+			want: []string{"production"},
+		},
+		"ExplicitScope": {
+			cr:   variable(withGroupID(groupID), withKey(variableKey), withEnvironmentScope("production")),
+			want: []string{"*"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			remaining := []gitlab.GroupVariable{
+				{Key: variableKey, EnvironmentScope: "*"},
+				{Key: variableKey, EnvironmentScope: "production"},
+			}
+			client := &fake.MockClient{
+				MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					// Without a filter every scope sharing the key matches.
+					remaining = slices.DeleteFunc(remaining, func(v gitlab.GroupVariable) bool {
+						return v.Key == key && (opt == nil || opt.Filter == nil || opt.Filter.EnvironmentScope == v.EnvironmentScope)
+					})
+					return &gitlab.Response{}, nil
+				},
+			}
+
+			e := &external{client: client}
+			if _, err := e.Delete(context.Background(), tc.cr); err != nil {
+				t.Fatalf("Delete(...): unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(remaining))
+			for _, v := range remaining {
+				got = append(got, v.EnvironmentScope)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("remaining scopes: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeletePruneOtherScopes(t *testing.T) {
+	cases := map[string]struct {
+		prune *bool
+		want  []string
+	}{
+		"Disabled": {
+			want: []string{"production", "staging", "OTHER_KEY/*"},
+		},
+		"Enabled": {
+			prune: ptr.To(true),
+			want:  []string{"OTHER_KEY/*"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			remaining := []gitlab.GroupVariable{
+				{Key: variableKey, EnvironmentScope: "*"},
+				{Key: variableKey, EnvironmentScope: "production"},
+				{Key: variableKey, EnvironmentScope: "staging"},
+				{Key: "OTHER_KEY", EnvironmentScope: "*"},
+			}
+			client := &fake.MockClient{
+				MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+					list := make([]*gitlab.GroupVariable, 0, len(remaining))
+					for i := range remaining {
+						v := remaining[i]
+						list = append(list, &v)
+					}
+					return list, &gitlab.Response{}, nil
+				},
+				MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					remaining = slices.DeleteFunc(remaining, func(v gitlab.GroupVariable) bool {
+						return v.Key == key && opt.Filter.EnvironmentScope == v.EnvironmentScope
+					})
+					return &gitlab.Response{}, nil
+				},
+			}
+
+			cr := variable(withGroupID(groupID), withKey(variableKey))
+			cr.Spec.ForProvider.PruneOtherScopes = tc.prune
+			e := &external{client: client}
+			if _, err := e.Delete(context.Background(), cr); err != nil {
+				t.Fatalf("Delete(...): unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(remaining))
+			for _, v := range remaining {
+				if v.Key != variableKey {
+					got = append(got, v.Key+"/"+v.EnvironmentScope)
+					continue
+				}
+				got = append(got, v.EnvironmentScope)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("remaining scopes: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeletePruneOtherScopesListFailed(t *testing.T) {
+	client := &fake.MockClient{
+		MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+			return nil, &gitlab.Response{}, errBoom
+		},
+		MockRemoveGroupVariable: func(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			t.Errorf("Delete(...): the variable must not be removed before the other scopes are pruned")
+			return &gitlab.Response{}, nil
+		},
+	}
+
+	cr := variable(withGroupID(groupID), withKey(variableKey))
+	cr.Spec.ForProvider.PruneOtherScopes = ptr.To(true)
+	e := &external{client: client}
+	_, err := e.Delete(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errListFailed), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+	}
+}
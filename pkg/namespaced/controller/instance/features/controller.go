@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/instance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotFeature   = "managed resource is not a Gitlab feature custom resource"
+	errGetFailed    = "cannot get Gitlab feature"
+	errSetFailed    = "cannot set Gitlab feature gate %s"
+	errDeleteFailed = "cannot delete Gitlab feature"
+	errNotAdmin     = "managing Gitlab features requires a token of an administrator of a self-managed instance"
+	errGetGroup     = "cannot get Gitlab group %v"
+	errGetProject   = "cannot get Gitlab project %v"
+)
+
+// SetupFeature adds a controller that reconciles Instance Features.
+func SetupFeature(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FeatureGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
+			kube:               mgr.GetClient(),
+			newGitlabClientFn:  instance.NewFeatureClient,
+			newGroupClientFn:   groups.NewGroupClient,
+			newProjectClientFn: projects.NewProjectClient,
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FeatureGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.FeatureList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Feature{}).
+		Complete(r)
+}
+
+// SetupFeatureGated adds a controller with CRD gate support.
+func SetupFeatureGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupFeature(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.FeatureGroupVersionKind.String())
+		}
+	}, v1alpha1.FeatureGroupVersionKind)
+	return nil
+}
+
+// connector is responsible for producing an ExternalClient for Features
+type connector struct {
+	kube               client.Client
+	newGitlabClientFn  func(cfg common.Config) instance.FeatureClient
+	newGroupClientFn   func(cfg common.Config) groups.Client
+	newProjectClientFn func(cfg common.Config) projects.Client
+}
+
+// Connect establishes a connection to the external system.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return nil, errors.New(errNotFeature)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:          c.kube,
+		client:        c.newGitlabClientFn(*cfg),
+		groupClient:   c.newGroupClientFn(*cfg),
+		projectClient: c.newProjectClientFn(*cfg),
+	}, nil
+}
+
+// external is an external client for Instance Features
+type external struct {
+	kube          client.Client
+	client        instance.FeatureClient
+	groupClient   groups.Client
+	projectClient projects.Client
+}
+
+// Observe checks if the feature flag is set and if its gates are up to date.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFeature)
+	}
+
+	// Report the feature as gone so the finalizer can be removed.
+	if common.SkipExternalDelete(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	features, res, err := e.client.ListFeatures(gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, common.WrapForbidden(err, res, errGetFailed, errNotAdmin))
+	}
+	f := instance.FindFeature(features, cr.Spec.ForProvider.Name)
+	if f == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// Deleting: only need to determine external resource still exists.
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	cr.Status.AtProvider = instance.GenerateFeatureObservation(f)
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate := instance.IsFeatureUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+	if upToDate {
+		actors, err := e.desiredActors(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		for _, actorType := range slices.Sorted(maps.Keys(actors)) {
+			add, remove := instance.DiffFeatureActors(actorType, actors[actorType], cr.Status.AtProvider.Actors)
+			upToDate = upToDate && len(add) == 0 && len(remove) == 0
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// Create sets the gates of the feature flag in Gitlab using the Gitlab API.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFeature)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.setGates(ctx, &cr.Spec.ForProvider, v1alpha1.FeatureObservation{})
+}
+
+// Update sets the gates of the feature flag that are not up to date.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFeature)
+	}
+
+	return managed.ExternalUpdate{}, e.setGates(ctx, &cr.Spec.ForProvider, cr.Status.AtProvider)
+}
+
+// Delete deletes the feature flag in Gitlab using the Gitlab API, which
+// restores its default state.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotFeature)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteFeatureFlag(cr.Spec.ForProvider.Name, gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, common.WrapForbidden(err, res, errDeleteFailed, errNotAdmin))
+}
+
+// Disconnect disconnects from the external system (not implemented).
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// setGates sets the gates of the parameters that differ from the observed
+// ones. The boolean gate goes first, since disabling the feature this way
+// clears all other gates.
+func (e *external) setGates(ctx context.Context, p *v1alpha1.FeatureParameters, o v1alpha1.FeatureObservation) error {
+	if p.Enabled != nil && (*p.Enabled != o.Enabled || o.State == "") {
+		if err := e.set(ctx, p.Name, instance.FeatureGateBoolean, &gitlab.SetFeatureFlagOptions{Value: *p.Enabled}); err != nil {
+			return err
+		}
+		if !*p.Enabled {
+			o = v1alpha1.FeatureObservation{}
+		}
+	}
+	if p.PercentageOfTime != nil && *p.PercentageOfTime != o.PercentageOfTime {
+		if err := e.set(ctx, p.Name, instance.FeatureGatePercentageOfTime, &gitlab.SetFeatureFlagOptions{Value: *p.PercentageOfTime, Key: instance.FeatureGatePercentageOfTime}); err != nil {
+			return err
+		}
+	}
+	if p.PercentageOfActors != nil && *p.PercentageOfActors != o.PercentageOfActors {
+		if err := e.set(ctx, p.Name, instance.FeatureGatePercentageOfActors, &gitlab.SetFeatureFlagOptions{Value: *p.PercentageOfActors, Key: instance.FeatureGatePercentageOfActors}); err != nil {
+			return err
+		}
+	}
+
+	actors, err := e.desiredActors(ctx, p)
+	if err != nil {
+		return err
+	}
+	for _, actorType := range slices.Sorted(maps.Keys(actors)) {
+		add, remove := instance.DiffFeatureActors(actorType, actors[actorType], o.Actors)
+		for _, a := range add {
+			if err := e.setActor(ctx, p.Name, actorType, a, true); err != nil {
+				return err
+			}
+		}
+		for _, a := range remove {
+			if err := e.setActor(ctx, p.Name, actorType, a, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setActor enables or disables the feature flag for a single actor, which
+// GitLab identifies by its full path.
+func (e *external) setActor(ctx context.Context, name, actorType, actor string, enabled bool) error {
+	_, id, _ := instance.ParseFeatureActorID(actor)
+	opt := &gitlab.SetFeatureFlagOptions{Value: enabled}
+	switch actorType {
+	case instance.FeatureActorGroup:
+		g, _, err := e.groupClient.GetGroup(id, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return errors.Wrapf(err, errGetGroup, id)
+		}
+		opt.Group = g.FullPath
+	case instance.FeatureActorProject:
+		p, _, err := e.projectClient.GetProject(id, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return errors.Wrapf(err, errGetProject, id)
+		}
+		opt.Project = p.PathWithNamespace
+	}
+	return e.set(ctx, name, actor, opt)
+}
+
+func (e *external) set(ctx context.Context, name, gate string, opt *gitlab.SetFeatureFlagOptions) error {
+	_, res, err := e.client.SetFeatureFlag(name, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return common.WrapForbidden(err, res, fmt.Sprintf(errSetFailed, gate), errNotAdmin)
+	}
+	return nil
+}
+
+// desiredActors looks up the groups and projects of the parameters and
+// returns their actor IDs by actor type. Actor types the parameters do not
+// manage are left out.
+func (e *external) desiredActors(ctx context.Context, p *v1alpha1.FeatureParameters) (map[string][]string, error) {
+	actors := map[string][]string{}
+	if p.Groups != nil {
+		actors[instance.FeatureActorGroup] = []string{}
+		for _, path := range p.Groups {
+			g, _, err := e.groupClient.GetGroup(path, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, errors.Wrapf(err, errGetGroup, path)
+			}
+			actors[instance.FeatureActorGroup] = append(actors[instance.FeatureActorGroup], instance.FeatureActorID(instance.FeatureActorGroup, g.ID))
+		}
+	}
+	if p.Projects != nil {
+		actors[instance.FeatureActorProject] = []string{}
+		for _, path := range p.Projects {
+			pr, _, err := e.projectClient.GetProject(path, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, errors.Wrapf(err, errGetProject, path)
+			}
+			actors[instance.FeatureActorProject] = append(actors[instance.FeatureActorProject], instance.FeatureActorID(instance.FeatureActorProject, pr.ID))
+		}
+	}
+	return actors, nil
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	groupsfake "github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/groups/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/instance"
+	projectsfake "github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+
+	featureName = "my_feature"
+	forbidden   = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+	notFound    = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type MockClient struct {
+	MockListFeatures      func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error)
+	MockSetFeatureFlag    func(name string, opt *gitlab.SetFeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Feature, *gitlab.Response, error)
+	MockDeleteFeatureFlag func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+func (m *MockClient) ListFeatures(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error) {
+	return m.MockListFeatures(options...)
+}
+
+func (m *MockClient) SetFeatureFlag(name string, opt *gitlab.SetFeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Feature, *gitlab.Response, error) {
+	return m.MockSetFeatureFlag(name, opt, options...)
+}
+
+func (m *MockClient) DeleteFeatureFlag(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.MockDeleteFeatureFlag(name, options...)
+}
+
+type modifier func(*v1alpha1.Feature)
+
+func withSpec(p v1alpha1.FeatureParameters) modifier {
+	return func(cr *v1alpha1.Feature) {
+		p.Name = featureName
+		cr.Spec.ForProvider = p
+	}
+}
+
+func withObservation(o v1alpha1.FeatureObservation) modifier {
+	return func(cr *v1alpha1.Feature) {
+		cr.Status.AtProvider = o
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(cr *v1alpha1.Feature) {
+		cr.Status.SetConditions(c...)
+	}
+}
+
+func featureFlag(m ...modifier) *v1alpha1.Feature {
+	cr := &v1alpha1.Feature{}
+	cr.Spec.ForProvider.Name = featureName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listFeatures(features ...*gitlab.Feature) func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error) {
+	return func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error) {
+		return features, &gitlab.Response{}, nil
+	}
+}
+
+// groupClient resolves group paths to the IDs of groups and back.
+func groupClient(ids map[string]int64) *groupsfake.MockClient {
+	return &groupsfake.MockClient{MockGetGroup: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+		for path, id := range ids {
+			if gid == path || gid == id {
+				return &gitlab.Group{ID: id, FullPath: path}, &gitlab.Response{}, nil
+			}
+		}
+		return nil, notFound, errBoom
+	}}
+}
+
+// projectClient resolves project paths to the IDs of projects and back.
+func projectClient(ids map[string]int64) *projectsfake.MockClient {
+	return &projectsfake.MockClient{MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+		for path, id := range ids {
+			if pid == path || pid == id {
+				return &gitlab.Project{ID: id, PathWithNamespace: path}, &gitlab.Response{}, nil
+			}
+		}
+		return nil, notFound, errBoom
+	}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client instance.FeatureClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: want{cr: unexpectedItem, err: errors.New(errNotFeature)},
+		},
+		"NotAdmin": {
+			client: &MockClient{MockListFeatures: func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error) {
+				return nil, forbidden, errBoom
+			}},
+			cr:   featureFlag(),
+			want: want{cr: featureFlag(), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
+		"FailedList": {
+			client: &MockClient{MockListFeatures: func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Feature, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errBoom
+			}},
+			cr:   featureFlag(),
+			want: want{cr: featureFlag(), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"NotSet": {
+			client: &MockClient{MockListFeatures: listFeatures(&gitlab.Feature{Name: "other_feature", State: "on"})},
+			cr:     featureFlag(),
+			want:   want{cr: featureFlag()},
+		},
+		"UpToDate": {
+			client: &MockClient{MockListFeatures: listFeatures(&gitlab.Feature{
+				Name:  featureName,
+				State: "conditional",
+				Gates: []gitlab.Gate{
+					{Key: "boolean", Value: false},
+					{Key: "percentage_of_actors", Value: float64(25)},
+					{Key: "actors", Value: []any{"Project:2", "Group:1"}},
+				},
+			})},
+			cr: featureFlag(withSpec(v1alpha1.FeatureParameters{
+				Enabled:            ptr.To(false),
+				PercentageOfActors: ptr.To[int64](25),
+				Groups:             []string{"my-group"},
+			})),
+			want: want{
+				cr: featureFlag(withSpec(v1alpha1.FeatureParameters{
+					Enabled:            ptr.To(false),
+					PercentageOfActors: ptr.To[int64](25),
+					Groups:             []string{"my-group"},
+				}), withConditions(xpv1.Available()), withObservation(v1alpha1.FeatureObservation{
+					State:              "conditional",
+					PercentageOfActors: 25,
+					Actors:             []string{"Group:1", "Project:2"},
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PercentageDiffers": {
+			client: &MockClient{MockListFeatures: listFeatures(&gitlab.Feature{
+				Name:  featureName,
+				State: "conditional",
+				Gates: []gitlab.Gate{{Key: "boolean", Value: false}, {Key: "percentage_of_time", Value: float64(10)}},
+			})},
+			cr: featureFlag(withSpec(v1alpha1.FeatureParameters{PercentageOfTime: ptr.To[int64](50)})),
+			want: want{
+				cr: featureFlag(withSpec(v1alpha1.FeatureParameters{PercentageOfTime: ptr.To[int64](50)}), withConditions(xpv1.Available()), withObservation(v1alpha1.FeatureObservation{
+					State:            "conditional",
+					PercentageOfTime: 10,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ActorsDiffer": {
+			client: &MockClient{MockListFeatures: listFeatures(&gitlab.Feature{
+				Name:  featureName,
+				State: "conditional",
+				Gates: []gitlab.Gate{{Key: "boolean", Value: false}, {Key: "actors", Value: []any{"Project:2", "Project:3"}}},
+			})},
+			cr: featureFlag(withSpec(v1alpha1.FeatureParameters{Projects: []string{"my-group/my-project"}})),
+			want: want{
+				cr: featureFlag(withSpec(v1alpha1.FeatureParameters{Projects: []string{"my-group/my-project"}}), withConditions(xpv1.Available()), withObservation(v1alpha1.FeatureObservation{
+					State:  "conditional",
+					Actors: []string{"Project:2", "Project:3"},
+				})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"UnknownProject": {
+			client: &MockClient{MockListFeatures: listFeatures(&gitlab.Feature{Name: featureName, State: "off"})},
+			cr:     featureFlag(withSpec(v1alpha1.FeatureParameters{Projects: []string{"unknown"}})),
+			want: want{
+				cr:  featureFlag(withSpec(v1alpha1.FeatureParameters{Projects: []string{"unknown"}}), withConditions(xpv1.Available()), withObservation(v1alpha1.FeatureObservation{State: "off"})),
+				err: errors.Wrapf(errBoom, errGetProject, "unknown"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client:        tc.client,
+				groupClient:   groupClient(map[string]int64{"my-group": 1}),
+				projectClient: projectClient(map[string]int64{"my-group/my-project": 2, "my-group/other-project": 3}),
+			}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetGates(t *testing.T) {
+	cases := map[string]struct {
+		cr     *v1alpha1.Feature
+		create bool
+		err    error
+		want   []gitlab.SetFeatureFlagOptions
+	}{
+		"CreateDisabled": {
+			cr:     featureFlag(withSpec(v1alpha1.FeatureParameters{Enabled: ptr.To(false)})),
+			create: true,
+			want:   []gitlab.SetFeatureFlagOptions{{Value: false}},
+		},
+		"CreatePercentageOfActors": {
+			cr:     featureFlag(withSpec(v1alpha1.FeatureParameters{PercentageOfActors: ptr.To[int64](25)})),
+			create: true,
+			want:   []gitlab.SetFeatureFlagOptions{{Value: int64(25), Key: "percentage_of_actors"}},
+		},
+		"UpdateOnlyDifferentGates": {
+			cr: featureFlag(
+				withSpec(v1alpha1.FeatureParameters{Enabled: ptr.To(false), PercentageOfTime: ptr.To[int64](50)}),
+				withObservation(v1alpha1.FeatureObservation{State: "conditional", PercentageOfTime: 10}),
+			),
+			want: []gitlab.SetFeatureFlagOptions{{Value: int64(50), Key: "percentage_of_time"}},
+		},
+		"DisableClearsOtherGates": {
+			cr: featureFlag(
+				withSpec(v1alpha1.FeatureParameters{Enabled: ptr.To(false), PercentageOfTime: ptr.To[int64](50)}),
+				withObservation(v1alpha1.FeatureObservation{State: "on", Enabled: true, PercentageOfTime: 50}),
+			),
+			want: []gitlab.SetFeatureFlagOptions{{Value: false}, {Value: int64(50), Key: "percentage_of_time"}},
+		},
+		"Actors": {
+			cr: featureFlag(
+				withSpec(v1alpha1.FeatureParameters{Groups: []string{"my-group"}, Projects: []string{"my-group/my-project"}}),
+				withObservation(v1alpha1.FeatureObservation{State: "conditional", Actors: []string{"Project:2", "Project:3", "User:5"}}),
+			),
+			want: []gitlab.SetFeatureFlagOptions{
+				{Value: true, Group: "my-group"},
+				{Value: false, Project: "my-group/other-project"},
+			},
+		},
+		"NotAdmin": {
+			cr:     featureFlag(withSpec(v1alpha1.FeatureParameters{Enabled: ptr.To(true)})),
+			create: true,
+			err:    errors.Wrap(errBoom, errNotAdmin),
+			want:   []gitlab.SetFeatureFlagOptions{{Value: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []gitlab.SetFeatureFlagOptions
+			e := &external{
+				client: &MockClient{MockSetFeatureFlag: func(name string, opt *gitlab.SetFeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Feature, *gitlab.Response, error) {
+					got = append(got, *opt)
+					if tc.err != nil {
+						return nil, forbidden, errBoom
+					}
+					return &gitlab.Feature{Name: name}, &gitlab.Response{}, nil
+				}},
+				groupClient:   groupClient(map[string]int64{"my-group": 1}),
+				projectClient: projectClient(map[string]int64{"my-group/my-project": 2, "my-group/other-project": 3}),
+			}
+			var err error
+			if tc.create {
+				_, err = e.Create(context.Background(), tc.cr)
+			} else {
+				_, err = e.Update(context.Background(), tc.cr)
+			}
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SetFeatureFlag(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		res  *gitlab.Response
+		err  error
+		want error
+	}{
+		"Successful": {
+			res: &gitlab.Response{},
+		},
+		"AlreadyDeleted": {
+			res: notFound,
+			err: errBoom,
+		},
+		"NotAdmin": {
+			res:  forbidden,
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errNotAdmin),
+		},
+		"Failed": {
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &MockClient{MockDeleteFeatureFlag: func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				if name != featureName {
+					t.Errorf("DeleteFeatureFlag(...): want %s, got %s", featureName, name)
+				}
+				return tc.res, tc.err
+			}}}
+			_, err := e.Delete(context.Background(), featureFlag())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,10 +21,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/appearance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/features"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/license"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/runners"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/serviceaccounts"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/settings"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/topics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/userimpersonationtokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/instance/variables"
 )
 
@@ -36,6 +39,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		serviceaccounts.SetupServiceAccount,
 		license.SetupLicense,
 		variables.SetupVariable,
+		features.SetupFeature,
+		topics.SetupTopic,
+		userimpersonationtokens.SetupUserImpersonationToken,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -54,6 +60,9 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		serviceaccounts.SetupServiceAccountGated,
 		license.SetupLicenseGated,
 		variables.SetupVariableGated,
+		features.SetupFeatureGated,
+		topics.SetupTopicGated,
+		userimpersonationtokens.SetupUserImpersonationTokenGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
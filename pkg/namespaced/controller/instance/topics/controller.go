@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topics
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/instance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotTopic        = "managed resource is not a Gitlab topic custom resource"
+	errIDNotInt        = "external name is not a valid GitLab topic ID"
+	errGetFailed       = "cannot get Gitlab topic"
+	errCreateFailed    = "cannot create Gitlab topic"
+	errUpdateFailed    = "cannot update Gitlab topic"
+	errDeleteFailed    = "cannot delete Gitlab topic"
+	errNotAdmin        = "managing Gitlab topics requires a token of an administrator"
+	errGetAvatarFailed = "cannot read Gitlab topic avatar"
+	errInvalidAvatar   = "cannot use Gitlab topic avatar"
+)
+
+// SetupTopic adds a controller that reconciles Instance Topics.
+func SetupTopic(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TopicGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: instance.NewTopicClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.TopicList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Topic{}).
+		Complete(r)
+}
+
+// SetupTopicGated adds a controller with CRD gate support.
+func SetupTopicGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupTopic(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.TopicGroupVersionKind.String())
+		}
+	}, v1alpha1.TopicGroupVersionKind)
+	return nil
+}
+
+// connector is responsible for producing an ExternalClient for Topics
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) instance.TopicClient
+}
+
+// Connect establishes a connection to the external system.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return nil, errors.New(errNotTopic)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+// external is an external client for Instance Topics
+type external struct {
+	kube   client.Client
+	client instance.TopicClient
+}
+
+// Observe checks if the topic exists and if it is up to date.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTopic)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.ParseInt(externalName, 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+
+	topic, res, err := e.client.GetTopic(id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// Deleting: only need to determine external resource still exists.
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeTopic(&cr.Spec.ForProvider, topic)
+
+	avatarHash := cr.Status.AtProvider.AvatarHash
+	cr.Status.AtProvider = instance.GenerateTopicObservation(topic)
+	cr.Status.AtProvider.AvatarHash = avatarHash
+
+	avatarUpToDate, err := e.isAvatarUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsTopicUpToDate(&cr.Spec.ForProvider, topic) && avatarUpToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create creates the topic in Gitlab using the Gitlab API, together with its
+// avatar.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTopic)
+	}
+
+	opt := instance.GenerateCreateTopicOptions(&cr.Spec.ForProvider)
+	avatar, hash, err := e.avatar(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if hash != "" {
+		opt.Avatar = avatar
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	topic, res, err := e.client.CreateTopic(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, common.WrapForbidden(err, res, errCreateFailed, errNotAdmin)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(topic.ID, 10))
+	cr.Status.AtProvider.AvatarHash = hash
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the topic in Gitlab using the Gitlab API. The avatar is
+// only sent if it changed.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTopic)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	opt := instance.GenerateUpdateTopicOptions(&cr.Spec.ForProvider)
+	avatarUpToDate, err := e.isAvatarUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	hash := cr.Status.AtProvider.AvatarHash
+	if !avatarUpToDate {
+		if opt.Avatar, hash, err = e.avatar(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	_, res, err := e.client.UpdateTopic(id, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, common.WrapForbidden(err, res, errUpdateFailed, errNotAdmin)
+	}
+	cr.Status.AtProvider.AvatarHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the topic in Gitlab using the Gitlab API, which removes it
+// from all projects.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotTopic)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteTopic(id, gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, common.WrapForbidden(err, res, errDeleteFailed, errNotAdmin)
+}
+
+// Disconnect disconnects from the external system (not implemented).
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// avatar returns the avatar to send for the image referenced by the spec,
+// and the hash of the image. An avatar without an image removes the avatar
+// of the topic and has an empty hash.
+func (e *external) avatar(ctx context.Context, cr *v1alpha1.Topic) (*gitlab.TopicAvatar, string, error) {
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil || image == nil {
+		return &gitlab.TopicAvatar{}, "", err
+	}
+	filename, err := projects.ValidateAvatar(image)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errInvalidAvatar)
+	}
+	return &gitlab.TopicAvatar{Filename: filename, Image: bytes.NewReader(image)}, projects.HashAvatar(image), nil
+}
+
+// getAvatar returns the avatar image referenced by the spec, or nil if no
+// image is referenced.
+func (e *external) getAvatar(ctx context.Context, cr *v1alpha1.Topic) ([]byte, error) {
+	avatar := cr.Spec.ForProvider.Avatar
+	switch {
+	case avatar == nil:
+		return nil, nil
+	case avatar.SecretRef != nil:
+		image, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, avatar.SecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetAvatarFailed)
+		}
+		return []byte(*image), nil
+	case avatar.ConfigMapRef != nil:
+		image, err := common.GetValueFromLocalConfigMap(ctx, e.kube, cr, avatar.ConfigMapRef)
+		return image, errors.Wrap(err, errGetAvatarFailed)
+	}
+	return nil, nil
+}
+
+// isAvatarUpToDate compares the avatar image referenced by the spec with the
+// hash of the image last uploaded. An avatar without an image is up to date
+// if the topic has no avatar.
+func (e *external) isAvatarUpToDate(ctx context.Context, cr *v1alpha1.Topic) (bool, error) {
+	if cr.Spec.ForProvider.Avatar == nil {
+		return true, nil
+	}
+
+	image, err := e.getAvatar(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	if image == nil {
+		return cr.Status.AtProvider.AvatarURL == "", nil
+	}
+	return cr.Status.AtProvider.AvatarURL != "" && projects.HashAvatar(image) == cr.Status.AtProvider.AvatarHash, nil
+}
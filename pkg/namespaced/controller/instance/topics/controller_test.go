@@ -0,0 +1,422 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topics
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+
+	topicID     = int64(42)
+	avatarImage = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	avatarHash  = projects.HashAvatar(avatarImage)
+	forbidden   = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+	notFound    = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type MockClient struct {
+	MockGetTopic    func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	MockCreateTopic func(opt *gitlab.CreateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	MockUpdateTopic func(topic int64, opt *gitlab.UpdateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error)
+	MockDeleteTopic func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+func (m *MockClient) GetTopic(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+	return m.MockGetTopic(topic, options...)
+}
+
+func (m *MockClient) CreateTopic(opt *gitlab.CreateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+	return m.MockCreateTopic(opt, options...)
+}
+
+func (m *MockClient) UpdateTopic(topic int64, opt *gitlab.UpdateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+	return m.MockUpdateTopic(topic, opt, options...)
+}
+
+func (m *MockClient) DeleteTopic(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.MockDeleteTopic(topic, options...)
+}
+
+type modifier func(*v1alpha1.Topic)
+
+func withExternalName(n string) modifier {
+	return func(cr *v1alpha1.Topic) {
+		meta.SetExternalName(cr, n)
+	}
+}
+
+func withSpec(p v1alpha1.TopicParameters) modifier {
+	return func(cr *v1alpha1.Topic) {
+		cr.Spec.ForProvider = p
+	}
+}
+
+func withObservation(o v1alpha1.TopicObservation) modifier {
+	return func(cr *v1alpha1.Topic) {
+		cr.Status.AtProvider = o
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(cr *v1alpha1.Topic) {
+		cr.Status.SetConditions(c...)
+	}
+}
+
+func topic(m ...modifier) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func avatarSecretClient(image []byte) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{
+				Data: map[string][]byte{"avatar.png": image},
+			}
+			return nil
+		}),
+	}
+}
+
+var (
+	params = v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Description: ptr.To("Cloud native control planes")}
+
+	avatarParams = v1alpha1.TopicParameters{
+		Name:        "crossplane",
+		Title:       "Crossplane",
+		Description: ptr.To("Cloud native control planes"),
+		Avatar: &v1alpha1.TopicAvatar{SecretRef: &xpv1.LocalSecretKeySelector{
+			LocalSecretReference: xpv1.LocalSecretReference{Name: "avatar"},
+			Key:                  "avatar.png",
+		}},
+	}
+
+	gitlabTopic = &gitlab.Topic{ID: topicID, Name: "crossplane", Title: "Crossplane", Description: "Cloud native control planes", TotalProjectsCount: 3}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube   client.Client
+		client *MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: want{cr: unexpectedItem, err: errors.New(errNotTopic)},
+		},
+		"NoExternalName": {
+			cr:   topic(withSpec(params)),
+			want: want{cr: topic(withSpec(params))},
+		},
+		"ExternalNameNotInt": {
+			cr:   topic(withExternalName("crossplane")),
+			want: want{cr: topic(withExternalName("crossplane")), err: errors.New(errIDNotInt)},
+		},
+		"NotFound": {
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				return nil, notFound, errBoom
+			}},
+			cr:   topic(withExternalName("42")),
+			want: want{cr: topic(withExternalName("42"))},
+		},
+		"FailedGet": {
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errBoom
+			}},
+			cr:   topic(withExternalName("42")),
+			want: want{cr: topic(withExternalName("42")), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				return gitlabTopic, &gitlab.Response{}, nil
+			}},
+			cr: topic(withExternalName("42"), withSpec(v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane"})),
+			want: want{
+				cr: topic(withExternalName("42"), withSpec(params), withConditions(xpv1.Available()), withObservation(v1alpha1.TopicObservation{
+					ID: topicID, Name: "crossplane", Title: "Crossplane", Description: "Cloud native control planes", TotalProjectsCount: 3,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"TitleChanged": {
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				return gitlabTopic, &gitlab.Response{}, nil
+			}},
+			cr: topic(withExternalName("42"), withSpec(v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane topic", Description: params.Description})),
+			want: want{
+				cr: topic(withExternalName("42"), withSpec(v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane topic", Description: params.Description}), withConditions(xpv1.Available()), withObservation(v1alpha1.TopicObservation{
+					ID: topicID, Name: "crossplane", Title: "Crossplane", Description: "Cloud native control planes", TotalProjectsCount: 3,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"AvatarUpToDate": {
+			kube: avatarSecretClient(avatarImage),
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				t := *gitlabTopic
+				t.AvatarURL = "https://gitlab.example.com/avatar.png"
+				return &t, &gitlab.Response{}, nil
+			}},
+			cr: topic(withExternalName("42"), withSpec(avatarParams), withObservation(v1alpha1.TopicObservation{AvatarHash: avatarHash})),
+			want: want{
+				cr: topic(withExternalName("42"), withSpec(avatarParams), withConditions(xpv1.Available()), withObservation(v1alpha1.TopicObservation{
+					ID: topicID, Name: "crossplane", Title: "Crossplane", Description: "Cloud native control planes", TotalProjectsCount: 3,
+					AvatarURL: "https://gitlab.example.com/avatar.png", AvatarHash: avatarHash,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AvatarChanged": {
+			kube: avatarSecretClient(append(slices.Clone(avatarImage), 0)),
+			client: &MockClient{MockGetTopic: func(topic int64, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				t := *gitlabTopic
+				t.AvatarURL = "https://gitlab.example.com/avatar.png"
+				return &t, &gitlab.Response{}, nil
+			}},
+			cr: topic(withExternalName("42"), withSpec(avatarParams), withObservation(v1alpha1.TopicObservation{AvatarHash: avatarHash})),
+			want: want{
+				cr: topic(withExternalName("42"), withSpec(avatarParams), withConditions(xpv1.Available()), withObservation(v1alpha1.TopicObservation{
+					ID: topicID, Name: "crossplane", Title: "Crossplane", Description: "Cloud native control planes", TotalProjectsCount: 3,
+					AvatarURL: "https://gitlab.example.com/avatar.png", AvatarHash: avatarHash,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		kube       client.Client
+		cr         *v1alpha1.Topic
+		res        *gitlab.Response
+		err        error
+		wantErr    error
+		wantName   string
+		wantHash   string
+		wantAvatar bool
+	}{
+		"Successful": {
+			cr:       topic(withSpec(params)),
+			wantName: "42",
+		},
+		"WithAvatar": {
+			kube:       avatarSecretClient(avatarImage),
+			cr:         topic(withSpec(avatarParams)),
+			wantName:   "42",
+			wantHash:   avatarHash,
+			wantAvatar: true,
+		},
+		"InvalidAvatar": {
+			kube:    avatarSecretClient([]byte("not an image")),
+			cr:      topic(withSpec(avatarParams)),
+			wantErr: errors.Wrap(errors.New("avatar image type text/plain; charset=utf-8 is not supported"), errInvalidAvatar),
+		},
+		"NotAdmin": {
+			cr:      topic(withSpec(params)),
+			res:     forbidden,
+			err:     errBoom,
+			wantErr: errors.Wrap(errBoom, errNotAdmin),
+		},
+		"Failed": {
+			cr:      topic(withSpec(params)),
+			res:     &gitlab.Response{},
+			err:     errBoom,
+			wantErr: errors.Wrap(errBoom, errCreateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: &MockClient{MockCreateTopic: func(opt *gitlab.CreateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				if ptr.Deref(opt.Name, "") != "crossplane" || ptr.Deref(opt.Title, "") != "Crossplane" {
+					t.Errorf("CreateTopic(...): unexpected options %+v", opt)
+				}
+				if (opt.Avatar != nil) != tc.wantAvatar {
+					t.Errorf("CreateTopic(...): want avatar %t, got %+v", tc.wantAvatar, opt.Avatar)
+				}
+				if tc.err != nil {
+					return nil, tc.res, tc.err
+				}
+				return &gitlab.Topic{ID: topicID}, &gitlab.Response{}, nil
+			}}}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if got := meta.GetExternalName(tc.cr); got != tc.wantName {
+				t.Errorf("external name: want %q, got %q", tc.wantName, got)
+			}
+			if got := tc.cr.Status.AtProvider.AvatarHash; got != tc.wantHash {
+				t.Errorf("avatar hash: want %q, got %q", tc.wantHash, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		kube       client.Client
+		cr         *v1alpha1.Topic
+		err        error
+		wantErr    error
+		wantAvatar *gitlab.TopicAvatar
+		wantHash   string
+	}{
+		"TextOnly": {
+			cr: topic(withExternalName("42"), withSpec(params)),
+		},
+		"UploadAvatar": {
+			kube:       avatarSecretClient(avatarImage),
+			cr:         topic(withExternalName("42"), withSpec(avatarParams)),
+			wantAvatar: &gitlab.TopicAvatar{Filename: "avatar.png"},
+			wantHash:   avatarHash,
+		},
+		"KeepAvatar": {
+			kube:     avatarSecretClient(avatarImage),
+			cr:       topic(withExternalName("42"), withSpec(avatarParams), withObservation(v1alpha1.TopicObservation{AvatarURL: "https://gitlab.example.com/avatar.png", AvatarHash: avatarHash})),
+			wantHash: avatarHash,
+		},
+		"RemoveAvatar": {
+			cr:         topic(withExternalName("42"), withSpec(v1alpha1.TopicParameters{Name: "crossplane", Title: "Crossplane", Avatar: &v1alpha1.TopicAvatar{}}), withObservation(v1alpha1.TopicObservation{AvatarURL: "https://gitlab.example.com/avatar.png", AvatarHash: avatarHash})),
+			wantAvatar: &gitlab.TopicAvatar{},
+		},
+		"NotAdmin": {
+			cr:       topic(withExternalName("42"), withSpec(params)),
+			err:      errBoom,
+			wantErr:  errors.Wrap(errBoom, errNotAdmin),
+			wantHash: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: &MockClient{MockUpdateTopic: func(id int64, opt *gitlab.UpdateTopicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Topic, *gitlab.Response, error) {
+				if id != topicID {
+					t.Errorf("UpdateTopic(...): want ID %d, got %d", topicID, id)
+				}
+				var avatar *gitlab.TopicAvatar
+				if opt.Avatar != nil {
+					avatar = &gitlab.TopicAvatar{Filename: opt.Avatar.Filename}
+				}
+				if diff := cmp.Diff(tc.wantAvatar, avatar); diff != "" {
+					t.Errorf("UpdateTopic(...): -want avatar, +got avatar:\n%s", diff)
+				}
+				if tc.err != nil {
+					return nil, forbidden, tc.err
+				}
+				return &gitlab.Topic{ID: id}, &gitlab.Response{}, nil
+			}}}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if got := tc.cr.Status.AtProvider.AvatarHash; got != tc.wantHash {
+				t.Errorf("avatar hash: want %q, got %q", tc.wantHash, got)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		res  *gitlab.Response
+		err  error
+		want error
+	}{
+		"Successful": {
+			res: &gitlab.Response{},
+		},
+		"AlreadyDeleted": {
+			res: notFound,
+			err: errBoom,
+		},
+		"NotAdmin": {
+			res:  forbidden,
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errNotAdmin),
+		},
+		"Failed": {
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &MockClient{MockDeleteTopic: func(id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				if id != topicID {
+					t.Errorf("DeleteTopic(...): want ID %d, got %d", topicID, id)
+				}
+				return tc.res, tc.err
+			}}}
+			_, err := e.Delete(context.Background(), topic(withExternalName("42")))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userimpersonationtokens
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/instance"
+)
+
+const (
+	errNotUserImpersonationToken = "managed resource is not a Gitlab user impersonation token custom resource"
+	errFailedParseID             = "cannot parse impersonation token ID to int"
+	errGetFailed                 = "cannot get Gitlab impersonation token"
+	errCreateFailed              = "cannot create Gitlab impersonation token"
+	errRevokeFailed              = "cannot revoke Gitlab impersonation token"
+	errNotAdmin                  = "managing Gitlab impersonation tokens requires a token of an administrator"
+)
+
+// SetupUserImpersonationToken adds a controller that reconciles
+// UserImpersonationTokens.
+func SetupUserImpersonationToken(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserImpersonationTokenGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: instance.NewUserImpersonationTokenClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UserImpersonationTokenGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.UserImpersonationTokenList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.UserImpersonationToken{}).
+		Complete(r)
+}
+
+// SetupUserImpersonationTokenGated adds a controller with CRD gate support.
+func SetupUserImpersonationTokenGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupUserImpersonationToken(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.UserImpersonationTokenGroupVersionKind.String())
+		}
+	}, v1alpha1.UserImpersonationTokenGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) instance.UserImpersonationTokenClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UserImpersonationToken)
+	if !ok {
+		return nil, errors.New(errNotUserImpersonationToken)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client instance.UserImpersonationTokenClient
+}
+
+// Observe gets the token by its ID. A token that is inactive, i.e. revoked
+// or expired, or whose expiry differs from the desired one is reported as
+// missing so that Create replaces it.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UserImpersonationToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserImpersonationToken)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	tokenID, err := strconv.ParseInt(externalName, 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFailedParseID)
+	}
+
+	t, res, err := e.client.GetImpersonationToken(cr.Spec.ForProvider.UserID, tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, common.WrapForbidden(err, res, errGetFailed, errNotAdmin)
+	}
+
+	cr.Status.AtProvider = instance.GenerateUserImpersonationTokenObservation(t)
+	common.SetTokenExpiry(cr, (*time.Time)(t.ExpiresAt))
+
+	if instance.ShouldRotateImpersonationToken(&cr.Spec.ForProvider, t) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: false,
+	}, nil
+}
+
+// Create creates a new token and publishes it. GitLab cannot rotate
+// impersonation tokens, so an active token being replaced is revoked first,
+// which keeps at most one token of the resource active.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UserImpersonationToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserImpersonationToken)
+	}
+
+	if o := cr.Status.AtProvider; o.Active && meta.GetExternalName(cr) == strconv.FormatInt(o.ID, 10) {
+		if err := e.revoke(ctx, cr.Spec.ForProvider.UserID, o.ID); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	t, res, err := e.client.CreateImpersonationToken(
+		cr.Spec.ForProvider.UserID,
+		instance.GenerateCreateImpersonationTokenOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, common.WrapForbidden(err, res, errCreateFailed, errNotAdmin)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(t.ID, 10))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{"token": []byte(t.Token)},
+	}, nil
+}
+
+// Update is a no-op as all parameters are immutable, a token whose expiry
+// changed is replaced by Create.
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.UserImpersonationToken)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotUserImpersonationToken)
+	}
+
+	tokenID, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errFailedParseID)
+	}
+
+	return managed.ExternalDelete{}, e.revoke(ctx, cr.Spec.ForProvider.UserID, tokenID)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// revoke revokes the token of the user. Tokens that no longer exist are
+// considered revoked.
+func (e *external) revoke(ctx context.Context, user, token int64) error {
+	res, err := e.client.RevokeImpersonationToken(user, token, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return common.WrapForbidden(err, res, errRevokeFailed, errNotAdmin)
+	}
+	return nil
+}
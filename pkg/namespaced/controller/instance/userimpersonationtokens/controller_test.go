@@ -0,0 +1,363 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userimpersonationtokens
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/instance/v1alpha1"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+
+	userID    = int64(7)
+	tokenID   = int64(42)
+	forbidden = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	expiresAt = time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	params    = v1alpha1.UserImpersonationTokenParameters{
+		UserID:    userID,
+		Name:      "ci-bot",
+		Scopes:    []string{"api"},
+		ExpiresAt: &metav1.Time{Time: expiresAt},
+	}
+)
+
+type MockClient struct {
+	MockGetImpersonationToken    func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error)
+	MockCreateImpersonationToken func(user int64, opt *gitlab.CreateImpersonationTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error)
+	MockRevokeImpersonationToken func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+func (m *MockClient) GetImpersonationToken(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+	return m.MockGetImpersonationToken(user, token, options...)
+}
+
+func (m *MockClient) CreateImpersonationToken(user int64, opt *gitlab.CreateImpersonationTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+	return m.MockCreateImpersonationToken(user, opt, options...)
+}
+
+func (m *MockClient) RevokeImpersonationToken(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.MockRevokeImpersonationToken(user, token, options...)
+}
+
+type modifier func(*v1alpha1.UserImpersonationToken)
+
+func withExternalName(n string) modifier {
+	return func(cr *v1alpha1.UserImpersonationToken) {
+		meta.SetExternalName(cr, n)
+	}
+}
+
+func withSpec(p v1alpha1.UserImpersonationTokenParameters) modifier {
+	return func(cr *v1alpha1.UserImpersonationToken) {
+		cr.Spec.ForProvider = p
+	}
+}
+
+func withObservation(o v1alpha1.UserImpersonationTokenObservation) modifier {
+	return func(cr *v1alpha1.UserImpersonationToken) {
+		cr.Status.AtProvider = o
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(cr *v1alpha1.UserImpersonationToken) {
+		cr.Status.SetConditions(c...)
+	}
+}
+
+func token(m ...modifier) *v1alpha1.UserImpersonationToken {
+	cr := &v1alpha1.UserImpersonationToken{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabToken(active bool, expires time.Time) *gitlab.ImpersonationToken {
+	return &gitlab.ImpersonationToken{
+		ID:        tokenID,
+		Name:      "ci-bot",
+		Active:    active,
+		Scopes:    []string{"api"},
+		ExpiresAt: (*gitlab.ISOTime)(&expires),
+	}
+}
+
+func observation(active bool, expires time.Time) v1alpha1.UserImpersonationTokenObservation {
+	return v1alpha1.UserImpersonationTokenObservation{
+		ID:        tokenID,
+		Name:      "ci-bot",
+		Active:    active,
+		Scopes:    []string{"api"},
+		ExpiresAt: &metav1.Time{Time: expires},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: want{cr: unexpectedItem, err: errors.New(errNotUserImpersonationToken)},
+		},
+		"NoExternalName": {
+			cr:   token(withSpec(params)),
+			want: want{cr: token(withSpec(params))},
+		},
+		"NotFound": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return nil, notFound, errBoom
+			}},
+			cr:   token(withExternalName("42"), withSpec(params)),
+			want: want{cr: token(withExternalName("42"), withSpec(params))},
+		},
+		"NotAdmin": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return nil, forbidden, errBoom
+			}},
+			cr:   token(withExternalName("42"), withSpec(params)),
+			want: want{cr: token(withExternalName("42"), withSpec(params)), err: errors.Wrap(errBoom, errNotAdmin)},
+		},
+		"FailedGet": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errBoom
+			}},
+			cr:   token(withExternalName("42"), withSpec(params)),
+			want: want{cr: token(withExternalName("42"), withSpec(params)), err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return gitlabToken(true, expiresAt), &gitlab.Response{}, nil
+			}},
+			cr: token(withExternalName("42"), withSpec(params)),
+			want: want{
+				cr:     token(withExternalName("42"), withSpec(params), withObservation(observation(true, expiresAt)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExpiryChanged": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return gitlabToken(true, expiresAt.AddDate(0, 1, 0)), &gitlab.Response{}, nil
+			}},
+			cr: token(withExternalName("42"), withSpec(params)),
+			want: want{
+				cr: token(withExternalName("42"), withSpec(params), withObservation(observation(true, expiresAt.AddDate(0, 1, 0)))),
+			},
+		},
+		"Expired": {
+			client: &MockClient{MockGetImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+				return gitlabToken(false, expiresAt), &gitlab.Response{}, nil
+			}},
+			cr: token(withExternalName("42"), withSpec(params)),
+			want: want{
+				cr: token(withExternalName("42"), withSpec(params), withObservation(observation(false, expiresAt))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		result       managed.ExternalCreation
+		revoked      []int64
+		err          error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.UserImpersonationToken
+		res       *gitlab.Response
+		err       error
+		revokeRes *gitlab.Response
+		revokeErr error
+		want      want
+	}{
+		"Successful": {
+			cr: token(withSpec(params)),
+			want: want{
+				externalName: "43",
+				result:       managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{"token": []byte("glpat-new")}},
+			},
+		},
+		"ReplacesActiveToken": {
+			cr: token(withExternalName("42"), withSpec(params), withObservation(observation(true, expiresAt.AddDate(0, 1, 0)))),
+			want: want{
+				externalName: "43",
+				result:       managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{"token": []byte("glpat-new")}},
+				revoked:      []int64{tokenID},
+			},
+		},
+		"ReplacesExpiredToken": {
+			cr: token(withExternalName("42"), withSpec(params), withObservation(observation(false, expiresAt))),
+			want: want{
+				externalName: "43",
+				result:       managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{"token": []byte("glpat-new")}},
+			},
+		},
+		"FailedRevoke": {
+			cr:        token(withExternalName("42"), withSpec(params), withObservation(observation(true, expiresAt.AddDate(0, 1, 0)))),
+			revokeRes: &gitlab.Response{},
+			revokeErr: errBoom,
+			want: want{
+				externalName: "42",
+				revoked:      []int64{tokenID},
+				err:          errors.Wrap(errBoom, errRevokeFailed),
+			},
+		},
+		"NotAdmin": {
+			cr:   token(withSpec(params)),
+			res:  forbidden,
+			err:  errBoom,
+			want: want{err: errors.Wrap(errBoom, errNotAdmin)},
+		},
+		"Failed": {
+			cr:   token(withSpec(params)),
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: want{err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var revoked []int64
+			e := &external{client: &MockClient{
+				MockCreateImpersonationToken: func(user int64, opt *gitlab.CreateImpersonationTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImpersonationToken, *gitlab.Response, error) {
+					if user != userID {
+						t.Errorf("CreateImpersonationToken(...): want user %d, got %d", userID, user)
+					}
+					if tc.err != nil {
+						return nil, tc.res, tc.err
+					}
+					return &gitlab.ImpersonationToken{ID: 43, Token: "glpat-new"}, &gitlab.Response{}, nil
+				},
+				MockRevokeImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					revoked = append(revoked, token)
+					return tc.revokeRes, tc.revokeErr
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("external name: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.revoked, revoked); diff != "" {
+				t.Errorf("revoked: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		cr   resource.Managed
+		res  *gitlab.Response
+		err  error
+		want error
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: errors.New(errNotUserImpersonationToken),
+		},
+		"Successful": {
+			cr:  token(withExternalName("42"), withSpec(params)),
+			res: &gitlab.Response{},
+		},
+		"AlreadyRevoked": {
+			cr:  token(withExternalName("42"), withSpec(params)),
+			res: notFound,
+			err: errBoom,
+		},
+		"NotAdmin": {
+			cr:   token(withExternalName("42"), withSpec(params)),
+			res:  forbidden,
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errNotAdmin),
+		},
+		"Failed": {
+			cr:   token(withExternalName("42"), withSpec(params)),
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errRevokeFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &MockClient{
+				MockRevokeImpersonationToken: func(user, token int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					if user != userID || token != tokenID {
+						t.Errorf("RevokeImpersonationToken(...): want %d/%d, got %d/%d", userID, tokenID, user, token)
+					}
+					return tc.res, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -33,10 +33,12 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
 )
@@ -49,6 +51,7 @@ var (
 	sAccessTokenID = strconv.FormatInt(accessTokenID, 10)
 	invalidInput   resource.Managed
 	expiresAt      = time.Now().AddDate(0, 6, 0)
+	expiredAt      = time.Now().AddDate(0, 0, -1)
 	accessLevel    = 40
 	name           = "Access Token Name"
 	token          = "Token"
@@ -79,6 +82,15 @@ func withConditions(c ...xpv1.Condition) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func tokenExpiring(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:    common.TypeTokenExpiring,
+		Status:  corev1.ConditionTrue,
+		Reason:  common.ReasonExpiresSoon,
+		Message: message,
+	}
+}
+
 func withSpec(fp v1alpha1.AccessTokenParameters) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { r.Spec.ForProvider = fp }
 }
@@ -354,7 +366,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), tokenExpiring("token expired on 2026-06-15")),
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID:   &projectID,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
@@ -472,7 +484,6 @@ func TestObserve(t *testing.T) {
 			args: args{
 				accessTokenClient: &fake.MockClient{
 					MockGetProjectAccessToken: func(pid interface{}, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
-						expiredAt := time.Now().AddDate(0, 0, -1)
 						return &gitlab.ProjectAccessToken{
 							PersonalAccessToken: gitlab.PersonalAccessToken{Active: false, Revoked: false, ExpiresAt: (*gitlab.ISOTime)(&expiredAt)},
 						}, &gitlab.Response{}, nil
@@ -490,7 +501,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(),
+					withConditions(tokenExpiring("token expired on "+expiredAt.UTC().Format(time.DateOnly))),
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID:   &projectID,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
@@ -0,0 +1,399 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalconfigurations
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+
+	deletionTimestamp = metav1.Now()
+
+	gitlabApprovals = &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:        true,
+		MergeRequestsAuthorApproval: true,
+	}
+)
+
+type args struct {
+	client projects.ApprovalConfigurationClient
+	cr     resource.Managed
+}
+
+type approvalConfigurationModifier func(*v1alpha1.ApprovalConfiguration)
+
+func withConditions(c ...xpv1.Condition) approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.ApprovalConfigurationObservation) approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Status.AtProvider = s }
+}
+
+func withResetApprovalsOnPush(b bool) approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Spec.ForProvider.ResetApprovalsOnPush = &b }
+}
+
+func withSelectiveCodeOwnerRemovals(b bool) approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Spec.ForProvider.SelectiveCodeOwnerRemovals = &b }
+}
+
+// withoutSelectiveCodeOwnerRemovals clears the setting, which is not late
+// initialized while approvals are reset on push.
+func withoutSelectiveCodeOwnerRemovals() approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Spec.ForProvider.SelectiveCodeOwnerRemovals = nil }
+}
+
+func withDeletionTimestamp() approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) {
+		r.DeletionTimestamp = &deletionTimestamp
+	}
+}
+
+func withProjectID() approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+// withDefaultSpec sets every setting to the values of gitlabApprovals.
+func withDefaultSpec() approvalConfigurationModifier {
+	return func(r *v1alpha1.ApprovalConfiguration) {
+		r.Spec.ForProvider = v1alpha1.ApprovalConfigurationParameters{
+			ProjectID:                  &projectID,
+			ResetApprovalsOnPush:       ptr.To(true),
+			SelectiveCodeOwnerRemovals: ptr.To(false),
+			DisableOverridingApproversPerMergeRequest: ptr.To(false),
+			MergeRequestsAuthorApproval:               ptr.To(true),
+			MergeRequestsDisableCommittersApproval:    ptr.To(false),
+			RequirePasswordToApprove:                  ptr.To(false),
+		}
+	}
+}
+
+func approvalConfiguration(m ...approvalConfigurationModifier) *v1alpha1.ApprovalConfiguration {
+	cr := &v1alpha1.ApprovalConfiguration{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	getApprovals := func(a *gitlab.ProjectApprovals, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetApprovalConfiguration: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+				return a, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotApprovalConfiguration),
+			},
+		},
+		"NoProjectID": {
+			args: args{
+				cr: approvalConfiguration(),
+			},
+			want: want{
+				cr:  approvalConfiguration(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: getApprovals(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:     approvalConfiguration(withDefaultSpec()),
+			},
+			want: want{
+				cr:  approvalConfiguration(withDefaultSpec()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: getApprovals(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:     approvalConfiguration(withDefaultSpec()),
+			},
+			want: want{
+				cr: approvalConfiguration(withDefaultSpec()),
+			},
+		},
+		"Deleting": {
+			args: args{
+				client: getApprovals(gitlabApprovals, &gitlab.Response{}, nil),
+				cr:     approvalConfiguration(withDefaultSpec(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: approvalConfiguration(withDefaultSpec(), withDeletionTimestamp()),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: getApprovals(gitlabApprovals, &gitlab.Response{}, nil),
+				cr:     approvalConfiguration(withDefaultSpec()),
+			},
+			want: want{
+				cr: approvalConfiguration(
+					withDefaultSpec(),
+					withStatus(projects.GenerateApprovalConfigurationObservation(gitlabApprovals)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: getApprovals(gitlabApprovals, &gitlab.Response{}, nil),
+				cr:     approvalConfiguration(withProjectID()),
+			},
+			want: want{
+				cr: approvalConfiguration(
+					withDefaultSpec(),
+					withoutSelectiveCodeOwnerRemovals(),
+					withStatus(projects.GenerateApprovalConfigurationObservation(gitlabApprovals)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ResetOnPushDisabled": {
+			args: args{
+				client: getApprovals(gitlabApprovals, &gitlab.Response{}, nil),
+				cr:     approvalConfiguration(withDefaultSpec(), withResetApprovalsOnPush(false)),
+			},
+			want: want{
+				cr: approvalConfiguration(
+					withDefaultSpec(),
+					withResetApprovalsOnPush(false),
+					withStatus(projects.GenerateApprovalConfigurationObservation(gitlabApprovals)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SelectiveCodeOwnerRemovalsEnabled": {
+			args: args{
+				client: getApprovals(&gitlab.ProjectApprovals{MergeRequestsAuthorApproval: true}, &gitlab.Response{}, nil),
+				cr:     approvalConfiguration(withDefaultSpec(), withResetApprovalsOnPush(false), withSelectiveCodeOwnerRemovals(true)),
+			},
+			want: want{
+				cr: approvalConfiguration(
+					withDefaultSpec(),
+					withResetApprovalsOnPush(false),
+					withSelectiveCodeOwnerRemovals(true),
+					withStatus(v1alpha1.ApprovalConfigurationObservation{MergeRequestsAuthorApproval: true}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotApprovalConfiguration),
+			},
+		},
+		"SuccessfulAdoption": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return gitlabApprovals, &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalConfiguration(withDefaultSpec()),
+			},
+			want: want{
+				cr: approvalConfiguration(withDefaultSpec(), withConditions(xpv1.Creating())),
+			},
+		},
+		"UnsupportedByLicense": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+					},
+				},
+				cr: approvalConfiguration(withDefaultSpec()),
+			},
+			want: want{
+				cr: func() *v1alpha1.ApprovalConfiguration {
+					cr := approvalConfiguration(withDefaultSpec(), withConditions(xpv1.Creating()))
+					common.SetUnsupportedByLicense(cr, featureApprovalConfiguration)
+					return cr
+				}(),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.ApprovalConfiguration
+		want *gitlab.ChangeApprovalConfigurationOptions
+		err  error
+	}{
+		"ResetOnPushEnabled": {
+			cr: approvalConfiguration(withProjectID(), withResetApprovalsOnPush(true)),
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush: ptr.To(true),
+			},
+		},
+		"ResetOnPushDisabled": {
+			cr: approvalConfiguration(withProjectID(), withResetApprovalsOnPush(false)),
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush: ptr.To(false),
+			},
+		},
+		"SelectiveCodeOwnerRemovalsEnabled": {
+			cr: approvalConfiguration(withProjectID(), withResetApprovalsOnPush(false), withSelectiveCodeOwnerRemovals(true)),
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				ResetApprovalsOnPush:       ptr.To(false),
+				SelectiveCodeOwnerRemovals: ptr.To(true),
+			},
+		},
+		"SelectiveCodeOwnerRemovalsDisabled": {
+			cr: approvalConfiguration(withProjectID(), withSelectiveCodeOwnerRemovals(false)),
+			want: &gitlab.ChangeApprovalConfigurationOptions{
+				SelectiveCodeOwnerRemovals: ptr.To(false),
+			},
+		},
+		"FailedUpdate": {
+			cr:   approvalConfiguration(withProjectID(), withResetApprovalsOnPush(true)),
+			want: &gitlab.ChangeApprovalConfigurationOptions{ResetApprovalsOnPush: ptr.To(true)},
+			err:  errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *gitlab.ChangeApprovalConfigurationOptions
+			e := &external{client: &fake.MockClient{
+				MockChangeApprovalConfiguration: func(pid any, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+					got = opt
+					if tc.err != nil {
+						return nil, &gitlab.Response{}, errBoom
+					}
+					return &gitlab.ProjectApprovals{}, &gitlab.Response{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ChangeApprovalConfiguration(...): -want options, +got options:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := approvalConfiguration(withDefaultSpec())
+	e := &external{client: &fake.MockClient{}}
+
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(approvalConfiguration(withDefaultSpec(), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	branchName     = "release/1.0"
+	ref            = "main"
+	commitID       = "0123456789abcdef"
+
+	gitlabBranch = &gitlab.Branch{
+		Name:   branchName,
+		Commit: &gitlab.Commit{ID: commitID},
+		WebURL: "https://gitlab.example.com/group/project/-/tree/release/1.0",
+	}
+)
+
+type args struct {
+	branch projects.BranchClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withConditions(c ...xpv1.Condition) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.BranchObservation) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.AtProvider = s }
+}
+
+func withProjectID(id string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withBranchName(name string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.Branch = name }
+}
+
+func withRef(ref string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.Ref = ref }
+}
+
+func withExternalName(n string) branchModifier {
+	return func(r *v1alpha1.Branch) { meta.SetExternalName(r, n) }
+}
+
+func branch(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedBranch() v1alpha1.BranchObservation {
+	return v1alpha1.BranchObservation{
+		Name:     branchName,
+		Ref:      ref,
+		CommitID: commitID,
+		WebURL:   gitlabBranch.WebURL,
+	}
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		result managed.ExternalClient
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errNotBranch),
+			},
+		},
+		"ProviderConfigRefNotGivenError": {
+			args: args{
+				cr:   branch(),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+			want: want{
+				err: errors.New("providerConfigRef is not given"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newGitlabClientFn: func(cfg common.Config) projects.BranchClient {
+				return tc.branch
+			}}
+			o, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"NoBranchName": {
+			args: args{
+				cr: branch(),
+			},
+			want: want{
+				cr:     branch(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: branch(withBranchName(branchName)),
+			},
+			want: want{
+				cr:  branch(withBranchName(branchName)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				branch: &fake.MockClient{
+					MockGetBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"BranchNotFound": {
+			args: args{
+				branch: &fake.MockClient{
+					MockGetBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+			},
+			want: want{
+				cr:     branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptExistingBranch": {
+			args: args{
+				branch: &fake.MockClient{
+					MockGetBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return gitlabBranch, &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+			},
+			want: want{
+				cr: branch(
+					withProjectID(projectID),
+					withBranchName(branchName),
+					withRef(ref),
+					withStatus(observedBranch()),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.branch}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"BranchNameMissing": {
+			args: args{
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID)),
+				err: errors.New(errBranchNameMissing),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: branch(withBranchName(branchName)),
+			},
+			want: want{
+				cr:  branch(withBranchName(branchName)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				branch: &fake.MockClient{
+					MockCreateBranch: func(pid any, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						if *opt.Branch != branchName || *opt.Ref != ref {
+							return nil, nil, errBoom
+						}
+						return gitlabBranch, &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+			},
+			want: want{
+				cr: branch(
+					withProjectID(projectID),
+					withBranchName(branchName),
+					withRef(ref),
+					withExternalName(branchName),
+					withStatus(observedBranch()),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				branch: &fake.MockClient{
+					MockCreateBranch: func(pid any, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withRef(ref)),
+			},
+			want: want{
+				cr: branch(
+					withProjectID(projectID),
+					withBranchName(branchName),
+					withRef(ref),
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.branch}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				branch: &fake.MockClient{
+					MockDeleteBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName)),
+			},
+			want: want{
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				branch: &fake.MockClient{
+					MockDeleteBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName)),
+			},
+			want: want{
+				cr: branch(withProjectID(projectID), withBranchName(branchName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				branch: &fake.MockClient{
+					MockDeleteBranch: func(pid any, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 403}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID), withBranchName(branchName)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID), withBranchName(branchName), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.branch}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
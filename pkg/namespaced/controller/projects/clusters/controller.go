@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotCluster         = "managed resource is not a GitLab project cluster custom resource"
+	errProjectIDMissing   = "ProjectID is missing"
+	errIDNotInt           = "external name is not a valid GitLab cluster ID"
+	errGetFailed          = "cannot get GitLab project cluster"
+	errCreateFailed       = "cannot add GitLab project cluster"
+	errUpdateFailed       = "cannot update GitLab project cluster"
+	errDeleteFailed       = "cannot delete GitLab project cluster"
+	errTokenSecretFailed  = "cannot get Kubernetes token from secret"
+	errCaCertSecretFailed = "cannot get Kubernetes CA certificate from secret"
+)
+
+// SetupCluster adds a controller that reconciles ProjectClusters.
+func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectClusterGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewClusterClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectClusterGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectClusterList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectCluster{}).
+		Complete(r)
+}
+
+// SetupClusterGated adds a controller with CRD gate support.
+func SetupClusterGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupCluster(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ProjectClusterGroupVersionKind.String())
+		}
+	}, v1alpha1.ProjectClusterGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.ClusterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectCluster)
+	if !ok {
+		return nil, errors.New(errNotCluster)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ClusterClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCluster)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	cluster, res, err := e.client.GetCluster(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeCluster(&cr.Spec.ForProvider, cluster)
+
+	credentialsHash := cr.Status.AtProvider.CredentialsHash
+	cr.Status.AtProvider = projects.GenerateClusterObservation(cluster)
+	cr.Status.AtProvider.CredentialsHash = credentialsHash
+	cr.Status.SetConditions(xpv1.Available())
+
+	// GitLab does not reliably return the token and CA certificate, so they
+	// are compared against the hash of the values last pushed instead.
+	upToDate := projects.IsClusterUpToDate(&cr.Spec.ForProvider, cluster)
+	if upToDate {
+		token, caCert, err := e.getCredentials(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = projects.HashClusterCredentials(token, caCert) == credentialsHash
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCluster)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	token, caCert, err := e.getCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	cluster, _, err := e.client.AddCluster(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateAddClusterOptions(&cr.Spec.ForProvider, token, caCert),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateClusterObservation(cluster)
+	cr.Status.AtProvider.CredentialsHash = projects.HashClusterCredentials(token, caCert)
+	meta.SetExternalName(cr, strconv.FormatInt(cluster.ID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCluster)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	token, caCert, err := e.getCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	cluster, _, err := e.client.EditCluster(
+		*cr.Spec.ForProvider.ProjectID,
+		id,
+		projects.GenerateEditClusterOptions(&cr.Spec.ForProvider, token, caCert),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateClusterObservation(cluster)
+	cr.Status.AtProvider.CredentialsHash = projects.HashClusterCredentials(token, caCert)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectCluster)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotCluster)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteCluster(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getCredentials returns the Kubernetes token and the optional CA
+// certificate referenced by the spec.
+func (e *external) getCredentials(ctx context.Context, cr *v1alpha1.ProjectCluster) (string, *string, error) {
+	pk := cr.Spec.ForProvider.PlatformKubernetes
+	token, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, &pk.TokenSecretRef)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errTokenSecretFailed)
+	}
+	if pk.CaCertSecretRef == nil {
+		return *token, nil, nil
+	}
+	caCert, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, pk.CaCertSecretRef)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errCaCertSecretFailed)
+	}
+	return *token, caCert, nil
+}
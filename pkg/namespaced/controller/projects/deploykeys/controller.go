@@ -3,6 +3,7 @@ package deploykeys
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
@@ -32,9 +33,13 @@ const (
 	errCreateFail       = "cannot create Gitlab deploy key"
 	errUpdateFail       = "cannot update Gitlab deploy key"
 	errDeleteFail       = "cannot delete Gitlab deploy key"
+	errEnableFail       = "cannot enable Gitlab deploy key"
+	errListFail         = "cannot list Gitlab deploy keys"
 	errKeyMissing       = "missing key ref value"
 	errIDNotAnInt       = "external-name is not an int"
 	errProjectIDMissing = "missing project ID"
+
+	listPageSize = 100
 )
 
 type external struct {
@@ -52,7 +57,7 @@ func SetupDeployKey(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.DeployKeyGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -117,8 +122,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	externalName := meta.GetExternalName(cr)
 	if externalName == "" {
-		// Resource doesn't exist yet
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		dk, err := e.findDeployKey(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if dk == nil {
+			// Resource doesn't exist yet
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		externalName = strconv.FormatInt(dk.ID, 10)
+		meta.SetExternalName(cr, externalName)
 	}
 
 	id, err := strconv.Atoi(externalName)
@@ -137,10 +150,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFail)
 	}
 
+	var key *string
+	if cr.Spec.ForProvider.KeySecretRef != nil {
+		key, err = common.GetTokenValueFromLocalSecret(ctx, e.kube, mg, cr.Spec.ForProvider.KeySecretRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	currentState := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeProjectDeployKey(&cr.Spec.ForProvider, dk)
 	isLateInitialized := !cmp.Equal(currentState, &cr.Spec.ForProvider)
 
+	changed := common.ChangedImmutableFields(immutableFields(&cr.Spec.ForProvider, key, dk)...)
+	common.SetImmutableFieldsChanged(cr, changed)
+
 	cr.Status.AtProvider = v1alpha1.DeployKeyObservation{
 		ID: func() *int64 {
 			if dk.ID != 0 {
@@ -149,11 +173,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			}
 			return nil
 		}(),
-		CreatedAt: common.TimeToMetaTime(dk.CreatedAt),
+		CreatedAt:         common.TimeToMetaTime(dk.CreatedAt),
+		FingerprintSHA256: dk.FingerprintSHA256,
 	}
 
 	cr.Status.SetConditions(xpv1.Available())
-	isUpToDate := isUpToDate(cr, dk)
+	isUpToDate := isUpToDate(cr, dk) && len(changed) == 0
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -173,7 +198,17 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
 
-	key, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, mg, &cr.Spec.ForProvider.KeySecretRef)
+	// An existing deploy key is enabled with its own title and without push
+	// access, both are updated on the next reconcile.
+	if id := cr.Spec.ForProvider.DeployKeyID; id != nil {
+		if _, _, err := e.client.EnableDeployKey(*cr.Spec.ForProvider.ProjectID, *id, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errEnableFail)
+		}
+		meta.SetExternalName(cr, strconv.FormatInt(*id, 10))
+		return managed.ExternalCreation{}, nil
+	}
+
+	key, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, mg, cr.Spec.ForProvider.KeySecretRef)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
@@ -210,6 +245,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errIDNotAnInt)
 	}
 
+	// The key, the enabled deploy key and its expiry cannot be updated, changing them requires
+	// creating the deploy key again.
+	recreated, err := common.HandleChangedImmutableFields(cr, func() error {
+		_, err := e.Delete(ctx, cr)
+		return err
+	})
+	if recreated {
+		return managed.ExternalUpdate{}, err
+	}
+
 	_, _, er := e.client.UpdateDeployKey(
 		*cr.Spec.ForProvider.ProjectID,
 		int64(id),
@@ -249,6 +294,46 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// findDeployKey pages through the deploy keys of the project until the one
+// the spec refers to is found, either by DeployKeyID or by the fingerprint of
+// the key in KeySecretRef. It returns nil if there is no such key. Keys that
+// cannot be parsed are never found, adding them reports GitLab's error.
+func (e *external) findDeployKey(ctx context.Context, cr *v1alpha1.DeployKey) (*gitlab.ProjectDeployKey, error) {
+	var id int64
+	var fingerprint string
+	switch p := &cr.Spec.ForProvider; {
+	case p.DeployKeyID != nil:
+		id = *p.DeployKeyID
+	case p.KeySecretRef != nil:
+		key, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, p.KeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		if fingerprint, err = projects.DeployKeyFingerprintSHA256(*key); err != nil {
+			return nil, nil //nolint:nilerr // the key is rejected by Create.
+		}
+	default:
+		return nil, nil
+	}
+
+	opt := &gitlab.ListProjectDeployKeysOptions{
+		ListOptions: gitlab.ListOptions{PerPage: listPageSize, Page: 1},
+	}
+	for {
+		keys, res, err := e.client.ListProjectDeployKeys(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListFail)
+		}
+		if dk := projects.FindDeployKey(keys, id, fingerprint); dk != nil {
+			return dk, nil
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
 func lateInitializeProjectDeployKey(local *v1alpha1.DeployKeyParameters, external *gitlab.ProjectDeployKey) {
 	if external == nil {
 		return
@@ -260,11 +345,15 @@ func lateInitializeProjectDeployKey(local *v1alpha1.DeployKeyParameters, externa
 }
 
 func generateCreateOptions(externalName string, params *v1alpha1.DeployKeyParameters) *gitlab.AddDeployKeyOptions {
-	return &gitlab.AddDeployKeyOptions{
+	opt := &gitlab.AddDeployKeyOptions{
 		Key:     &externalName,
 		Title:   &params.Title,
 		CanPush: params.CanPush,
 	}
+	if params.ExpiresAt != nil {
+		opt.ExpiresAt = &params.ExpiresAt.Time
+	}
+	return opt
 }
 
 func newDeployKeyClient(clientConfig common.Config) projects.DeployKeyClient {
@@ -284,3 +373,27 @@ func isUpToDate(cr *v1alpha1.DeployKey, dk *gitlab.ProjectDeployKey) bool {
 
 	return isCanPushUpToDate && isTitleUpToDate
 }
+
+// immutableFields returns the fields of a deploy key that GitLab cannot
+// update. The key is nil if the spec enables an existing deploy key.
+func immutableFields(p *v1alpha1.DeployKeyParameters, key *string, dk *gitlab.ProjectDeployKey) []common.ImmutableField {
+	return []common.ImmutableField{
+		{Name: "forProvider.keySecretRef", UpToDate: key == nil || isSameKey(*key, dk)},
+		{Name: "forProvider.deployKeyId", UpToDate: p.DeployKeyID == nil || *p.DeployKeyID == dk.ID},
+		{Name: "forProvider.expiresAt", UpToDate: p.ExpiresAt == nil || dk.ExpiresAt != nil && p.ExpiresAt.Time.Equal(*dk.ExpiresAt)},
+	}
+}
+
+// isSameKey compares the key with the deploy key by fingerprint. Keys that
+// cannot be parsed are compared as is, ignoring the line breaks and
+// surrounding whitespace GitLab strips from keys.
+func isSameKey(key string, dk *gitlab.ProjectDeployKey) bool {
+	if fingerprint, err := projects.DeployKeyFingerprintSHA256(key); err == nil && dk.FingerprintSHA256 != "" {
+		return fingerprint == dk.FingerprintSHA256
+	}
+	return normalizeKey(key) == normalizeKey(dk.Key)
+}
+
+func normalizeKey(key string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r", "", "\n", "").Replace(key))
+}
@@ -61,6 +61,23 @@ var (
 		CanPush:   true,
 	}
 
+	testPublicKey            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINISkfduuvGxQE+4GKJv7ecQOCukAcnTiukS0FrAL4ui deploy@example.com"
+	testPublicKeyFingerprint = "SHA256:tNk8B5iVe2acM6/ZVCzrrP+xNCTb2aBQbcuYtNRXHAc"
+
+	testFingerprintDeployKey = gitlab.ProjectDeployKey{
+		ID:                testKeyID,
+		Title:             testKeyTitle,
+		Key:               "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINISkfduuvGxQE+4GKJv7ecQOCukAcnTiukS0FrAL4ui",
+		FingerprintSHA256: testPublicKeyFingerprint,
+		CreatedAt:         &testCreatedAt,
+		CanPush:           true,
+	}
+	testOtherDeployKey = gitlab.ProjectDeployKey{
+		ID:                456,
+		Title:             "other",
+		FingerprintSHA256: "SHA256:other",
+	}
+
 	testDeployKeyNoProjectID = &v1alpha1.DeployKey{}
 )
 
@@ -90,10 +107,18 @@ func withConditions(conditions ...xpv1.Condition) deployKeyModifier {
 
 func withTestKeyRef() deployKeyModifier {
 	return func(dk *v1alpha1.DeployKey) {
-		dk.Spec.ForProvider.KeySecretRef = *common.TestCreateLocalSecretKeySelector("testName", "testKey")
+		dk.Spec.ForProvider.KeySecretRef = common.TestCreateLocalSecretKeySelector("testName", "testKey")
 	}
 }
 
+func withDeployKeyID(id int64) deployKeyModifier {
+	return func(dk *v1alpha1.DeployKey) { dk.Spec.ForProvider.DeployKeyID = &id }
+}
+
+func withFingerprint() deployKeyModifier {
+	return func(dk *v1alpha1.DeployKey) { dk.Status.AtProvider.FingerprintSHA256 = testPublicKeyFingerprint }
+}
+
 func withID() deployKeyModifier {
 	return func(dk *v1alpha1.DeployKey) { dk.Status.AtProvider.ID = &testKeyID }
 }
@@ -102,6 +127,21 @@ func withCreatedAt() deployKeyModifier {
 	return func(dk *v1alpha1.DeployKey) { dk.Status.AtProvider.CreatedAt = &metav1.Time{Time: testCreatedAt} }
 }
 
+func withAnnotations(a map[string]string) deployKeyModifier {
+	return func(dk *v1alpha1.DeployKey) { meta.AddAnnotations(dk, a) }
+}
+
+func keySecretClient(key string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{
+				Data: map[string][]byte{"testKey": []byte(key)},
+			}
+			return nil
+		}),
+	}
+}
+
 func buildDeployKey(modifiers ...deployKeyModifier) *v1alpha1.DeployKey {
 	deployKey := &v1alpha1.DeployKey{} // why to use `&`?
 	for _, modifier := range modifiers {
@@ -195,8 +235,10 @@ func TestObserve(t *testing.T) {
 		},
 		"SuccessLateInitTrueUpToDateFalse": {
 			args: args{
+				kube: keySecretClient(testKey + "\n"),
 				cr: buildDeployKey(
 					withExternalName(testExternalName),
+					withTestKeyRef(),
 				),
 				deployKeyService: &fake.MockClient{
 					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
@@ -207,6 +249,7 @@ func TestObserve(t *testing.T) {
 			expected: expected{
 				dk: buildDeployKey(
 					withExternalName(testExternalName),
+					withTestKeyRef(),
 					withConditions(xpv1.Available()),
 					withCanPush(),
 					withID(),
@@ -222,8 +265,10 @@ func TestObserve(t *testing.T) {
 		},
 		"SuccessLateInitFalseUpToDateTrue": {
 			args: args{
+				kube: keySecretClient(testKey + "\n"),
 				cr: buildDeployKey(
 					withExternalName(testExternalName),
+					withTestKeyRef(),
 					withCanPush(),
 					withTitle(),
 				),
@@ -236,6 +281,7 @@ func TestObserve(t *testing.T) {
 			expected: expected{
 				dk: buildDeployKey(
 					withExternalName(testExternalName),
+					withTestKeyRef(),
 					withCanPush(),
 					withTitle(),
 					withConditions(xpv1.Available()),
@@ -250,6 +296,301 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AdoptedByFingerprint": {
+			args: args{
+				kube: keySecretClient(testPublicKey),
+				cr: buildDeployKey(
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockListProjectDeployKeys: func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return []*gitlab.ProjectDeployKey{&testOtherDeployKey, &testFingerprintDeployKey}, &gitlab.Response{}, nil
+					},
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testFingerprintDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available()),
+					withID(),
+					withCreatedAt(),
+					withFingerprint(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AdoptedOnLaterPage": {
+			args: args{
+				kube: keySecretClient(testPublicKey),
+				cr: buildDeployKey(
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockListProjectDeployKeys: func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						if opt.Page == 1 {
+							return []*gitlab.ProjectDeployKey{&testOtherDeployKey}, &gitlab.Response{NextPage: 2}, nil
+						}
+						return []*gitlab.ProjectDeployKey{&testFingerprintDeployKey}, &gitlab.Response{}, nil
+					},
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testFingerprintDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available()),
+					withID(),
+					withCreatedAt(),
+					withFingerprint(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoKeyWithFingerprint": {
+			args: args{
+				kube: keySecretClient(testPublicKey),
+				cr:   buildDeployKey(withTestKeyRef()),
+				deployKeyService: &fake.MockClient{
+					MockListProjectDeployKeys: func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return []*gitlab.ProjectDeployKey{&testOtherDeployKey}, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk:     buildDeployKey(withTestKeyRef()),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UnparsableKeyNotAdopted": {
+			args: args{
+				kube: keySecretClient(testKey),
+				cr:   buildDeployKey(withTestKeyRef()),
+			},
+			expected: expected{
+				dk:     buildDeployKey(withTestKeyRef()),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListKeysError": {
+			args: args{
+				kube: keySecretClient(testPublicKey),
+				cr:   buildDeployKey(withTestKeyRef()),
+				deployKeyService: &fake.MockClient{
+					MockListProjectDeployKeys: func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, testError()
+					},
+				},
+			},
+			expected: expected{
+				dk:  buildDeployKey(withTestKeyRef()),
+				err: errors.Wrap(testError(), errListFail),
+			},
+		},
+		"EnabledKeyAdoptedByID": {
+			args: args{
+				cr: buildDeployKey(
+					withDeployKeyID(testKeyID),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockListProjectDeployKeys: func(pid any, opt *gitlab.ListProjectDeployKeysOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return []*gitlab.ProjectDeployKey{&testOtherDeployKey, &testDeployKey}, &gitlab.Response{}, nil
+					},
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withDeployKeyID(testKeyID),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available()),
+					withID(),
+					withCreatedAt(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeployKeyIDChanged": {
+			args: args{
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withDeployKeyID(456),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withDeployKeyID(456),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available(), xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.deployKeyId is immutable, recreate required",
+					}),
+					withID(),
+					withCreatedAt(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"KeyChangedByFingerprint": {
+			args: args{
+				kube: keySecretClient(testPublicKey),
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						dk := testFingerprintDeployKey
+						dk.FingerprintSHA256 = testOtherDeployKey.FingerprintSHA256
+						return &dk, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available(), xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+					withID(),
+					withCreatedAt(),
+					func(dk *v1alpha1.DeployKey) {
+						dk.Status.AtProvider.FingerprintSHA256 = testOtherDeployKey.FingerprintSHA256
+					},
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"KeyChanged": {
+			args: args{
+				kube: keySecretClient("otherKey"),
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+				),
+				deployKeyService: &fake.MockClient{
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Available(), xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+					withID(),
+					withCreatedAt(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"KeyChangeReverted": {
+			args: args{
+				kube: keySecretClient(testKey),
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Condition{
+						Type:   common.TypeImmutableFieldsChanged,
+						Status: corev1.ConditionTrue,
+						Reason: common.ReasonRecreateRequired,
+					}),
+				),
+				deployKeyService: &fake.MockClient{
+					MockGetDeployKey: func(pid interface{}, deployKey int64, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return &testDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withTestKeyRef(),
+					withCanPush(),
+					withTitle(),
+					withConditions(xpv1.Condition{
+						Type:   common.TypeImmutableFieldsChanged,
+						Status: corev1.ConditionFalse,
+						Reason: common.ReasonImmutableFieldsUnchanged,
+					}, xpv1.Available()),
+					withID(),
+					withCreatedAt(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -307,15 +648,57 @@ func TestCreate(t *testing.T) {
 		"NoKeySecretRef": {
 			args: args{
 				cr: buildDeployKey(),
+			},
+			expected: expected{
+				dk:  buildDeployKey(),
+				err: errors.New(common.ErrSecretSelectorNil),
+			},
+		},
+		"SecretNotFound": {
+			args: args{
+				cr: buildDeployKey(withTestKeyRef()),
 				kube: &test.MockClient{
 					MockGet: test.NewMockGetFn(errors.New("secret not found")),
 				},
 			},
 			expected: expected{
-				dk:  buildDeployKey(),
+				dk:  buildDeployKey(withTestKeyRef()),
 				err: errors.Wrap(errors.New("secret not found"), "Cannot find referenced secret"),
 			},
 		},
+		"EnableExistingKey": {
+			args: args{
+				cr: buildDeployKey(withDeployKeyID(testKeyID)),
+				deployKeyService: &fake.MockClient{
+					MockEnableDeployKey: func(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						if deployKey != testKeyID {
+							return nil, &gitlab.Response{}, testError()
+						}
+						return &testDeployKey, &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withDeployKeyID(testKeyID),
+					withExternalName(testExternalName),
+				),
+			},
+		},
+		"FailedToEnable": {
+			args: args{
+				cr: buildDeployKey(withDeployKeyID(testKeyID)),
+				deployKeyService: &fake.MockClient{
+					MockEnableDeployKey: func(pid any, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, testError()
+					},
+				},
+			},
+			expected: expected{
+				dk:  buildDeployKey(withDeployKeyID(testKeyID)),
+				err: errors.Wrap(testError(), errEnableFail),
+			},
+		},
 		"FaileToAdd": {
 			args: args{
 				cr: buildDeployKey(withTestKeyRef()),
@@ -482,6 +865,71 @@ func TestUpdate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"ImmutableFieldChanged": {
+			args: args{
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withConditions(xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+				),
+				deployKeyService: &fake.MockClient{
+					MockUpdateDeployKey: func(pid interface{}, deployKey int64, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						t.Errorf("UpdateDeployKey(...): unexpected call")
+						return nil, nil, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withConditions(xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+				),
+				err: errors.New("field forProvider.keySecretRef is immutable, recreate required, set the " + common.AnnotationKeyRecreateOnImmutableChange + " annotation to \"true\" to delete and create it again"),
+			},
+		},
+		"ImmutableFieldChangedRecreate": {
+			args: args{
+				cr: buildDeployKey(
+					withExternalName(testExternalName),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+				),
+				deployKeyService: &fake.MockClient{
+					MockDeleteDeployKey: func(pid interface{}, deployKey int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if deployKey != testKeyID {
+							t.Errorf("DeleteDeployKey(...): unexpected key %d", deployKey)
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+			},
+			expected: expected{
+				dk: buildDeployKey(
+					withExternalName(testExternalName),
+					withAnnotations(map[string]string{common.AnnotationKeyRecreateOnImmutableChange: "true"}),
+					withConditions(xpv1.Condition{
+						Type:    common.TypeImmutableFieldsChanged,
+						Status:  corev1.ConditionTrue,
+						Reason:  common.ReasonRecreateRequired,
+						Message: "field forProvider.keySecretRef is immutable, recreate required",
+					}),
+				),
+			},
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotDeployment      = "managed resource is not a GitLab deployment custom resource"
+	errProjectIDMissing   = "ProjectID is missing"
+	errEnvironmentMissing = "one of environment or deploymentId is required"
+	errGetFailed          = "cannot get GitLab deployment"
+	errListFailed         = "cannot list GitLab deployments"
+
+	msgNoDeployment = "No matching deployment found"
+)
+
+// SetupDeployment adds a controller that observes Deployments.
+func SetupDeployment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DeploymentGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDeploymentClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DeploymentGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.DeploymentList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Deployment{}).
+		Complete(r)
+}
+
+// SetupDeploymentGated adds a controller with CRD gate support.
+func SetupDeploymentGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupDeployment(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.DeploymentGroupVersionKind.String())
+		}
+	}, v1alpha1.DeploymentGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.DeploymentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Deployment)
+	if !ok {
+		return nil, errors.New(errNotDeployment)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.DeploymentClient
+}
+
+// Observe reports the observed deployment. Deployments are only observed,
+// the resource therefore always exists and is up to date, and is gone as
+// soon as it is deleted.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Deployment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDeployment)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	d, err := e.getDeployment(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = projects.GenerateDeploymentObservation(d)
+	if d == nil {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(msgNoDeployment))
+	} else {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create is never called since Observe always reports the resource as
+// existing.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is never called since Observe always reports the resource as up
+// to date.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is never called since Observe reports deleted resources as gone,
+// deployments are never deleted.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getDeployment returns the deployment with the given ID, or the latest
+// deployment of the environment. It returns nil if there is none.
+func (e *external) getDeployment(ctx context.Context, p *v1alpha1.DeploymentParameters) (*gitlab.Deployment, error) {
+	if p.DeploymentID != nil {
+		d, res, err := e.client.GetProjectDeployment(*p.ProjectID, *p.DeploymentID, gitlab.WithContext(ctx))
+		if err != nil {
+			if clients.IsResponseNotFound(res) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, errGetFailed)
+		}
+		return d, nil
+	}
+
+	if p.Environment == nil {
+		return nil, errors.New(errEnvironmentMissing)
+	}
+	ds, _, err := e.client.ListProjectDeployments(*p.ProjectID, projects.GenerateLatestDeploymentOptions(p), gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, errListFailed)
+	}
+	if len(ds) == 0 {
+		return nil, nil
+	}
+	return ds[0], nil
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	environment    = "production"
+	createdAt      = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	finishedAt     = time.Date(2026, 10, 1, 12, 5, 0, 0, time.UTC)
+
+	gitlabDeployment = &gitlab.Deployment{
+		ID:          42,
+		IID:         7,
+		Ref:         "main",
+		SHA:         "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Status:      "success",
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &finishedAt,
+		User:        &gitlab.ProjectUser{Username: "deployer"},
+		Environment: &gitlab.Environment{Name: environment},
+		Deployable: gitlab.DeploymentDeployable{
+			FinishedAt: &finishedAt,
+			Pipeline:   gitlab.DeploymentDeployablePipeline{ID: 99},
+		},
+	}
+
+	observedDeployment = v1alpha1.DeploymentObservation{
+		ID:          42,
+		IID:         7,
+		Environment: environment,
+		Status:      "success",
+		Ref:         "main",
+		SHA:         "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Username:    "deployer",
+		PipelineID:  99,
+		CreatedAt:   &metav1.Time{Time: createdAt},
+		UpdatedAt:   &metav1.Time{Time: finishedAt},
+		FinishedAt:  &metav1.Time{Time: finishedAt},
+	}
+)
+
+type deploymentModifier func(*v1alpha1.Deployment)
+
+func withConditions(c ...xpv1.Condition) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.DeploymentObservation) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { r.Status.AtProvider = s }
+}
+
+func withEnvironment() deploymentModifier {
+	return func(r *v1alpha1.Deployment) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.Environment = &environment
+	}
+}
+
+func withDeploymentID(id int64) deploymentModifier {
+	return func(r *v1alpha1.Deployment) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.DeploymentID = &id
+	}
+}
+
+func withDeletionTimestamp() deploymentModifier {
+	return func(r *v1alpha1.Deployment) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: finishedAt})
+	}
+}
+
+func deployment(m ...deploymentModifier) *v1alpha1.Deployment {
+	cr := &v1alpha1.Deployment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client projects.DeploymentClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotDeployment),
+			},
+		},
+		"Deleted": {
+			cr: deployment(withEnvironment(), withDeletionTimestamp()),
+			want: want{
+				cr: deployment(withEnvironment(), withDeletionTimestamp()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: deployment(),
+			want: want{
+				cr:  deployment(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"EnvironmentMissing": {
+			cr: deployment(func(r *v1alpha1.Deployment) { r.Spec.ForProvider.ProjectID = &projectID }),
+			want: want{
+				cr:  deployment(func(r *v1alpha1.Deployment) { r.Spec.ForProvider.ProjectID = &projectID }),
+				err: errors.New(errEnvironmentMissing),
+			},
+		},
+		"LatestDeployment": {
+			client: &fake.MockClient{
+				MockListProjectDeployments: func(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+					if pid != projectID || ptr.Deref(opts.Environment, "") != environment || ptr.Deref(opts.Sort, "") != "desc" || opts.PerPage != 1 {
+						return nil, nil, errBoom
+					}
+					return []*gitlab.Deployment{gitlabDeployment}, &gitlab.Response{}, nil
+				},
+			},
+			cr: deployment(withEnvironment()),
+			want: want{
+				cr:     deployment(withEnvironment(), withStatus(observedDeployment), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NoDeploymentYet": {
+			client: &fake.MockClient{
+				MockListProjectDeployments: func(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+					return []*gitlab.Deployment{}, &gitlab.Response{}, nil
+				},
+			},
+			cr: deployment(withEnvironment()),
+			want: want{
+				cr:     deployment(withEnvironment(), withConditions(xpv1.Unavailable().WithMessage(msgNoDeployment))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedList": {
+			client: &fake.MockClient{
+				MockListProjectDeployments: func(pid any, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+					return nil, nil, errBoom
+				},
+			},
+			cr: deployment(withEnvironment()),
+			want: want{
+				cr:  deployment(withEnvironment()),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+		"DeploymentByID": {
+			client: &fake.MockClient{
+				MockGetProjectDeployment: func(pid any, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+					if pid != projectID || id != 42 {
+						return nil, nil, errBoom
+					}
+					return gitlabDeployment, &gitlab.Response{}, nil
+				},
+			},
+			cr: deployment(withDeploymentID(42)),
+			want: want{
+				cr:     deployment(withDeploymentID(42), withStatus(observedDeployment), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeploymentByIDNotFound": {
+			client: &fake.MockClient{
+				MockGetProjectDeployment: func(pid any, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+				},
+			},
+			cr: deployment(withDeploymentID(42), withStatus(observedDeployment)),
+			want: want{
+				cr:     deployment(withDeploymentID(42), withConditions(xpv1.Unavailable().WithMessage(msgNoDeployment))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetProjectDeployment: func(pid any, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+					return nil, nil, errBoom
+				},
+			},
+			cr: deployment(withDeploymentID(42)),
+			want: want{
+				cr:  deployment(withDeploymentID(42)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeverChangesDeployments(t *testing.T) {
+	// The client has no mocks, any call against GitLab panics.
+	e := &external{client: &fake.MockClient{}}
+	cr := deployment(withEnvironment())
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("Create(...): %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("Update(...): %v", err)
+	}
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalstatuschecks
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotExternalStatusCheck = "managed resource is not a Gitlab external status check custom resource"
+	errProjectIDMissing       = "ProjectID is missing"
+	errIDNotAnInt             = "external name is not a status check ID"
+	errGetURL                 = "cannot get external URL from secret"
+	errListFailed             = "cannot list Gitlab external status checks"
+	errCreateFailed           = "cannot create Gitlab external status check"
+	errUpdateFailed           = "cannot update Gitlab external status check"
+	errDeleteFailed           = "cannot delete Gitlab external status check"
+
+	featureExternalStatusChecks = "external status checks"
+
+	listPageSize = 100
+)
+
+// SetupProjectExternalStatusCheck adds a controller that reconciles
+// ProjectExternalStatusChecks.
+func SetupProjectExternalStatusCheck(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectExternalStatusCheckGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewExternalStatusCheckClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectExternalStatusCheckGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectExternalStatusCheckList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectExternalStatusCheck{}).
+		Complete(r)
+}
+
+// SetupProjectExternalStatusCheckGated adds a controller with CRD gate support.
+func SetupProjectExternalStatusCheckGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupProjectExternalStatusCheck(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ProjectExternalStatusCheckGroupVersionKind.String())
+		}
+	}, v1alpha1.ProjectExternalStatusCheckGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.ExternalStatusCheckClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExternalStatusCheck)
+	if !ok {
+		return nil, errors.New(errNotExternalStatusCheck)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), sudo: common.Sudo(*cfg, cr)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ExternalStatusCheckClient
+	sudo   string
+}
+
+// Observe looks the status check up by the ID in the external name. A status
+// check without one is adopted by its name, which is unique in the project.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExternalStatusCheck)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotExternalStatusCheck)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	id, err := externalID(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	checks, res, err := e.listStatusChecks(ctx, cr)
+	if err != nil {
+		// The Community Edition does not know the endpoint, creating the
+		// status check reports the missing license.
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+	check := projects.FindExternalStatusCheck(checks, id, cr.Spec.ForProvider.Name)
+	if check == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(check.ID, 10))
+
+	url, err := e.externalURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = projects.GenerateExternalStatusCheckObservation(check)
+	// resets a license restriction reported earlier, e.g. before an upgrade
+	common.SetUnsupportedFeatures(cr, nil, nil)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsExternalStatusCheckUpToDate(&cr.Spec.ForProvider, url, check),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExternalStatusCheck)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotExternalStatusCheck)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	url, err := e.externalURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	check, res, err := e.client.CreateProjectExternalStatusCheck(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateExternalStatusCheckOptions(&cr.Spec.ForProvider, url),
+		common.RequestOptions(ctx, e.sudo)...,
+	)
+	if err != nil {
+		// Without an Ultimate license GitLab denies the request, the
+		// Community Edition does not know the endpoint at all.
+		if clients.IsResponseForbidden(res) || clients.IsResponseNotFound(res) {
+			common.SetUnsupportedByLicense(cr, featureExternalStatusChecks)
+		}
+		return managed.ExternalCreation{}, common.RedactError(errors.Wrap(err, errCreateFailed), secretURL(cr, url))
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(check.ID, 10))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExternalStatusCheck)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotExternalStatusCheck)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	id, err := externalID(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	url, err := e.externalURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = e.client.UpdateProjectExternalStatusCheck(
+		*cr.Spec.ForProvider.ProjectID,
+		id,
+		projects.GenerateUpdateExternalStatusCheckOptions(&cr.Spec.ForProvider, url),
+		common.RequestOptions(ctx, e.sudo)...,
+	)
+	return managed.ExternalUpdate{}, common.RedactError(errors.Wrap(err, errUpdateFailed), secretURL(cr, url))
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExternalStatusCheck)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotExternalStatusCheck)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	id, err := externalID(cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteProjectExternalStatusCheck(
+		*cr.Spec.ForProvider.ProjectID,
+		id,
+		&gitlab.DeleteProjectExternalStatusCheckOptions{},
+		common.RequestOptions(ctx, e.sudo)...,
+	)
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// externalID returns the status check ID of the external name, or zero if
+// it is not set yet.
+func externalID(cr *v1alpha1.ProjectExternalStatusCheck) (int64, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, errIDNotAnInt)
+	}
+	return id, nil
+}
+
+// externalURL returns the external URL of the spec, read from its secret if
+// it references one.
+func (e *external) externalURL(ctx context.Context, cr *v1alpha1.ProjectExternalStatusCheck) (string, error) {
+	p := &cr.Spec.ForProvider
+	if p.ExternalURLSecretRef == nil {
+		return ptr.Deref(p.ExternalURL, ""), nil
+	}
+	url, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, p.ExternalURLSecretRef)
+	if err != nil {
+		return "", errors.Wrap(err, errGetURL)
+	}
+	return *url, nil
+}
+
+// secretURL returns the external URL if it is read from a secret, so that
+// it can be redacted from errors GitLab may echo it in.
+func secretURL(cr *v1alpha1.ProjectExternalStatusCheck, url string) string {
+	if cr.Spec.ForProvider.ExternalURLSecretRef == nil {
+		return ""
+	}
+	return url
+}
+
+// listStatusChecks returns all external status checks of the project.
+func (e *external) listStatusChecks(ctx context.Context, cr *v1alpha1.ProjectExternalStatusCheck) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+	opt := &gitlab.ListProjectExternalStatusChecksOptions{
+		ListOptions: gitlab.ListOptions{PerPage: listPageSize, Page: 1},
+	}
+	var all []*gitlab.ProjectStatusCheck
+	for {
+		checks, res, err := e.client.ListProjectExternalStatusChecks(*cr.Spec.ForProvider.ProjectID, opt, common.RequestOptions(ctx, e.sudo)...)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, checks...)
+		if res == nil || res.NextPage == 0 {
+			return all, res, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
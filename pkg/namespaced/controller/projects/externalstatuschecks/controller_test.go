@@ -0,0 +1,525 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalstatuschecks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = int64(42)
+	checkName      = "compliance"
+	checkURL       = "https://checks.example.org/gitlab"
+	secretURLValue = "https://checks.example.org/gitlab?token=s3cr3t"
+
+	urlSecretRef = &xpv1.LocalSecretKeySelector{
+		LocalSecretReference: xpv1.LocalSecretReference{Name: "status-check"},
+		Key:                  "url",
+	}
+)
+
+type args struct {
+	client projects.ExternalStatusCheckClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type checkModifier func(*v1alpha1.ProjectExternalStatusCheck)
+
+func withConditions(c ...xpv1.Condition) checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUnsupportedByLicense() checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) {
+		common.SetUnsupportedByLicense(cr, featureExternalStatusChecks)
+	}
+}
+
+func withExternalName(n string) checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) { meta.SetExternalName(cr, n) }
+}
+
+func withSecretURL() checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) {
+		cr.Spec.ForProvider.ExternalURL = nil
+		cr.Spec.ForProvider.ExternalURLSecretRef = urlSecretRef
+	}
+}
+
+func withProtectedBranchIDs(ids ...int64) checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) { cr.Spec.ForProvider.ProtectedBranchIDs = ids }
+}
+
+func withStatus(o v1alpha1.ProjectExternalStatusCheckObservation) checkModifier {
+	return func(cr *v1alpha1.ProjectExternalStatusCheck) { cr.Status.AtProvider = o }
+}
+
+func statusCheck(m ...checkModifier) *v1alpha1.ProjectExternalStatusCheck {
+	cr := &v1alpha1.ProjectExternalStatusCheck{
+		Spec: v1alpha1.ProjectExternalStatusCheckSpec{
+			ForProvider: v1alpha1.ProjectExternalStatusCheckParameters{
+				ProjectID:   &projectID,
+				Name:        checkName,
+				ExternalURL: &checkURL,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func secretKube() client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{"url": []byte(secretURLValue)}
+			return nil
+		}),
+	}
+}
+
+func notFound() *gitlab.Response {
+	return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+}
+
+func TestObserve(t *testing.T) {
+	observed := &gitlab.ProjectStatusCheck{
+		ID:                7,
+		Name:              checkName,
+		ExternalURL:       checkURL,
+		HMAC:              true,
+		ProtectedBranches: []gitlab.StatusCheckProtectedBranch{{ID: 2, Name: "main"}, {ID: 1, Name: "release"}},
+	}
+	observation := v1alpha1.ProjectExternalStatusCheckObservation{
+		ID:   7,
+		Name: checkName,
+		HMAC: true,
+		ProtectedBranches: []v1alpha1.ExternalStatusCheckProtectedBranch{
+			{ID: 2, Name: "main"},
+			{ID: 1, Name: "release"},
+		},
+	}
+	list := func(checks ...*gitlab.ProjectStatusCheck) func(any, *gitlab.ListProjectExternalStatusChecksOptions, ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+		return func(_ any, _ *gitlab.ListProjectExternalStatusChecksOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+			return checks, &gitlab.Response{}, nil
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotExternalStatusCheck)},
+		},
+		"ProjectIDMissing": {
+			args: args{cr: &v1alpha1.ProjectExternalStatusCheck{}},
+			want: want{cr: &v1alpha1.ProjectExternalStatusCheck{}, err: errors.New(errProjectIDMissing)},
+		},
+		"NotAvailableInCommunityEdition": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProjectExternalStatusChecks: func(_ any, _ *gitlab.ListProjectExternalStatusChecksOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						return nil, notFound(), errBoom
+					},
+				},
+				cr: statusCheck(),
+			},
+			want: want{cr: statusCheck()},
+		},
+		"ListFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProjectExternalStatusChecks: func(_ any, _ *gitlab.ListProjectExternalStatusChecksOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: statusCheck(),
+			},
+			want: want{cr: statusCheck(), err: errors.Wrap(errBoom, errListFailed)},
+		},
+		"DoesNotExist": {
+			args: args{
+				client: &fake.MockClient{MockListProjectExternalStatusChecks: list(&gitlab.ProjectStatusCheck{ID: 8, Name: "other"})},
+				cr:     statusCheck(),
+			},
+			want: want{cr: statusCheck()},
+		},
+		"DeletedExternally": {
+			args: args{
+				client: &fake.MockClient{MockListProjectExternalStatusChecks: list(&gitlab.ProjectStatusCheck{ID: 8, Name: checkName})},
+				cr:     statusCheck(withExternalName("7")),
+			},
+			want: want{cr: statusCheck(withExternalName("7"))},
+		},
+		"AdoptedByName": {
+			args: args{
+				client: &fake.MockClient{MockListProjectExternalStatusChecks: list(observed)},
+				cr:     statusCheck(withProtectedBranchIDs(1, 2), withUnsupportedByLicense()),
+			},
+			want: want{
+				cr: statusCheck(
+					withProtectedBranchIDs(1, 2),
+					withExternalName("7"),
+					withStatus(observation),
+					withConditions(
+						xpv1.Condition{Type: common.TypeUnsupportedFeatures, Status: corev1.ConditionFalse, Reason: common.ReasonAllFeaturesSupported},
+						xpv1.Available(),
+					),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ProtectedBranchesChanged": {
+			args: args{
+				client: &fake.MockClient{MockListProjectExternalStatusChecks: list(observed)},
+				cr:     statusCheck(withExternalName("7"), withProtectedBranchIDs(1)),
+			},
+			want: want{
+				cr: statusCheck(
+					withExternalName("7"),
+					withProtectedBranchIDs(1),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"SecretURLChanged": {
+			args: args{
+				client: &fake.MockClient{MockListProjectExternalStatusChecks: list(observed)},
+				kube:   secretKube(),
+				cr:     statusCheck(withExternalName("7"), withSecretURL(), withProtectedBranchIDs(1, 2)),
+			},
+			want: want{
+				cr: statusCheck(
+					withExternalName("7"),
+					withSecretURL(),
+					withProtectedBranchIDs(1, 2),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ListsAllPages": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProjectExternalStatusChecks: func(_ any, opt *gitlab.ListProjectExternalStatusChecksOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						if opt.Page == 1 {
+							return []*gitlab.ProjectStatusCheck{{ID: 8, Name: "other"}}, &gitlab.Response{NextPage: 2}, nil
+						}
+						return []*gitlab.ProjectStatusCheck{observed}, &gitlab.Response{}, nil
+					},
+				},
+				cr: statusCheck(withProtectedBranchIDs(2, 1)),
+			},
+			want: want{
+				cr: statusCheck(
+					withProtectedBranchIDs(2, 1),
+					withExternalName("7"),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotExternalStatusCheck)},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateProjectExternalStatusCheck: func(_ any, opt *gitlab.CreateProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						want := &gitlab.CreateProjectExternalStatusCheckOptions{
+							Name:               &checkName,
+							ExternalURL:        &secretURLValue,
+							ProtectedBranchIDs: &[]int64{1, 2},
+						}
+						if diff := cmp.Diff(want, opt); diff != "" {
+							t.Errorf("CreateProjectExternalStatusCheck(...): -want, +got:\n%s", diff)
+						}
+						return &gitlab.ProjectStatusCheck{ID: 7}, &gitlab.Response{}, nil
+					},
+				},
+				kube: secretKube(),
+				cr:   statusCheck(withSecretURL(), withProtectedBranchIDs(1, 2)),
+			},
+			want: want{
+				cr: statusCheck(
+					withSecretURL(),
+					withProtectedBranchIDs(1, 2),
+					withExternalName("7"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"UnsupportedByLicense": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateProjectExternalStatusCheck: func(_ any, _ *gitlab.CreateProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+					},
+				},
+				cr: statusCheck(),
+			},
+			want: want{
+				cr:  statusCheck(withConditions(xpv1.Creating()), withUnsupportedByLicense()),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"SecretURLRedacted": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateProjectExternalStatusCheck: func(_ any, _ *gitlab.CreateProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errors.New("invalid url " + secretURLValue)
+					},
+				},
+				kube: secretKube(),
+				cr:   statusCheck(withSecretURL()),
+			},
+			want: want{
+				cr:  statusCheck(withSecretURL(), withConditions(xpv1.Creating())),
+				err: errors.New(errCreateFailed + ": invalid url " + common.Redacted),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotExternalStatusCheck)},
+		},
+		"ExternalNameNotInt": {
+			args: args{cr: statusCheck(withExternalName("abc"))},
+			want: want{
+				cr:  statusCheck(withExternalName("abc")),
+				err: errors.Wrap(errors.New(`strconv.ParseInt: parsing "abc": invalid syntax`), errIDNotAnInt),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateProjectExternalStatusCheck: func(_ any, check int64, opt *gitlab.UpdateProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						if check != 7 {
+							t.Errorf("UpdateProjectExternalStatusCheck(...): want check 7, got %d", check)
+						}
+						want := &gitlab.UpdateProjectExternalStatusCheckOptions{
+							Name:               &checkName,
+							ExternalURL:        &checkURL,
+							ProtectedBranchIDs: &[]int64{},
+						}
+						if diff := cmp.Diff(want, opt); diff != "" {
+							t.Errorf("UpdateProjectExternalStatusCheck(...): -want, +got:\n%s", diff)
+						}
+						return &gitlab.ProjectStatusCheck{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: statusCheck(withExternalName("7")),
+			},
+			want: want{cr: statusCheck(withExternalName("7"))},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateProjectExternalStatusCheck: func(_ any, _ int64, _ *gitlab.UpdateProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: statusCheck(withExternalName("7")),
+			},
+			want: want{cr: statusCheck(withExternalName("7")), err: errors.Wrap(errBoom, errUpdateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{cr: unexpectedItem},
+			want: want{cr: unexpectedItem, err: errors.New(errNotExternalStatusCheck)},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectExternalStatusCheck: func(_ any, check int64, _ *gitlab.DeleteProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if check != 7 {
+							t.Errorf("DeleteProjectExternalStatusCheck(...): want check 7, got %d", check)
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: statusCheck(withExternalName("7")),
+			},
+			want: want{cr: statusCheck(withExternalName("7"), withConditions(xpv1.Deleting()))},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectExternalStatusCheck: func(_ any, _ int64, _ *gitlab.DeleteProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound(), errBoom
+					},
+				},
+				cr: statusCheck(withExternalName("7")),
+			},
+			want: want{cr: statusCheck(withExternalName("7"), withConditions(xpv1.Deleting()))},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectExternalStatusCheck: func(_ any, _ int64, _ *gitlab.DeleteProjectExternalStatusCheckOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: statusCheck(withExternalName("7")),
+			},
+			want: want{
+				cr:  statusCheck(withExternalName("7"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
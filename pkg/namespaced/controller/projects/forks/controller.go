@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forks
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotFork               = "managed resource is not a GitLab project fork custom resource"
+	errForkedFromIDMissing   = "ForkedFromProjectID is missing"
+	errProjectNotFound       = "project referenced by projectId does not exist"
+	errGetFailed             = "cannot get GitLab project fork"
+	errGetSourceFailed       = "cannot get GitLab project to fork from"
+	errCreateFailed          = "cannot fork GitLab project"
+	errCreateRelationFailed  = "cannot create GitLab project fork relationship"
+	errDeleteRelationFailed  = "cannot remove GitLab project fork relationship"
+	errDeleteFailed          = "cannot delete GitLab project fork"
+	errDeletionPendingSuffix = "Deletion pending."
+)
+
+// SetupFork adds a controller that reconciles ProjectForks.
+func SetupFork(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectForkGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewForkClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectForkGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectForkList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectFork{}).
+		Complete(r)
+}
+
+// SetupForkGated adds a controller with CRD gate support.
+func SetupForkGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupFork(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.ProjectForkGroupVersionKind.String())
+		}
+	}, v1alpha1.ProjectForkGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.ForkClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectFork)
+	if !ok {
+		return nil, errors.New(errNotFork)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ForkClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectFork)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFork)
+	}
+
+	// An existing project is adopted as soon as it is referenced, its fork
+	// relationship is then set up by Update.
+	adopted := false
+	if meta.GetExternalName(cr) == "" {
+		if cr.Spec.ForProvider.ProjectID == nil {
+			return managed.ExternalObservation{}, nil
+		}
+		meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+		adopted = true
+	}
+
+	prj, res, err := e.client.GetProject(meta.GetExternalName(cr), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	if meta.WasDeleted(cr) {
+		switch projects.ForkDeletePolicy(&cr.Spec.ForProvider) {
+		case v1alpha1.ProjectForkDeleteProject:
+			if projects.IsMarkedForDeletion(prj) {
+				return managed.ExternalObservation{}, nil
+			}
+		case v1alpha1.ProjectForkRemoveForkRelation:
+			if prj.ForkedFromProject == nil {
+				return managed.ExternalObservation{}, nil
+			}
+		}
+	}
+
+	cr.Status.AtProvider = projects.GenerateForkObservation(prj)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsForkedFrom(&cr.Spec.ForProvider, prj),
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectFork)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFork)
+	}
+
+	if cr.Spec.ForProvider.ProjectID != nil {
+		return managed.ExternalCreation{}, errors.New(errProjectNotFound)
+	}
+
+	if cr.Spec.ForProvider.ForkedFromProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errForkedFromIDMissing)
+	}
+
+	opt, err := projects.GenerateForkProjectOptions(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	prj, _, err := e.client.ForkProject(*cr.Spec.ForProvider.ForkedFromProjectID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateForkObservation(prj)
+	meta.SetExternalName(cr, strconv.FormatInt(prj.ID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update moves the fork relationship to the desired source project. All
+// other parameters only apply when the project is forked.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectFork)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFork)
+	}
+
+	if cr.Spec.ForProvider.ForkedFromProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errForkedFromIDMissing)
+	}
+
+	sourceID, err := e.sourceProjectID(ctx, *cr.Spec.ForProvider.ForkedFromProjectID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if cr.Status.AtProvider.ForkedFromProject != nil {
+		res, err := e.client.DeleteProjectForkRelation(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteRelationFailed)
+		}
+	}
+
+	if _, _, err := e.client.CreateProjectForkRelation(meta.GetExternalName(cr), sourceID, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateRelationFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectFork)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotFork)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if projects.ForkDeletePolicy(&cr.Spec.ForProvider) == v1alpha1.ProjectForkRemoveForkRelation {
+		res, err := e.client.DeleteProjectForkRelation(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalDelete{}, errors.Wrap(err, errDeleteRelationFailed)
+		}
+		return managed.ExternalDelete{}, nil
+	}
+
+	res, err := e.client.DeleteProject(meta.GetExternalName(cr), &gitlab.DeleteProjectOptions{}, gitlab.WithContext(ctx))
+	// a fork already marked for deletion is deleted by GitLab eventually
+	if err != nil && !clients.IsResponseNotFound(res) && !strings.Contains(err.Error(), errDeletionPendingSuffix) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// sourceProjectID returns the numeric ID of the project to fork from, which
+// the fork relationship API requires even if the source is given by path.
+func (e *external) sourceProjectID(ctx context.Context, source string) (int64, error) {
+	if id, err := strconv.ParseInt(source, 10, 64); err == nil {
+		return id, nil
+	}
+	prj, _, err := e.client.GetProject(source, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, errGetSourceFailed)
+	}
+	return prj.ID, nil
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issues
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotIssue         = "managed resource is not a GitLab issue custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errIIDNotInt        = "external name is not a valid GitLab issue IID"
+	errGetFailed        = "cannot get GitLab issue"
+	errCreateFailed     = "cannot create GitLab issue"
+	errUpdateFailed     = "cannot update GitLab issue"
+	errDeleteFailed     = "cannot delete GitLab issue"
+	errDeleteForbidden  = "cannot delete GitLab issue, deleting issues requires the Owner role on the project or administrator access"
+)
+
+// SetupIssue adds a controller that reconciles Issues.
+func SetupIssue(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.IssueGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewIssueClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.IssueGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.IssueList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Issue{}).
+		Complete(r)
+}
+
+// SetupIssueGated adds a controller with CRD gate support.
+func SetupIssueGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupIssue(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.IssueGroupVersionKind.String())
+		}
+	}, v1alpha1.IssueGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.IssueClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return nil, errors.New(errNotIssue)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.IssueClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIssue)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := issueIID(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	issue, res, err := e.client.GetIssue(*cr.Spec.ForProvider.ProjectID, iid, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeIssue(&cr.Spec.ForProvider, issue)
+
+	cr.Status.AtProvider = projects.GenerateIssueObservation(issue)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsIssueUpToDate(&cr.Spec.ForProvider, issue),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIssue)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	issue, _, err := e.client.CreateIssue(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateIssueOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateIssueObservation(issue)
+	meta.SetExternalName(cr, strconv.FormatInt(issue.IID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIssue)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := issueIID(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	issue, _, err := e.client.UpdateIssue(
+		*cr.Spec.ForProvider.ProjectID,
+		iid,
+		projects.GenerateUpdateIssueOptions(&cr.Spec.ForProvider, cr.Status.AtProvider.State),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateIssueObservation(issue)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotIssue)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	iid, err := issueIID(cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteIssue(*cr.Spec.ForProvider.ProjectID, iid, gitlab.WithContext(ctx))
+	switch {
+	case err == nil, clients.IsResponseNotFound(res):
+		return managed.ExternalDelete{}, nil
+	case clients.IsResponseForbidden(res):
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteForbidden)
+	default:
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// issueIID returns the IID of the issue within its project, which is used as
+// external name since the issue API does not address issues by global ID.
+func issueIID(cr *v1alpha1.Issue) (int64, error) {
+	iid, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return 0, errors.New(errIIDNotInt)
+	}
+	return iid, nil
+}
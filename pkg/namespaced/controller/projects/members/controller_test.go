@@ -29,26 +29,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/users"
 )
 
 var (
-	unexpecedItem resource.Managed
-	errBoom       = errors.New("boom")
-	projectID     = int64(0)
-	username      = "username"
-	userID        = int64(123)
-	name          = "name"
-	state         = "state"
-	avatarURL     = "http://avatarURL"
-	webURL        = "http://webURL"
-	email         = "email@gmail.com"
-	accessLevel   = gitlab.AccessLevelValue(30)
-	now           = time.Now()
-	expiresAt     = gitlab.ISOTime(now.AddDate(0, 0, 7*3))
-	expiresAtNew  = gitlab.ISOTime(now.AddDate(0, 0, 7*4))
+	unexpecedItem  resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = int64(0)
+	username       = "username"
+	userID         = int64(123)
+	providerUserID = int64(1)
+	name           = "name"
+	state          = "state"
+	avatarURL      = "http://avatarURL"
+	webURL         = "http://webURL"
+	email          = "email@gmail.com"
+	accessLevel    = gitlab.AccessLevelValue(30)
+	now            = time.Now()
+	expiresAt      = gitlab.ISOTime(now.AddDate(0, 0, 7*3))
+	expiresAtNew   = gitlab.ISOTime(now.AddDate(0, 0, 7*4))
 )
 
 type args struct {
@@ -174,12 +176,27 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errProjectIDMissing),
 			},
 		},
+		"WaitingForProject": {
+			args: args{
+				cr: projectMember(withConditions(common.WaitingForDependency("project", errBoom))),
+			},
+			want: want{
+				cr: projectMember(withConditions(common.WaitingForDependency("project", errBoom))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"ErrGet404": {
 			args: args{
 				projectMember: &fake.MockClient{
 					MockGetMember: func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
 						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
+					MockListAllMembers: func(pid any, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
 				},
 				cr: projectMember(
 					withProjectID(),
@@ -539,6 +556,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"SuccessfulUpdate": {
 			args: args{
+				user: currentUser(providerUserID),
 				projectMember: &fake.MockClient{
 					MockEditMember: func(gid interface{}, user int64, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
 						return &gitlab.ProjectMember{
@@ -590,7 +608,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.projectMember}
+			e := &external{kube: tc.kube, client: tc.projectMember, userClient: tc.user}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -627,6 +645,7 @@ func TestDelete(t *testing.T) {
 		},
 		"SuccessfulDeletion": {
 			args: args{
+				user: currentUser(providerUserID),
 				projectMember: &fake.MockClient{
 					MockDeleteMember: func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
@@ -668,7 +687,7 @@ func TestDelete(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.projectMember}
+			e := &external{kube: tc.kube, client: tc.projectMember, userClient: tc.user}
 			_, err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -681,3 +700,145 @@ func TestDelete(t *testing.T) {
 	}
 
 }
+
+func currentUser(id int64) *fake.MockClient {
+	return &fake.MockClient{
+		MockCurrentUser: func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+			return &gitlab.User{ID: id}, &gitlab.Response{}, nil
+		},
+	}
+}
+
+func TestSelfMembership(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		wantErr     bool
+	}{
+		"Refused": {
+			wantErr: true,
+		},
+		"Allowed": {
+			annotations: map[string]string{common.AnnotationKeyAllowSelfMembershipChange: "true"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var edited, removed bool
+			e := &external{
+				client: &fake.MockClient{
+					MockEditMember: func(gid interface{}, user int64, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						edited = true
+						return &gitlab.ProjectMember{}, &gitlab.Response{}, nil
+					},
+					MockDeleteMember: func(gid interface{}, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						removed = true
+						return &gitlab.Response{}, nil
+					},
+				},
+				// The managed member is the user the provider authenticates as.
+				userClient: currentUser(userID),
+			}
+			cr := projectMember(
+				withProjectID(),
+				withSpec(v1alpha1.MemberParameters{UserID: &userID, ProjectID: &projectID}),
+			)
+			cr.SetAnnotations(tc.annotations)
+
+			if _, err := e.Update(context.Background(), cr); (err != nil) != tc.wantErr {
+				t.Errorf("Update(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if _, err := e.Delete(context.Background(), cr); (err != nil) != tc.wantErr {
+				t.Errorf("Delete(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if edited == tc.wantErr || removed == tc.wantErr {
+				t.Errorf("want the membership changed %t, got edited %t, removed %t", !tc.wantErr, edited, removed)
+			}
+		})
+	}
+}
+
+func TestInheritedMembership(t *testing.T) {
+	maintainer := gitlab.AccessLevelValue(40)
+
+	cases := map[string]struct {
+		direct      *gitlab.ProjectMember
+		inherited   []*gitlab.ProjectMember
+		accessLevel int
+		want        managed.ExternalObservation
+		wantStatus  v1alpha1.AccessLevelValue
+		wantCreate  bool
+	}{
+		"DirectAndInherited": {
+			direct:      &gitlab.ProjectMember{ID: userID, AccessLevel: accessLevel},
+			inherited:   []*gitlab.ProjectMember{{ID: userID, AccessLevel: maintainer}},
+			accessLevel: int(accessLevel),
+			want:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"InheritedHigherAccess": {
+			inherited:   []*gitlab.ProjectMember{{ID: 7}, {ID: userID, AccessLevel: maintainer}},
+			accessLevel: int(accessLevel),
+			wantStatus:  v1alpha1.AccessLevelValue(maintainer),
+		},
+		"InheritedLowerAccess": {
+			inherited:   []*gitlab.ProjectMember{{ID: userID, AccessLevel: accessLevel}},
+			accessLevel: int(maintainer),
+			wantStatus:  v1alpha1.AccessLevelValue(accessLevel),
+			wantCreate:  true,
+		},
+		"NotAMember": {
+			accessLevel: int(accessLevel),
+			wantCreate:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			e := &external{client: &fake.MockClient{
+				MockGetMember: func(pid any, user int64, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+					if tc.direct == nil {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					}
+					return tc.direct, &gitlab.Response{}, nil
+				},
+				MockListAllMembers: func(pid any, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+					if tc.direct != nil {
+						return nil, nil, errors.New("inherited members must not be listed for direct members")
+					}
+					if opt.Page == 1 {
+						return tc.inherited[:min(1, len(tc.inherited))], &gitlab.Response{NextPage: 2}, nil
+					}
+					return tc.inherited[min(1, len(tc.inherited)):], &gitlab.Response{}, nil
+				},
+				MockAddMember: func(pid any, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+					created = true
+					return &gitlab.ProjectMember{}, &gitlab.Response{}, nil
+				},
+			}}
+			cr := projectMember(
+				withSpec(v1alpha1.MemberParameters{UserID: &userID, ProjectID: &projectID}),
+				withAccessLevel(tc.accessLevel),
+			)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantStatus, cr.Status.AtProvider.InheritedAccessLevel); diff != "" {
+				t.Errorf("Observe(...): -want inherited access level, +got:\n%s", diff)
+			}
+			if o.ResourceExists {
+				return
+			}
+
+			_, err = e.Create(context.Background(), cr)
+			if created != tc.wantCreate || (err != nil) == tc.wantCreate {
+				t.Errorf("Create(...): want created %t, got created %t with error %v", tc.wantCreate, created, err)
+			}
+		})
+	}
+}
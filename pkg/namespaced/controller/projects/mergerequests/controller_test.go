@@ -0,0 +1,587 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mergerequests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	mrIIDName      = "12"
+	title          = "Release v1.2.0"
+	description    = "Bumps the version."
+
+	gitlabMR = gitlabMergeRequest("opened", false)
+)
+
+func gitlabMergeRequest(state string, autoMerge bool) *gitlab.MergeRequest {
+	return &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			ID:                        99,
+			IID:                       12,
+			SourceBranch:              "release/v1.2.0",
+			TargetBranch:              "main",
+			Title:                     title,
+			Description:               description,
+			State:                     state,
+			Labels:                    gitlab.Labels{"release"},
+			Assignees:                 []*gitlab.BasicUser{{ID: 3}},
+			ForceRemoveSourceBranch:   true,
+			MergeWhenPipelineSucceeds: autoMerge,
+		},
+	}
+}
+
+type args struct {
+	mr projects.MergeRequestClient
+	cr resource.Managed
+}
+
+type mergeRequestModifier func(*v1alpha1.MergeRequest)
+
+func withConditions(c ...xpv1.Condition) mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.MergeRequestObservation) mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) { meta.SetExternalName(r, n) }
+}
+
+func withTitle(t string) mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) { r.Spec.ForProvider.Title = t }
+}
+
+func withAutoMerge(b bool) mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) { r.Spec.ForProvider.AutoMerge = &b }
+}
+
+func withAccept() mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) {
+		meta.AddAnnotations(r, map[string]string{AnnotationKeyAccept: "true"})
+	}
+}
+
+func withDefaultSpec() mergeRequestModifier {
+	return func(r *v1alpha1.MergeRequest) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.SourceBranch = "release/v1.2.0"
+		r.Spec.ForProvider.TargetBranch = "main"
+		r.Spec.ForProvider.Title = title
+		r.Spec.ForProvider.Description = &description
+		r.Spec.ForProvider.Labels = []string{"release"}
+		r.Spec.ForProvider.AssigneeIDs = []int64{3}
+		r.Spec.ForProvider.RemoveSourceBranch = ptr.To(true)
+	}
+}
+
+func mr(m ...mergeRequestModifier) *v1alpha1.MergeRequest {
+	cr := &v1alpha1.MergeRequest{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	getMR := func(m *gitlab.MergeRequest, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetMergeRequest: func(pid any, mergeRequest int64, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+				return m, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotMergeRequest),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: mr(withDefaultSpec()),
+			},
+			want: want{
+				cr: mr(withDefaultSpec()),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				mr: getMR(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr:  mr(withDefaultSpec(), withExternalName(mrIIDName)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"MergeRequestNotFound": {
+			args: args{
+				mr: getMR(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mr: getMR(gitlabMR, &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TitleChanged": {
+			args: args{
+				mr: getMR(gitlabMR, &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withTitle("Release v1.3.0"), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withTitle("Release v1.3.0"),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"AutoMergeNotEnabled": {
+			args: args{
+				mr: getMR(gitlabMR, &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withAutoMerge(true), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAutoMerge(true),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"AcceptRequested": {
+			args: args{
+				mr: getMR(gitlabMR, &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withAccept(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAccept(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"MergedOutOfBand": {
+			args: args{
+				mr: getMR(gitlabMergeRequest("merged", false), &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withTitle("Release v1.3.0"), withAccept(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withTitle("Release v1.3.0"),
+					withAccept(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMergeRequest("merged", false))),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ClosedOutOfBand": {
+			args: args{
+				mr: getMR(gitlabMergeRequest("closed", false), &gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withAutoMerge(true), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAutoMerge(true),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMergeRequest("closed", false))),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotMergeRequest),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: mr(withTitle(title)),
+			},
+			want: want{
+				cr:  mr(withTitle(title)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				mr: &fake.MockClient{
+					MockCreateMergeRequest: func(pid any, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						return gitlabMR, &gitlab.Response{}, nil
+					},
+				},
+				cr: mr(withDefaultSpec()),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				mr: &fake.MockClient{
+					MockCreateMergeRequest: func(pid any, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: mr(withDefaultSpec()),
+			},
+			want: want{
+				cr:  mr(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mr}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	updateMR := func(m *gitlab.MergeRequest, err error) func(pid any, mergeRequest int64, opt *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+		return func(pid any, mergeRequest int64, opt *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return m, &gitlab.Response{}, err
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotMergeRequest),
+			},
+		},
+		"UpdateMetadata": {
+			args: args{
+				mr: &fake.MockClient{MockUpdateMergeRequest: updateMR(gitlabMR, nil)},
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				mr: &fake.MockClient{MockUpdateMergeRequest: updateMR(nil, errBoom)},
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr:  mr(withDefaultSpec(), withExternalName(mrIIDName)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"EnableAutoMerge": {
+			args: args{
+				mr: &fake.MockClient{
+					MockUpdateMergeRequest: updateMR(gitlabMR, nil),
+					MockAcceptMergeRequest: func(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						if !ptr.Deref(opt.AutoMerge, false) {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return gitlabMergeRequest("opened", true), &gitlab.Response{}, nil
+					},
+				},
+				cr: mr(withDefaultSpec(), withAutoMerge(true), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAutoMerge(true),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMergeRequest("opened", true))),
+				),
+			},
+		},
+		"CancelAutoMerge": {
+			args: args{
+				mr: &fake.MockClient{
+					MockUpdateMergeRequest: updateMR(gitlabMergeRequest("opened", true), nil),
+					MockCancelMergeWhenPipelineSucceeds: func(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						return gitlabMR, &gitlab.Response{}, nil
+					},
+				},
+				cr: mr(withDefaultSpec(), withAutoMerge(false), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAutoMerge(false),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMR)),
+				),
+			},
+		},
+		"Accept": {
+			args: args{
+				mr: &fake.MockClient{
+					MockUpdateMergeRequest: updateMR(gitlabMR, nil),
+					MockAcceptMergeRequest: func(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						if opt.AutoMerge != nil || !ptr.Deref(opt.ShouldRemoveSourceBranch, false) {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return gitlabMergeRequest("merged", false), &gitlab.Response{}, nil
+					},
+				},
+				cr: mr(withDefaultSpec(), withAccept(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(
+					withDefaultSpec(),
+					withAccept(),
+					withExternalName(mrIIDName),
+					withStatus(projects.GenerateMergeRequestObservation(gitlabMergeRequest("merged", false))),
+				),
+			},
+		},
+		"FailedAccept": {
+			args: args{
+				mr: &fake.MockClient{
+					MockUpdateMergeRequest: updateMR(gitlabMR, nil),
+					MockAcceptMergeRequest: func(pid any, mergeRequest int64, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 405}}, errBoom
+					},
+				},
+				cr: mr(withDefaultSpec(), withAccept(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr:  mr(withDefaultSpec(), withAccept(), withExternalName(mrIIDName)),
+				err: errors.Wrap(errBoom, errAcceptFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mr}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteMR := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteMergeRequest: func(pid any, mergeRequest int64, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotMergeRequest),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				mr: deleteMR(&gitlab.Response{}, nil),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				mr: deleteMR(&gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeletionForbidden": {
+			args: args{
+				mr: deleteMR(&gitlab.Response{Response: &http.Response{StatusCode: 403}}, errBoom),
+				cr: mr(withDefaultSpec(), withExternalName(mrIIDName)),
+			},
+			want: want{
+				cr:  mr(withDefaultSpec(), withExternalName(mrIIDName), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteForbidden),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mr}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
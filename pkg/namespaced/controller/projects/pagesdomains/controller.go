@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pagesdomains
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotPagesDomain   = "managed resource is not a GitLab Pages domain custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errDomainMissing    = "domain is missing from spec.forProvider.domain"
+	errGetFailed        = "cannot get GitLab Pages domain"
+	errCreateFailed     = "cannot create GitLab Pages domain"
+	errUpdateFailed     = "cannot update GitLab Pages domain"
+	errDeleteFailed     = "cannot delete GitLab Pages domain"
+	errKeySecretFailed  = "cannot get Pages domain key from secret"
+)
+
+// SetupPagesDomain adds a controller that reconciles PagesDomains.
+func SetupPagesDomain(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PagesDomainGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewPagesDomainClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PagesDomainGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.PagesDomainList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PagesDomain{}).
+		Complete(r)
+}
+
+// SetupPagesDomainGated adds a controller with CRD gate support.
+func SetupPagesDomainGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupPagesDomain(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.PagesDomainGroupVersionKind.String())
+		}
+	}, v1alpha1.PagesDomainGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.PagesDomainClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return nil, errors.New(errNotPagesDomain)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.PagesDomainClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPagesDomain)
+	}
+
+	if cr.Spec.ForProvider.Domain == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	domain, res, err := e.client.GetPagesDomain(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.Domain, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	keyHash := cr.Status.AtProvider.KeyHash
+	cr.Status.AtProvider = projects.GeneratePagesDomainObservation(domain)
+	cr.Status.AtProvider.KeyHash = keyHash
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate := projects.IsPagesDomainUpToDate(&cr.Spec.ForProvider, domain)
+	if upToDate && cr.Spec.ForProvider.KeySecretRef != nil {
+		key, err := e.getKey(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = projects.HashPagesDomainKey(*key) == keyHash
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPagesDomain)
+	}
+
+	if cr.Spec.ForProvider.Domain == "" {
+		return managed.ExternalCreation{}, errors.New(errDomainMissing)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	key, err := e.getKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	domain, _, err := e.client.CreatePagesDomain(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreatePagesDomainOptions(&cr.Spec.ForProvider, key),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GeneratePagesDomainObservation(domain)
+	cr.Status.AtProvider.KeyHash = hashKey(key)
+	meta.SetExternalName(cr, domain.Domain)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPagesDomain)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	key, err := e.getKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	domain, _, err := e.client.UpdatePagesDomain(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Domain,
+		projects.GenerateUpdatePagesDomainOptions(&cr.Spec.ForProvider, key),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	cr.Status.AtProvider = projects.GeneratePagesDomainObservation(domain)
+	cr.Status.AtProvider.KeyHash = hashKey(key)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPagesDomain)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeletePagesDomain(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.Domain, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getKey returns the certificate key referenced by the spec, or nil if no
+// key is referenced.
+func (e *external) getKey(ctx context.Context, cr *v1alpha1.PagesDomain) (*string, error) {
+	if cr.Spec.ForProvider.KeySecretRef == nil {
+		return nil, nil
+	}
+	key, err := common.GetTokenValueFromLocalSecret(ctx, e.kube, cr, cr.Spec.ForProvider.KeySecretRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errKeySecretFailed)
+	}
+	return key, nil
+}
+
+func hashKey(key *string) string {
+	if key == nil {
+		return ""
+	}
+	return projects.HashPagesDomainKey(*key)
+}
@@ -17,14 +17,18 @@ limitations under the License.
 package projects
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"reflect"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -34,10 +38,13 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
 )
@@ -50,12 +57,17 @@ var (
 	extName           = strconv.FormatInt(projectID, 10)
 	extNameAnnotation = map[string]string{meta.AnnotationKeyExternalName: extName}
 	timeNow           = time.Now()
+	avatarImage       = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	avatarHash        = projects.HashAvatar(avatarImage)
+	avatarURL         = "https://gitlab.example.com/uploads/-/system/project/avatar/1234/avatar.png"
+	gravatarURL       = "https://secure.gravatar.com/avatar/205e460b479e2e5b48aec07710c08d50?s=80&d=identicon"
 )
 
 type args struct {
-	project projects.Client
-	kube    client.Client
-	cr      resource.Managed
+	project   projects.Client
+	namespace projects.NamespaceClient
+	kube      client.Client
+	cr        resource.Managed
 }
 
 type projectModifier func(*v1alpha1.Project)
@@ -80,7 +92,7 @@ func withSpec(s v1alpha1.ProjectParameters) projectModifier {
 	return func(r *v1alpha1.Project) { r.Spec.ForProvider = s }
 }
 
-func withProjectPushRules(pr *v1alpha1.PushRules) projectModifier {
+func withProjectPushRules(pr *commonv1alpha1.PushRules) projectModifier {
 	return func(r *v1alpha1.Project) { r.Spec.ForProvider.PushRules = pr }
 }
 
@@ -90,9 +102,10 @@ func withClientDefaultValues() projectModifier {
 		i64 := int64(0)
 		p.Spec.ForProvider = v1alpha1.ProjectParameters{
 			AllowMergeOnSkippedPipeline:               &f,
+			AllowPipelineTriggerApproveDeployment:     &f,
 			CIForwardDeploymentEnabled:                &f,
 			NamespaceID:                               &i64,
-			EmailsDisabled:                            &f,
+			EmailsEnabled:                             &f,
 			ResolveOutdatedDiffDiscussions:            &f,
 			ContainerRegistryEnabled:                  &f,
 			SharedRunnersEnabled:                      &f,
@@ -119,10 +132,25 @@ func withClientDefaultValues() projectModifier {
 			PackagesEnabled:                           &f,
 			ServiceDeskEnabled:                        &f,
 			AutocloseReferencedIssues:                 &f,
+			KeepLatestArtifact:                        &f,
+			MergeRequestDefaultTargetSelf:             &f,
 		}
 	}
 }
 
+func withAvatar(a *v1alpha1.ProjectAvatar) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.Avatar = a }
+}
+
+func withAvatarSecret() projectModifier {
+	return withAvatar(&v1alpha1.ProjectAvatar{
+		SecretRef: &xpv1.LocalSecretKeySelector{
+			Key:                  "avatar.png",
+			LocalSecretReference: xpv1.LocalSecretReference{Name: "branding"},
+		},
+	})
+}
+
 func withAnnotations(a map[string]string) projectModifier {
 	return func(p *v1alpha1.Project) { meta.AddAnnotations(p, a) }
 }
@@ -139,6 +167,17 @@ func project(m ...projectModifier) *v1alpha1.Project {
 	return cr
 }
 
+func avatarSecretClient(image []byte) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{
+				Data: map[string][]byte{"avatar.png": image},
+			}
+			return nil
+		}),
+	}
+}
+
 func TestConnect(t *testing.T) {
 	type want struct {
 		cr     resource.Managed
@@ -219,20 +258,6 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
-			args: args{
-				project: &fake.MockClient{
-					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
-						return &gitlab.Project{}, &gitlab.Response{}, nil
-					},
-				},
-				cr: project(withExternalName("fr")),
-			},
-			want: want{
-				cr:  project(withExternalName("fr")),
-				err: errors.New(errNotProject),
-			},
-		},
 		"FailedGetRequest": {
 			args: args{
 				project: &fake.MockClient{
@@ -402,7 +427,7 @@ func TestObserve(t *testing.T) {
 				cr: project(
 					withClientDefaultValues(),
 					withExternalName(extName),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -423,7 +448,7 @@ func TestObserve(t *testing.T) {
 				cr: project(
 					withClientDefaultValues(),
 					withExternalName(extName),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -630,6 +655,269 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AvatarUpToDate": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+					MockDownloadAvatar: func(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+						return bytes.NewReader(avatarImage), &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL, AvatarHash: avatarHash, AvatarURLChecked: avatarURL}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarURLUnchanged": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+					MockDownloadAvatar: func(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+						return nil, nil, errors.New("the avatar must not be downloaded")
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash, AvatarURLChecked: avatarURL}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL, AvatarHash: avatarHash, AvatarURLChecked: avatarURL}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarChanged": {
+			args: args{
+				kube: avatarSecretClient([]byte("GIF89a\x01\x00\x01\x00")),
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL, AvatarHash: avatarHash}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarRemovedOutsideProvider": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarToBeCleared": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarReplacedOutsideProvider": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+					MockDownloadAvatar: func(pid any, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+						return bytes.NewReader([]byte("GIF89a\x01\x00\x01\x00")), &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withStatus(v1alpha1.ProjectObservation{AvatarHash: avatarHash}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL, AvatarHash: avatarHash}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarClearedGravatar": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{AvatarURL: gravatarURL}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: gravatarURL}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarSecretFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAvatarSecret(),
+					withConditions(xpv1.Available()),
+				),
+				err: errors.Wrap(errors.Wrap(errBoom, common.ErrSecretNotFound), errGetAvatarFailed),
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -681,7 +969,7 @@ func TestObserve(t *testing.T) {
 				cr: project(
 					withClientDefaultValues(),
 					withMirrorUserIDNil(),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -705,7 +993,7 @@ func TestObserve(t *testing.T) {
 					withClientDefaultValues(),
 					withMirrorUserIDNil(),
 					withExternalName(extName),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -752,7 +1040,7 @@ func TestObserve(t *testing.T) {
 						p.Spec.ForProvider.RemoveFinalizerOnPendingDeletion = ptr.To(true)
 					},
 					withExternalName(extName),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -773,7 +1061,7 @@ func TestObserve(t *testing.T) {
 				cr: project(
 					withClientDefaultValues(),
 					withExternalName(extName),
-					withProjectPushRules(&v1alpha1.PushRules{
+					withProjectPushRules(&commonv1alpha1.PushRules{
 						AuthorEmailRegex:           ptr.To(""),
 						BranchNameRegex:            ptr.To(""),
 						CommitCommitterCheck:       ptr.To(false),
@@ -800,55 +1088,157 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-	}
-
-	isProjectUpToDateCases := map[string]interface{}{
-		"Name":                                      "name",
-		"Path":                                      "path",
-		"DefaultBranch":                             "Default branch",
-		"Description":                               "description",
-		"IssuesAccessLevel":                         gitlab.PublicAccessControl,
-		"RepositoryAccessLevel":                     gitlab.PublicAccessControl,
-		"MergeRequestsAccessLevel":                  gitlab.PublicAccessControl,
-		"ForkingAccessLevel":                        gitlab.PublicAccessControl,
-		"BuildsAccessLevel":                         gitlab.PublicAccessControl,
-		"WikiAccessLevel":                           gitlab.PublicAccessControl,
-		"SnippetsAccessLevel":                       gitlab.PublicAccessControl,
-		"PagesAccessLevel":                          gitlab.PublicAccessControl,
-		"ResolveOutdatedDiffDiscussions":            true,
-		"ContainerRegistryEnabled":                  true,
-		"ContainerRegistryAccessLevel":              gitlab.EnabledAccessControl,
-		"SharedRunnersEnabled":                      true,
-		"Visibility":                                gitlab.PrivateVisibility,
-		"PublicBuilds":                              true,
-		"OnlyAllowMergeIfPipelineSucceeds":          true,
-		"OnlyAllowMergeIfAllDiscussionsAreResolved": true,
-		"MergeMethod":                               gitlab.RebaseMerge,
-		"RemoveSourceBranchAfterMerge":              true,
-		"LFSEnabled":                                true,
-		"RequestAccessEnabled":                      true,
-		"TagList":                                   []string{"tag-1", "tag-2"},
-		"Topics":                                    []string{"tag-1", "tag-2"},
-		"CIConfigPath":                              "CI configPath",
-		"CIDefaultGitDepth":                         int64(1),
-		"Mirror":                                    true,
-		"MirrorUserID":                              int64(1),
-		"MirrorTriggerBuilds":                       true,
-		"OnlyMirrorProtectedBranches":               true,
-		"MirrorOverwritesDivergedBranches":          true,
-		"PackagesEnabled":                           true,
-		"ServiceDeskEnabled":                        true,
-		"AutocloseReferencedIssues":                 true,
-		"AllowMergeOnSkippedPipeline":               true,
-		"CIForwardDeploymentEnabled":                true,
-	}
-
-	f := false
-	i64 := int64(0)
-	al := v1alpha1.PublicAccessControl
-	tags := []string{"tag-1 new", "tag-2 new"}
-	topics := []string{"tag-1 new", "tag-2 new"}
-	mergeMethod := v1alpha1.FastForwardMerge
+		"PendingDeletionIsDeletedByDefault": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{
+							MarkedForDeletionAt: &gitlab.ISOTime{},
+						}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withExternalName(extName),
+					func(p *v1alpha1.Project) { p.DeletionTimestamp = &v1.Time{Time: timeNow} },
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName(extName),
+					func(p *v1alpha1.Project) { p.DeletionTimestamp = &v1.Time{Time: timeNow} },
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"PendingDeletionPermanentlyRemove": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{
+							MarkedForDeletionOn: &gitlab.ISOTime{},
+						}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withPermanentlyRemove(ptr.To(true)),
+					func(p *v1alpha1.Project) { p.DeletionTimestamp = &v1.Time{Time: timeNow} },
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withPermanentlyRemove(ptr.To(true)),
+					func(p *v1alpha1.Project) { p.DeletionTimestamp = &v1.Time{Time: timeNow} },
+					withConditions(xpv1.Deleting().WithMessage("Project is in pending deletion state")),
+					withStatus(v1alpha1.ProjectObservation{MarkedForDeletionOn: &v1.Time{}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"PendingDeletionRestore": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{
+							MarkedForDeletionOn: &gitlab.ISOTime{},
+						}, &gitlab.Response{}, nil
+					},
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+					withConditions(xpv1.Unavailable().WithMessage("Project is in pending deletion state but this managed resource is not")),
+					withStatus(v1alpha1.ProjectObservation{MarkedForDeletionOn: &v1.Time{}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+	}
+
+	isProjectUpToDateCases := map[string]interface{}{
+		"Name":                                      "name",
+		"Path":                                      "path",
+		"DefaultBranch":                             "Default branch",
+		"Description":                               "description",
+		"IssuesAccessLevel":                         gitlab.PublicAccessControl,
+		"RepositoryAccessLevel":                     gitlab.PublicAccessControl,
+		"MergeRequestsAccessLevel":                  gitlab.PublicAccessControl,
+		"ForkingAccessLevel":                        gitlab.PublicAccessControl,
+		"BuildsAccessLevel":                         gitlab.PublicAccessControl,
+		"WikiAccessLevel":                           gitlab.PublicAccessControl,
+		"SnippetsAccessLevel":                       gitlab.PublicAccessControl,
+		"PagesAccessLevel":                          gitlab.PublicAccessControl,
+		"ResolveOutdatedDiffDiscussions":            true,
+		"ContainerRegistryEnabled":                  true,
+		"ContainerRegistryAccessLevel":              gitlab.EnabledAccessControl,
+		"SharedRunnersEnabled":                      true,
+		"Visibility":                                gitlab.PrivateVisibility,
+		"PublicBuilds":                              true,
+		"OnlyAllowMergeIfPipelineSucceeds":          true,
+		"OnlyAllowMergeIfAllDiscussionsAreResolved": true,
+		"MergeMethod":                               gitlab.RebaseMerge,
+		"RemoveSourceBranchAfterMerge":              true,
+		"LFSEnabled":                                true,
+		"RequestAccessEnabled":                      true,
+		"TagList":                                   []string{"tag-1", "tag-2"},
+		"Topics":                                    []string{"tag-1", "tag-2"},
+		"CIConfigPath":                              "CI configPath",
+		"CIDefaultGitDepth":                         int64(1),
+		"Mirror":                                    true,
+		"MirrorUserID":                              int64(1),
+		"MirrorTriggerBuilds":                       true,
+		"OnlyMirrorProtectedBranches":               true,
+		"MirrorOverwritesDivergedBranches":          true,
+		"PackagesEnabled":                           true,
+		"ServiceDeskEnabled":                        true,
+		"AutocloseReferencedIssues":                 true,
+		"AllowMergeOnSkippedPipeline":               true,
+		"AllowPipelineTriggerApproveDeployment":     true,
+		"CIForwardDeploymentEnabled":                true,
+		"CIPipelineVariablesMinimumOverrideRole":    "owner",
+		"RestrictUserDefinedVariables":              true,
+		"CIDeletePipelinesInSeconds":                int64(86400),
+		"KeepLatestArtifact":                        true,
+		"PrintingMergeRequestLinkEnabled":           true,
+		"MergeRequestDefaultTargetSelf":             true,
+		"EmailsEnabled":                             true,
+		"AutoDevopsEnabled":                         true,
+		"AutoDevopsDeployStrategy":                  "timed_incremental",
+		"MergeCommitTemplate":                       "Merge %{source_branch}",
+		"SquashCommitTemplate":                      "%{title}",
+	}
+
+	f := false
+	i64 := int64(0)
+	al := v1alpha1.PublicAccessControl
+	tags := []string{"tag-1 new", "tag-2 new"}
+	topics := []string{"tag-1 new", "tag-2 new"}
+	mergeMethod := v1alpha1.FastForwardMerge
 	s := "default string"
 	visibility := v1alpha1.PublicVisibility
 
@@ -874,25 +1264,37 @@ func TestObserve(t *testing.T) {
 		PublicBuilds:                     &f,
 		OnlyAllowMergeIfPipelineSucceeds: &f,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: &f,
-		MergeMethod:                      &mergeMethod,
-		RemoveSourceBranchAfterMerge:     &f,
-		LFSEnabled:                       &f,
-		RequestAccessEnabled:             &f,
-		TagList:                          tags,
-		Topics:                           topics,
-		CIConfigPath:                     &s,
-		CIDefaultGitDepth:                &i64,
-		Mirror:                           &f,
-		MirrorUserID:                     &i64,
-		MirrorTriggerBuilds:              &f,
-		OnlyMirrorProtectedBranches:      &f,
-		MirrorOverwritesDivergedBranches: &f,
-		PackagesEnabled:                  &f,
-		ServiceDeskEnabled:               &f,
-		AutocloseReferencedIssues:        &f,
-		AllowMergeOnSkippedPipeline:      &f,
-		CIForwardDeploymentEnabled:       &f,
-		PushRules: &v1alpha1.PushRules{
+		MergeMethod:                            &mergeMethod,
+		RemoveSourceBranchAfterMerge:           &f,
+		LFSEnabled:                             &f,
+		RequestAccessEnabled:                   &f,
+		TagList:                                tags,
+		Topics:                                 topics,
+		CIConfigPath:                           &s,
+		CIDefaultGitDepth:                      &i64,
+		Mirror:                                 &f,
+		MirrorUserID:                           &i64,
+		MirrorTriggerBuilds:                    &f,
+		OnlyMirrorProtectedBranches:            &f,
+		MirrorOverwritesDivergedBranches:       &f,
+		PackagesEnabled:                        &f,
+		ServiceDeskEnabled:                     &f,
+		AutocloseReferencedIssues:              &f,
+		AllowMergeOnSkippedPipeline:            &f,
+		AllowPipelineTriggerApproveDeployment:  &f,
+		CIForwardDeploymentEnabled:             &f,
+		CIPipelineVariablesMinimumOverrideRole: ptr.To("developer"),
+		RestrictUserDefinedVariables:           &f,
+		CIDeletePipelinesInSeconds:             &i64,
+		KeepLatestArtifact:                     &f,
+		PrintingMergeRequestLinkEnabled:        &f,
+		MergeRequestDefaultTargetSelf:          &f,
+		EmailsEnabled:                          &f,
+		AutoDevopsEnabled:                      &f,
+		AutoDevopsDeployStrategy:               ptr.To("continuous"),
+		MergeCommitTemplate:                    ptr.To("Merge branch '%{source_branch}'"),
+		SquashCommitTemplate:                   ptr.To("%{title} (%{reference})"),
+		PushRules: &commonv1alpha1.PushRules{
 			AuthorEmailRegex:           ptr.To(""),
 			BranchNameRegex:            ptr.To(""),
 			CommitCommitterCheck:       ptr.To(false),
@@ -917,7 +1319,7 @@ func TestObserve(t *testing.T) {
 		wantProjectModifier := []projectModifier{
 			withSpec(projectParameters),
 			withExternalName("0"),
-			withProjectPushRules(&v1alpha1.PushRules{
+			withProjectPushRules(&commonv1alpha1.PushRules{
 				AuthorEmailRegex:           ptr.To(""),
 				BranchNameRegex:            ptr.To(""),
 				CommitCommitterCheck:       ptr.To(false),
@@ -961,25 +1363,35 @@ func TestObserve(t *testing.T) {
 			PublicBuilds:                     f,
 			OnlyAllowMergeIfPipelineSucceeds: f,
 			OnlyAllowMergeIfAllDiscussionsAreResolved: f,
-			MergeMethod:                      gitlab.FastForwardMerge,
-			RemoveSourceBranchAfterMerge:     f,
-			LFSEnabled:                       f,
-			RequestAccessEnabled:             f,
-			TagList:                          tags,
-			Topics:                           topics,
-			CIConfigPath:                     s,
-			CIDefaultGitDepth:                i64,
-			ApprovalsBeforeMerge:             i64,
-			Mirror:                           f,
-			MirrorUserID:                     i64,
-			MirrorTriggerBuilds:              f,
-			OnlyMirrorProtectedBranches:      f,
-			MirrorOverwritesDivergedBranches: f,
-			PackagesEnabled:                  f,
-			ServiceDeskEnabled:               f,
-			AutocloseReferencedIssues:        f,
-			AllowMergeOnSkippedPipeline:      f,
-			CIForwardDeploymentEnabled:       f,
+			MergeMethod:                            gitlab.FastForwardMerge,
+			RemoveSourceBranchAfterMerge:           f,
+			LFSEnabled:                             f,
+			RequestAccessEnabled:                   f,
+			TagList:                                tags,
+			Topics:                                 topics,
+			CIConfigPath:                           s,
+			CIDefaultGitDepth:                      i64,
+			ApprovalsBeforeMerge:                   i64,
+			Mirror:                                 f,
+			MirrorUserID:                           i64,
+			MirrorTriggerBuilds:                    f,
+			OnlyMirrorProtectedBranches:            f,
+			MirrorOverwritesDivergedBranches:       f,
+			PackagesEnabled:                        f,
+			ServiceDeskEnabled:                     f,
+			AutocloseReferencedIssues:              f,
+			AllowMergeOnSkippedPipeline:            f,
+			AllowPipelineTriggerApproveDeployment:  f,
+			CIForwardDeploymentEnabled:             f,
+			CIPipelineVariablesMinimumOverrideRole: "developer",
+			RestrictUserDefinedVariables:           f,
+			PrintingMergeRequestLinkEnabled:        f,
+			MergeRequestDefaultTargetSelf:          f,
+			EmailsEnabled:                          f,
+			AutoDevopsEnabled:                      f,
+			AutoDevopsDeployStrategy:               "continuous",
+			MergeCommitTemplate:                    "Merge branch '%{source_branch}'",
+			SquashCommitTemplate:                   "%{title} (%{reference})",
 		}
 		gitlabProject.Name = name
 		structValue := reflect.ValueOf(gitlabProject).Elem()
@@ -1035,6 +1447,129 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveExternalNameFormat(t *testing.T) {
+	type want struct {
+		pid             interface{}
+		externalName    string
+		lateInitialized bool
+	}
+
+	cases := map[string]struct {
+		format       string
+		externalName string
+		want
+	}{
+		"ID": {
+			externalName: extName,
+			want:         want{pid: 1234, externalName: extName},
+		},
+		"PathToID": {
+			externalName: path,
+			want:         want{pid: path, externalName: extName, lateInitialized: true},
+		},
+		"Path": {
+			format:       common.ExternalNameFormatPath,
+			externalName: path,
+			want:         want{pid: path, externalName: path},
+		},
+		"IDToPath": {
+			format:       common.ExternalNameFormatPath,
+			externalName: extName,
+			want:         want{pid: 1234, externalName: path, lateInitialized: true},
+		},
+		"TransferredPath": {
+			format:       common.ExternalNameFormatPath,
+			externalName: "some/old/path/to/repo",
+			want:         want{pid: "some/old/path/to/repo", externalName: path, lateInitialized: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pid interface{}
+			e := &external{client: &fake.MockClient{
+				MockGetProject: func(id interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					pid = id
+					return &gitlab.Project{ID: projectID, PathWithNamespace: path}, &gitlab.Response{}, nil
+				},
+				MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+					return &gitlab.ProjectPushRules{ID: 1}, nil, nil
+				},
+			}}
+			cr := project(withClientDefaultValues(), withExternalName(tc.externalName))
+			if tc.format != "" {
+				meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyExternalNameFormat: tc.format})
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.pid, pid); diff != "" {
+				t.Errorf("GetProject(...): -want pid, +got pid:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Observe(...): -want external name, +got:\n%s", diff)
+			}
+			if o.ResourceLateInitialized != tc.want.lateInitialized {
+				t.Errorf("Observe(...): want ResourceLateInitialized %t, got %t", tc.want.lateInitialized, o.ResourceLateInitialized)
+			}
+		})
+	}
+}
+
+func TestObserveVerifiedID(t *testing.T) {
+	cases := map[string]struct {
+		path       string
+		verifiedID int64
+		want       int64
+	}{
+		"RecordedAtExpectedPath": {
+			path: "repo",
+			want: projectID,
+		},
+		"NotRecordedAtOtherPath": {
+			path: "other-repo",
+		},
+		"KeptAfterTransfer": {
+			path:       "other-repo",
+			verifiedID: projectID,
+			want:       projectID,
+		},
+		"KeptForOtherProject": {
+			path:       "repo",
+			verifiedID: 1,
+			want:       1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockGetProject: func(id interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					return &gitlab.Project{ID: projectID, Path: "repo", PathWithNamespace: path, Namespace: &gitlab.ProjectNamespace{}}, &gitlab.Response{}, nil
+				},
+				MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+					return &gitlab.ProjectPushRules{ID: 1}, nil, nil
+				},
+			}}
+			cr := project(
+				withClientDefaultValues(),
+				withExternalName(extName),
+				withPath(ptr.To(tc.path)),
+				withStatus(v1alpha1.ProjectObservation{VerifiedID: tc.verifiedID}),
+			)
+
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, cr.Status.AtProvider.VerifiedID); diff != "" {
+				t.Errorf("Observe(...): -want verifiedId, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type want struct {
 		cr     resource.Managed
@@ -1072,6 +1607,23 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulCreationWithPathFormat": {
+			args: args{
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, PathWithNamespace: path}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAnnotations(map[string]string{common.AnnotationKeyExternalNameFormat: common.ExternalNameFormatPath})),
+			},
+			want: want{
+				cr: project(
+					withAnnotations(map[string]string{common.AnnotationKeyExternalNameFormat: common.ExternalNameFormatPath}),
+					withExternalName(path),
+				),
+				result: managed.ExternalCreation{},
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				project: &fake.MockClient{
@@ -1086,10 +1638,158 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"SuccessfulCreationFromTemplatePath": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if pid != "templates/service" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: 42}, &gitlab.Response{}, nil
+					},
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if ptr.Deref(opt.TemplateProjectID, 0) != 42 || !ptr.Deref(opt.UseCustomTemplate, false) || ptr.Deref(opt.GroupWithProjectTemplatesID, 0) != 7 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					TemplateProjectPath:         ptr.To("templates/service"),
+					UseCustomTemplate:           ptr.To(true),
+					GroupWithProjectTemplatesID: ptr.To(int64(7)),
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					TemplateProjectPath:         ptr.To("templates/service"),
+					UseCustomTemplate:           ptr.To(true),
+					GroupWithProjectTemplatesID: ptr.To(int64(7)),
+				}), withExternalName("1")),
+			},
+		},
+		"TemplateProjectIDTakesPrecedence": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if ptr.Deref(opt.TemplateProjectID, 0) != 42 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					TemplateProjectID:   ptr.To(int64(42)),
+					TemplateProjectPath: ptr.To("templates/service"),
+					UseCustomTemplate:   ptr.To(true),
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					TemplateProjectID:   ptr.To(int64(42)),
+					TemplateProjectPath: ptr.To("templates/service"),
+					UseCustomTemplate:   ptr.To(true),
+				}), withExternalName("1")),
+			},
+		},
+		"FailedGetTemplateProject": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{TemplateProjectPath: ptr.To("templates/service")})),
+			},
+			want: want{
+				cr:  project(withSpec(v1alpha1.ProjectParameters{TemplateProjectPath: ptr.To("templates/service")})),
+				err: errors.Wrap(errBoom, errGetTemplateFailed),
+			},
+		},
+		"SuccessfulCreationInNamespacePath": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				namespace: &fake.MockNamespaceClient{
+					MockGetNamespace: func(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						if id != "platform/services" {
+							return nil, nil, errBoom
+						}
+						return &gitlab.Namespace{ID: 13}, &gitlab.Response{}, nil
+					},
+				},
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if ptr.Deref(opt.NamespaceID, 0) != 13 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{NamespacePath: ptr.To("platform/services")})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{NamespacePath: ptr.To("platform/services")}), withExternalName("1")),
+			},
+		},
+		"NamespaceIDTakesPrecedence": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				namespace: &fake.MockNamespaceClient{
+					MockGetNamespace: func(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if ptr.Deref(opt.NamespaceID, 0) != 7 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					NamespaceID:   ptr.To(int64(7)),
+					NamespacePath: ptr.To("platform/services"),
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					NamespaceID:   ptr.To(int64(7)),
+					NamespacePath: ptr.To("platform/services"),
+				}), withExternalName("1")),
+			},
+		},
+		"FailedGetNamespace": {
+			args: args{
+				namespace: &fake.MockNamespaceClient{
+					MockGetNamespace: func(id any, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{NamespacePath: ptr.To("platform/services")})),
+			},
+			want: want{
+				cr:  project(withSpec(v1alpha1.ProjectParameters{NamespacePath: ptr.To("platform/services")})),
+				err: errors.Wrapf(errBoom, errGetNamespaceFailed, "platform/services"),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, client: tc.project, namespaces: tc.namespace}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -1115,7 +1815,7 @@ func TestUpdate(t *testing.T) {
 
 	cases := map[string]struct {
 		args
-		cacheExternalPushRules *v1alpha1.PushRules
+		cacheExternalPushRules *commonv1alpha1.PushRules
 		cachePushRulesUpToDate bool
 		want
 	}{
@@ -1144,7 +1844,50 @@ func TestUpdate(t *testing.T) {
 				cr: project(withStatus(v1alpha1.ProjectObservation{ID: 1234})),
 			},
 		},
-		"FailedEdit": {
+		"RestorePendingDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockRestoreProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, MarkedForDeletionOn: &v1.Time{}}),
+				),
+			},
+			cachePushRulesUpToDate: true,
+			want: want{
+				cr: project(
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, MarkedForDeletionOn: &v1.Time{}}),
+				),
+			},
+		},
+		"FailedRestorePendingDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockRestoreProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, MarkedForDeletionOn: &v1.Time{}}),
+				),
+			},
+			want: want{
+				cr: project(
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.RestoreOnPendingDeletion = ptr.To(true) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, MarkedForDeletionOn: &v1.Time{}}),
+				),
+				err: errors.Wrap(errBoom, errRestoreFailed),
+			},
+		},
+		"FailedEdit": {
 			args: args{
 				project: &fake.MockClient{
 					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
@@ -1173,15 +1916,15 @@ func TestUpdate(t *testing.T) {
 				},
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
-			cacheExternalPushRules: &v1alpha1.PushRules{DenyDeleteTag: ptr.To(false)},
+			cacheExternalPushRules: &commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(false)},
 			cachePushRulesUpToDate: false,
 			want: want{
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
 		},
@@ -1197,7 +1940,7 @@ func TestUpdate(t *testing.T) {
 				},
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
 			cacheExternalPushRules: nil,
@@ -1205,7 +1948,7 @@ func TestUpdate(t *testing.T) {
 			want: want{
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
 		},
@@ -1221,15 +1964,15 @@ func TestUpdate(t *testing.T) {
 				},
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
-			cacheExternalPushRules: &v1alpha1.PushRules{DenyDeleteTag: ptr.To(false)},
+			cacheExternalPushRules: &commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(false)},
 			cachePushRulesUpToDate: false,
 			want: want{
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 				err: errors.Wrap(errBoom, errUpdatePushRulesFailed),
 			},
@@ -1246,7 +1989,7 @@ func TestUpdate(t *testing.T) {
 				},
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
 			cacheExternalPushRules: nil,
@@ -1254,11 +1997,106 @@ func TestUpdate(t *testing.T) {
 			want: want{
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 				err: errors.Wrap(errBoom, errUpdatePushRulesFailed),
 			},
 		},
+		"SuccessfulUploadAvatar": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockUploadAvatar: func(pid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if filename != "avatar.png" {
+							return nil, nil, errors.Errorf("unexpected filename %q", filename)
+						}
+						return &gitlab.Project{AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatarSecret(),
+				),
+			},
+			cachePushRulesUpToDate: true,
+			want: want{
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarHash: avatarHash}),
+					withAvatarSecret(),
+				),
+			},
+		},
+		"SuccessfulRemoveAvatar": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarHash: avatarHash}),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+				),
+			},
+			cachePushRulesUpToDate: true,
+			want: want{
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatar(&v1alpha1.ProjectAvatar{}),
+				),
+			},
+		},
+		"InvalidAvatar": {
+			args: args{
+				kube: avatarSecretClient([]byte("<svg></svg>")),
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					// UploadAvatar must not be called for invalid images
+				},
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatarSecret(),
+				),
+			},
+			cachePushRulesUpToDate: true,
+			want: want{
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatarSecret(),
+				),
+				err: errors.Wrap(errors.New("avatar image type text/plain; charset=utf-8 is not supported"), errInvalidAvatar),
+			},
+		},
+		"FailedUploadAvatar": {
+			args: args{
+				kube: avatarSecretClient(avatarImage),
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockUploadAvatar: func(pid any, avatar io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatarSecret(),
+				),
+			},
+			cachePushRulesUpToDate: true,
+			want: want{
+				cr: project(
+					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
+					withAvatarSecret(),
+				),
+				err: errors.Wrap(errBoom, errUpdateAvatarFailed),
+			},
+		},
 		"PushRulesUpToDateSkipped": {
 			args: args{
 				project: &fake.MockClient{
@@ -1269,15 +2107,15 @@ func TestUpdate(t *testing.T) {
 				},
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
-			cacheExternalPushRules: &v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)},
+			cacheExternalPushRules: &commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)},
 			cachePushRulesUpToDate: true,
 			want: want{
 				cr: project(
 					withStatus(v1alpha1.ProjectObservation{ID: 1234}),
-					withProjectPushRules(&v1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
+					withProjectPushRules(&commonv1alpha1.PushRules{DenyDeleteTag: ptr.To(true)}),
 				),
 			},
 		},
@@ -1359,6 +2197,139 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDeleteFailed),
 			},
 		},
+		"PermanentlyDeletePendingDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						recordedCalls = append(recordedCalls, deleteProjectCalls{Pid: pid, Opt: opt})
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withExternalName("0"),
+					withPermanentlyRemove(gitlab.Ptr(true)),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "path/to/project", MarkedForDeletionOn: &v1.Time{}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("0"),
+					withPermanentlyRemove(gitlab.Ptr(true)),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "path/to/project", MarkedForDeletionOn: &v1.Time{}}),
+				),
+				calls: []deleteProjectCalls{
+					{Pid: "0", Opt: &gitlab.DeleteProjectOptions{PermanentlyRemove: gitlab.Ptr(true), FullPath: gitlab.Ptr("path/to/project")}},
+				},
+			},
+		},
+		"RefuseUnexpectedPath": {
+			args: args{
+				project: &fake.MockClient{},
+				cr: project(
+					withExternalName("0"),
+					withPath(ptr.To("my-project")),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "my-group/other-project"}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("0"),
+					withPath(ptr.To("my-project")),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "my-group/other-project"}),
+				),
+				err: errors.Errorf(errUnexpectedPath, "my-group/other-project"),
+			},
+		},
+		"DeleteVerifiedProjectAfterTransfer": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withExternalName("1"),
+					withPath(ptr.To("my-project")),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.NamespaceID = ptr.To(int64(7)) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1, VerifiedID: 1, PathWithNamespace: "renamed-group/my-project"}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("1"),
+					withPath(ptr.To("my-project")),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.NamespaceID = ptr.To(int64(7)) },
+					withStatus(v1alpha1.ProjectObservation{ID: 1, VerifiedID: 1, PathWithNamespace: "renamed-group/my-project"}),
+				),
+			},
+		},
+		"RefuseOtherProjectAtExpectedPath": {
+			args: args{
+				project: &fake.MockClient{},
+				cr: project(
+					withExternalName("2"),
+					withPath(ptr.To("my-project")),
+					withStatus(v1alpha1.ProjectObservation{ID: 2, VerifiedID: 1, PathWithNamespace: "my-group/my-project"}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("2"),
+					withPath(ptr.To("my-project")),
+					withStatus(v1alpha1.ProjectObservation{ID: 2, VerifiedID: 1, PathWithNamespace: "my-group/my-project"}),
+				),
+				err: errors.Errorf(errUnexpectedPath, "my-group/my-project"),
+			},
+		},
+		"SkipPathVerification": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withExternalName("0"),
+					withPath(ptr.To("my-project")),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.VerifyPathOnDelete = ptr.To(false) },
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "my-group/other-project"}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("0"),
+					withPath(ptr.To("my-project")),
+					func(p *v1alpha1.Project) { p.Spec.ForProvider.VerifyPathOnDelete = ptr.To(false) },
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: "my-group/other-project"}),
+				),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: project(withExternalName("0"), withPermanentlyRemove(gitlab.Ptr(true))),
+			},
+			want: want{
+				cr: project(withExternalName("0"), withPermanentlyRemove(gitlab.Ptr(true))),
+			},
+		},
+		"IgnoreDeletionPending": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, opt *gitlab.DeleteProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errors.New("400 Bad Request: Project has been already marked for deletion. Deletion pending.")
+					},
+				},
+				cr: project(withExternalName("0")),
+			},
+			want: want{
+				cr: project(withExternalName("0")),
+			},
+		},
 		"SuccessfulPermanentlyDeletion": {
 			args: args{
 				project: &fake.MockClient{
@@ -1460,3 +2431,1051 @@ func TestSanitizeImportURL(t *testing.T) {
 		})
 	}
 }
+
+func TestIsProjectUpToDateIgnoresTemplate(t *testing.T) {
+	p := &v1alpha1.ProjectParameters{
+		TemplateName:                ptr.To("service"),
+		TemplateProjectID:           ptr.To(int64(42)),
+		TemplateProjectPath:         ptr.To("templates/service"),
+		UseCustomTemplate:           ptr.To(true),
+		GroupWithProjectTemplatesID: ptr.To(int64(7)),
+	}
+
+	if !isProjectUpToDate(p, &gitlab.Project{}) {
+		t.Errorf("isProjectUpToDate(...): want template parameters to be ignored after creation")
+	}
+}
+
+func TestIsProjectUpToDateExternalCIConfigPath(t *testing.T) {
+	p := &v1alpha1.ProjectParameters{CIConfigPath: ptr.To("ci/service.yml@platform/ci-templates:main")}
+
+	if !isProjectUpToDate(p, &gitlab.Project{CIConfigPath: "ci/service.yml@platform/ci-templates:main"}) {
+		t.Errorf("isProjectUpToDate(...): want external CI configuration path to be up to date")
+	}
+	if isProjectUpToDate(p, &gitlab.Project{CIConfigPath: "ci/service.yml@platform/ci-templates"}) {
+		t.Errorf("isProjectUpToDate(...): want CI configuration path to be compared verbatim")
+	}
+}
+
+func TestIsProjectUpToDateReorderedTopics(t *testing.T) {
+	p := &v1alpha1.ProjectParameters{Topics: []string{"go", "crossplane"}}
+
+	if !isProjectUpToDate(p, &gitlab.Project{Topics: []string{"crossplane", "go"}}) {
+		t.Errorf("isProjectUpToDate(...): want reordered topics to be up to date")
+	}
+	if isProjectUpToDate(p, &gitlab.Project{Topics: []string{"crossplane"}}) {
+		t.Errorf("isProjectUpToDate(...): want missing topics to be detected")
+	}
+}
+
+func TestFeatureAccessLevels(t *testing.T) {
+	project := &gitlab.Project{
+		ReleasesAccessLevel:          gitlab.PrivateAccessControl,
+		EnvironmentsAccessLevel:      gitlab.DisabledAccessControl,
+		ContainerRegistryAccessLevel: gitlab.EnabledAccessControl,
+	}
+
+	e := &external{}
+	e.cache.externalPushRules = &commonv1alpha1.PushRules{}
+	cr := &v1alpha1.Project{Spec: v1alpha1.ProjectSpec{ForProvider: v1alpha1.ProjectParameters{
+		EnvironmentsAccessLevel: ptr.To(v1alpha1.EnabledAccessControl),
+	}}}
+	if err := e.lateInitialize(context.Background(), cr, project); err != nil {
+		t.Fatalf("lateInitialize(...): %v", err)
+	}
+
+	p := cr.Spec.ForProvider
+	if diff := cmp.Diff(ptr.To(v1alpha1.PrivateAccessControl), p.ReleasesAccessLevel); diff != "" {
+		t.Errorf("lateInitialize(...): -want releasesAccessLevel, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(v1alpha1.EnabledAccessControl), p.EnvironmentsAccessLevel); diff != "" {
+		t.Errorf("lateInitialize(...): -want environmentsAccessLevel, +got:\n%s", diff)
+	}
+	if p.MonitorAccessLevel != nil {
+		t.Errorf("lateInitialize(...): want access levels GitLab does not return to stay unset, got %q", *p.MonitorAccessLevel)
+	}
+
+	if isProjectUpToDate(&p, project) {
+		t.Errorf("isProjectUpToDate(...): want a differing environmentsAccessLevel to be out of date")
+	}
+	p.EnvironmentsAccessLevel = ptr.To(v1alpha1.DisabledAccessControl)
+	if !isProjectUpToDate(&p, project) {
+		t.Errorf("isProjectUpToDate(...): want matching access levels to be up to date")
+	}
+}
+
+func TestPackagesEnabled(t *testing.T) {
+	prj := &gitlab.Project{PackagesEnabled: true}
+	p := &v1alpha1.ProjectParameters{PackagesEnabled: ptr.To(false)}
+
+	if isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want disabling packages to be out of date")
+	}
+	if diff := cmp.Diff(ptr.To(false), projects.GenerateEditProjectOptions("project", p).PackagesEnabled); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want packagesEnabled, +got:\n%s", diff)
+	}
+	prj.PackagesEnabled = false
+	if !isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want disabled packages to be up to date")
+	}
+}
+
+func TestMergeRequestDiscussionSettings(t *testing.T) {
+	prj := &gitlab.Project{
+		ResolveOutdatedDiffDiscussions:  true,
+		PrintingMergeRequestLinkEnabled: true,
+	}
+
+	e := &external{}
+	e.cache.externalPushRules = &commonv1alpha1.PushRules{}
+	cr := &v1alpha1.Project{Spec: v1alpha1.ProjectSpec{ForProvider: v1alpha1.ProjectParameters{
+		PrintingMergeRequestLinkEnabled: ptr.To(false),
+	}}}
+	if err := e.lateInitialize(context.Background(), cr, prj); err != nil {
+		t.Fatalf("lateInitialize(...): %v", err)
+	}
+
+	p := &cr.Spec.ForProvider
+	if diff := cmp.Diff(ptr.To(true), p.ResolveOutdatedDiffDiscussions); diff != "" {
+		t.Errorf("lateInitialize(...): -want resolveOutdatedDiffDiscussions, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(false), p.MergeRequestDefaultTargetSelf); diff != "" {
+		t.Errorf("lateInitialize(...): -want mergeRequestDefaultTargetSelf, +got:\n%s", diff)
+	}
+	if isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want disabling the merge request link to be out of date")
+	}
+	o := projects.GenerateEditProjectOptions("project", p)
+	if diff := cmp.Diff(ptr.To(false), o.PrintingMergeRequestLinkEnabled); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want printingMergeRequestLinkEnabled, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(true), o.ResolveOutdatedDiffDiscussions); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want resolveOutdatedDiffDiscussions, +got:\n%s", diff)
+	}
+	prj.PrintingMergeRequestLinkEnabled = false
+	if !isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want matching merge request settings to be up to date")
+	}
+}
+
+func TestEmailsEnabled(t *testing.T) {
+	cases := map[string]struct {
+		emailsDisabled *bool
+		emailsEnabled  *bool
+		observed       bool
+		want           *bool
+		upToDate       bool
+	}{
+		"EmailsEnabled": {
+			emailsEnabled: ptr.To(false),
+			observed:      true,
+			want:          ptr.To(false),
+		},
+		"DeprecatedEmailsDisabled": {
+			emailsDisabled: ptr.To(true),
+			want:           ptr.To(false),
+			upToDate:       true,
+		},
+		"EmailsEnabledTakesPrecedence": {
+			emailsDisabled: ptr.To(true),
+			emailsEnabled:  ptr.To(true),
+			observed:       true,
+			want:           ptr.To(true),
+			upToDate:       true,
+		},
+		"Unmanaged": {
+			observed: true,
+			want:     ptr.To(true),
+			upToDate: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			prj := &gitlab.Project{EmailsEnabled: tc.observed}
+			p := &v1alpha1.ProjectParameters{EmailsDisabled: tc.emailsDisabled, EmailsEnabled: tc.emailsEnabled}
+
+			if got := isProjectUpToDate(p, prj); got != tc.upToDate {
+				t.Errorf("isProjectUpToDate(...): want %t, got %t", tc.upToDate, got)
+			}
+			o := projects.GenerateEditProjectOptions("project", p)
+			if tc.emailsDisabled != nil || tc.emailsEnabled != nil {
+				if diff := cmp.Diff(tc.want, o.EmailsEnabled); diff != "" {
+					t.Errorf("GenerateEditProjectOptions(...): -want emailsEnabled, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(ptr.To(!*tc.want), o.EmailsDisabled); diff != "" { //nolint:staticcheck
+					t.Errorf("GenerateEditProjectOptions(...): -want emailsDisabled, +got:\n%s", diff)
+				}
+			}
+
+			e := &external{}
+			e.cache.externalPushRules = &commonv1alpha1.PushRules{}
+			cr := &v1alpha1.Project{Spec: v1alpha1.ProjectSpec{ForProvider: *p}}
+			if err := e.lateInitialize(context.Background(), cr, prj); err != nil {
+				t.Fatalf("lateInitialize(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, cr.Spec.ForProvider.EmailsEnabled); diff != "" {
+				t.Errorf("lateInitialize(...): -want emailsEnabled, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoDevops(t *testing.T) {
+	for _, strategy := range []string{"continuous", "manual", "timed_incremental"} {
+		t.Run(strategy, func(t *testing.T) {
+			p := &v1alpha1.ProjectParameters{AutoDevopsEnabled: ptr.To(true), AutoDevopsDeployStrategy: ptr.To(strategy)}
+
+			c := projects.GenerateCreateProjectOptions("project", p)
+			if diff := cmp.Diff(ptr.To(strategy), c.AutoDevopsDeployStrategy); diff != "" {
+				t.Errorf("GenerateCreateProjectOptions(...): -want, +got:\n%s", diff)
+			}
+			o := projects.GenerateEditProjectOptions("project", p)
+			if diff := cmp.Diff(ptr.To(strategy), o.AutoDevopsDeployStrategy); diff != "" {
+				t.Errorf("GenerateEditProjectOptions(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To(true), o.AutoDevopsEnabled); diff != "" {
+				t.Errorf("GenerateEditProjectOptions(...): -want autoDevopsEnabled, +got:\n%s", diff)
+			}
+
+			// GitLab reports the strategy as it was sent.
+			prj := &gitlab.Project{AutoDevopsEnabled: true, AutoDevopsDeployStrategy: strategy}
+			if !isProjectUpToDate(p, prj) {
+				t.Errorf("isProjectUpToDate(...): want true, got false")
+			}
+			prj.AutoDevopsDeployStrategy = "other"
+			if isProjectUpToDate(p, prj) {
+				t.Errorf("isProjectUpToDate(...): want false for a changed strategy, got true")
+			}
+
+			e := &external{}
+			e.cache.externalPushRules = &commonv1alpha1.PushRules{}
+			cr := &v1alpha1.Project{}
+			if err := e.lateInitialize(context.Background(), cr, &gitlab.Project{AutoDevopsEnabled: true, AutoDevopsDeployStrategy: strategy}); err != nil {
+				t.Fatalf("lateInitialize(...): %v", err)
+			}
+			if diff := cmp.Diff(ptr.To(strategy), cr.Spec.ForProvider.AutoDevopsDeployStrategy); diff != "" {
+				t.Errorf("lateInitialize(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To(true), cr.Spec.ForProvider.AutoDevopsEnabled); diff != "" {
+				t.Errorf("lateInitialize(...): -want autoDevopsEnabled, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommitTemplates(t *testing.T) {
+	merge := "Merge branch '%{source_branch}' into '%{target_branch}'\n\n%{title}\n\nSee merge request %{reference}"
+	squash := "%{title}\n\n%{co_authored_by}"
+
+	cases := map[string]struct {
+		p        v1alpha1.ProjectParameters
+		prj      gitlab.Project
+		upToDate bool
+	}{
+		"UpToDate": {
+			p:        v1alpha1.ProjectParameters{MergeCommitTemplate: &merge, SquashCommitTemplate: &squash},
+			prj:      gitlab.Project{MergeCommitTemplate: merge, SquashCommitTemplate: squash},
+			upToDate: true,
+		},
+		"MergeCommitTemplateChanged": {
+			p:   v1alpha1.ProjectParameters{MergeCommitTemplate: &merge},
+			prj: gitlab.Project{MergeCommitTemplate: "Merge branch '%{source_branch}'"},
+		},
+		"SquashCommitTemplateWhitespaceChanged": {
+			p:   v1alpha1.ProjectParameters{SquashCommitTemplate: &squash},
+			prj: gitlab.Project{SquashCommitTemplate: "%{title}\n%{co_authored_by}"},
+		},
+		"ClearedTemplate": {
+			p:   v1alpha1.ProjectParameters{SquashCommitTemplate: ptr.To("")},
+			prj: gitlab.Project{SquashCommitTemplate: squash},
+		},
+		"Unmanaged": {
+			prj:      gitlab.Project{MergeCommitTemplate: merge, SquashCommitTemplate: squash},
+			upToDate: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isProjectUpToDate(&tc.p, &tc.prj); got != tc.upToDate {
+				t.Errorf("isProjectUpToDate(...): want %t, got %t", tc.upToDate, got)
+			}
+
+			c := projects.GenerateCreateProjectOptions("project", &tc.p)
+			o := projects.GenerateEditProjectOptions("project", &tc.p)
+			for _, got := range []*string{c.MergeCommitTemplate, o.MergeCommitTemplate} {
+				if diff := cmp.Diff(tc.p.MergeCommitTemplate, got); diff != "" {
+					t.Errorf("mergeCommitTemplate: -want, +got:\n%s", diff)
+				}
+			}
+			for _, got := range []*string{c.SquashCommitTemplate, o.SquashCommitTemplate} {
+				if diff := cmp.Diff(tc.p.SquashCommitTemplate, got); diff != "" {
+					t.Errorf("squashCommitTemplate: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPreventMergeWithoutJiraIssue(t *testing.T) {
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	cases := map[string]struct {
+		spec *bool
+		jira *gitlab.JiraService
+		res  *gitlab.Response
+		err  error
+		want *bool
+		werr error
+	}{
+		"EnabledWithActiveJira": {
+			spec: ptr.To(true),
+			jira: &gitlab.JiraService{Service: gitlab.Service{Active: true}},
+			want: ptr.To(true),
+		},
+		"EnabledWithoutJira": {
+			spec: ptr.To(true),
+			res:  notFound,
+			err:  errBoom,
+			werr: errors.New(errJiraNotActive),
+		},
+		"EnabledWithInactiveJira": {
+			spec: ptr.To(true),
+			jira: &gitlab.JiraService{},
+			werr: errors.New(errJiraNotActive),
+		},
+		"DisabledWithoutJira": {
+			spec: ptr.To(false),
+			res:  notFound,
+			err:  errBoom,
+		},
+		"Unmanaged": {},
+		"JiraUnreadable": {
+			spec: ptr.To(true),
+			err:  errBoom,
+			werr: errors.Wrap(errBoom, errGetJiraFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent *bool
+			e := &external{
+				client: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						sent = opt.PreventMergeWithoutJiraIssue
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				jira: &fake.MockJiraClient{
+					MockGetJiraService: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+						return tc.jira, tc.res, tc.err
+					},
+				},
+			}
+			e.cache.isPushRulesUpToDate = true
+			e.cache.isAvatarUpToDate = true
+			e.cache.isComplianceFrameworksUpToDate = true
+			e.cache.isPackagesCleanupUpToDate = true
+
+			cr := project(withExternalName("1234"), withSpec(v1alpha1.ProjectParameters{PreventMergeWithoutJiraIssue: tc.spec}))
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.werr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, sent); diff != "" {
+				t.Errorf("Update(...): -want preventMergeWithoutJiraIssue, +got:\n%s", diff)
+			}
+		})
+	}
+
+	prj := &gitlab.Project{PreventMergeWithoutJiraIssue: true}
+	if !isProjectUpToDate(&v1alpha1.ProjectParameters{}, prj) {
+		t.Errorf("isProjectUpToDate(...): want an unset preventMergeWithoutJiraIssue to be up to date")
+	}
+	if isProjectUpToDate(&v1alpha1.ProjectParameters{PreventMergeWithoutJiraIssue: ptr.To(false)}, prj) {
+		t.Errorf("isProjectUpToDate(...): want a changed preventMergeWithoutJiraIssue to be out of date")
+	}
+}
+
+func TestContainerExpirationPolicyCadence(t *testing.T) {
+	nextRunAt := time.Now()
+	prj := &gitlab.Project{ContainerExpirationPolicy: &gitlab.ContainerExpirationPolicy{Cadence: "1d", Enabled: true, NextRunAt: &nextRunAt}}
+	p := &v1alpha1.ProjectParameters{ContainerExpirationPolicyAttributes: &v1alpha1.ContainerExpirationPolicyAttributes{
+		Cadence: ptr.To("7d"),
+		Enabled: ptr.To(true),
+	}}
+
+	if isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want a changed cadence to be out of date")
+	}
+	if diff := cmp.Diff(ptr.To("7d"), projects.GenerateEditProjectOptions("project", p).ContainerExpirationPolicyAttributes.Cadence); diff != "" {
+		t.Errorf("GenerateEditProjectOptions(...): -want cadence, +got:\n%s", diff)
+	}
+	prj.ContainerExpirationPolicy.Cadence = "7d"
+	if !isProjectUpToDate(p, prj) {
+		t.Errorf("isProjectUpToDate(...): want the same cadence to be up to date regardless of nextRunAt")
+	}
+}
+
+func TestPackagesCleanupPolicy(t *testing.T) {
+	var updated string
+	e := &external{packagesCleanup: &fake.MockPackagesCleanupPolicyClient{
+		MockGetPackagesCleanupPolicy: func(ctx context.Context, project string) (*projects.PackagesCleanupPolicy, error) {
+			if project != "acme/app" {
+				return nil, errBoom
+			}
+			return &projects.PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "all"}, nil
+		},
+		MockUpdatePackagesCleanupPolicy: func(ctx context.Context, project string, keepN string) error {
+			updated = project + "=" + keepN
+			return nil
+		},
+	}}
+	prj := &gitlab.Project{PathWithNamespace: "acme/app"}
+
+	cr := project(withSpec(v1alpha1.ProjectParameters{}))
+	if got, err := e.observePackagesCleanupPolicy(context.Background(), cr, prj); got != nil || err != nil {
+		t.Errorf("observePackagesCleanupPolicy(...): want an unset policy not to be observed, got %v, %v", got, err)
+	}
+
+	cr = project(withSpec(v1alpha1.ProjectParameters{PackagesCleanupPolicy: &v1alpha1.PackagesCleanupPolicy{KeepNDuplicatedPackageFiles: "10"}}))
+	got, err := e.observePackagesCleanupPolicy(context.Background(), cr, prj)
+	if err != nil {
+		t.Fatalf("observePackagesCleanupPolicy(...): %v", err)
+	}
+	if projects.IsPackagesCleanupPolicyUpToDate(cr.Spec.ForProvider.PackagesCleanupPolicy, got) {
+		t.Errorf("IsPackagesCleanupPolicyUpToDate(...): want a different policy to be out of date")
+	}
+
+	cr.Status.AtProvider.PathWithNamespace = "acme/app"
+	if err := e.updatePackagesCleanupPolicy(context.Background(), cr); err != nil {
+		t.Fatalf("updatePackagesCleanupPolicy(...): %v", err)
+	}
+	if diff := cmp.Diff("acme/app=10", updated); diff != "" {
+		t.Errorf("updatePackagesCleanupPolicy(...): -want, +got:\n%s", diff)
+	}
+
+	prj.PathWithNamespace = "acme/other"
+	_, err = e.observePackagesCleanupPolicy(context.Background(), cr, prj)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errGetPackagesCleanup), err, test.EquateErrors()); diff != "" {
+		t.Errorf("observePackagesCleanupPolicy(...): -want error, +got:\n%s", diff)
+	}
+}
+
+func TestCICatalogResource(t *testing.T) {
+	published := false
+	var calls []string
+	e := &external{catalog: &fake.MockCatalogResourceClient{
+		MockIsCatalogResource: func(ctx context.Context, project string) (bool, error) {
+			if project != "acme/components" {
+				return false, errBoom
+			}
+			return published, nil
+		},
+		MockCreateCatalogResource: func(ctx context.Context, project string) error {
+			calls = append(calls, "create "+project)
+			published = true
+			return nil
+		},
+		MockDestroyCatalogResource: func(ctx context.Context, project string) error {
+			calls = append(calls, "destroy "+project)
+			published = false
+			return nil
+		},
+	}}
+	prj := &gitlab.Project{PathWithNamespace: "acme/components"}
+	cr := project()
+	cr.Status.AtProvider.PathWithNamespace = "acme/components"
+
+	if got, err := e.observeCatalogResource(context.Background(), &cr.Spec.ForProvider, prj); got != nil || err != nil {
+		t.Errorf("observeCatalogResource(...): want an unset ciCatalogResource not to be observed, got %v, %v", got, err)
+	}
+
+	for _, enabled := range []bool{true, false} {
+		p := &v1alpha1.ProjectParameters{CICatalogResource: ptr.To(enabled)}
+		got, err := e.observeCatalogResource(context.Background(), p, prj)
+		if err != nil {
+			t.Fatalf("observeCatalogResource(...): %v", err)
+		}
+		if *got == enabled {
+			t.Errorf("observeCatalogResource(...): want publication %t to differ from the observed one", enabled)
+		}
+		if err := e.updateCatalogResource(context.Background(), cr, p); err != nil {
+			t.Fatalf("updateCatalogResource(...): %v", err)
+		}
+		if published != enabled {
+			t.Errorf("updateCatalogResource(...): want published %t, got %t", enabled, published)
+		}
+	}
+	if diff := cmp.Diff([]string{"create acme/components", "destroy acme/components"}, calls); diff != "" {
+		t.Errorf("updateCatalogResource(...): -want calls, +got:\n%s", diff)
+	}
+
+	prj.PathWithNamespace = "acme/other"
+	_, err := e.observeCatalogResource(context.Background(), &v1alpha1.ProjectParameters{CICatalogResource: ptr.To(true)}, prj)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errGetCatalogResource), err, test.EquateErrors()); diff != "" {
+		t.Errorf("observeCatalogResource(...): -want error, +got:\n%s", diff)
+	}
+
+	p := &v1alpha1.ProjectParameters{CICatalogResource: ptr.To(true)}
+	omitted := projects.OmitUnsupportedProjectParameters(p, &common.ServerVersion{Major: 16, Minor: 5})
+	if diff := cmp.Diff([]string{"ciCatalogResource"}, omitted); diff != "" || p.CICatalogResource != nil {
+		t.Errorf("OmitUnsupportedProjectParameters(...): want ciCatalogResource omitted before GitLab 16.6, -want, +got:\n%s", diff)
+	}
+}
+
+func TestCICatalogVersion(t *testing.T) {
+	releasedAt := time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+	e := &external{catalog: &fake.MockCatalogResourceClient{
+		MockGetLatestCatalogResourceVersion: func(ctx context.Context, project string) (*projects.CatalogResourceVersion, error) {
+			if project != "acme/components" {
+				return nil, errBoom
+			}
+			return &projects.CatalogResourceVersion{Name: "1.2.0", ReleasedAt: &releasedAt}, nil
+		},
+	}}
+	prj := &gitlab.Project{PathWithNamespace: "acme/components"}
+
+	// Versions are only observed while the project is published.
+	for _, published := range []*bool{nil, ptr.To(false)} {
+		if got, err := e.observeCatalogVersion(context.Background(), published, prj); got != nil || err != nil {
+			t.Errorf("observeCatalogVersion(...): want no version of an unpublished project, got %v, %v", got, err)
+		}
+	}
+
+	got, err := e.observeCatalogVersion(context.Background(), ptr.To(true), prj)
+	if err != nil {
+		t.Fatalf("observeCatalogVersion(...): %v", err)
+	}
+	want := &v1alpha1.CICatalogVersionObservation{Name: "1.2.0", ReleasedAt: &v1.Time{Time: releasedAt}}
+	if diff := cmp.Diff(want, projects.GenerateCatalogResourceVersionObservation(got)); diff != "" {
+		t.Errorf("observeCatalogVersion(...): -want, +got:\n%s", diff)
+	}
+
+	prj.PathWithNamespace = "acme/other"
+	_, err = e.observeCatalogVersion(context.Background(), ptr.To(true), prj)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errGetCatalogVersion), err, test.EquateErrors()); diff != "" {
+		t.Errorf("observeCatalogVersion(...): -want error, +got:\n%s", diff)
+	}
+}
+
+func TestSkipForbiddenFields(t *testing.T) {
+	var edits []*gitlab.EditProjectOptions
+	e := &external{
+		kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		client: &fake.MockClient{
+			MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				edits = append(edits, opt)
+				if opt.ServiceDeskEnabled != nil {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errBoom
+				}
+				return &gitlab.Project{}, &gitlab.Response{}, nil
+			},
+		},
+	}
+	e.cache.isPushRulesUpToDate = true
+	e.cache.isAvatarUpToDate = true
+	e.cache.isComplianceFrameworksUpToDate = true
+	e.cache.isPackagesCleanupUpToDate = true
+
+	cr := project(withExternalName("1"), withSpec(v1alpha1.ProjectParameters{
+		Description:        ptr.To("description"),
+		ServiceDeskEnabled: ptr.To(true),
+	}))
+
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Errorf("Update(...): want an error unless forbidden fields are skipped")
+	}
+
+	edits = nil
+	cr.SetAnnotations(map[string]string{common.AnnotationKeySkipForbiddenFields: "true"})
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if diff := cmp.Diff("serviceDeskEnabled", cr.GetAnnotations()[common.AnnotationKeyForbiddenFields]); diff != "" {
+		t.Errorf("Update(...): -want forbidden fields, +got:\n%s", diff)
+	}
+	if cr.GetCondition(common.TypeForbiddenFieldsSkipped).Status != corev1.ConditionTrue {
+		t.Errorf("Update(...): want the %s condition to be true", common.TypeForbiddenFieldsSkipped)
+	}
+	if !slices.ContainsFunc(edits, func(opt *gitlab.EditProjectOptions) bool { return ptr.Deref(opt.Description, "") == "description" }) {
+		t.Errorf("Update(...): want the description to be updated regardless of the forbidden field")
+	}
+
+	edits = nil
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if len(edits) != 1 || edits[0].ServiceDeskEnabled != nil {
+		t.Errorf("Update(...): want forbidden fields not to be sent again, got %d edits", len(edits))
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	common.OmitFields(current, common.ForbiddenFields(cr))
+	if !isProjectUpToDate(current, &gitlab.Project{Name: "example-project", Description: "description"}) {
+		t.Errorf("isProjectUpToDate(...): want skipped fields not to be compared")
+	}
+}
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestTransfer(t *testing.T) {
+	record := &eventRecorder{}
+	e := &external{
+		record: record,
+		client: &fake.MockClient{
+			MockTransferProject: func(pid any, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				if pid != "1" || opt.Namespace != int64(8) {
+					return nil, nil, errBoom
+				}
+				return &gitlab.Project{ID: 1, PathWithNamespace: "new-group/project"}, &gitlab.Response{}, nil
+			},
+			MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				return &gitlab.Project{}, &gitlab.Response{}, nil
+			},
+		},
+	}
+	e.cache.isPushRulesUpToDate = true
+	e.cache.isAvatarUpToDate = true
+	e.cache.isComplianceFrameworksUpToDate = true
+	e.cache.transferNamespaceID = ptr.To(int64(8))
+
+	cr := project(withExternalName("1"), withSpec(v1alpha1.ProjectParameters{
+		NamespaceID:   ptr.To(int64(8)),
+		AllowTransfer: ptr.To(true),
+	}))
+	cr.Status.AtProvider.PathWithNamespace = "old-group/project"
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if diff := cmp.Diff("new-group/project", cr.Status.AtProvider.PathWithNamespace); diff != "" {
+		t.Errorf("Update(...): -want path, +got:\n%s", diff)
+	}
+	want := []event.Event{event.Normal(reasonTransferred, "Transferred project from old-group/project to new-group/project")}
+	if diff := cmp.Diff(want, record.events); diff != "" {
+		t.Errorf("Update(...): -want events, +got:\n%s", diff)
+	}
+
+	e.cache.transferNamespaceID = ptr.To(int64(9))
+	_, err := e.Update(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrapf(errBoom, errTransferFailed, 9), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Update(...): -want error, +got:\n%s", diff)
+	}
+}
+
+func TestCheckCIConfigProject(t *testing.T) {
+	inaccessible := xpv1.Condition{
+		Type:    TypeCIConfigProjectInaccessible,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonCIConfigProjectNotFound,
+		Message: "project platform/ci-templates referenced by ciConfigPath does not exist or cannot be read with the provider credentials, pipelines cannot load their CI configuration",
+	}
+	accessible := xpv1.Condition{
+		Type:   TypeCIConfigProjectInaccessible,
+		Status: corev1.ConditionFalse,
+		Reason: ReasonCIConfigProjectFound,
+	}
+
+	type want struct {
+		pid        any
+		checked    string
+		conditions []xpv1.Condition
+		err        error
+	}
+
+	cases := map[string]struct {
+		ciConfigPath string
+		checked      string
+		conditions   []xpv1.Condition
+		res          *gitlab.Response
+		err          error
+		want         want
+	}{
+		"LocalPath": {
+			ciConfigPath: "ci/service.yml",
+			want:         want{},
+		},
+		"Accessible": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates:main",
+			want:         want{pid: "platform/ci-templates", checked: "ci/service.yml@platform/ci-templates:main"},
+		},
+		"AlreadyChecked": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates:main",
+			checked:      "ci/service.yml@platform/ci-templates:main",
+			want:         want{checked: "ci/service.yml@platform/ci-templates:main"},
+		},
+		"PathChanged": {
+			ciConfigPath: "ci/service.yml@platform/other-templates",
+			checked:      "ci/service.yml@platform/ci-templates:main",
+			res:          &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			err:          errBoom,
+			want: want{
+				pid: "platform/other-templates",
+				conditions: []xpv1.Condition{{
+					Type:    TypeCIConfigProjectInaccessible,
+					Status:  corev1.ConditionTrue,
+					Reason:  ReasonCIConfigProjectNotFound,
+					Message: "project platform/other-templates referenced by ciConfigPath does not exist or cannot be read with the provider credentials, pipelines cannot load their CI configuration",
+				}},
+			},
+		},
+		"NotFound": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates",
+			res:          &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			err:          errBoom,
+			want: want{
+				pid:        "platform/ci-templates",
+				conditions: []xpv1.Condition{inaccessible},
+			},
+		},
+		"Forbidden": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates",
+			res:          &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+			err:          errBoom,
+			want: want{
+				pid:        "platform/ci-templates",
+				conditions: []xpv1.Condition{inaccessible},
+			},
+		},
+		"AccessibleAgain": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates",
+			conditions:   []xpv1.Condition{inaccessible},
+			want: want{
+				pid:        "platform/ci-templates",
+				checked:    "ci/service.yml@platform/ci-templates",
+				conditions: []xpv1.Condition{accessible},
+			},
+		},
+		"ErrGet": {
+			ciConfigPath: "ci/service.yml@platform/ci-templates",
+			err:          errBoom,
+			want: want{
+				pid: "platform/ci-templates",
+				err: errors.Wrap(errBoom, errGetCIConfigProject),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pid any
+			e := &external{client: &fake.MockClient{
+				MockGetProject: func(id interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					pid = id
+					return &gitlab.Project{}, tc.res, tc.err
+				},
+			}}
+			cr := project(withSpec(v1alpha1.ProjectParameters{CIConfigPath: &tc.ciConfigPath}), withConditions(tc.conditions...))
+			cr.Status.AtProvider.CIConfigPathChecked = tc.checked
+
+			err := e.checkCIConfigProject(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("checkCIConfigProject(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pid, pid); diff != "" {
+				t.Errorf("GetProject(...): -want pid, +got pid:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.checked, cr.Status.AtProvider.CIConfigPathChecked); diff != "" {
+				t.Errorf("checkCIConfigProject(...): -want ciConfigPathChecked, +got:\n%s", diff)
+			}
+			want := project(withSpec(v1alpha1.ProjectParameters{CIConfigPath: &tc.ciConfigPath}), withConditions(tc.want.conditions...))
+			if diff := cmp.Diff(want.Status.Conditions, cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("checkCIConfigProject(...): -want conditions, +got conditions:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComplianceFrameworksUpToDate(t *testing.T) {
+	available := []projects.ComplianceFramework{{ID: 1, Name: "SOX"}, {ID: 2, Name: "HIPAA"}}
+	unsupported := xpv1.Condition{
+		Type:    common.TypeUnsupportedFeatures,
+		Status:  corev1.ConditionTrue,
+		Reason:  common.ReasonUnsupportedByLicense,
+		Message: "Compliance frameworks require a GitLab Premium or Ultimate license, or the provider credentials lack the permission to manage them",
+	}
+
+	type want struct {
+		upToDate   bool
+		ids        []int64
+		group      string
+		conditions []xpv1.Condition
+		err        error
+	}
+
+	cases := map[string]struct {
+		refs     *[]v1alpha1.ComplianceFrameworkReference
+		assigned []string
+		err      error
+		want     want
+	}{
+		"Unmanaged": {
+			assigned: []string{"SOX"},
+			want:     want{upToDate: true},
+		},
+		"UpToDate": {
+			refs:     &[]v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("HIPAA")}, {ID: ptr.To[int64](1)}},
+			assigned: []string{"SOX", "HIPAA"},
+			want:     want{upToDate: true, ids: []int64{2, 1}, group: "acme"},
+		},
+		"NotAssigned": {
+			refs:     &[]v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("SOX")}},
+			assigned: nil,
+			want:     want{ids: []int64{1}, group: "acme"},
+		},
+		"RemoveAll": {
+			refs:     &[]v1alpha1.ComplianceFrameworkReference{},
+			assigned: []string{"SOX"},
+			want:     want{ids: []int64{}, group: "acme"},
+		},
+		"UnknownFramework": {
+			refs: &[]v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("ISO 27001")}},
+			want: want{group: "acme", err: errors.New(`compliance framework "ISO 27001" does not exist in group acme`)},
+		},
+		"Unlicensed": {
+			refs: &[]v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("SOX")}},
+			err:  errors.New("The resource that you are attempting to access does not exist or you don't have permission to perform this action"),
+			want: want{upToDate: true, group: "acme", conditions: []xpv1.Condition{unsupported}},
+		},
+		"ErrList": {
+			refs: &[]v1alpha1.ComplianceFrameworkReference{{Name: ptr.To("SOX")}},
+			err:  errBoom,
+			want: want{group: "acme", err: errors.Wrap(errBoom, errGetComplianceFrameworks)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var group string
+			e := &external{complianceFrameworks: &fake.MockComplianceFrameworkClient{
+				MockListComplianceFrameworks: func(ctx context.Context, g string) ([]projects.ComplianceFramework, error) {
+					group = g
+					return available, tc.err
+				},
+			}}
+			cr := project(func(p *v1alpha1.Project) { p.Spec.ForProvider.ComplianceFrameworks = tc.refs })
+			prj := &gitlab.Project{PathWithNamespace: "acme/platform/service", ComplianceFrameworks: tc.assigned}
+
+			got, err := e.isComplianceFrameworksUpToDate(context.Background(), cr, prj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("isComplianceFrameworksUpToDate(...): -want error, +got error:\n%s", diff)
+			}
+			if got != tc.want.upToDate {
+				t.Errorf("isComplianceFrameworksUpToDate(...): want %t, got %t", tc.want.upToDate, got)
+			}
+			if diff := cmp.Diff(tc.want.ids, e.cache.complianceFrameworkIDs); diff != "" {
+				t.Errorf("isComplianceFrameworksUpToDate(...): -want IDs, +got IDs:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.group, group); diff != "" {
+				t.Errorf("ListComplianceFrameworks(...): -want group, +got group:\n%s", diff)
+			}
+			want := project(withConditions(tc.want.conditions...))
+			if diff := cmp.Diff(want.Status.Conditions, cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("isComplianceFrameworksUpToDate(...): -want conditions, +got conditions:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateComplianceFrameworks(t *testing.T) {
+	type want struct {
+		pid int64
+		ids []int64
+		err error
+	}
+
+	cases := map[string]struct {
+		ids  []int64
+		err  error
+		want want
+	}{
+		"Success": {
+			ids:  []int64{2, 1},
+			want: want{pid: projectID, ids: []int64{2, 1}},
+		},
+		"ErrUpdate": {
+			ids:  []int64{1},
+			err:  errBoom,
+			want: want{pid: projectID, ids: []int64{1}, err: errors.Wrap(errBoom, errUpdateComplianceFrames)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pid int64
+			var ids []int64
+			e := &external{complianceFrameworks: &fake.MockComplianceFrameworkClient{
+				MockUpdateProjectComplianceFrameworks: func(ctx context.Context, projectID int64, frameworkIDs []int64) error {
+					pid, ids = projectID, frameworkIDs
+					return tc.err
+				},
+			}}
+			e.cache.complianceFrameworkIDs = tc.ids
+			cr := project(
+				func(p *v1alpha1.Project) {
+					p.Spec.ForProvider.ComplianceFrameworks = &[]v1alpha1.ComplianceFrameworkReference{}
+				},
+				withStatus(v1alpha1.ProjectObservation{ID: projectID}),
+			)
+
+			err := e.updateComplianceFrameworks(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("updateComplianceFrameworks(...): -want error, +got error:\n%s", diff)
+			}
+			if pid != tc.want.pid {
+				t.Errorf("UpdateProjectComplianceFrameworks(...): want project %d, got %d", tc.want.pid, pid)
+			}
+			if diff := cmp.Diff(tc.want.ids, ids); diff != "" {
+				t.Errorf("UpdateProjectComplianceFrameworks(...): -want IDs, +got IDs:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipelineVariablesMinimumOverrideRole(t *testing.T) {
+	var edited *gitlab.EditProjectOptions
+	prj := &gitlab.Project{CIPipelineVariablesMinimumOverrideRole: "developer", RestrictUserDefinedVariables: true}
+	e := &external{
+		client: &fake.MockClient{
+			MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				return prj, &gitlab.Response{}, nil
+			},
+			MockGetProjectPushRules: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+				return &gitlab.ProjectPushRules{}, &gitlab.Response{}, nil
+			},
+			MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				edited = opt
+				return prj, &gitlab.Response{}, nil
+			},
+		},
+		version: &common.ServerVersion{Major: 16, Minor: 11},
+	}
+	cr := project(withClientDefaultValues(), withExternalName("1"), func(p *v1alpha1.Project) {
+		p.Spec.ForProvider.CIPipelineVariablesMinimumOverrideRole = ptr.To("maintainer")
+		p.Spec.ForProvider.RestrictUserDefinedVariables = ptr.To(true)
+	})
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): want a role the GitLab version does not support to be ignored")
+	}
+	if cr.GetCondition(common.TypeUnsupportedFeatures).Status != corev1.ConditionTrue {
+		t.Errorf("Observe(...): want the %s condition to be true", common.TypeUnsupportedFeatures)
+	}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if edited.CIPipelineVariablesMinimumOverrideRole != nil {
+		t.Errorf("Update(...): want a role the GitLab version does not support not to be sent")
+	}
+
+	e.version = &common.ServerVersion{Major: 17, Minor: 1}
+	if o, err = e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceUpToDate {
+		t.Errorf("Observe(...): want a changed role to be out of date")
+	}
+	if cr.GetCondition(common.TypeUnsupportedFeatures).Status != corev1.ConditionFalse {
+		t.Errorf("Observe(...): want the %s condition to be reset", common.TypeUnsupportedFeatures)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if diff := cmp.Diff(ptr.To("maintainer"), edited.CIPipelineVariablesMinimumOverrideRole); diff != "" {
+		t.Errorf("Update(...): -want role, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(true), edited.RestrictUserDefinedVariables); diff != "" { //nolint:staticcheck
+		t.Errorf("Update(...): -want restrictUserDefinedVariables, +got:\n%s", diff)
+	}
+}
+
+func TestImportURLSecretRotation(t *testing.T) {
+	secretVersion := "1"
+	var edited *gitlab.EditProjectOptions
+	e := &external{
+		kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			secret := obj.(*corev1.Secret)
+			secret.ResourceVersion = secretVersion
+			secret.Data = map[string][]byte{"url": []byte("https://token-" + secretVersion + "@github.com/example/repo.git")}
+			return nil
+		}},
+		client: &fake.MockClient{
+			MockGetProject: func(pid any, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				// GitLab redacts the credentials of the import URL.
+				return &gitlab.Project{ImportURL: "https://github.com/example/repo.git"}, &gitlab.Response{}, nil
+			},
+			MockGetProjectPushRules: func(pid any, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+				return &gitlab.ProjectPushRules{}, &gitlab.Response{}, nil
+			},
+			MockEditProject: func(pid any, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				edited = opt
+				return &gitlab.Project{}, &gitlab.Response{}, nil
+			},
+		},
+	}
+	cr := project(withClientDefaultValues(), withExternalName("1"), func(p *v1alpha1.Project) {
+		p.Spec.ForProvider.ImportURLSecretRef = &xpv1.LocalSecretKeySelector{
+			LocalSecretReference: xpv1.LocalSecretReference{Name: "import-url"},
+			Key:                  "url",
+		}
+	})
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): want the redacted import URL to be up to date")
+	}
+	if diff := cmp.Diff("1", cr.Status.AtProvider.ImportURLSecretVersion); diff != "" {
+		t.Errorf("Observe(...): -want secret version, +got:\n%s", diff)
+	}
+
+	cr.Spec.ForProvider.Description = ptr.To("changed")
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if edited.ImportURL != nil {
+		t.Errorf("Update(...): want an unchanged import URL not to be sent")
+	}
+	cr.Spec.ForProvider.Description = nil
+
+	secretVersion = "2"
+	if o, err = e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceUpToDate {
+		t.Errorf("Observe(...): want a changed secret to be out of date")
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if diff := cmp.Diff(ptr.To("https://token-2@github.com/example/repo.git"), edited.ImportURL); diff != "" {
+		t.Errorf("Update(...): -want import URL, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("2", cr.Status.AtProvider.ImportURLSecretVersion); diff != "" {
+		t.Errorf("Update(...): -want secret version, +got:\n%s", diff)
+	}
+
+	if o, err = e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): want the pushed import URL to be up to date")
+	}
+}
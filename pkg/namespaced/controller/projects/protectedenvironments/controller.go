@@ -23,6 +23,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -40,6 +41,9 @@ const (
 	errUpdateFailed            = "cannot update GitLab protected environment"
 	errDeleteFailed            = "cannot delete GitLab protected environment"
 	errNameMissing             = "environment name is missing from spec.forProvider.name"
+	errGetProjectFailed        = "cannot get GitLab project"
+	errSelfApprovalFailed      = "cannot update self-approval setting of GitLab project"
+	errResolveGroupsFailed     = "cannot resolve groups of GitLab protected environment"
 )
 
 // SetupProtectedEnvironment adds a controller that reconciles ProtectedEnvironments.
@@ -47,7 +51,12 @@ func SetupProtectedEnvironment(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.ProtectedEnvironmentGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProtectedEnvironmentClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{
+			kube:                 mgr.GetClient(),
+			newGitlabClientFn:    projects.NewProtectedEnvironmentClient,
+			newProjectClientFn:   projects.NewProjectClient,
+			newNamespaceClientFn: projects.NewNamespaceClient,
+		})),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -90,8 +99,10 @@ func SetupProtectedEnvironmentGated(mgr ctrl.Manager, o controller.Options) erro
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg common.Config) projects.ProtectedEnvironmentClient
+	kube                 client.Client
+	newGitlabClientFn    func(cfg common.Config) projects.ProtectedEnvironmentClient
+	newProjectClientFn   func(cfg common.Config) projects.Client
+	newNamespaceClientFn func(cfg common.Config) projects.NamespaceClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -105,11 +116,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{client: c.newGitlabClientFn(*cfg)}, nil
+	e := &external{client: c.newGitlabClientFn(*cfg), projectClient: c.newProjectClientFn(*cfg)}
+	if c.newNamespaceClientFn != nil {
+		e.namespaces = c.newNamespaceClientFn(*cfg)
+	}
+	return e, nil
 }
 
 type external struct {
-	client projects.ProtectedEnvironmentClient
+	client        projects.ProtectedEnvironmentClient
+	projectClient projects.Client
+	namespaces    projects.NamespaceClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -127,6 +144,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
+	// Groups named by path are resolved to the IDs GitLab compares against.
+	// Create and Update see the resolved IDs, as they follow Observe.
+	current := cr.Spec.ForProvider.DeepCopy()
+	if err := projects.ResolveGroupPaths(ctx, e.namespaces, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveGroupsFailed)
+	}
+
 	pe, res, err := e.client.GetProtectedEnvironment(*cr.Spec.ForProvider.ProjectID, *envName, gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -135,15 +159,25 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(projects.IsErrorProtectedEnvironmentNotFound, err), errGetFailed)
 	}
 
-	current := cr.Spec.ForProvider.DeepCopy()
 	projects.LateInitializeProtectedEnvironment(&cr.Spec.ForProvider, pe)
 
+	prj, err := e.getSelfApprovalProject(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	cr.Status.AtProvider = projects.GenerateProtectedEnvironmentObservation(pe)
+	if prj != nil {
+		cr.Status.AtProvider.PreventSelfApproval = ptr.To(!prj.AllowPipelineTriggerApproveDeployment)
+	}
 	cr.Status.SetConditions(xpv1.Available())
 
+	upToDate := projects.IsProtectedEnvironmentUpToDate(&cr.Spec.ForProvider, pe) &&
+		projects.IsSelfApprovalUpToDate(&cr.Spec.ForProvider, prj)
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        projects.IsProtectedEnvironmentUpToDate(&cr.Spec.ForProvider, pe),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -171,7 +205,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{}, e.updateSelfApproval(ctx, cr)
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -195,16 +229,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	upd := projects.GenerateUpdateProtectedEnvironmentsOptions(&cr.Spec.ForProvider, pe)
-	if upd == nil {
-		return managed.ExternalUpdate{}, nil
+	if upd != nil {
+		_, _, err = e.client.UpdateProtectedEnvironments(*cr.Spec.ForProvider.ProjectID, *envName, upd, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		}
 	}
 
-	_, _, err = e.client.UpdateProtectedEnvironments(*cr.Spec.ForProvider.ProjectID, *envName, upd, gitlab.WithContext(ctx))
+	prj, err := e.getSelfApprovalProject(ctx, cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		return managed.ExternalUpdate{}, err
+	}
+	if projects.IsSelfApprovalUpToDate(&cr.Spec.ForProvider, prj) {
+		return managed.ExternalUpdate{}, nil
 	}
 
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{}, e.updateSelfApproval(ctx, cr)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -228,6 +268,32 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
 
+// getSelfApprovalProject returns the project of the protected environment
+// when the self-approval setting is managed, and nil otherwise.
+func (e *external) getSelfApprovalProject(ctx context.Context, cr *v1alpha1.ProtectedEnvironment) (*gitlab.Project, error) {
+	if cr.Spec.ForProvider.PreventSelfApproval == nil {
+		return nil, nil
+	}
+
+	prj, _, err := e.projectClient.GetProject(*cr.Spec.ForProvider.ProjectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, errGetProjectFailed)
+	}
+	return prj, nil
+}
+
+// updateSelfApproval applies the self-approval setting to the project of the
+// protected environment when it is managed.
+func (e *external) updateSelfApproval(ctx context.Context, cr *v1alpha1.ProtectedEnvironment) error {
+	opt := projects.GenerateSelfApprovalOptions(&cr.Spec.ForProvider)
+	if opt == nil {
+		return nil
+	}
+
+	_, _, err := e.projectClient.EditProject(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errSelfApprovalFailed)
+}
+
 func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
@@ -21,20 +21,33 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/accesstokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/approvalconfigurations"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/approvalrules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/badges"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/branches"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/clusters"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/deploykeys"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/deployments"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/deploytokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/externalstatuschecks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/forks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/hooks"
 	integrationmattermost "github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/integrationmattermost"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/issues"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/mergerequests"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/pagesdomains"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/pipelineschedules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/projectsharegroups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/protectedbranches"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/protectedenvironments"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/runners"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/tags"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/terraformstates"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/variablesets"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/projects/wikipages"
 )
 
 // Setup all project controllers
@@ -49,12 +62,25 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		deploykeys.SetupDeployKey,
 		pipelineschedules.SetupPipelineSchedule,
 		approvalrules.SetupRules,
+		approvalconfigurations.SetupApprovalConfiguration,
 		runners.SetupRunner,
 		protectedbranches.SetupProtectedBranch,
 		badges.SetupBadge,
 		integrationmattermost.SetupIntegrationMattermost,
 		protectedenvironments.SetupProtectedEnvironment,
 		projectsharegroups.SetupProjectShareGroup,
+		branches.SetupBranch,
+		tags.SetupTag,
+		pagesdomains.SetupPagesDomain,
+		clusters.SetupCluster,
+		forks.SetupFork,
+		issues.SetupIssue,
+		wikipages.SetupWikiPage,
+		mergerequests.SetupMergeRequest,
+		deployments.SetupDeployment,
+		variablesets.SetupVariableSet,
+		externalstatuschecks.SetupProjectExternalStatusCheck,
+		terraformstates.SetupTerraformState,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -76,12 +102,25 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 		deploykeys.SetupDeployKeyGated,
 		pipelineschedules.SetupPipelineScheduleGated,
 		approvalrules.SetupRulesGated,
+		approvalconfigurations.SetupApprovalConfigurationGated,
 		runners.SetupRunnerGated,
 		protectedbranches.SetupProtectedBranchGated,
 		badges.SetupBadgeGated,
 		integrationmattermost.SetupIntegrationMattermostGated,
 		protectedenvironments.SetupProtectedEnvironmentGated,
 		projectsharegroups.SetupProjectShareGroupGated,
+		branches.SetupBranchGated,
+		tags.SetupTagGated,
+		pagesdomains.SetupPagesDomainGated,
+		clusters.SetupClusterGated,
+		forks.SetupForkGated,
+		issues.SetupIssueGated,
+		wikipages.SetupWikiPageGated,
+		mergerequests.SetupMergeRequestGated,
+		deployments.SetupDeploymentGated,
+		variablesets.SetupVariableSetGated,
+		externalstatuschecks.SetupProjectExternalStatusCheckGated,
+		terraformstates.SetupTerraformStateGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotTag              = "managed resource is not a GitLab tag custom resource"
+	errProjectIDMissing    = "ProjectID is missing"
+	errTagNameMissing      = "tag name is missing from spec.forProvider.tagName"
+	errGetFailed           = "cannot get GitLab tag"
+	errCreateFailed        = "cannot create GitLab tag"
+	errCreateReleaseFailed = "cannot create GitLab release for tag"
+	errDeleteFailed        = "cannot delete GitLab tag"
+	errTagProtected        = "the token is not allowed to modify tag %q, check the project's protected tag rules"
+)
+
+// SetupTag adds a controller that reconciles Tags.
+func SetupTag(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TagGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewTagClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TagGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.TagList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Tag{}).
+		Complete(r)
+}
+
+// SetupTagGated adds a controller with CRD gate support.
+func SetupTagGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupTag(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.TagGroupVersionKind.String())
+		}
+	}, v1alpha1.TagGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.TagClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Tag)
+	if !ok {
+		return nil, errors.New(errNotTag)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.TagClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Tag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTag)
+	}
+
+	tagName := cr.Spec.ForProvider.TagName
+	if tagName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	tag, res, err := e.client.GetTag(*cr.Spec.ForProvider.ProjectID, tagName, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// GitLab does not return the ref a tag was created from. The ref is
+	// immutable in the API, so the spec still holds the one it was created
+	// (or adopted) with.
+	cr.Status.AtProvider = projects.GenerateTagObservation(tag)
+	cr.Status.AtProvider.Ref = cr.Spec.ForProvider.Ref
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsTagUpToDate(&cr.Spec.ForProvider, tag),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Tag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTag)
+	}
+
+	if cr.Spec.ForProvider.TagName == "" {
+		return managed.ExternalCreation{}, errors.New(errTagNameMissing)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.create(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.TagName)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Tag)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTag)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	// Tags cannot be edited, so any drift is resolved by recreating the tag.
+	if err := e.delete(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.create(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Tag)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotTag)
+	}
+
+	if cr.Spec.ForProvider.TagName == "" {
+		return managed.ExternalDelete{}, errors.New(errTagNameMissing)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, e.delete(ctx, cr)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+func (e *external) create(ctx context.Context, cr *v1alpha1.Tag) error {
+	pid := *cr.Spec.ForProvider.ProjectID
+	tag, res, err := e.client.CreateTag(pid, projects.GenerateCreateTagOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil {
+		return wrapTagError(err, res, cr.Spec.ForProvider.TagName, errCreateFailed)
+	}
+
+	if cr.Spec.ForProvider.ReleaseDescription != nil {
+		_, res, err := e.client.CreateRelease(pid, projects.GenerateCreateTagReleaseOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+		if err != nil {
+			return wrapTagError(err, res, cr.Spec.ForProvider.TagName, errCreateReleaseFailed)
+		}
+	}
+
+	cr.Status.AtProvider = projects.GenerateTagObservation(tag)
+	cr.Status.AtProvider.Ref = cr.Spec.ForProvider.Ref
+	if cr.Spec.ForProvider.ReleaseDescription != nil {
+		cr.Status.AtProvider.ReleaseDescription = *cr.Spec.ForProvider.ReleaseDescription
+	}
+	return nil
+}
+
+func (e *external) delete(ctx context.Context, cr *v1alpha1.Tag) error {
+	res, err := e.client.DeleteTag(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return wrapTagError(err, res, cr.Spec.ForProvider.TagName, errDeleteFailed)
+	}
+	return nil
+}
+
+// wrapTagError points at protected tag rules when GitLab rejects a request
+// as forbidden, since that is by far the most common cause for tags.
+func wrapTagError(err error, res *gitlab.Response, tagName, msg string) error {
+	if clients.IsResponseForbidden(res) {
+		return errors.Wrapf(errors.Wrap(err, msg), errTagProtected, tagName)
+	}
+	return errors.Wrap(err, msg)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraformstates
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+)
+
+const (
+	errNotTerraformState = "managed resource is not a GitLab Terraform state custom resource"
+	errProjectIDMissing  = "ProjectID is missing"
+	errListFailed        = "cannot list GitLab Terraform states"
+	errLockFailed        = "cannot lock GitLab Terraform state"
+	errUnlockFailed      = "cannot unlock GitLab Terraform state"
+	errDeleteFailed      = "cannot delete GitLab Terraform state"
+
+	msgNoState = "Terraform has not pushed the state yet"
+)
+
+// SetupTerraformState adds a controller that reconciles TerraformStates.
+func SetupTerraformState(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TerraformStateGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewTerraformStateClient})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TerraformStateGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.TerraformStateList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.TerraformState{}).
+		Complete(r)
+}
+
+// SetupTerraformStateGated adds a controller with CRD gate support.
+func SetupTerraformStateGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupTerraformState(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.TerraformStateGroupVersionKind.String())
+		}
+	}, v1alpha1.TerraformStateGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.TerraformStateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TerraformState)
+	if !ok {
+		return nil, errors.New(errNotTerraformState)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.TerraformStateClient
+}
+
+// Observe lists the states of the project. States cannot be created through
+// the API, so a state Terraform has not pushed yet is reported as existing
+// but unavailable until it appears.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TerraformState)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTerraformState)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	path, err := e.projectPath(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	states, err := e.client.ListTerraformStates(ctx, path)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+	state := projects.FindTerraformState(states, cr.Spec.ForProvider.Name)
+
+	cr.Status.AtProvider = projects.GenerateTerraformStateObservation(path, state)
+	if state == nil {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(msgNoState))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsTerraformStateUpToDate(&cr.Spec.ForProvider, state),
+	}, nil
+}
+
+// Create is never called since Observe always reports the resource as
+// existing.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update locks or unlocks the state.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TerraformState)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTerraformState)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	path, err := e.projectPath(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if cr.Spec.ForProvider.Locked == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	if *cr.Spec.ForProvider.Locked {
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.LockTerraformState(ctx, path, cr.Spec.ForProvider.Name), errLockFailed)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.client.UnlockTerraformState(ctx, path, cr.Spec.ForProvider.Name), errUnlockFailed)
+}
+
+// Delete deletes the state with all its versions.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.TerraformState)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotTerraformState)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	path, err := e.projectPath(ctx, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteTerraformState(ctx, path, cr.Spec.ForProvider.Name)
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// projectPath returns the full path of the project of the state. It is only
+// looked up once, the project ID is immutable.
+func (e *external) projectPath(ctx context.Context, cr *v1alpha1.TerraformState) (string, error) {
+	if cr.Status.AtProvider.ProjectPath != "" {
+		return cr.Status.AtProvider.ProjectPath, nil
+	}
+	return e.client.GetProjectPath(ctx, *cr.Spec.ForProvider.ProjectID)
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraformstates
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	projectPath    = "acme/infra"
+	stateName      = "production"
+	createdAt      = time.Date(2026, time.May, 4, 8, 0, 0, 0, time.UTC)
+	pushedAt       = time.Date(2026, time.May, 6, 9, 30, 0, 0, time.UTC)
+)
+
+type stateModifier func(*v1alpha1.TerraformState)
+
+func withConditions(c ...xpv1.Condition) stateModifier {
+	return func(cr *v1alpha1.TerraformState) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withLocked(locked bool) stateModifier {
+	return func(cr *v1alpha1.TerraformState) { cr.Spec.ForProvider.Locked = &locked }
+}
+
+func withStatus(o v1alpha1.TerraformStateObservation) stateModifier {
+	return func(cr *v1alpha1.TerraformState) { cr.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() stateModifier {
+	return func(cr *v1alpha1.TerraformState) { cr.SetDeletionTimestamp(&metav1.Time{Time: pushedAt}) }
+}
+
+func terraformState(m ...stateModifier) *v1alpha1.TerraformState {
+	cr := &v1alpha1.TerraformState{
+		Spec: v1alpha1.TerraformStateSpec{
+			ForProvider: v1alpha1.TerraformStateParameters{
+				ProjectID: &projectID,
+				Name:      stateName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func projectPathFn(t *testing.T) func(context.Context, string) (string, error) {
+	return func(_ context.Context, project string) (string, error) {
+		if project != projectID {
+			t.Errorf("GetProjectPath(...): want project %s, got %s", projectID, project)
+		}
+		return projectPath, nil
+	}
+}
+
+func listFn(t *testing.T, states ...gitlab.TerraformState) func(context.Context, string) ([]gitlab.TerraformState, error) {
+	return func(_ context.Context, project string) ([]gitlab.TerraformState, error) {
+		if project != projectPath {
+			t.Errorf("ListTerraformStates(...): want project %s, got %s", projectPath, project)
+		}
+		return states, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	state := gitlab.TerraformState{
+		Name:          stateName,
+		CreatedAt:     createdAt,
+		LatestVersion: gitlab.TerraformStateVersion{Serial: 42, CreatedAt: pushedAt},
+	}
+	locked := state
+	locked.LockedAt = pushedAt
+	observation := v1alpha1.TerraformStateObservation{
+		ProjectPath: projectPath,
+		Name:        stateName,
+		Serial:      42,
+		CreatedAt:   &metav1.Time{Time: createdAt},
+		UpdatedAt:   &metav1.Time{Time: pushedAt},
+	}
+	lockedObservation := observation
+	lockedObservation.LockedAt = &metav1.Time{Time: pushedAt}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockTerraformStateClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: want{cr: unexpectedItem, err: errors.New(errNotTerraformState)},
+		},
+		"ProjectIDMissing": {
+			cr:   &v1alpha1.TerraformState{},
+			want: want{cr: &v1alpha1.TerraformState{}, err: errors.New(errProjectIDMissing)},
+		},
+		"NamedStateListed": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath:      projectPathFn(t),
+				MockListTerraformStates: listFn(t, gitlab.TerraformState{Name: "staging"}, state),
+			},
+			cr: terraformState(),
+			want: want{
+				cr:     terraformState(withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotPushedYet": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath:      projectPathFn(t),
+				MockListTerraformStates: listFn(t, gitlab.TerraformState{Name: "staging"}),
+			},
+			cr: terraformState(),
+			want: want{
+				cr: terraformState(
+					withStatus(v1alpha1.TerraformStateObservation{ProjectPath: projectPath}),
+					withConditions(xpv1.Unavailable().WithMessage(msgNoState)),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			client: &fake.MockTerraformStateClient{
+				MockListTerraformStates: listFn(t),
+			},
+			cr: terraformState(withDeletionTimestamp(), withStatus(observation)),
+			want: want{
+				cr: terraformState(withDeletionTimestamp(), withStatus(v1alpha1.TerraformStateObservation{ProjectPath: projectPath})),
+			},
+		},
+		"Unlocked": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath:      projectPathFn(t),
+				MockListTerraformStates: listFn(t, locked),
+			},
+			cr: terraformState(withLocked(false)),
+			want: want{
+				cr:     terraformState(withLocked(false), withStatus(lockedObservation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ListFailed": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath: projectPathFn(t),
+				MockListTerraformStates: func(_ context.Context, _ string) ([]gitlab.TerraformState, error) {
+					return nil, errBoom
+				},
+			},
+			cr:   terraformState(),
+			want: want{cr: terraformState(), err: errors.Wrap(errBoom, errListFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		cr       *v1alpha1.TerraformState
+		lock     error
+		unlock   error
+		want     error
+		wantCall string
+	}{
+		"Lock": {
+			cr:       terraformState(withLocked(true), withStatus(v1alpha1.TerraformStateObservation{ProjectPath: projectPath})),
+			wantCall: "lock",
+		},
+		"Unlock": {
+			cr:       terraformState(withLocked(false), withStatus(v1alpha1.TerraformStateObservation{ProjectPath: projectPath})),
+			wantCall: "unlock",
+		},
+		"UnlockFailed": {
+			cr:       terraformState(withLocked(false), withStatus(v1alpha1.TerraformStateObservation{ProjectPath: projectPath})),
+			unlock:   errBoom,
+			want:     errors.Wrap(errBoom, errUnlockFailed),
+			wantCall: "unlock",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var call string
+			e := &external{client: &fake.MockTerraformStateClient{
+				MockLockTerraformState: func(_ context.Context, project, name string) error {
+					call = "lock"
+					return tc.lock
+				},
+				MockUnlockTerraformState: func(_ context.Context, project, name string) error {
+					call = "unlock"
+					return tc.unlock
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if call != tc.wantCall {
+				t.Errorf("Update(...): want call %q, got %q", tc.wantCall, call)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockTerraformStateClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InvalidInput": {
+			cr:   unexpectedItem,
+			want: want{cr: unexpectedItem, err: errors.New(errNotTerraformState)},
+		},
+		"NamedStateDeleted": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath: projectPathFn(t),
+				MockDeleteTerraformState: func(_ context.Context, project, name string) (*gitlab.Response, error) {
+					if project != projectPath || name != stateName {
+						t.Errorf("DeleteTerraformState(...): want %s/%s, got %s/%s", projectPath, stateName, project, name)
+					}
+					return &gitlab.Response{}, nil
+				},
+			},
+			cr:   terraformState(),
+			want: want{cr: terraformState(withConditions(xpv1.Deleting()))},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath: projectPathFn(t),
+				MockDeleteTerraformState: func(_ context.Context, _, _ string) (*gitlab.Response, error) {
+					return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+				},
+			},
+			cr:   terraformState(),
+			want: want{cr: terraformState(withConditions(xpv1.Deleting()))},
+		},
+		"DeleteFailed": {
+			client: &fake.MockTerraformStateClient{
+				MockGetProjectPath: projectPathFn(t),
+				MockDeleteTerraformState: func(_ context.Context, _, _ string) (*gitlab.Response, error) {
+					return &gitlab.Response{}, errBoom
+				},
+			},
+			cr: terraformState(),
+			want: want{
+				cr:  terraformState(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
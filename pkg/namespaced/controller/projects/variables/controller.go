@@ -24,11 +24,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
-	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -45,7 +46,16 @@ const (
 	errCreateFailed     = "cannot create Gitlab variable"
 	errUpdateFailed     = "cannot update Gitlab variable"
 	errDeleteFailed     = "cannot delete Gitlab variable"
+	errListFailed       = "cannot list Gitlab variables"
+	errPruneFailed      = "cannot delete Gitlab variable in environment scope %q"
 	errProjectIDMissing = "ProjectID is missing"
+	errSearchFailed     = "cannot resolve ProjectID from the project path or search"
+	errResolveValue     = "cannot resolve Gitlab variable value"
+	errValueConflict    = "value is ignored since valueSecretRef is set"
+
+	reasonValueConflict event.Reason = "ValueConflict"
+
+	listPageSize = 100
 )
 
 // SetupVariable adds a controller that reconciles Variables.
@@ -53,8 +63,9 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.VariableGroupKind)
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewVariableClient}),
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), newGitlabClientFn: projects.NewVariableClient, newSearchClientFn: projects.NewSearchClient, serverVersionFn: common.GetServerVersion, etags: common.NewETagCache[gitlab.ProjectVariable]()})),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(common.WaitForDependency(mgr.GetClient(), "project", projectIDResolved)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -90,9 +101,20 @@ func SetupVariableGated(mgr ctrl.Manager, o controller.Options) error {
 	return nil
 }
 
+// projectIDResolved reports whether the ProjectID of a Variable is set, so it
+// does not wait for its project.
+func projectIDResolved(mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.Variable)
+	return !ok || cr.Spec.ForProvider.ProjectID != nil
+}
+
 type connector struct {
 	kube              client.Client
+	record            event.Recorder
 	newGitlabClientFn func(cfg common.Config) projects.VariableClient
+	newSearchClientFn func(cfg common.Config) projects.SearchClient
+	serverVersionFn   func(ctx context.Context, cfg common.Config) *common.ServerVersion
+	etags             *common.ETagCache[gitlab.ProjectVariable]
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,12 +126,27 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	var search projects.SearchClient
+	if c.newSearchClientFn != nil {
+		search = c.newSearchClientFn(*cfg)
+	}
+	return &external{kube: c.kube, record: c.record, client: c.newGitlabClientFn(*cfg), search: search, etags: c.etags, variables: common.ProjectVariableCache(*cfg), version: version, hashKey: common.VariableValueHashKey(cfg.ValueHashSecret, cr.GetUID()), sudo: common.Sudo(*cfg, cr)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.VariableClient
+	kube      client.Client
+	record    event.Recorder
+	client    projects.VariableClient
+	search    projects.SearchClient
+	etags     *common.ETagCache[gitlab.ProjectVariable]
+	variables *common.VariableCache[gitlab.ProjectVariable]
+	version   *common.ServerVersion
+	hashKey   []byte
+	sudo      string
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -117,20 +154,37 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotVariable)
 	}
+
+	// Report the variable as gone so the finalizer can be removed.
+	if common.SkipExternalDelete(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+	resolved, err := e.searchProjectID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSearchFailed)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil && common.IsWaitingForDependency(cr) {
+		return common.WaitingObservation(cr), nil
+	}
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
-	variable, res, err := e.client.GetVariable(
-		*cr.Spec.ForProvider.ProjectID,
-		cr.Spec.ForProvider.Key,
-		projects.GenerateGetVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx))
+	bound := boundParameters(cr)
+	variable, res, err := e.getVariable(ctx, cr, bound)
+	// Update moves the variable to the desired environment scope but cannot
+	// persist the external name, so the variable is looked up there as well.
+	if (err == nil && variable == nil || err != nil && clients.IsResponseNotFound(res)) && projects.EnvironmentScope(bound) != projects.EnvironmentScope(&cr.Spec.ForProvider) {
+		variable, res, err = e.getVariable(ctx, cr, &cr.Spec.ForProvider)
+	}
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
 		}
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		return managed.ExternalObservation{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, errors.Wrap(err, errGetFailed))
+	}
+	if variable == nil {
+		return managed.ExternalObservation{}, nil
 	}
 
 	// Deleting: only need to determine external resource still exists.
@@ -138,22 +192,37 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: true}, nil
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err = variables.UpdateVariableFromSecret(e.kube, mg, ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider.CommonVariableParameters); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
-		}
+	owned := cr.Spec.ForProvider.ValueSecretRef != nil || cr.Spec.ForProvider.ValueTemplate != nil
+	adopted := variables.AdoptValue(cr, &cr.Spec.ForProvider.CommonVariableParameters, owned, variable.Value, projects.IsVariableValueHidden(&cr.Spec.ForProvider, variable))
+
+	desired, err := e.resolveValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveValue)
 	}
+	connection := variables.ConnectionDetails(&desired.CommonVariableParameters)
+
+	name := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, common.VariableExternalName(variable.Key, variable.EnvironmentScope))
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	projects.LateInitializeVariable(&cr.Spec.ForProvider, variable)
+	projects.LateInitializeVariable(desired, variable)
+
+	common.SetUnsupportedFeatures(cr, e.version, common.OmitUnsupportedVariableParameters(&desired.CommonVariableParameters, &desired.MaskedAndHidden, e.version))
+	variables.IgnoreNotMaskable(cr, &desired.CommonVariableParameters)
+
+	common.SetImmutableFieldsChanged(cr, common.ChangedImmutableFields(projects.ImmutableVariableFields(desired, variable)...))
 
 	cr.Status.SetConditions(xpv1.Available())
+	valueHash := cr.Status.AtProvider.ValueHash
 	cr.Status.AtProvider = projects.GenerateVariableObservation(variable)
+	cr.Status.AtProvider.ValueHash = valueHash
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        projects.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        projects.IsVariableUpToDate(desired, variable) && e.isHiddenValueUpToDate(desired, variable, valueHash),
+		ResourceLateInitialized: resolved || adopted || !clients.IsEqual(current, &cr.Spec.ForProvider) || name != meta.GetExternalName(cr),
+		ConnectionDetails:       connection,
 	}, nil
 }
 
@@ -163,23 +232,50 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := variables.UpdateVariableFromSecret(e.kube, mg, ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider.CommonVariableParameters); err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
-		}
+	p, err := e.resolveValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
+	variables.DefaultRaw(&p.CommonVariableParameters)
+	cr.Spec.ForProvider.Raw = p.Raw
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
 
+	if err := variables.ValidateVariable(&p.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	// A variable named by the external name, e.g. one being migrated, is
+	// adopted instead of created. It is only created if GitLab returns 404.
+	if meta.GetExternalName(cr) != "" {
+		existing, res, err := e.getVariable(ctx, cr, boundParameters(cr))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetFailed)
+		}
+		if err == nil && existing != nil {
+			meta.SetExternalName(cr, common.VariableExternalName(existing.Key, existing.EnvironmentScope))
+			return managed.ExternalCreation{}, nil
+		}
+	}
+
 	cr.Status.SetConditions(xpv1.Creating())
-	_, _, err := e.client.CreateVariable(
+	variable, _, err := e.client.CreateVariable(
 		*cr.Spec.ForProvider.ProjectID,
-		projects.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx))
+		projects.GenerateCreateVariableOptions(e.supportedParameters(p)),
+		common.RequestOptions(ctx, e.sudo)...)
+	// Any write, even a failed one, may change the variables of the project.
+	e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		// GitLab may echo the value, which must never end up in events or logs.
+		return managed.ExternalCreation{}, common.RedactError(errors.Wrap(err, errCreateFailed), ptr.Deref(p.Value, ""))
+	}
+	if variable != nil {
+		variables.SetValueNotMaskable(cr, &cr.Spec.ForProvider.CommonVariableParameters, variable.Masked)
 	}
+	e.setValueHash(cr, p)
+
+	meta.SetExternalName(cr, common.VariableExternalName(cr.Spec.ForProvider.Key, projects.EnvironmentScope(&cr.Spec.ForProvider)))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -189,22 +285,54 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := variables.UpdateVariableFromSecret(e.kube, mg, ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider.CommonVariableParameters); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
-		}
+	p, err := e.resolveValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
 	}
 
-	_, _, err := e.client.UpdateVariable(
+	// GitLab can neither hide nor unhide an existing variable, changing
+	// maskedAndHidden requires creating the variable again.
+	recreated, err := common.HandleChangedImmutableFields(cr, func() error {
+		_, err := e.client.RemoveVariable(
+			*cr.Spec.ForProvider.ProjectID,
+			cr.Spec.ForProvider.Key,
+			projects.GenerateRemoveVariableOptions(boundParameters(cr)),
+			common.RequestOptions(ctx, e.sudo)...,
+		)
+		e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
+		return errors.Wrap(err, errDeleteFailed)
+	})
+	if recreated {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := variables.ValidateVariable(&p.CommonVariableParameters, e.version); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	// The filter matches the environment scope the variable is bound to, so
+	// that a changed scope in the spec moves the variable.
+	opt := projects.GenerateUpdateVariableOptions(e.supportedParameters(p))
+	opt.Filter = projects.GenerateVariableFilter(boundParameters(cr))
+
+	variable, _, err := e.client.UpdateVariable(
 		*cr.Spec.ForProvider.ProjectID,
 		cr.Spec.ForProvider.Key,
-		projects.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
+		opt,
+		common.RequestOptions(ctx, e.sudo)...,
 	)
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
+	if err != nil {
+		return managed.ExternalUpdate{}, common.RedactError(errors.Wrap(err, errUpdateFailed), ptr.Deref(p.Value, ""))
+	}
+	if variable != nil {
+		variables.SetValueNotMaskable(cr, &cr.Spec.ForProvider.CommonVariableParameters, variable.Masked)
+	}
+	e.setValueHash(cr, p)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -218,16 +346,201 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.RemoveVariable(
+	// The other scopes are pruned first, since the variable is no longer
+	// observed once it is removed and the delete would not be retried.
+	if ptr.Deref(cr.Spec.ForProvider.PruneOtherScopes, false) {
+		if err := e.pruneOtherScopes(ctx, cr); err != nil {
+			return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, err)
+		}
+	}
+	res, err := e.client.RemoveVariable(
 		*cr.Spec.ForProvider.ProjectID,
 		cr.Spec.ForProvider.Key,
-		projects.GenerateRemoveVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
+		projects.GenerateRemoveVariableOptions(boundParameters(cr)),
+		common.RequestOptions(ctx, e.sudo)...,
 	)
-	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
+	// The variable is already gone, e.g. removed outside the provider or not
+	// in the scope of the filter anymore.
+	if err != nil && clients.IsResponseNotFound(res) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, common.RecordExternalDeleteFailure(ctx, e.kube, cr, errors.Wrap(err, errDeleteFailed))
 }
 
 func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
 }
+
+// resolveValue returns a copy of the variable parameters with the value
+// read from its secret or rendered from its value template, if it has one.
+// Only whether the variable is masked and raw, which may depend on the value,
+// is set in the spec as well. The value itself is never written to the spec,
+// which would store it in plain text.
+func (e *external) resolveValue(ctx context.Context, cr *v1alpha1.Variable) (*v1alpha1.VariableParameters, error) {
+	p := cr.Spec.ForProvider.DeepCopy()
+	// A masked and hidden variable is always masked.
+	if ptr.Deref(p.MaskedAndHidden, false) {
+		p.Masked = ptr.To(true)
+	}
+	switch {
+	case p.ValueSecretRef != nil:
+		if p.Value != nil {
+			e.record.Event(cr, event.Warning(reasonValueConflict, errors.New(errValueConflict)))
+		}
+		if err := variables.UpdateVariableFromSecret(e.kube, cr, ctx, p.ValueSecretRef, &p.CommonVariableParameters); err != nil {
+			return nil, err
+		}
+	case p.ValueTemplate != nil:
+		selectors := make(map[string]*xpv1.LocalSecretKeySelector, len(p.ValueTemplate.SecretKeyRefs))
+		for i := range p.ValueTemplate.SecretKeyRefs {
+			ref := &p.ValueTemplate.SecretKeyRefs[i]
+			selectors[ref.Name] = &ref.SecretKeyRef
+		}
+		if err := variables.UpdateVariableFromTemplate(e.kube, cr, ctx, p.ValueTemplate.Template, selectors, &p.CommonVariableParameters); err != nil {
+			return nil, err
+		}
+	}
+	variables.ResolveMaskIfPossible(cr, &p.CommonVariableParameters, p.MaskIfPossible, e.version)
+	cr.Spec.ForProvider.Masked = p.Masked
+	cr.Spec.ForProvider.Raw = p.Raw
+	return p, nil
+}
+
+// isHiddenValueUpToDate compares the hash of the desired value with the one
+// last applied if GitLab did not return the value of the masked variable.
+// Without a hash the value is applied once to record it.
+func (e *external) isHiddenValueUpToDate(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariable, valueHash string) bool {
+	if p.Value == nil || !projects.IsVariableValueHidden(p, g) {
+		return true
+	}
+	return common.HashVariableValue(e.hashKey, *p.Value) == valueHash
+}
+
+// setValueHash records the hash of the value applied to GitLab if it is
+// masked, since GitLab may not return it then.
+func (e *external) setValueHash(cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) {
+	if p.Value != nil && ptr.Deref(p.Masked, false) {
+		cr.Status.AtProvider.ValueHash = common.HashVariableValue(e.hashKey, *p.Value)
+	}
+}
+
+// searchProjectID resolves the ProjectID from the ProjectPath or, without
+// one, the ProjectIDSearch unless it is already set. It returns true if the
+// ProjectID was resolved.
+func (e *external) searchProjectID(ctx context.Context, cr *v1alpha1.Variable) (bool, error) {
+	p := &cr.Spec.ForProvider
+	if p.ProjectID != nil || p.ProjectPath == nil && p.ProjectIDSearch == nil || e.search == nil {
+		return false, nil
+	}
+	var id int64
+	var err error
+	if p.ProjectPath != nil {
+		id, err = projects.ProjectIDByPath(ctx, e.search, *p.ProjectPath)
+	} else {
+		id, err = projects.SearchProjectID(ctx, e.search, p.ProjectIDSearch)
+	}
+	if err != nil {
+		return false, err
+	}
+	cr.Spec.ForProvider.ProjectID = &id
+	return true, nil
+}
+
+// supportedParameters returns a copy of the variable parameters without the
+// ones the GitLab server does not support.
+func (e *external) supportedParameters(p *v1alpha1.VariableParameters) *v1alpha1.VariableParameters {
+	p = p.DeepCopy()
+	common.OmitUnsupportedVariableParameters(&p.CommonVariableParameters, &p.MaskedAndHidden, e.version)
+	return p
+}
+
+// getVariable returns the project variable identified by the key and
+// environment scope of the given parameters, or nil if GitLab returned a
+// variable with another identity. With the variable cache enabled it is
+// looked up in the cached variables of the project.
+func (e *external) getVariable(ctx context.Context, cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	if e.variables != nil {
+		variables, err := e.listVariables(ctx, cr, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return projects.FindVariable(variables, p), nil, nil
+	}
+	etagKey := common.ETagCacheKey(cr, *p.ProjectID, p.Key, ptr.Deref(p.EnvironmentScope, ""))
+	variable, res, err := e.client.GetVariable(
+		*p.ProjectID,
+		p.Key,
+		projects.GenerateGetVariableOptions(p),
+		append(e.etags.RequestOptions(etagKey), common.RequestOptions(ctx, e.sudo)...)...)
+	variable, err = e.etags.Resolve(etagKey, variable, res, err)
+	// A GET filtered by environment scope answers 404 both if the key does
+	// not exist and if it only exists in other scopes, and has been seen to
+	// miss a variable in the requested scope. The unfiltered list tells these
+	// apart, so that a variable is only created if its scope really lacks it
+	// rather than failing as a duplicate key.
+	if err != nil && clients.IsResponseNotFound(res) {
+		variables, lerr := e.listVariables(ctx, cr, p)
+		if lerr != nil {
+			return nil, nil, lerr
+		}
+		if v := projects.ScopeVariable(variables, p); v != nil {
+			return v, nil, nil
+		}
+	}
+	if err != nil || !projects.IsProjectVariable(p, variable) {
+		return nil, res, err
+	}
+	return variable, res, nil
+}
+
+// boundParameters returns a copy of the variable parameters identifying the
+// variable the resource is bound to. The environment scope is taken from the
+// external name unless it only holds the key, as it did for resources created
+// before the environment scope was part of it, or names another key.
+func boundParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	key, scope := common.ParseVariableExternalName(meta.GetExternalName(cr))
+	if key == p.Key && scope != nil {
+		p.EnvironmentScope = scope
+	}
+	return p
+}
+
+// pruneOtherScopes removes the variables with the key of the variable in all
+// environment scopes other than the one it is bound to.
+func (e *external) pruneOtherScopes(ctx context.Context, cr *v1alpha1.Variable) error {
+	bound := boundParameters(cr)
+	variables, err := e.listVariables(ctx, cr, bound)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range projects.OtherScopeVariables(variables, bound) {
+		res, err := e.client.RemoveVariable(
+			*bound.ProjectID,
+			v.Key,
+			&gitlab.RemoveProjectVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope}},
+			common.RequestOptions(ctx, e.sudo)...,
+		)
+		e.variables.Invalidate(projects.VariableCacheKey(*bound.ProjectID))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errPruneFailed, v.EnvironmentScope)
+		}
+	}
+	return nil
+}
+
+// listVariables returns all variables of the project of the variable
+// parameters, in every environment scope.
+func (e *external) listVariables(ctx context.Context, cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) ([]*gitlab.ProjectVariable, error) {
+	all, err := e.variables.List(projects.VariableCacheKey(*p.ProjectID), func(page int64, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		opt := projects.GenerateListVariablesOptions(page, listPageSize)
+		return e.client.ListVariables(*p.ProjectID, opt, append(options, common.RequestOptions(ctx, e.sudo)...)...)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListFailed)
+	}
+	return all, nil
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variablesets
+
+import (
+	"context"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	variables "github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/controller/common/variables"
+)
+
+const (
+	errNotVariableSet   = "managed resource is not a Gitlab variable set custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errSourceMissing    = "one of configMapRef or secretRef is required"
+	errGetConfigMap     = "cannot get variables from config map"
+	errGetSecret        = "cannot get variables from secret"
+	errDuplicateKey     = "key %s is set in both the config map and the secret"
+	errInvalidValue     = "invalid value of key %s"
+	errVariableExists   = "variable %s already exists in GitLab, set adoptExisting to take it over"
+	errListFailed       = "cannot list Gitlab variables"
+	errCreateFailed     = "cannot create Gitlab variable %s"
+	errUpdateFailed     = "cannot update Gitlab variable %s"
+	errDeleteFailed     = "cannot delete Gitlab variable %s"
+
+	listPageSize = 100
+)
+
+// SetupVariableSet adds a controller that reconciles VariableSets.
+func SetupVariableSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.VariableSetGroupKind)
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(common.WithTransientServerErrors(o.Features, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewVariableClient, serverVersionFn: common.GetServerVersion})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.VariableSetGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.VariableSetList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.VariableSet{}).
+		Complete(r)
+}
+
+// SetupVariableSetGated adds a controller with CRD gate support.
+func SetupVariableSetGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupVariableSet(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1alpha1.VariableSetGroupVersionKind.String())
+		}
+	}, v1alpha1.VariableSetGroupVersionKind)
+	return nil
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg common.Config) projects.VariableClient
+	serverVersionFn   func(ctx context.Context, cfg common.Config) *common.ServerVersion
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VariableSet)
+	if !ok {
+		return nil, errors.New(errNotVariableSet)
+	}
+	cfg, err := common.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	var version *common.ServerVersion
+	if c.serverVersionFn != nil {
+		version = c.serverVersionFn(ctx, *cfg)
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), variables: common.ProjectVariableCache(*cfg), version: version, sudo: common.Sudo(*cfg, cr)}, nil
+}
+
+type external struct {
+	kube      client.Client
+	client    projects.VariableClient
+	variables *common.VariableCache[gitlab.ProjectVariable]
+	version   *common.ServerVersion
+	sudo      string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VariableSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVariableSet)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	observed, err := e.listVariables(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The set tracks the keys it synced in its status, so that the variables
+	// of keys removed from the sources are deleted as well. Variables of
+	// other keys are only synced by Create and Update, which refuse to take
+	// over existing ones unless adoptExisting is set.
+	adopted := adoptedKeys(cr)
+	existing := setVariables(observed, managedKeys(cr))
+	cr.Status.AtProvider = generateObservation(existing, adopted)
+
+	// Deleting: only the variables the set created and that are left matter.
+	if meta.WasDeleted(cr) {
+		for key := range existing {
+			if !adopted[key] {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			}
+		}
+		return managed.ExternalObservation{}, nil
+	}
+
+	desired, err := e.desiredVariables(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		// A set without keys has nothing to create.
+		ResourceExists:   len(existing) > 0 || len(desired) == 0,
+		ResourceUpToDate: isVariableSetUpToDate(desired, existing),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VariableSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVariableSet)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.sync(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VariableSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVariableSet)
+	}
+
+	return managed.ExternalUpdate{}, e.sync(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.VariableSet)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotVariableSet)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	defer e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
+	for _, v := range cr.Status.AtProvider.Variables {
+		// Adopted variables existed before the set and are left in GitLab.
+		if v.Adopted {
+			continue
+		}
+		if err := e.removeVariable(ctx, cr, v.Key); err != nil {
+			return managed.ExternalDelete{}, err
+		}
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// sync creates and updates the variables of the keys of the sources and
+// deletes those the set created whose key was removed from them. Variables
+// that already exist with a key of the sources are only taken over if
+// adoptExisting is set.
+func (e *external) sync(ctx context.Context, cr *v1alpha1.VariableSet) error {
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	desired, err := e.desiredVariables(ctx, cr)
+	if err != nil {
+		return err
+	}
+	observed, err := e.listVariables(ctx, cr)
+	if err != nil {
+		return err
+	}
+	adopted := adoptedKeys(cr)
+	existing := setVariables(observed, managedKeys(cr))
+	// Any write, even a failed one, may change the variables of the project.
+	defer e.variables.Invalidate(projects.VariableCacheKey(*cr.Spec.ForProvider.ProjectID))
+	// The variables synced so far are recorded even if a later one fails, so
+	// that the set does not mistake the ones it created for existing ones.
+	defer func() { cr.Status.AtProvider = generateObservation(existing, adopted) }()
+
+	for _, key := range sortedKeys(desired) {
+		p := desired[key]
+		v, ok := existing[key]
+		if o, found := observed[key]; !ok && found {
+			if !ptr.Deref(cr.Spec.ForProvider.AdoptExisting, false) {
+				return errors.Errorf(errVariableExists, key)
+			}
+			v, ok = o, true
+			existing[key] = o
+			adopted[key] = true
+		}
+		switch {
+		case !ok:
+			v, _, err = e.client.CreateVariable(*cr.Spec.ForProvider.ProjectID, projects.GenerateCreateVariableOptions(p), common.RequestOptions(ctx, e.sudo)...)
+			if err != nil {
+				return common.RedactError(errors.Wrapf(err, errCreateFailed, key), ptr.Deref(p.Value, ""))
+			}
+			delete(adopted, key)
+		case !projects.IsVariableUpToDate(p, v):
+			v, _, err = e.client.UpdateVariable(*cr.Spec.ForProvider.ProjectID, key, projects.GenerateUpdateVariableOptions(p), common.RequestOptions(ctx, e.sudo)...)
+			if err != nil {
+				return common.RedactError(errors.Wrapf(err, errUpdateFailed, key), ptr.Deref(p.Value, ""))
+			}
+		}
+		if v != nil {
+			existing[key] = v
+		}
+	}
+
+	for _, key := range sortedKeys(existing) {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if !adopted[key] {
+			if err := e.removeVariable(ctx, cr, key); err != nil {
+				return err
+			}
+		}
+		delete(existing, key)
+		delete(adopted, key)
+	}
+	return nil
+}
+
+// desiredVariables returns the parameters of the variables of the keys of
+// the config map and the secret of the set.
+func (e *external) desiredVariables(ctx context.Context, cr *v1alpha1.VariableSet) (map[string]*v1alpha1.VariableParameters, error) {
+	p := &cr.Spec.ForProvider
+	if p.ConfigMapRef == nil && p.SecretRef == nil {
+		return nil, errors.New(errSourceMissing)
+	}
+
+	var configMap, secret map[string][]byte
+	var err error
+	if p.ConfigMapRef != nil {
+		if configMap, err = common.GetLocalConfigMapData(ctx, e.kube, cr, p.ConfigMapRef); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+	}
+	if p.SecretRef != nil {
+		if secret, err = common.GetLocalSecretData(ctx, e.kube, cr, p.SecretRef); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+	}
+	return generateVariableParameters(p, configMap, secret, e.version)
+}
+
+// generateVariableParameters returns the parameters of a variable for each
+// key of the config map and the secret. Values of the secret are masked, and
+// a value the GitLab version cannot mask is an error.
+func generateVariableParameters(p *v1alpha1.VariableSetParameters, configMap, secret map[string][]byte, version *common.ServerVersion) (map[string]*v1alpha1.VariableParameters, error) {
+	desired := make(map[string]*v1alpha1.VariableParameters, len(configMap)+len(secret))
+	for key, value := range configMap {
+		v := newVariableParameters(p, key)
+		v.Value = ptr.To(string(value))
+		v.Masked = ptr.To(false)
+		desired[key] = v
+	}
+	for key, value := range secret {
+		if _, ok := desired[key]; ok {
+			return nil, errors.Errorf(errDuplicateKey, key)
+		}
+		v := newVariableParameters(p, key)
+		variables.SetSecretValue(&v.CommonVariableParameters, string(value))
+		if err := variables.ValidateVariable(&v.CommonVariableParameters, version); err != nil {
+			return nil, errors.Wrapf(err, errInvalidValue, key)
+		}
+		desired[key] = v
+	}
+	return desired, nil
+}
+
+func newVariableParameters(p *v1alpha1.VariableSetParameters, key string) *v1alpha1.VariableParameters {
+	return &v1alpha1.VariableParameters{
+		CommonVariableParameters: commonv1alpha1.CommonVariableParameters{
+			Key:          key,
+			VariableType: ptr.To(commonv1alpha1.VariableTypeEnvVar),
+			Protected:    p.Protected,
+		},
+		EnvironmentScope: ptr.To(ptr.Deref(p.EnvironmentScope, common.DefaultEnvironmentScope)),
+	}
+}
+
+// listVariables returns the variables of the project in the environment
+// scope of the set by their key.
+func (e *external) listVariables(ctx context.Context, cr *v1alpha1.VariableSet) (map[string]*gitlab.ProjectVariable, error) {
+	pid := *cr.Spec.ForProvider.ProjectID
+	all, err := e.variables.List(projects.VariableCacheKey(pid), func(page int64, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		opt := projects.GenerateListVariablesOptions(page, listPageSize)
+		return e.client.ListVariables(pid, opt, append(options, common.RequestOptions(ctx, e.sudo)...)...)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListFailed)
+	}
+	scope := ptr.Deref(cr.Spec.ForProvider.EnvironmentScope, common.DefaultEnvironmentScope)
+	observed := map[string]*gitlab.ProjectVariable{}
+	for _, v := range all {
+		if v != nil && v.EnvironmentScope == scope {
+			observed[v.Key] = v
+		}
+	}
+	return observed, nil
+}
+
+func (e *external) removeVariable(ctx context.Context, cr *v1alpha1.VariableSet, key string) error {
+	res, err := e.client.RemoveVariable(
+		*cr.Spec.ForProvider.ProjectID,
+		key,
+		&gitlab.RemoveProjectVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: ptr.Deref(cr.Spec.ForProvider.EnvironmentScope, common.DefaultEnvironmentScope)}},
+		common.RequestOptions(ctx, e.sudo)...,
+	)
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrapf(err, errDeleteFailed, key)
+	}
+	return nil
+}
+
+// managedKeys returns the keys of the variables the set synced before.
+func managedKeys(cr *v1alpha1.VariableSet) map[string]bool {
+	keys := make(map[string]bool, len(cr.Status.AtProvider.Variables))
+	for _, v := range cr.Status.AtProvider.Variables {
+		keys[v.Key] = true
+	}
+	return keys
+}
+
+// adoptedKeys returns the keys of the variables the set adopted before.
+func adoptedKeys(cr *v1alpha1.VariableSet) map[string]bool {
+	keys := map[string]bool{}
+	for _, v := range cr.Status.AtProvider.Variables {
+		if v.Adopted {
+			keys[v.Key] = true
+		}
+	}
+	return keys
+}
+
+// setVariables returns the observed variables with the given keys.
+func setVariables(observed map[string]*gitlab.ProjectVariable, keys map[string]bool) map[string]*gitlab.ProjectVariable {
+	existing := map[string]*gitlab.ProjectVariable{}
+	for key := range keys {
+		if v, ok := observed[key]; ok {
+			existing[key] = v
+		}
+	}
+	return existing
+}
+
+// isVariableSetUpToDate returns true if every key has an up to date variable
+// and no variable of a removed key is left.
+func isVariableSetUpToDate(desired map[string]*v1alpha1.VariableParameters, existing map[string]*gitlab.ProjectVariable) bool {
+	for key, p := range desired {
+		if !projects.IsVariableUpToDate(p, existing[key]) {
+			return false
+		}
+	}
+	for key := range existing {
+		if _, ok := desired[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func generateObservation(existing map[string]*gitlab.ProjectVariable, adopted map[string]bool) v1alpha1.VariableSetObservation {
+	o := v1alpha1.VariableSetObservation{}
+	for _, key := range sortedKeys(existing) {
+		o.Variables = append(o.Variables, v1alpha1.VariableSetVariable{Key: key, Masked: existing[key].Masked, Adopted: adopted[key]})
+	}
+	return o
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variablesets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/common/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = int64(1234)
+	token     = "glpat-0123456789abcdef"
+)
+
+// sources are the ConfigMap and Secret a variable set is synced from.
+type sources struct {
+	configMap map[string]string
+	secret    map[string]string
+}
+
+func (s *sources) kube() client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *corev1.ConfigMap:
+				if s.configMap == nil || key.Name != "variables" {
+					return kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+				}
+				o.Data = s.configMap
+			case *corev1.Secret:
+				if s.secret == nil || key.Name != "variables" {
+					return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+				}
+				o.Data = map[string][]byte{}
+				for k, v := range s.secret {
+					o.Data[k] = []byte(v)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// project keeps the variables of a project in memory.
+type project struct {
+	variables map[string]*gitlab.ProjectVariable
+	removed   []string
+}
+
+func (p *project) client() *fake.MockClient {
+	return &fake.MockClient{
+		MockListVariables: func(pid any, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+			var all []*gitlab.ProjectVariable
+			for _, key := range sortedKeys(p.variables) {
+				all = append(all, p.variables[key])
+			}
+			return all, &gitlab.Response{}, nil
+		},
+		MockCreateVariable: func(pid any, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+			v := &gitlab.ProjectVariable{
+				Key:              *opt.Key,
+				Value:            *opt.Value,
+				VariableType:     *opt.VariableType,
+				Protected:        ptr.Deref(opt.Protected, false),
+				Masked:           ptr.Deref(opt.Masked, false),
+				Raw:              ptr.Deref(opt.Raw, false),
+				EnvironmentScope: *opt.EnvironmentScope,
+			}
+			p.variables[v.Key] = v
+			return v, &gitlab.Response{}, nil
+		},
+		MockUpdateVariable: func(pid any, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+			v := p.variables[key]
+			v.Value = *opt.Value
+			v.Masked = ptr.Deref(opt.Masked, v.Masked)
+			v.Raw = ptr.Deref(opt.Raw, v.Raw)
+			return v, &gitlab.Response{}, nil
+		},
+		MockRemoveVariable: func(pid any, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			if _, ok := p.variables[key]; !ok {
+				return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+			}
+			delete(p.variables, key)
+			p.removed = append(p.removed, key)
+			return &gitlab.Response{}, nil
+		},
+	}
+}
+
+func variableSet() *v1alpha1.VariableSet {
+	return &v1alpha1.VariableSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "default"},
+		Spec: v1alpha1.VariableSetSpec{ForProvider: v1alpha1.VariableSetParameters{
+			ProjectID:    &projectID,
+			ConfigMapRef: &commonv1alpha1.LocalConfigMapReference{Name: "variables"},
+			SecretRef:    &xpv1.LocalSecretReference{Name: "variables"},
+		}},
+	}
+}
+
+func envVar(key, value string, masked, raw bool) *gitlab.ProjectVariable {
+	return &gitlab.ProjectVariable{
+		Key:              key,
+		Value:            value,
+		VariableType:     gitlab.EnvVariableType,
+		Masked:           masked,
+		Raw:              raw,
+		EnvironmentScope: common.DefaultEnvironmentScope,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		result managed.ExternalObservation
+		status v1alpha1.VariableSetObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		sources   sources
+		variables map[string]*gitlab.ProjectVariable
+		status    []v1alpha1.VariableSetVariable
+		want      want
+	}{
+		"NotSynced": {
+			sources:   sources{configMap: map[string]string{"REGION": "eu-west-1"}, secret: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{},
+			want:      want{result: managed.ExternalObservation{}},
+		},
+		"UpToDate": {
+			sources: sources{configMap: map[string]string{"REGION": "eu-west-1"}, secret: map[string]string{"TOKEN": token}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+				"TOKEN":  envVar("TOKEN", token, true, true),
+				"OTHER":  envVar("OTHER", "unmanaged", false, false),
+			},
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "TOKEN", Masked: true}},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: v1alpha1.VariableSetObservation{Variables: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "TOKEN", Masked: true}}},
+			},
+		},
+		"ValueChanged": {
+			sources: sources{configMap: map[string]string{"REGION": "us-east-1"}, secret: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+			},
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION"}},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+				status: v1alpha1.VariableSetObservation{Variables: []v1alpha1.VariableSetVariable{{Key: "REGION"}}},
+			},
+		},
+		"ExistingVariableNotSynced": {
+			sources: sources{configMap: map[string]string{"REGION": "eu-west-1"}, secret: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+			},
+			want: want{result: managed.ExternalObservation{}},
+		},
+		"AdoptedVariable": {
+			sources: sources{configMap: map[string]string{"REGION": "eu-west-1"}, secret: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+			},
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION", Adopted: true}},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: v1alpha1.VariableSetObservation{Variables: []v1alpha1.VariableSetVariable{{Key: "REGION", Adopted: true}}},
+			},
+		},
+		"KeyRemoved": {
+			sources: sources{configMap: map[string]string{"REGION": "eu-west-1"}, secret: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+				"STAGE":  envVar("STAGE", "prod", false, false),
+			},
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "STAGE"}},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+				status: v1alpha1.VariableSetObservation{Variables: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "STAGE"}}},
+			},
+		},
+		"UnmaskableSecretValue": {
+			sources: sources{secret: map[string]string{"PIN": "1234"}, configMap: map[string]string{}},
+			variables: map[string]*gitlab.ProjectVariable{
+				"PIN": envVar("PIN", "1234", false, true),
+			},
+			status: []v1alpha1.VariableSetVariable{{Key: "PIN"}},
+			want: want{
+				err: errors.Wrapf(errors.New("masked variables must have a value of at least 8 characters, the value has 4"), errInvalidValue, "PIN"),
+			},
+		},
+		"DuplicateKey": {
+			sources:   sources{configMap: map[string]string{"TOKEN": "a"}, secret: map[string]string{"TOKEN": token}},
+			variables: map[string]*gitlab.ProjectVariable{},
+			want:      want{err: errors.Errorf(errDuplicateKey, "TOKEN")},
+		},
+		"SecretMissing": {
+			sources:   sources{configMap: map[string]string{"REGION": "eu-west-1"}},
+			variables: map[string]*gitlab.ProjectVariable{},
+			want:      want{err: errors.Wrap(errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "variables"), common.ErrSecretNotFound), errGetSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &project{variables: tc.variables}
+			e := &external{kube: tc.sources.kube(), client: p.client()}
+			cr := variableSet()
+			cr.Status.AtProvider.Variables = tc.status
+
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider); diff != "" {
+					t.Errorf("Observe(...): -want status, +got status:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConvergence(t *testing.T) {
+	s := &sources{
+		configMap: map[string]string{"REGION": "eu-west-1", "STAGE": "prod"},
+		secret:    map[string]string{"TOKEN": token},
+	}
+	p := &project{variables: map[string]*gitlab.ProjectVariable{
+		"OTHER": envVar("OTHER", "unmanaged", false, false),
+	}}
+	e := &external{kube: s.kube(), client: p.client()}
+	cr := variableSet()
+
+	reconcile := func(step string) {
+		t.Helper()
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("%s: Observe(...): %v", step, err)
+		}
+		switch {
+		case !o.ResourceExists:
+			_, err = e.Create(context.Background(), cr)
+		case !o.ResourceUpToDate:
+			_, err = e.Update(context.Background(), cr)
+		}
+		if err != nil {
+			t.Fatalf("%s: sync: %v", step, err)
+		}
+		if o, err = e.Observe(context.Background(), cr); err != nil || !o.ResourceUpToDate {
+			t.Fatalf("%s: Observe(...): want up to date, got %+v, %v", step, o, err)
+		}
+	}
+	values := func() map[string]string {
+		got := map[string]string{}
+		for k, v := range p.variables {
+			got[k] = v.Value
+		}
+		return got
+	}
+
+	reconcile("Add")
+	want := map[string]string{"OTHER": "unmanaged", "REGION": "eu-west-1", "STAGE": "prod", "TOKEN": token}
+	if diff := cmp.Diff(want, values()); diff != "" {
+		t.Errorf("Add: -want variables, +got:\n%s", diff)
+	}
+	if !p.variables["TOKEN"].Masked || p.variables["REGION"].Masked {
+		t.Errorf("Add: want only the secret value masked")
+	}
+
+	s.configMap["REGION"] = "us-east-1"
+	s.secret["DEPLOY_KEY"] = "deploy-key-value"
+	reconcile("Change")
+	want = map[string]string{"DEPLOY_KEY": "deploy-key-value", "OTHER": "unmanaged", "REGION": "us-east-1", "STAGE": "prod", "TOKEN": token}
+	if diff := cmp.Diff(want, values()); diff != "" {
+		t.Errorf("Change: -want variables, +got:\n%s", diff)
+	}
+
+	delete(s.configMap, "STAGE")
+	delete(s.secret, "TOKEN")
+	reconcile("Remove")
+	want = map[string]string{"DEPLOY_KEY": "deploy-key-value", "OTHER": "unmanaged", "REGION": "us-east-1"}
+	if diff := cmp.Diff(want, values()); diff != "" {
+		t.Errorf("Remove: -want variables, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"STAGE", "TOKEN"}, p.removed); diff != "" {
+		t.Errorf("Remove: -want removed, +got:\n%s", diff)
+	}
+	wantStatus := []v1alpha1.VariableSetVariable{{Key: "DEPLOY_KEY", Masked: true}, {Key: "REGION"}}
+	if diff := cmp.Diff(wantStatus, cr.Status.AtProvider.Variables); diff != "" {
+		t.Errorf("Remove: -want status, +got:\n%s", diff)
+	}
+}
+
+func TestSyncExistingVariable(t *testing.T) {
+	type want struct {
+		value  string
+		status []v1alpha1.VariableSetVariable
+		err    error
+	}
+
+	cases := map[string]struct {
+		adoptExisting *bool
+		want          want
+	}{
+		"NotAdoptedByDefault": {
+			want: want{
+				value: "eu-west-1",
+				err:   errors.Errorf(errVariableExists, "REGION"),
+			},
+		},
+		"Adopted": {
+			adoptExisting: ptr.To(true),
+			want: want{
+				value:  "us-east-1",
+				status: []v1alpha1.VariableSetVariable{{Key: "REGION", Adopted: true}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &sources{configMap: map[string]string{"REGION": "us-east-1"}, secret: map[string]string{}}
+			p := &project{variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+			}}
+			e := &external{kube: s.kube(), client: p.client()}
+			cr := variableSet()
+			cr.Spec.ForProvider.AdoptExisting = tc.adoptExisting
+
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, p.variables["REGION"].Value); diff != "" {
+				t.Errorf("Create(...): -want value, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider.Variables); diff != "" {
+				t.Errorf("Create(...): -want status, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		removed []string
+		exists  bool
+	}
+
+	cases := map[string]struct {
+		status []v1alpha1.VariableSetVariable
+		want   want
+	}{
+		"CreatedVariables": {
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "GONE"}},
+			want:   want{removed: []string{"REGION"}},
+		},
+		"AdoptedVariablesKept": {
+			status: []v1alpha1.VariableSetVariable{{Key: "REGION"}, {Key: "STAGE", Adopted: true}},
+			want:   want{removed: []string{"REGION"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &project{variables: map[string]*gitlab.ProjectVariable{
+				"REGION": envVar("REGION", "eu-west-1", false, false),
+				"STAGE":  envVar("STAGE", "prod", false, false),
+				"OTHER":  envVar("OTHER", "unmanaged", false, false),
+			}}
+			e := &external{client: p.client()}
+			cr := variableSet()
+			cr.Status.AtProvider.Variables = tc.status
+
+			if _, err := e.Delete(context.Background(), cr); err != nil {
+				t.Fatalf("Delete(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.removed, p.removed); diff != "" {
+				t.Errorf("Delete(...): -want removed, +got:\n%s", diff)
+			}
+			if _, ok := p.variables["OTHER"]; !ok {
+				t.Errorf("Delete(...): want variables not managed by the set to be kept")
+			}
+
+			now := metav1.Now()
+			cr.SetDeletionTimestamp(&now)
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.exists, o.ResourceExists); diff != "" {
+				t.Errorf("Observe(...): -want exists, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,530 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wikipages
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/namespaced/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/common"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/namespaced/clients/projects/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	title          = "Incident Runbook"
+	slug           = "Incident-Runbook"
+	content        = "# Incident Runbook\n\nPage the on-call engineer."
+	markdown       = "markdown"
+
+	gitlabWiki = &gitlab.Wiki{
+		Title:    title,
+		Slug:     slug,
+		Content:  content,
+		Format:   gitlab.WikiFormatMarkdown,
+		Encoding: "UTF-8",
+	}
+	renamedWiki = &gitlab.Wiki{
+		Title:    "Incident Handbook",
+		Slug:     "Incident-Handbook",
+		Content:  content,
+		Format:   gitlab.WikiFormatMarkdown,
+		Encoding: "UTF-8",
+	}
+)
+
+type args struct {
+	wiki projects.WikiPageClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type wikiPageModifier func(*v1alpha1.WikiPage)
+
+func withConditions(c ...xpv1.Condition) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.WikiPageObservation) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { meta.SetExternalName(r, n) }
+}
+
+func withTitle(t string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.Title = t }
+}
+
+func withContent(c *string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.Content = c }
+}
+
+func withContentSecretRef() wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) {
+		r.Spec.ForProvider.Content = nil
+		r.Spec.ForProvider.ContentSecretRef = common.TestCreateLocalSecretKeySelector("runbook", "content")
+	}
+}
+
+func withFormat(f string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.Format = &f }
+}
+
+func withDefaultSpec() wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) {
+		r.Spec.ForProvider.ProjectID = &projectID
+		r.Spec.ForProvider.Title = title
+		r.Spec.ForProvider.Content = &content
+	}
+}
+
+func wikiPage(m ...wikiPageModifier) *v1alpha1.WikiPage {
+	cr := &v1alpha1.WikiPage{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func contentSecret(value string) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*corev1.Secret) = corev1.Secret{
+				Data: map[string][]byte{"content": []byte(value)},
+			}
+			return nil
+		}),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	getWikiPage := func(w *gitlab.Wiki, res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockGetWikiPage: func(pid any, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+				return w, res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: wikiPage(withDefaultSpec()),
+			},
+			want: want{
+				cr: wikiPage(withDefaultSpec()),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				wiki: getWikiPage(nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withDefaultSpec(), withExternalName(slug)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"WikiPageNotFound": {
+			args: args{
+				wiki: getWikiPage(nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+		},
+		"UpToDateLateInitializesFormat": {
+			args: args{
+				wiki: getWikiPage(gitlabWiki, &gitlab.Response{}, nil),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withFormat(markdown),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(gitlabWiki)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				wiki: getWikiPage(gitlabWiki, &gitlab.Response{}, nil),
+				cr:   wikiPage(withDefaultSpec(), withContent(gitlab.Ptr("# Outdated")), withFormat(markdown), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withContent(gitlab.Ptr("# Outdated")),
+					withFormat(markdown),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(gitlabWiki)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ContentFromSecret": {
+			args: args{
+				wiki: getWikiPage(gitlabWiki, &gitlab.Response{}, nil),
+				kube: contentSecret("# Rotated"),
+				cr:   wikiPage(withDefaultSpec(), withContentSecretRef(), withFormat(markdown), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withContentSecretRef(),
+					withFormat(markdown),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(gitlabWiki)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ContentMissing": {
+			args: args{
+				wiki: getWikiPage(gitlabWiki, &gitlab.Response{}, nil),
+				cr:   wikiPage(withDefaultSpec(), withContent(nil), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withDefaultSpec(), withContent(nil), withExternalName(slug)),
+				err: errors.New(errContentMissing),
+			},
+		},
+		"RenamedSlugFromStatus": {
+			args: args{
+				wiki: &fake.MockClient{
+					MockGetWikiPage: func(pid any, s string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						if s != renamedWiki.Slug {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						return renamedWiki, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(
+					withDefaultSpec(),
+					withTitle(renamedWiki.Title),
+					withFormat(markdown),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(renamedWiki)),
+				),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withTitle(renamedWiki.Title),
+					withFormat(markdown),
+					withExternalName(renamedWiki.Slug),
+					withStatus(projects.GenerateWikiPageObservation(renamedWiki)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wiki}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: wikiPage(withTitle(title)),
+			},
+			want: want{
+				cr:  wikiPage(withTitle(title)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreationFromSecret": {
+			args: args{
+				wiki: &fake.MockClient{
+					MockCreateWikiPage: func(pid any, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						if *opt.Content != content {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return gitlabWiki, &gitlab.Response{}, nil
+					},
+				},
+				kube: contentSecret(content),
+				cr:   wikiPage(withDefaultSpec(), withContentSecretRef()),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withContentSecretRef(),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(gitlabWiki)),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				wiki: &fake.MockClient{
+					MockCreateWikiPage: func(pid any, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withDefaultSpec()),
+			},
+			want: want{
+				cr:  wikiPage(withDefaultSpec(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wiki}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"RenamesSlug": {
+			args: args{
+				wiki: &fake.MockClient{
+					MockEditWikiPage: func(pid any, s string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						if s != slug || *opt.Title != renamedWiki.Title {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return renamedWiki, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(
+					withDefaultSpec(),
+					withTitle(renamedWiki.Title),
+					withExternalName(slug),
+					withStatus(projects.GenerateWikiPageObservation(gitlabWiki)),
+				),
+			},
+			want: want{
+				cr: wikiPage(
+					withDefaultSpec(),
+					withTitle(renamedWiki.Title),
+					withExternalName(renamedWiki.Slug),
+					withStatus(projects.GenerateWikiPageObservation(renamedWiki)),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				wiki: &fake.MockClient{
+					MockEditWikiPage: func(pid any, s string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withDefaultSpec(), withExternalName(slug)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wiki}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteWikiPage := func(res *gitlab.Response, err error) *fake.MockClient {
+		return &fake.MockClient{
+			MockDeleteWikiPage: func(pid any, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				return res, err
+			},
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				wiki: deleteWikiPage(&gitlab.Response{}, nil),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(withDefaultSpec(), withExternalName(slug), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				wiki: deleteWikiPage(&gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(withDefaultSpec(), withExternalName(slug), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				wiki: deleteWikiPage(&gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:   wikiPage(withDefaultSpec(), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withDefaultSpec(), withExternalName(slug), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wiki}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}